@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// agcFrameDuration is the window over which the AGC measures loudness
+const agcFrameDuration = 10 * time.Millisecond
+
+// agcGateDb is the level below which audio is treated as silence: the gain
+// holds instead of boosting background noise
+const agcGateDb = -50.0
+
+// agc is an automatic gain control stage that steers each track toward a
+// common RMS loudness, so quiet TTS voices and loud sound effects land at a
+// similar level. Gain drops quickly (attack) and rises slowly (release).
+type agc struct {
+	targetDb   float64
+	maxGainDb  float64
+	channels   int
+	frameLen   int // samples per analysis frame across all channels
+	levelDb    float64
+	gainDb     float64
+	attackCoef float64
+	releaseDb  float64 // max gain increase per frame
+	lastGain   float64 // linear gain applied at the end of the previous frame
+}
+
+// newAGC creates an AGC for audio at sampleRate with the given layout
+func newAGC(targetDb, maxGainDb float64, sampleRate, channels int) *agc {
+	return &agc{
+		targetDb:   targetDb,
+		maxGainDb:  maxGainDb,
+		channels:   channels,
+		frameLen:   int(time.Duration(sampleRate)*agcFrameDuration/time.Second) * channels,
+		levelDb:    targetDb,
+		attackCoef: 0.5,
+		releaseDb:  0.05, // ~5 dB/s
+		lastGain:   1.0,
+	}
+}
+
+// process applies the AGC to interleaved samples in place
+func (a *agc) process(samples []int16) {
+	for start := 0; start < len(samples); start += a.frameLen {
+		end := min(start+a.frameLen, len(samples))
+		frame := samples[start:end]
+
+		level := frameLevelDb(frame)
+		if level > agcGateDb {
+			// Smooth the measured level so single transients don't pump
+			a.levelDb += (level - a.levelDb) * 0.3
+
+			want := math.Min(a.targetDb-a.levelDb, a.maxGainDb)
+			if want < a.gainDb {
+				a.gainDb += (want - a.gainDb) * a.attackCoef
+			} else {
+				a.gainDb = math.Min(a.gainDb+a.releaseDb, want)
+			}
+		}
+
+		gain := math.Pow(10, a.gainDb/20)
+		applyGainRamp(frame, a.channels, a.lastGain, gain)
+		a.lastGain = gain
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+// quietTone is a 48kHz tone well below the AGC target, which an enabled AGC
+// boosts
+func quietTone(n int) []int16 {
+	samples := make([]int16, n)
+	for i := range samples {
+		samples[i] = int16(300 * ((i/24)%2*2 - 1))
+	}
+	return samples
+}
+
+func TestSetTrackAGC(t *testing.T) {
+	tests := []struct {
+		name       string
+		agcDefault bool
+		set        []bool // SetTrackAGC calls, in order
+		wantAGC    bool
+	}{
+		{name: "default off", wantAGC: false},
+		{name: "default on", agcDefault: true, wantAGC: true},
+		{name: "turned off", agcDefault: true, set: []bool{false}, wantAGC: false},
+		{name: "turned on", set: []bool{true}, wantAGC: true},
+		{name: "on then off", set: []bool{true, false}, wantAGC: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := NewRoomSession("user", &Config{
+				SampleRate:   48000,
+				AGCEnabled:   tt.agcDefault,
+				AGCTargetDb:  -20,
+				AGCMaxGainDb: 12,
+			})
+			session.trackStates["speaker"] = &trackState{channels: 1, agc: session.newAGCLocked("speaker", 1)}
+			for _, enabled := range tt.set {
+				session.SetTrackAGC("", enabled)
+			}
+
+			in := quietTone(48000)
+			samples := slices.Clone(in)
+			session.applyTrackAGC("speaker", samples)
+
+			changed := !slices.Equal(samples, in)
+			if changed != tt.wantAGC {
+				t.Fatalf("samples changed = %v, want %v", changed, tt.wantAGC)
+			}
+
+			// A track created later, e.g. by the next playback, keeps the setting
+			if got := session.newAGCLocked("speaker", 1) != nil; got != tt.wantAGC {
+				t.Fatalf("recreated track has AGC = %v, want %v", got, tt.wantAGC)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// AMR storage format (RFC 4867) headers, which ffmpeg needs to find the
+// frames
+const (
+	amrMagic   = "#!AMR\n"
+	amrWBMagic = "#!AMR-WB\n"
+)
+
+// Rates AMR decodes at: narrowband and wideband speech
+const (
+	amrSampleRate   = 8000
+	amrWBSampleRate = 16000
+)
+
+// playAMR decodes and plays AMR-NB or AMR-WB speech with ffmpeg, as mono
+// at its own rate. Bare frames from a cellular gateway, without the
+// storage header, are given one.
+func (s *LiveKitBridgeService) playAMR(
+	ctx context.Context,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	wideband bool,
+) (int64, error) {
+	codec, magic, sampleRate := "AMR-NB", amrMagic, amrSampleRate
+	if wideband {
+		codec, magic, sampleRate = "AMR-WB", amrWBMagic, amrWBSampleRate
+	}
+
+	var r io.Reader = br
+	if header, _ := br.Peek(len(magic)); string(header) != magic {
+		r = io.MultiReader(strings.NewReader(magic), br)
+	}
+	return s.playFFmpeg(ctx, r, req, sink, trackName, codec, sampleRate, 1)
+}
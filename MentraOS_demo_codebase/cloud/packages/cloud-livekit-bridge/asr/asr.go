@@ -0,0 +1,361 @@
+package asr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	dialTimeout       = 10 * time.Second
+	writeTimeout      = 5 * time.Second
+	keepaliveInterval = 5 * time.Second  // how long without audio before a keepalive is sent
+	finishTimeout     = 3 * time.Second  // wait for final results after the audio ends
+	maxReconnectDelay = 30 * time.Second // cap on the exponential backoff
+)
+
+// Transcript is one result from the recognizer. Interim results are
+// revised by later ones until a final result covers the same audio.
+type Transcript struct {
+	Text       string
+	Final      bool
+	Start      time.Duration // from the first audio written to the stream
+	End        time.Duration
+	Confidence float64
+	Language   string
+}
+
+// Config for Forwarder
+type Config struct {
+	Provider       string // "deepgram" or "whisper"
+	URL            string // WebSocket endpoint (Deepgram's by default)
+	APIKey         string
+	Language       string // default language; a stream may override it
+	Model          string
+	Interim        bool // ask for interim results
+	SampleRate     int  // of the PCM16 mono audio written (default 16000)
+	QueueFrames    int  // audio chunks buffered per stream while the recognizer is slow or reconnecting
+	ReconnectDelay time.Duration
+	Enabled        bool
+}
+
+// Forwarder streams mic audio to a speech recognizer over WebSocket, one
+// connection per stream, and hands back transcripts. A disabled (or nil)
+// forwarder opens no streams.
+type Forwarder struct {
+	cfg     Config
+	dialect dialect
+	mu      sync.Mutex
+	streams map[*Stream]struct{}
+	wg      sync.WaitGroup
+}
+
+// New creates a forwarder for the configured provider. An unknown provider,
+// or one without an endpoint, is logged and leaves the forwarder disabled.
+func New(cfg Config) *Forwarder {
+	if cfg.SampleRate == 0 {
+		cfg.SampleRate = 16000
+	}
+	if cfg.QueueFrames == 0 {
+		cfg.QueueFrames = 500
+	}
+	if cfg.ReconnectDelay == 0 {
+		cfg.ReconnectDelay = time.Second
+	}
+
+	f := &Forwarder{cfg: cfg, streams: make(map[*Stream]struct{})}
+	if !cfg.Enabled {
+		return f
+	}
+
+	switch strings.ToLower(cfg.Provider) {
+	case "deepgram":
+		f.dialect = deepgram{}
+		if f.cfg.URL == "" {
+			f.cfg.URL = deepgramURL
+		}
+	case "whisper":
+		f.dialect = whisper{}
+	default:
+		slog.Error("ASR forwarding disabled: unknown provider", "provider", cfg.Provider)
+		f.cfg.Enabled = false
+		return f
+	}
+	if f.cfg.URL == "" {
+		slog.Error("ASR forwarding disabled: ASR_URL is required", "provider", cfg.Provider)
+		f.cfg.Enabled = false
+		return f
+	}
+
+	slog.Info("ASR forwarding enabled", "provider", cfg.Provider, "url", f.cfg.URL,
+		"language", cfg.Language, "model", cfg.Model, "interim", cfg.Interim)
+	return f
+}
+
+// NewFromEnv creates a forwarder from ASR_PROVIDER and friends; forwarding
+// is off unless ASR_PROVIDER is set
+func NewFromEnv() *Forwarder {
+	provider := os.Getenv("ASR_PROVIDER")
+	interim := true
+	if v, err := strconv.ParseBool(os.Getenv("ASR_INTERIM_RESULTS")); err == nil {
+		interim = v
+	}
+	queueFrames, _ := strconv.Atoi(os.Getenv("ASR_QUEUE_FRAMES"))
+	reconnectMs, _ := strconv.Atoi(os.Getenv("ASR_RECONNECT_DELAY_MS"))
+	return New(Config{
+		Provider:       provider,
+		URL:            os.Getenv("ASR_URL"),
+		APIKey:         os.Getenv("ASR_API_KEY"),
+		Language:       os.Getenv("ASR_LANGUAGE"),
+		Model:          os.Getenv("ASR_MODEL"),
+		Interim:        interim,
+		QueueFrames:    queueFrames,
+		ReconnectDelay: time.Duration(reconnectMs) * time.Millisecond,
+		Enabled:        provider != "",
+	})
+}
+
+// Enabled reports whether streams can be opened
+func (f *Forwarder) Enabled() bool {
+	return f != nil && f.cfg.Enabled
+}
+
+// Open starts a stream that forwards audio passed to Write and calls
+// onResult with each transcript, from one goroutine at a time. language
+// overrides the configured one when set. Returns nil when disabled.
+func (f *Forwarder) Open(log *slog.Logger, language string, onResult func(Transcript)) *Stream {
+	if !f.Enabled() {
+		return nil
+	}
+	if language == "" {
+		language = f.cfg.Language
+	}
+
+	s := &Stream{
+		forwarder: f,
+		log:       log.With("asr_provider", f.cfg.Provider),
+		language:  language,
+		onResult:  onResult,
+		audio:     make(chan []byte, f.cfg.QueueFrames),
+		stopCh:    make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	f.mu.Lock()
+	f.streams[s] = struct{}{}
+	f.mu.Unlock()
+	f.wg.Add(1)
+	go s.run()
+	return s
+}
+
+// Close closes any streams still open and waits for them to finish
+func (f *Forwarder) Close() {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	streams := make([]*Stream, 0, len(f.streams))
+	for s := range f.streams {
+		streams = append(streams, s)
+	}
+	f.mu.Unlock()
+
+	for _, s := range streams {
+		s.Close()
+	}
+	f.wg.Wait()
+}
+
+// Stream is one session's connection to the recognizer. It reconnects with
+// backoff when the connection drops; audio written while it is down is
+// buffered up to QueueFrames chunks and dropped beyond that.
+type Stream struct {
+	forwarder *Forwarder
+	log       *slog.Logger
+	language  string
+	onResult  func(Transcript)
+	audio     chan []byte
+	sent      int64 // bytes sent over all connections, for result offsets (run goroutine only)
+	dropped   atomic.Int64
+	stopCh    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Write queues PCM16 mono audio for the recognizer without blocking,
+// reporting false when the queue is full and the audio was dropped. The
+// stream keeps a reference to pcm.
+func (s *Stream) Write(pcm []byte) bool {
+	if s == nil || len(pcm) == 0 {
+		return true
+	}
+	select {
+	case s.audio <- pcm:
+		return true
+	default:
+		s.dropped.Add(1)
+		return false
+	}
+}
+
+// Dropped returns how many audio chunks were dropped on a full queue
+func (s *Stream) Dropped() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.dropped.Load()
+}
+
+// Close ends the audio, waits briefly for the last final results and
+// disconnects. Safe to call more than once.
+func (s *Stream) Close() {
+	if s == nil {
+		return
+	}
+	s.closeOnce.Do(func() {
+		close(s.stopCh)
+	})
+	<-s.done
+}
+
+// run keeps the stream connected until it is closed
+func (s *Stream) run() {
+	defer s.forwarder.wg.Done()
+	defer close(s.done)
+	defer func() {
+		s.forwarder.mu.Lock()
+		delete(s.forwarder.streams, s)
+		s.forwarder.mu.Unlock()
+	}()
+
+	delay := s.forwarder.cfg.ReconnectDelay
+	for {
+		connected, err := s.connect()
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+		if connected {
+			delay = s.forwarder.cfg.ReconnectDelay
+		}
+		s.log.Warn("ASR connection lost, reconnecting", "error", err, "delay", delay)
+
+		select {
+		case <-time.After(delay):
+		case <-s.stopCh:
+			return
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// connect runs one connection: audio out, results in. It reports whether
+// the connection was established, and why it ended.
+func (s *Stream) connect() (bool, error) {
+	cfg := s.forwarder.cfg
+	dialect := s.forwarder.dialect
+
+	url, header := dialect.endpoint(cfg, s.language)
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	cancel()
+	if err != nil {
+		return false, fmt.Errorf("failed to connect: %w", err)
+	}
+	if err := dialect.start(conn, cfg, s.language); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("failed to start: %w", err)
+	}
+	s.log.Info("ASR connected", "language", s.language)
+
+	// Results are timed from the start of this connection; shift them onto
+	// the stream's timeline
+	offset := time.Duration(s.sent/2) * time.Second / time.Duration(cfg.SampleRate)
+	readDone := make(chan struct{})
+	var readErr error
+	go func() {
+		defer close(readDone)
+		readErr = s.read(conn, offset)
+	}()
+	defer func() {
+		conn.Close()
+		<-readDone
+	}()
+
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer keepalive.Stop()
+	lastAudio := time.Now()
+	for {
+		select {
+		case pcm := <-s.audio:
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteMessage(websocket.BinaryMessage, pcm); err != nil {
+				return true, err
+			}
+			s.sent += int64(len(pcm))
+			lastAudio = time.Now()
+
+		case <-keepalive.C:
+			message := dialect.keepalive()
+			if message == nil || time.Since(lastAudio) < keepaliveInterval {
+				continue
+			}
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return true, err
+			}
+
+		case <-readDone:
+			return true, readErr
+
+		case <-s.stopCh:
+			// Tell the recognizer the audio has ended so it finalizes what
+			// it has heard, then give it a moment to answer
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := dialect.finish(conn); err == nil {
+				select {
+				case <-readDone:
+				case <-time.After(finishTimeout):
+				}
+			}
+			return true, nil
+		}
+	}
+}
+
+// read hands results to onResult until the connection ends
+func (s *Stream) read(conn *websocket.Conn, offset time.Duration) error {
+	dialect := s.forwarder.dialect
+	for {
+		_, message, err := conn.ReadMessage()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return errors.New("closed by the recognizer")
+		}
+		if err != nil {
+			return err
+		}
+
+		results, err := dialect.parse(message, s.language)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if result.Text == "" {
+				continue
+			}
+			result.Start += offset
+			result.End += offset
+			s.onResult(result)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package asr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialect is one recognizer's WebSocket protocol. Audio always goes out as
+// binary PCM16 messages; the rest differs per provider.
+type dialect interface {
+	// endpoint returns the URL to dial and the headers to send
+	endpoint(cfg Config, language string) (string, http.Header)
+	// start sends whatever the recognizer needs before the audio
+	start(conn *websocket.Conn, cfg Config, language string) error
+	// keepalive returns a text message that keeps an idle connection open
+	// (nil = none needed)
+	keepalive() []byte
+	// finish tells the recognizer no more audio is coming
+	finish(conn *websocket.Conn) error
+	// parse turns a message from the recognizer into transcripts (none for
+	// messages that aren't results); an error ends the connection
+	parse(message []byte, language string) ([]Transcript, error)
+}
+
+// seconds converts the recognizers' fractional seconds to a duration
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// deepgramURL is Deepgram's live transcription endpoint
+const deepgramURL = "wss://api.deepgram.com/v1/listen"
+
+// deepgram speaks Deepgram's live streaming API: settings in the query
+// string, results as "Results" messages
+type deepgram struct{}
+
+// endpoint implements dialect
+func (deepgram) endpoint(cfg Config, language string) (string, http.Header) {
+	query := url.Values{}
+	query.Set("encoding", "linear16")
+	query.Set("sample_rate", strconv.Itoa(cfg.SampleRate))
+	query.Set("channels", "1")
+	query.Set("interim_results", strconv.FormatBool(cfg.Interim))
+	query.Set("punctuate", "true")
+	if language != "" {
+		query.Set("language", language)
+	}
+	if cfg.Model != "" {
+		query.Set("model", cfg.Model)
+	}
+
+	header := http.Header{}
+	if cfg.APIKey != "" {
+		header.Set("Authorization", "Token "+cfg.APIKey)
+	}
+	return cfg.URL + "?" + query.Encode(), header
+}
+
+// start implements dialect; everything is in the URL
+func (deepgram) start(*websocket.Conn, Config, string) error {
+	return nil
+}
+
+// keepalive implements dialect; Deepgram closes connections idle for 10s
+func (deepgram) keepalive() []byte {
+	return []byte(`{"type":"KeepAlive"}`)
+}
+
+// finish implements dialect
+func (deepgram) finish(conn *websocket.Conn) error {
+	return conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"CloseStream"}`))
+}
+
+// deepgramMessage is the part of Deepgram's messages the bridge reads
+type deepgramMessage struct {
+	Type     string  `json:"type"`
+	Start    float64 `json:"start"`
+	Duration float64 `json:"duration"`
+	IsFinal  bool    `json:"is_final"`
+	Channel  struct {
+		Alternatives []struct {
+			Transcript string  `json:"transcript"`
+			Confidence float64 `json:"confidence"`
+		} `json:"alternatives"`
+	} `json:"channel"`
+	Description string `json:"description"` // on errors
+}
+
+// parse implements dialect
+func (deepgram) parse(message []byte, language string) ([]Transcript, error) {
+	var msg deepgramMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil
+	}
+	switch msg.Type {
+	case "Results":
+	case "Error":
+		return nil, fmt.Errorf("deepgram error: %s", msg.Description)
+	default:
+		return nil, nil
+	}
+	if len(msg.Channel.Alternatives) == 0 {
+		return nil, nil
+	}
+
+	best := msg.Channel.Alternatives[0]
+	return []Transcript{{
+		Text:       best.Transcript,
+		Final:      msg.IsFinal,
+		Start:      seconds(msg.Start),
+		End:        seconds(msg.Start + msg.Duration),
+		Confidence: best.Confidence,
+		Language:   language,
+	}}, nil
+}
+
+// whisper speaks a minimal protocol for self-hosted Whisper streaming
+// servers: a JSON config message first, then audio, then {"type":"end"};
+// results come back as {"text", "final", "start", "end", "language",
+// "confidence"} (times in seconds), or as {"error"}
+type whisper struct{}
+
+// endpoint implements dialect
+func (whisper) endpoint(cfg Config, _ string) (string, http.Header) {
+	header := http.Header{}
+	if cfg.APIKey != "" {
+		header.Set("Authorization", "Bearer "+cfg.APIKey)
+	}
+	return cfg.URL, header
+}
+
+// start implements dialect
+func (whisper) start(conn *websocket.Conn, cfg Config, language string) error {
+	config, err := json.Marshal(map[string]interface{}{
+		"type":            "config",
+		"sample_rate":     cfg.SampleRate,
+		"encoding":        "pcm_s16le",
+		"language":        language,
+		"model":           cfg.Model,
+		"interim_results": cfg.Interim,
+	})
+	if err != nil {
+		return err
+	}
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return conn.WriteMessage(websocket.TextMessage, config)
+}
+
+// keepalive implements dialect; the server waits for audio indefinitely
+func (whisper) keepalive() []byte {
+	return nil
+}
+
+// finish implements dialect
+func (whisper) finish(conn *websocket.Conn) error {
+	return conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"end"}`))
+}
+
+// whisperMessage is a result (or error) from a Whisper server
+type whisperMessage struct {
+	Text       string  `json:"text"`
+	Final      bool    `json:"final"`
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+	Error      string  `json:"error"`
+}
+
+// parse implements dialect
+func (whisper) parse(message []byte, language string) ([]Transcript, error) {
+	var msg whisperMessage
+	if err := json.Unmarshal(message, &msg); err != nil {
+		return nil, nil
+	}
+	if msg.Error != "" {
+		return nil, fmt.Errorf("whisper error: %s", msg.Error)
+	}
+	if msg.Language == "" {
+		msg.Language = language
+	}
+	return []Transcript{{
+		Text:       msg.Text,
+		Final:      msg.Final,
+		Start:      seconds(msg.Start),
+		End:        seconds(msg.End),
+		Confidence: msg.Confidence,
+		Language:   msg.Language,
+	}}, nil
+}
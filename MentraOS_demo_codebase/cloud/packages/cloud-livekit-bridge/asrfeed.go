@@ -0,0 +1,35 @@
+package main
+
+// Sample rates a session may publish and receive at
+const (
+	minSampleRate = 8000
+	maxSampleRate = 48000
+)
+
+// validSampleRate reports whether a session can run at rate: within what
+// LiveKit's Opus tracks carry, in whole samples per 10ms frame
+func validSampleRate(rate int) bool {
+	return rate >= minSampleRate && rate <= maxSampleRate && rate%100 == 0
+}
+
+// asrFeed returns received mic audio as the 16kHz mono copy the bridge
+// analyzes and SubscribeAudio's asr_feed streams, downsampling it with the
+// sender's own resampler when the session runs at another rate
+func (s *RoomSession) asrFeed(identity string, pcmData []byte) []byte {
+	if s.sampleRate == incomingSampleRate {
+		return pcmData
+	}
+
+	s.feedMu.Lock()
+	defer s.feedMu.Unlock()
+
+	resampler, exists := s.feedResamplers[identity]
+	if !exists {
+		resampler = NewResampler(s.resampleMode, s.sampleRate, incomingSampleRate, 1)
+		s.feedResamplers[identity] = resampler
+	}
+	samples := pooledSamples(pcmData)
+	defer putSamples(samples)
+
+	return int16ToBytes(resampler.Process(*samples))
+}
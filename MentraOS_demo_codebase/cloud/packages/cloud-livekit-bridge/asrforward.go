@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/asr"
+)
+
+// startASR opens the session's stream to the speech recognizer, so mic
+// audio is transcribed here rather than forwarded to the cloud for it.
+// Does nothing when the bridge has no recognizer configured.
+func (s *RoomSession) startASR(forwarder *asr.Forwarder, language string) {
+	if !forwarder.Enabled() {
+		s.log().Warn("ASR requested but ASR_PROVIDER is not configured")
+		return
+	}
+	s.asr = forwarder.Open(s.log(), language, s.emitTranscript)
+	s.log().Info("Forwarding mic audio to ASR", "language", language)
+}
+
+// emitTranscript reports a transcript as a session event. Interim results
+// go only to StreamEvents subscribers; final ones are kept in the session
+// history too.
+func (s *RoomSession) emitTranscript(transcript asr.Transcript) {
+	kind := "interim"
+	if transcript.Final {
+		kind = "final"
+	}
+	asrTranscripts.WithLabelValues(kind).Inc()
+
+	attributes := map[string]string{
+		"text":       transcript.Text,
+		"final":      strconv.FormatBool(transcript.Final),
+		"start_ms":   strconv.FormatInt(transcript.Start.Milliseconds(), 10),
+		"end_ms":     strconv.FormatInt(transcript.End.Milliseconds(), 10),
+		"confidence": strconv.FormatFloat(transcript.Confidence, 'f', 3, 64),
+		"language":   transcript.Language,
+	}
+	if transcript.Final {
+		s.emitEvent(EventTranscript, "", attributes)
+		s.heardSpeech(transcript.Text, "transcript")
+		return
+	}
+	s.events.publish(SessionEvent{
+		Type:       EventTranscript,
+		Timestamp:  time.Now(),
+		Attributes: attributes,
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// audioSubscriberBuffer is how many frames (10ms each) a slow audio
+// subscriber may lag behind before new frames are dropped for it
+const audioSubscriberBuffer = 200
+
+// AudioFilter selects which incoming audio an audio subscriber receives;
+// empty fields match everything
+type AudioFilter struct {
+	ParticipantIdentity string
+	TrackName           string
+}
+
+// matches reports whether a frame passes the filter
+func (f AudioFilter) matches(frame AudioFrame) bool {
+	if f.ParticipantIdentity != "" && f.ParticipantIdentity != frame.ParticipantIdentity {
+		return false
+	}
+	if f.TrackName != "" && f.TrackName != frame.TrackName {
+		return false
+	}
+	return true
+}
+
+// audioSubscriber is one SubscribeAudio stream and the frames it wants
+type audioSubscriber struct {
+	filter  AudioFilter
+	asrFeed bool // wants the 16kHz ASR feed rather than the session's rate
+	ch      chan AudioFrame
+	dropped int64
+}
+
+// audioFanout copies incoming mic audio to subscribers that asked for a
+// single participant or track, alongside the merged audioFromLiveKit
+// buffer. Publishing never blocks: a subscriber that falls behind misses
+// frames.
+type audioFanout struct {
+	userId      string
+	mu          sync.Mutex
+	subscribers map[int]*audioSubscriber
+	nextID      int
+	closed      bool
+}
+
+// newAudioFanout creates an audio fan-out with no subscribers
+func newAudioFanout(userId string) *audioFanout {
+	return &audioFanout{userId: userId, subscribers: make(map[int]*audioSubscriber)}
+}
+
+// subscribe registers a subscriber for frames matching filter, at the
+// session's rate or from the ASR feed; the channel closes when the fan-out
+// closes
+func (f *audioFanout) subscribe(filter AudioFilter, asrFeed bool) (int, <-chan AudioFrame) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan AudioFrame, audioSubscriberBuffer)
+	if f.closed {
+		close(ch)
+		return -1, ch
+	}
+
+	id := f.nextID
+	f.nextID++
+	f.subscribers[id] = &audioSubscriber{filter: filter, asrFeed: asrFeed, ch: ch}
+	return id, ch
+}
+
+// subscriberCount returns how many subscriber channels are open
+func (f *audioFanout) subscriberCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.subscribers)
+}
+
+// unsubscribe removes a subscriber and closes its channel
+func (f *audioFanout) unsubscribe(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if sub, exists := f.subscribers[id]; exists {
+		close(sub.ch)
+		delete(f.subscribers, id)
+	}
+}
+
+// publish delivers a frame, or its ASR feed copy to subscribers that asked
+// for that, to every matching subscriber that has room for it
+func (f *audioFanout) publish(frame, feed AudioFrame) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for id, sub := range f.subscribers {
+		if !sub.filter.matches(frame) {
+			continue
+		}
+		out := frame
+		if sub.asrFeed {
+			if len(feed.PCM) == 0 {
+				continue // the resampler or denoiser is still filling
+			}
+			out = feed
+		}
+		select {
+		case sub.ch <- out:
+		default:
+			sub.dropped++
+			framesDropped.WithLabelValues("incoming").Inc()
+			if sub.dropped%50 == 1 {
+				slog.Warn("Audio subscriber is full, dropping frames", "user_id", f.userId,
+					"subscriber", id, "participant", sub.filter.ParticipantIdentity,
+					"track", sub.filter.TrackName, "total_dropped", sub.dropped)
+			}
+		}
+	}
+}
+
+// close closes every subscriber channel; later publishes are no-ops
+func (f *audioFanout) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+	f.closed = true
+	for id, sub := range f.subscribers {
+		close(sub.ch)
+		delete(f.subscribers, id)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// errUnsupportedFormat is wrapped by the errors for audio the bridge can't
+// decode, so a bad format can be told apart from a failed fetch or playback
+var errUnsupportedFormat = errors.New("unsupported audio format")
+
+// Sample rates raw audio may be described at; it is resampled to the
+// session's rate as it plays
+const (
+	minRawSampleRate = 8000
+	maxRawSampleRate = 192000
+)
+
+// rawAudioFormat converts a raw audio format descriptor, filling in the
+// defaults (16-bit little-endian signed PCM, 16kHz mono), and refuses
+// combinations the bridge can't decode
+func rawAudioFormat(format *pb.AudioFormat, dataBytes int64) (wavFormat, error) {
+	f := wavFormat{
+		audioFormat:   wavFormatPCM,
+		channels:      int(format.Channels),
+		sampleRate:    int(format.SampleRate),
+		bitsPerSample: int(format.BitDepth),
+		bigEndian:     format.Endianness == pb.AudioFormat_BIG,
+		dataBytes:     dataBytes,
+	}
+	if f.channels == 0 {
+		f.channels = 1
+	}
+	if f.sampleRate == 0 {
+		f.sampleRate = incomingSampleRate
+	}
+
+	encoding := strings.ToLower(format.Encoding.String())
+	var depths []int
+	switch format.Encoding {
+	case pb.AudioFormat_SIGNED:
+		depths = []int{16, 8, 24, 32}
+	case pb.AudioFormat_UNSIGNED:
+		f.unsigned = true
+		depths = []int{16, 8, 24, 32}
+	case pb.AudioFormat_FLOAT:
+		f.audioFormat = wavFormatFloat
+		depths = []int{32, 64}
+	case pb.AudioFormat_MULAW:
+		f.audioFormat = wavFormatMuLaw
+		depths = []int{8}
+	case pb.AudioFormat_ALAW:
+		f.audioFormat = wavFormatALaw
+		depths = []int{8}
+	default:
+		return wavFormat{}, fmt.Errorf("%w: unknown encoding %d", errUnsupportedFormat, format.Encoding)
+	}
+	if f.bitsPerSample == 0 {
+		f.bitsPerSample = depths[0]
+	}
+
+	supported := false
+	for _, depth := range depths {
+		supported = supported || f.bitsPerSample == depth
+	}
+	switch {
+	case !supported:
+		return wavFormat{}, fmt.Errorf("%w: %d-bit %s", errUnsupportedFormat, f.bitsPerSample, encoding)
+	case f.channels != 1 && f.channels != 2:
+		return wavFormat{}, fmt.Errorf("%w: %d channels (only mono and stereo)", errUnsupportedFormat, f.channels)
+	case f.sampleRate < minRawSampleRate || f.sampleRate > maxRawSampleRate:
+		return wavFormat{}, fmt.Errorf("%w: sample rate %d (%d-%d)", errUnsupportedFormat,
+			f.sampleRate, minRawSampleRate, maxRawSampleRate)
+	}
+	return f, nil
+}
+
+// validateAudioFormat checks a request's raw audio format descriptor, if it
+// has one, before anything is interrupted for it
+func validateAudioFormat(req *pb.PlayAudioRequest) error {
+	if req.Format == nil {
+		return nil
+	}
+	if req.Tone != nil {
+		return fmt.Errorf("format can't be combined with tone")
+	}
+	if req.Passthrough {
+		return fmt.Errorf("format can't be played with passthrough")
+	}
+	_, err := rawAudioFormat(req.Format, -1)
+	return err
+}
+
+// requestAudioFormat picks the decoder for a request's audio: raw samples
+// when it carries a format descriptor, an AU file when it has the header,
+// raw samples again for a raw content type such as audio/PCMU (filling in
+// the descriptor), otherwise what the content type, URL or first bytes say
+func requestAudioFormat(req *pb.PlayAudioRequest, contentType string, br *bufio.Reader) string {
+	if req.Format != nil {
+		return "raw"
+	}
+	if magic, _ := br.Peek(4); string(magic) == ".snd" {
+		return "au"
+	}
+	if req.Format = rawContentTypeFormat(contentType, req.AudioUrl, 0, 0); req.Format != nil {
+		return "raw"
+	}
+	return detectAudioFormat(contentType, strings.ToLower(req.AudioUrl), br)
+}
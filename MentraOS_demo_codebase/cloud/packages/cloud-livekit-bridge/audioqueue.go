@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// playoutPollInterval is how often a queued clip checks whether its audio
+// has played out
+const playoutPollInterval = 20 * time.Millisecond
+
+// errRemovedFromQueue ends a queued clip that was cleared before its turn
+var errRemovedFromQueue = errors.New("removed from queue")
+
+// queuedAudio is one EnqueueAudio clip waiting for, or holding, its track
+type queuedAudio struct {
+	req     *pb.PlayAudioRequest
+	turn    chan struct{} // closed when the clip may start writing to the track
+	removed chan struct{} // closed if the queue is cleared before its turn
+}
+
+// audioQueue orders the clips enqueued on one track. Only one clip writes
+// to the track at a time; the next starts as soon as it has written its
+// last sample, not once that sample has played, so the track's player
+// joins them without a gap.
+type audioQueue struct {
+	mu      sync.Mutex
+	writing *queuedAudio // clip currently writing to the track
+	waiting []*queuedAudio
+}
+
+// audioQueue returns the playback queue for a track, creating it if needed
+func (s *RoomSession) audioQueue(trackName string) *audioQueue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, exists := s.audioQueues[trackName]
+	if !exists {
+		queue = &audioQueue{}
+		s.audioQueues[trackName] = queue
+	}
+	return queue
+}
+
+// push adds a clip to the back of the queue; it gets its turn at once when
+// the queue is idle
+func (q *audioQueue) push(req *pb.PlayAudioRequest) *queuedAudio {
+	item := &queuedAudio{req: req, turn: make(chan struct{}), removed: make(chan struct{})}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.writing == nil && len(q.waiting) == 0 {
+		q.writing = item
+		close(item.turn)
+	} else {
+		q.waiting = append(q.waiting, item)
+	}
+	return item
+}
+
+// done releases the track once item has written all its audio (or failed)
+// and gives the next clip its turn. A clip that gave up while waiting just
+// leaves the queue. Safe to call more than once.
+func (q *audioQueue) done(item *queuedAudio) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.writing != item {
+		for i, waiting := range q.waiting {
+			if waiting == item {
+				q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	q.writing = nil
+	if len(q.waiting) > 0 {
+		q.writing = q.waiting[0]
+		q.waiting = q.waiting[1:]
+		close(q.writing.turn)
+	}
+}
+
+// clear removes every waiting clip and returns their request IDs; the clip
+// already writing is left alone
+func (q *audioQueue) clear() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	cleared := make([]string, 0, len(q.waiting))
+	for _, item := range q.waiting {
+		close(item.removed)
+		cleared = append(cleared, item.req.RequestId)
+	}
+	q.waiting = nil
+	return cleared
+}
+
+// move places a waiting clip at position among the waiting clips
+func (q *audioQueue) move(requestID string, position int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	from := -1
+	for i, item := range q.waiting {
+		if item.req.RequestId == requestID {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		if q.writing != nil && q.writing.req.RequestId == requestID {
+			return fmt.Errorf("request %q is already playing", requestID)
+		}
+		return fmt.Errorf("request %q is not queued", requestID)
+	}
+
+	item := q.waiting[from]
+	q.waiting = append(q.waiting[:from], q.waiting[from+1:]...)
+	position = max(0, min(position, len(q.waiting)))
+	q.waiting = append(q.waiting[:position], append([]*queuedAudio{item}, q.waiting[position:]...)...)
+	return nil
+}
+
+// waitingCount returns how many clips are waiting behind the current one
+func (q *audioQueue) waitingCount() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.waiting)
+}
+
+// list describes the queue in play order for the RPC response
+func (q *audioQueue) list() []*pb.QueuedAudio {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	items := make([]*pb.QueuedAudio, 0, len(q.waiting)+1)
+	if q.writing != nil {
+		items = append(items, &pb.QueuedAudio{
+			RequestId: q.writing.req.RequestId,
+			AudioUrl:  q.writing.req.AudioUrl,
+			Playing:   true,
+		})
+	}
+	for _, item := range q.waiting {
+		items = append(items, &pb.QueuedAudio{RequestId: item.req.RequestId, AudioUrl: item.req.AudioUrl})
+	}
+	return items
+}
+
+// pending returns the requests of the clip writing (unless it is skip) and
+// the clips waiting behind it, in play order
+func (q *audioQueue) pending(skip string) []*pb.PlayAudioRequest {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	reqs := make([]*pb.PlayAudioRequest, 0, len(q.waiting)+1)
+	if q.writing != nil && q.writing.req.RequestId != skip {
+		reqs = append(reqs, q.writing.req)
+	}
+	for _, item := range q.waiting {
+		reqs = append(reqs, item.req)
+	}
+	return reqs
+}
+
+// clearAudioQueuesLocked empties the queues of matching tracks (every track
+// when match is nil), for when their playback is interrupted; caller must
+// hold s.mu
+func (s *RoomSession) clearAudioQueuesLocked(match func(string) bool) {
+	for trackName, queue := range s.audioQueues {
+		if !matches(match, trackName) {
+			continue
+		}
+		if cleared := queue.clear(); len(cleared) > 0 {
+			s.log().Info("Cleared audio queue", "track_name", trackName, "request_ids", cleared)
+		}
+	}
+}
+
+// deferredPlayoutKey marks a playback context whose decoder should return as
+// soon as its audio is queued rather than once it has played out
+type deferredPlayoutKey struct{}
+
+// deferPlayout makes the decoders under ctx skip their final playout wait,
+// so the next queued clip can be written right behind this one
+func deferPlayout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deferredPlayoutKey{}, true)
+}
+
+// playoutDeferred reports whether ctx came from deferPlayout
+func playoutDeferred(ctx context.Context) bool {
+	deferred, _ := ctx.Value(deferredPlayoutKey{}).(bool)
+	return deferred
+}
+
+// playoutMark is the point in a track's output where the audio queued so
+// far ends, counted in frames (PCM) or packets (Opus) written to the track
+type playoutMark struct {
+	activity      *trackActivity
+	closed        <-chan struct{} // closed if the player or track goes away first
+	frames        int64
+	lead          time.Duration // output buffered past the last write
+	frameDuration time.Duration // 0 when frames vary in length (Opus)
+}
+
+// playoutMark marks the end of what is currently queued on a track; nil if
+// the track doesn't exist
+func (s *RoomSession) playoutMark(trackName string) *playoutMark {
+	s.mu.RLock()
+	var player *trackPlayer
+	if state, exists := s.trackStates[trackName]; exists {
+		player = state.player
+	}
+	opus := s.opusTracks[trackName]
+	s.mu.RUnlock()
+
+	switch {
+	case player != nil:
+		return &playoutMark{
+			activity:      player.activity,
+			closed:        player.closing,
+			frames:        player.activity.framesWritten.Load() + int64(player.queuedFrames()),
+			lead:          playbackLeadFrames * playbackFrameDuration,
+			frameDuration: playbackFrameDuration,
+		}
+	case opus != nil:
+		return &playoutMark{
+			activity: opus.activity,
+			closed:   opus.ctx.Done(),
+			frames:   opus.activity.framesWritten.Load() + int64(len(opus.frames)),
+		}
+	}
+	return nil
+}
+
+// wait blocks until the track has played out up to the mark. Returns
+// errPlayerClosed if the audio was flushed or the track closed first.
+func (m *playoutMark) wait(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(playoutPollInterval)
+	defer ticker.Stop()
+
+	for m.activity.framesWritten.Load() < m.frames {
+		select {
+		case <-ticker.C:
+		case <-m.closed:
+			return errPlayerClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case <-time.After(m.lead):
+		return nil
+	case <-m.closed:
+		return errPlayerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// since returns the audio length between an earlier mark and this one; 0
+// when it can't be measured in frames
+func (m *playoutMark) since(start *playoutMark) time.Duration {
+	if m == nil || m.frameDuration == 0 {
+		return 0
+	}
+	var base int64
+	if start != nil && start.activity == m.activity {
+		base = start.frames
+	}
+	return time.Duration(m.frames-base) * m.frameDuration
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// audioRing keeps the last few seconds of one source's audio, overwriting
+// the oldest samples as new ones arrive
+type audioRing struct {
+	sampleRate int
+	channels   int
+	samples    []int16
+	next       int  // where the next sample goes
+	full       bool // samples has wrapped at least once
+}
+
+// newAudioRing creates a ring holding duration of audio
+func newAudioRing(duration time.Duration, sampleRate, channels int) *audioRing {
+	size := int(duration.Seconds()*float64(sampleRate)) * channels
+	return &audioRing{sampleRate: sampleRate, channels: channels, samples: make([]int16, max(size, channels))}
+}
+
+// write appends samples, dropping the oldest to make room
+func (r *audioRing) write(samples []int16) {
+	if len(samples) >= len(r.samples) {
+		copy(r.samples, samples[len(samples)-len(r.samples):])
+		r.next, r.full = 0, true
+		return
+	}
+	n := copy(r.samples[r.next:], samples)
+	if n < len(samples) {
+		copy(r.samples, samples[n:])
+		r.full = true
+	}
+	r.next = (r.next + len(samples)) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// contents returns the buffered audio, oldest first
+func (r *audioRing) contents() []int16 {
+	if !r.full {
+		return append([]int16(nil), r.samples[:r.next]...)
+	}
+	out := make([]int16, 0, len(r.samples))
+	out = append(out, r.samples[r.next:]...)
+	return append(out, r.samples[:r.next]...)
+}
+
+// audioRings is a session's always-on diagnostic buffer: the last duration
+// of audio from each participant and to each published PCM track, ready to
+// be dumped when a user reports a problem after the fact
+type audioRings struct {
+	duration time.Duration // 0 = off
+
+	mu    sync.Mutex
+	rings map[string]*audioRing // by source ("incoming-<identity>", "track-<name>")
+}
+
+// newAudioRings creates the buffer; a zero duration disables it
+func newAudioRings(duration time.Duration) *audioRings {
+	return &audioRings{duration: duration, rings: make(map[string]*audioRing)}
+}
+
+// write adds a source's samples, starting its ring on first use (or again
+// if its format changed)
+func (a *audioRings) write(source string, samples []int16, sampleRate, channels int) {
+	if a.duration <= 0 || len(samples) == 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ring, ok := a.rings[source]
+	if !ok || ring.sampleRate != sampleRate || ring.channels != channels {
+		ring = newAudioRing(a.duration, sampleRate, channels)
+		a.rings[source] = ring
+	}
+	ring.write(samples)
+}
+
+// dump writes every ring to a WAV file under dir and returns the files
+func (a *audioRings) dump(dir string) ([]string, error) {
+	type copied struct {
+		source     string
+		samples    []int16
+		sampleRate int
+		channels   int
+	}
+
+	a.mu.Lock()
+	sources := make([]copied, 0, len(a.rings))
+	for source, ring := range a.rings {
+		sources = append(sources, copied{source, ring.contents(), ring.sampleRate, ring.channels})
+	}
+	a.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dump directory: %w", err)
+	}
+	var files []string
+	for _, source := range sources {
+		path := filepath.Join(dir, recordingName(source.source)+".wav")
+		file, err := newWAVFile(path, source.sampleRate, source.channels)
+		if err != nil {
+			return files, err
+		}
+		err = file.write(source.samples)
+		if closeErr := file.close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return files, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// DumpAudio writes the last AUDIO_RING_BUFFER_MS of a session's incoming
+// and published audio to WAV files, for diagnosing problems after the fact
+func (s *LiveKitBridgeService) DumpAudio(
+	ctx context.Context,
+	req *pb.DumpAudioRequest,
+) (*pb.DumpAudioResponse, error) {
+	slog.Info("DumpAudio request", "user_id", req.UserId, "reason", req.Reason)
+
+	if s.config.AudioRingDuration <= 0 {
+		return &pb.DumpAudioResponse{
+			Success: false,
+			Error:   "audio ring buffer is disabled (AUDIO_RING_BUFFER_MS=0)",
+		}, nil
+	}
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.DumpAudioResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	dir := filepath.Join(s.config.AudioDumpDir, recordingName(session.userId), time.Now().UTC().Format("20060102T150405.000Z"))
+	files, err := session.audioRings.dump(dir)
+	if err != nil {
+		session.log().Warn("Failed to dump audio", "directory", dir, "error", err)
+		return &pb.DumpAudioResponse{
+			Success: false,
+			Error:   err.Error(),
+			Files:   files,
+		}, nil
+	}
+	session.log().Info("Dumped audio ring buffer", "directory", dir, "files", len(files))
+
+	return &pb.DumpAudioResponse{
+		Success:   true,
+		Directory: dir,
+		Files:     files,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BargeInAction is what the bridge does to playing tracks when the user
+// starts talking over them
+type BargeInAction string
+
+const (
+	BargeInNone BargeInAction = "none" // only emit the event
+	BargeInDuck BargeInAction = "duck" // lower the tracks until the user stops talking
+	BargeInStop BargeInAction = "stop" // stop the tracks
+)
+
+// parseBargeInAction maps a config string to a BargeInAction (default none)
+func parseBargeInAction(action string) BargeInAction {
+	switch BargeInAction(strings.ToLower(strings.TrimSpace(action))) {
+	case BargeInDuck:
+		return BargeInDuck
+	case BargeInStop:
+		return BargeInStop
+	default:
+		return BargeInNone
+	}
+}
+
+// bargeInDetector watches the user's incoming audio for speech that starts
+// while one of the watched tracks (typically TTS) is playing
+type bargeInDetector struct {
+	tracks    []string
+	action    BargeInAction
+	duckLevel float64
+	ramp      time.Duration
+
+	mu     sync.Mutex
+	vad    *vad
+	ducked []string // tracks lowered by the current barge-in
+}
+
+// newBargeInDetector builds a detector from config; returns nil when disabled
+func newBargeInDetector(config *Config) *bargeInDetector {
+	if !config.BargeInEnabled {
+		return nil
+	}
+
+	tracks := config.BargeInTracks
+	if len(tracks) == 0 {
+		tracks = []string{"tts"}
+	}
+
+	return &bargeInDetector{
+		tracks:    tracks,
+		action:    parseBargeInAction(config.BargeInAction),
+		duckLevel: math.Pow(10, -config.BargeInDuckDb/20),
+		ramp:      config.DuckRamp,
+		vad:       newVAD(config.VADThresholdDb, config.VADMinSpeech, config.VADHangover),
+	}
+}
+
+// processIncomingAudio runs barge-in detection on PCM received from LiveKit
+func (s *RoomSession) processIncomingAudio(pcmData []byte) {
+	b := s.bargeIn
+	if b == nil {
+		return
+	}
+
+	samples := pooledSamples(pcmData)
+	defer putSamples(samples)
+
+	b.mu.Lock()
+	started, ended := b.vad.process(*samples)
+	var restore []string
+	if ended {
+		restore = b.ducked
+		b.ducked = nil
+	}
+	b.mu.Unlock()
+
+	if started {
+		s.handleBargeIn(b)
+	}
+	for _, trackName := range restore {
+		if player := s.trackPlayer(trackName); player != nil {
+			player.setDuck(s.duckLevel(trackName), b.ramp)
+		}
+	}
+}
+
+// handleBargeIn emits a barge-in event for the watched tracks that are
+// playing and applies the configured action to them
+func (s *RoomSession) handleBargeIn(b *bargeInDetector) {
+	var playing []string
+	for _, trackName := range b.tracks {
+		if player := s.trackPlayer(trackName); player != nil && player.isActive() {
+			playing = append(playing, trackName)
+		}
+	}
+	if len(playing) == 0 {
+		return
+	}
+
+	s.log().Info("Barge-in detected", "track_name", playing[0], "playing", playing, "action", b.action)
+	s.emitEvent(EventBargeIn, playing[0], map[string]string{
+		"tracks": strings.Join(playing, ","),
+		"action": string(b.action),
+	})
+
+	switch b.action {
+	case BargeInDuck:
+		for _, trackName := range playing {
+			if player := s.trackPlayer(trackName); player != nil {
+				player.setDuck(b.duckLevel, b.ramp)
+			}
+		}
+		b.mu.Lock()
+		b.ducked = append(b.ducked, playing...)
+		b.mu.Unlock()
+	case BargeInStop:
+		for _, trackName := range playing {
+			s.stopTrackPlayback(trackName)
+		}
+	}
+}
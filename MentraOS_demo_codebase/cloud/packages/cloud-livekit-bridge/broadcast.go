@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// broadcastLead is how far decoding may run ahead of real time
+const broadcastLead = time.Second
+
+// broadcastQueue is how many decoded chunks a room may have waiting before
+// the ones it can't keep up with are dropped
+const broadcastQueue = 256
+
+// errBroadcastEnded is returned to the decoder once no room is left playing
+var errBroadcastEnded = errors.New("broadcast stopped in every room")
+
+// broadcast is the sink a shared decode writes to: every chunk is copied to
+// each room's queue and written to its track by a goroutine of its own, so
+// rooms pace independently and one that stalls only loses its own audio
+type broadcast struct {
+	requestID string
+	trackName string
+	logger    *slog.Logger
+	rooms     []*broadcastRoom
+
+	start   time.Time     // when decoding started, for pacing
+	decoded time.Duration // audio handed to the rooms so far
+}
+
+// broadcastRoom is one session's share of a broadcast
+type broadcastRoom struct {
+	session  *RoomSession
+	result   *pb.BroadcastResult
+	chunks   chan broadcastChunk
+	playback *runningPlayback
+	done     chan struct{} // closed once the room has finished; err is set by then
+	err      error
+	dropped  time.Duration // written by the decoder only
+}
+
+// broadcastChunk is a piece of decoded audio, copied for one room
+type broadcastChunk struct {
+	samples    []int16
+	sampleRate int
+	channels   int
+}
+
+// join adds a session to the broadcast: its track's playback is stopped as
+// PlayAudio would, and the room's writer starts waiting for audio
+func (b *broadcast) join(ctx context.Context, session *RoomSession, req *pb.PlayAudioRequest, result *pb.BroadcastResult) {
+	if !interruptPlayback(ctx, session, req) {
+		session.stopTrackPlayback(b.trackName)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	room := &broadcastRoom{
+		session: session,
+		result:  result,
+		chunks:  make(chan broadcastChunk, broadcastQueue),
+		done:    make(chan struct{}),
+	}
+	// Registered with the session, so StopAudio stops this room alone
+	room.playback = session.startPlayback(b.trackName, b.requestID, cancel, room.done)
+	b.rooms = append(b.rooms, room)
+
+	emitPlaybackEvent(session, b.trackName, &pb.PlayAudioEvent{
+		Type:      pb.PlayAudioEvent_STARTED,
+		RequestId: b.requestID,
+	})
+	session.spawn("broadcast", func() { b.runRoom(ctx, cancel, room) })
+}
+
+// runRoom writes a room's queued audio to its track until the broadcast
+// ends (then waits for it to play out), the room is stopped or the session
+// closes
+func (b *broadcast) runRoom(ctx context.Context, cancel context.CancelFunc, room *broadcastRoom) {
+	defer close(room.done)
+	defer room.session.endPlayback(room.playback)
+	defer cancel()
+
+	for {
+		select {
+		case chunk, ok := <-room.chunks:
+			if !ok {
+				room.err = room.session.waitForTrackPlayout(ctx, b.trackName)
+				return
+			}
+			if err := room.session.writeSamplesToTrack(ctx, chunk.samples, b.trackName, chunk.sampleRate, chunk.channels); err != nil {
+				room.err = err
+				return
+			}
+		case <-ctx.Done():
+			room.err = ctx.Err()
+			return
+		}
+	}
+}
+
+// writeSamplesToTrack hands decoded audio to every room still playing,
+// holding the decoder back to broadcastLead ahead of real time
+func (b *broadcast) writeSamplesToTrack(ctx context.Context, samples []int16, _ string, sampleRate, channels int) error {
+	if b.start.IsZero() {
+		b.start = time.Now()
+	}
+	if wait := time.Until(b.start.Add(b.decoded - broadcastLead)); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+	duration := time.Duration(len(samples)/channels) * time.Second / time.Duration(sampleRate)
+	b.decoded += duration
+
+	playing := 0
+	for _, room := range b.rooms {
+		select {
+		case <-room.done:
+			continue
+		default:
+		}
+		playing++
+
+		// Each room gets its own copy: the decoder reuses its buffer and
+		// a track's AGC works in place
+		chunk := broadcastChunk{
+			samples:    append([]int16(nil), samples...),
+			sampleRate: sampleRate,
+			channels:   channels,
+		}
+		select {
+		case room.chunks <- chunk:
+		default:
+			room.dropped += duration
+			framesDropped.WithLabelValues("broadcast").Inc()
+		}
+	}
+	if playing == 0 {
+		return errBroadcastEnded
+	}
+	return nil
+}
+
+// waitForTrackPlayout ends the stream and waits for every room to play out
+// what it has queued; it fails only when no room succeeded
+func (b *broadcast) waitForTrackPlayout(ctx context.Context, _ string) error {
+	for _, room := range b.rooms {
+		close(room.chunks)
+	}
+
+	var err error
+	played := false
+	for _, room := range b.rooms {
+		select {
+		case <-room.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if room.err == nil {
+			played = true
+		} else if err == nil {
+			err = room.err
+		}
+	}
+	if played {
+		return nil
+	}
+	return err
+}
+
+// log returns the broadcast's logger
+func (b *broadcast) log() *slog.Logger {
+	return b.logger
+}
+
+// finish waits for every room to stop, fills in its result and emits its
+// playback outcome; err is the decode's, for rooms cut short by it
+func (b *broadcast) finish(duration int64, err error) {
+	cutShort := err != nil && !errors.Is(err, errBroadcastEnded)
+	for _, room := range b.rooms {
+		<-room.done
+
+		roomErr := room.err
+		if cutShort && (roomErr == nil || errors.Is(roomErr, context.Canceled)) {
+			roomErr = err
+		}
+		room.result.Success = roomErr == nil
+		room.result.DroppedMs = room.dropped.Milliseconds()
+
+		event := &pb.PlayAudioEvent{
+			Type:      playbackOutcome(roomErr),
+			RequestId: b.requestID,
+		}
+		if roomErr != nil {
+			room.result.Error = roomErr.Error()
+			event.Error = roomErr.Error()
+		} else {
+			event.DurationMs = duration
+		}
+		emitPlaybackEvent(room.session, b.trackName, event)
+	}
+}
+
+// decodeBroadcast fetches (or reads inline) the request's audio and decodes
+// it once into the broadcast
+func (s *LiveKitBridgeService) decodeBroadcast(ctx context.Context, req *pb.PlayAudioRequest, b *broadcast) (int64, error) {
+	var body io.Reader
+	contentType := strings.ToLower(req.ContentType)
+	if len(req.AudioData) > 0 {
+		body = bytes.NewReader(req.AudioData)
+	} else {
+		if req.AudioUrl == "" {
+			return 0, fmt.Errorf("audio_url or audio_data is required")
+		}
+		httpStream, err := openHTTPStream(ctx, req.AudioUrl, s.config.StreamRetries, b.log())
+		if err != nil {
+			return 0, err
+		}
+		defer httpStream.Close()
+
+		body = httpStream
+		if contentType == "" {
+			contentType = httpStream.contentType
+		}
+	}
+
+	br := bufio.NewReader(body)
+	format := requestAudioFormat(req, contentType, br)
+	if format == "" {
+		return 0, fmt.Errorf("%w: %s", errUnsupportedFormat, contentType)
+	}
+	b.log().Info("Broadcasting audio", "rooms", len(b.rooms), "url", req.AudioUrl,
+		"inline_bytes", len(req.AudioData), "format", format)
+
+	return s.decodeAudio(ctx, format, br, req, b, b.trackName, nil)
+}
+
+// Broadcast plays one clip on a track in many sessions' rooms, decoding it
+// once; sessions that can't take part are reported in the results
+func (s *LiveKitBridgeService) Broadcast(
+	ctx context.Context,
+	req *pb.BroadcastRequest,
+) (*pb.BroadcastResponse, error) {
+	if req.RequestId == "" {
+		req.RequestId = fmt.Sprintf("broadcast-%d", time.Now().UnixNano())
+	}
+	slog.Info("Broadcast request", "request_id", req.RequestId, "users", len(req.UserIds),
+		"track_name", req.TrackName, "app_id", req.AppId, "url", req.AudioUrl)
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.BroadcastResponse{
+			Success:   false,
+			Error:     err.Error(),
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	play := &pb.PlayAudioRequest{
+		RequestId:   req.RequestId,
+		AppId:       req.AppId,
+		TrackName:   req.TrackName,
+		AudioUrl:    req.AudioUrl,
+		AudioData:   req.AudioData,
+		ContentType: req.ContentType,
+		Volume:      req.Volume,
+		StopOther:   req.StopOther,
+		Format:      req.Format,
+	}
+	if err := validateAudioFormat(play); err != nil {
+		return &pb.BroadcastResponse{
+			Success:   false,
+			Error:     err.Error(),
+			RequestId: req.RequestId,
+		}, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	b := &broadcast{
+		requestID: req.RequestId,
+		trackName: trackName,
+		logger:    slog.With("request_id", req.RequestId, "track_name", trackName),
+	}
+	results := make([]*pb.BroadcastResult, len(req.UserIds))
+	joined := make(map[string]bool, len(req.UserIds))
+	for i, userId := range req.UserIds {
+		results[i] = &pb.BroadcastResult{UserId: userId}
+		if joined[userId] {
+			results[i].Error = "user listed more than once"
+			continue
+		}
+
+		session, ok := s.sessions.Load(userId)
+		if !ok {
+			results[i].Error = fmt.Sprintf("session not found for user %s", userId)
+			continue
+		}
+		session.touch()
+		if err := s.daily.check(ctx, session, trackName); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		b.join(ctx, session, play, results[i])
+		joined[userId] = true
+	}
+	if len(b.rooms) == 0 {
+		return &pb.BroadcastResponse{
+			Success:   false,
+			Error:     "no session to broadcast to",
+			RequestId: req.RequestId,
+			Results:   results,
+		}, nil
+	}
+
+	duration, err := s.decodeBroadcast(ctx, play, b)
+	if err != nil {
+		cancel()
+	}
+	b.finish(duration, err)
+
+	played := 0
+	for _, result := range results {
+		if result.Success {
+			played++
+		}
+	}
+	b.log().Info("Broadcast finished", "rooms", len(b.rooms), "played", played, "duration_ms", duration)
+
+	resp := &pb.BroadcastResponse{
+		Success:    played > 0,
+		RequestId:  req.RequestId,
+		DurationMs: duration,
+		Results:    results,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	} else if played == 0 {
+		resp.Error = "no room played the broadcast"
+	}
+	return resp, nil
+}
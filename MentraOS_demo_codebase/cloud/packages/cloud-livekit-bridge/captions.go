@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+)
+
+// captionPollInterval is how often captions check the playback clock
+const captionPollInterval = 20 * time.Millisecond
+
+// captionMaxWords caps a caption segment that has no sentence break
+const captionMaxWords = 12
+
+// errNoRoom is returned when publishing needs a room the session isn't in
+var errNoRoom = errors.New("not connected to a room")
+
+// playbackClock measures how much of one request's audio a track has played
+// out, from the frames its player has written since the request began
+type playbackClock struct {
+	session   *RoomSession
+	trackName string
+	player    *trackPlayer
+	base      int64 // frames written or already queued before this request's audio
+}
+
+// newPlaybackClock starts a clock for audio about to be written to trackName
+func (s *RoomSession) newPlaybackClock(trackName string) *playbackClock {
+	c := &playbackClock{session: s, trackName: trackName}
+	if player := s.trackPlayer(trackName); player != nil {
+		c.player = player
+		c.base = player.activity.framesWritten.Load() + int64(player.queuedFrames())
+	}
+	return c
+}
+
+// position returns how much of the request's audio has been heard; it stands
+// still while the track is paused or held for a reconnect
+func (c *playbackClock) position() time.Duration {
+	player := c.session.trackPlayer(c.trackName)
+	if player == nil {
+		return 0
+	}
+	if player != c.player {
+		// Created (or recreated) for this request: everything it wrote is ours
+		c.player, c.base = player, 0
+	}
+
+	// The player runs playbackLeadFrames ahead of what is audible
+	frames := player.activity.framesWritten.Load() - c.base - playbackLeadFrames
+	if frames < 0 {
+		return 0
+	}
+	return time.Duration(frames) * playbackFrameDuration
+}
+
+// captionMessage is the JSON published on the caption topic. Each spoken
+// word republishes its segment with word_index advanced, so clients can
+// highlight karaoke-style; the last message of a segment has final set.
+type captionMessage struct {
+	RequestID string   `json:"request_id"`
+	TrackName string   `json:"track_name"`
+	SegmentID int      `json:"segment_id"`
+	Text      string   `json:"text"`
+	Words     []string `json:"words"`
+	WordIndex int      `json:"word_index"` // last word spoken so far
+	StartMs   int64    `json:"start_ms"`
+	EndMs     int64    `json:"end_ms"`
+	Final     bool     `json:"final"`
+}
+
+// captionSegments groups word timings into segments, breaking after
+// sentence punctuation or captionMaxWords words
+func captionSegments(words []*pb.WordTiming) [][]*pb.WordTiming {
+	var segments [][]*pb.WordTiming
+	var current []*pb.WordTiming
+	for _, word := range words {
+		text := strings.TrimSpace(word.Word)
+		if text == "" {
+			continue
+		}
+		current = append(current, word)
+		if len(current) >= captionMaxWords || strings.ContainsAny(text[len(text)-1:], ".?!") {
+			segments = append(segments, current)
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		segments = append(segments, current)
+	}
+	return segments
+}
+
+// captionPublisher publishes a request's captions as its audio plays out
+type captionPublisher struct {
+	session   *RoomSession
+	topic     string
+	requestID string
+	trackName string
+	clock     *playbackClock
+	segments  [][]*pb.WordTiming
+	finishing chan struct{} // closed once the audio has finished playing
+	done      chan struct{} // closed when run returns
+}
+
+// startCaptions publishes captions for word timings in step with the audio
+// about to be written to trackName; returns nil when there are none. The
+// publisher stops without finishing when ctx ends.
+func (s *RoomSession) startCaptions(ctx context.Context, topic, requestID, trackName string, words []*pb.WordTiming) *captionPublisher {
+	segments := captionSegments(words)
+	if len(segments) == 0 || topic == "" {
+		return nil
+	}
+
+	c := &captionPublisher{
+		session:   s,
+		topic:     topic,
+		requestID: requestID,
+		trackName: trackName,
+		clock:     s.newPlaybackClock(trackName),
+		segments:  segments,
+		finishing: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go c.run(ctx)
+	return c
+}
+
+// finish publishes whatever captions are left now that the audio is over
+// (timings may run past the audio) and waits for the publisher to stop
+func (c *captionPublisher) finish() {
+	if c == nil {
+		return
+	}
+	close(c.finishing)
+	<-c.done
+}
+
+// run publishes each word once the playback clock reaches it, and closes
+// each segment once its last word has been said
+func (c *captionPublisher) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(captionPollInterval)
+	defer ticker.Stop()
+
+	for id, segment := range c.segments {
+		for i, word := range segment {
+			if !c.waitUntil(ctx, ticker, word.StartMs) {
+				return
+			}
+			c.publish(id, segment, i, false)
+		}
+		if !c.waitUntil(ctx, ticker, segment[len(segment)-1].EndMs) {
+			return
+		}
+		c.publish(id, segment, len(segment)-1, true)
+	}
+}
+
+// waitUntil blocks until the playback clock reaches atMs or the audio has
+// finished; returns false if ctx ended first
+func (c *captionPublisher) waitUntil(ctx context.Context, ticker *time.Ticker, atMs int64) bool {
+	at := time.Duration(atMs) * time.Millisecond
+	for c.clock.position() < at {
+		select {
+		case <-ticker.C:
+		case <-c.finishing:
+			return ctx.Err() == nil
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return ctx.Err() == nil
+}
+
+// publish sends one caption update; failures are logged and skipped
+func (c *captionPublisher) publish(id int, segment []*pb.WordTiming, wordIndex int, final bool) {
+	words := make([]string, len(segment))
+	for i, word := range segment {
+		words[i] = word.Word
+	}
+
+	payload, err := json.Marshal(captionMessage{
+		RequestID: c.requestID,
+		TrackName: c.trackName,
+		SegmentID: id,
+		Text:      strings.Join(words, " "),
+		Words:     words,
+		WordIndex: wordIndex,
+		StartMs:   segment[0].StartMs,
+		EndMs:     segment[len(segment)-1].EndMs,
+		Final:     final,
+	})
+	if err != nil {
+		return
+	}
+	if err := c.session.publishData(payload, c.topic); err != nil {
+		c.session.log().Warn("Failed to publish caption", "request_id", c.requestID, "segment", id, "error", err)
+	}
+}
+
+// publishData sends a reliable data packet to the room on topic
+func (s *RoomSession) publishData(payload []byte, topic string) error {
+	room := s.currentRoom()
+	if room == nil {
+		return errNoRoom
+	}
+	opts := []lksdk.DataPublishOption{lksdk.WithDataPublishReliable(true), lksdk.WithDataPublishTopic(topic)}
+	if s.shared.Load() != nil {
+		// Other users in a shared room get their own
+		opts = append(opts, lksdk.WithDataPublishDestination([]string{s.joinRequest.TargetIdentity}))
+	}
+	return room.LocalParticipant.PublishDataPacket(lksdk.UserData(payload), opts...)
+}
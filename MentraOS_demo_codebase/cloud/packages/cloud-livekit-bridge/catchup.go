@@ -0,0 +1,223 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// Chunks dropped while catching up are 5-15ms, around a voice's pitch
+// period, so one can be cut where the waveform repeats; each cut is
+// crossfaded over catchUpOverlap
+const (
+	catchUpMinDrop = 5 * time.Millisecond
+	catchUpMaxDrop = 15 * time.Millisecond
+	catchUpOverlap = 10 * time.Millisecond
+)
+
+// maxCatchUpRate caps how much faster than real time a track catches up
+const maxCatchUpRate = 1.25
+
+// timeStretcher plays a track slightly faster than real time, without
+// changing its pitch, until a backlog left by a reconnect or a stall has
+// been made up, so the track doesn't keep that delay for the rest of the
+// session. It cuts a chunk of audio whenever it owes one at its rate,
+// choosing the chunk length whose end best matches the waveform at its
+// start (SOLA) and crossfading across the cut. Guarded by the player's mu.
+type timeStretcher struct {
+	channels     int
+	frameSamples int     // samples per 10ms frame across all channels
+	rate         float64 // playback speed while catching up, e.g. 1.05
+	threshold    time.Duration
+
+	// Per-channel sample counts
+	minDrop, maxDrop, overlap int
+	debt                      int     // still to drop
+	owed                      float64 // due to be dropped by now at rate
+
+	buf []int16 // audio pulled from the queue, not yet played
+}
+
+// newTimeStretcher creates a stretcher for a player's frames that catches
+// up at rate on backlogs of at least threshold
+func newTimeStretcher(sampleRate, channels int, rate float64, threshold time.Duration) *timeStretcher {
+	samples := func(d time.Duration) int { return int(d.Seconds() * float64(sampleRate)) }
+	return &timeStretcher{
+		channels:     channels,
+		frameSamples: sampleRate / 100 * channels,
+		rate:         rate,
+		threshold:    threshold,
+		minDrop:      samples(catchUpMinDrop),
+		maxDrop:      samples(catchUpMaxDrop),
+		overlap:      samples(catchUpOverlap),
+	}
+}
+
+// owe adds a backlog to catch up on, unless it is under the threshold;
+// reports whether it was taken on
+func (t *timeStretcher) owe(behind time.Duration) bool {
+	if t == nil || behind < t.threshold {
+		return false
+	}
+	t.debt += int(behind.Seconds() * float64(t.frameSamples/t.channels*100))
+	return true
+}
+
+// active reports whether the stretcher is catching up or still has audio
+// to play
+func (t *timeStretcher) active() bool {
+	return t != nil && (t.debt > 0 || len(t.buf) > 0)
+}
+
+// buffered returns the samples pulled from the queue but not yet played
+func (t *timeStretcher) buffered() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.buf)
+}
+
+// reset stops catching up and returns the audio not yet played
+func (t *timeStretcher) reset() []int16 {
+	if t == nil {
+		return nil
+	}
+	buf := t.buf
+	t.buf, t.debt, t.owed = nil, 0, 0
+	return buf
+}
+
+// next returns the next frame to play, pulling queued frames as needed and
+// cutting a chunk when one is owed. Returns nil when nothing is left.
+func (t *timeStretcher) next(pull func() []int16) []int16 {
+	need := t.frameSamples
+	if t.debt > 0 {
+		need += (t.maxDrop + t.overlap) * t.channels
+	}
+	for len(t.buf) < need {
+		frame := pull()
+		if frame == nil {
+			// The queue ran dry, so there is no backlog left
+			t.debt, t.owed = 0, 0
+			break
+		}
+		t.buf = append(t.buf, frame...)
+	}
+
+	if t.debt > 0 {
+		t.owed += float64(t.frameSamples/t.channels) * (t.rate - 1)
+		if t.owed >= float64(t.maxDrop) {
+			t.drop()
+		}
+	}
+
+	n := min(t.frameSamples, len(t.buf))
+	if n == 0 {
+		return nil
+	}
+	frame := make([]int16, n)
+	copy(frame, t.buf)
+	t.buf = append(t.buf[:0], t.buf[n:]...)
+	return frame
+}
+
+// drop cuts a chunk from the start of the buffer, at the length where the
+// audio after it best continues the audio before it
+func (t *timeStretcher) drop() {
+	longest := min(t.maxDrop, t.debt)
+	if longest < t.minDrop {
+		// Too little left to cut cleanly
+		t.debt, t.owed = 0, 0
+		return
+	}
+
+	ch := t.channels
+	best, bestScore := t.minDrop, math.Inf(-1)
+	for d := t.minDrop; d <= longest; d++ {
+		var dot, energy float64
+		for i := 0; i < t.overlap*ch; i++ {
+			a, b := float64(t.buf[i]), float64(t.buf[d*ch+i])
+			dot += a * b
+			energy += b * b
+		}
+		if score := dot / math.Sqrt(energy+1); score > bestScore {
+			best, bestScore = d, score
+		}
+	}
+
+	// Crossfade from the start into the audio best later, then cut what
+	// lies between
+	for i := 0; i < t.overlap; i++ {
+		in := float64(i+1) / float64(t.overlap+1)
+		for c := 0; c < ch; c++ {
+			j := (best+i)*ch + c
+			t.buf[j] = softClip(float64(t.buf[i*ch+c])*(1-in) + float64(t.buf[j])*in)
+		}
+	}
+	t.buf = append(t.buf[:0], t.buf[best*ch:]...)
+	t.debt -= best
+	t.owed -= float64(best)
+	catchUpSeconds.Add(float64(best) / float64(t.frameSamples/t.channels*100))
+}
+
+// setCatchUp makes the player time-stretch its audio at rate to catch up
+// on backlogs of at least threshold (rate 1 or less = off)
+func (p *trackPlayer) setCatchUp(rate float64, threshold time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requeueStretchedLocked()
+	p.stretch = nil
+	if rate > 1 {
+		p.stretch = newTimeStretcher(p.frameSamples/p.channels*100, p.channels, min(rate, maxCatchUpRate), threshold)
+	}
+}
+
+// catchUp has the player catch up on a backlog of behind, if catching up is
+// on and the backlog is large enough; reports whether it will
+func (p *trackPlayer) catchUp(behind time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.catchUpLocked(behind)
+}
+
+// catchUpLocked is catchUp; caller holds p.mu
+func (p *trackPlayer) catchUpLocked(behind time.Duration) bool {
+	if !p.stretch.owe(behind) {
+		return false
+	}
+	p.logger.Info("Catching up on playback backlog", "behind_ms", behind.Milliseconds(), "rate", p.stretch.rate)
+	return true
+}
+
+// requeueStretchedLocked stops catching up and puts the audio the catch-up
+// and playback rate stretchers hold back at the head of the queue, so it
+// can be faded or replaced like the rest; caller holds p.mu
+func (p *trackPlayer) requeueStretchedLocked() {
+	// The rate stretcher pulls from the catch-up one, so its audio is older
+	p.requeueLocked(p.stretch.reset())
+	p.requeueLocked(p.speed.reset())
+}
+
+// requeueLocked puts samples back at the head of the queue; caller holds
+// p.mu
+func (p *trackPlayer) requeueLocked(buffered []int16) {
+	if len(buffered) == 0 {
+		return
+	}
+
+	samples := buffered
+	for _, frame := range p.queue {
+		samples = append(samples, frame...)
+	}
+	samples = append(samples, p.partial...)
+	p.queue, p.partial = nil, nil
+	for len(samples) >= p.frameSamples {
+		p.queue = append(p.queue, samples[:p.frameSamples:p.frameSamples])
+		samples = samples[p.frameSamples:]
+	}
+	if len(samples) > 0 {
+		p.partial = samples
+	}
+	p.resetTailLocked()
+}
@@ -0,0 +1,63 @@
+package main
+
+// cleanMicQueue is how many frames of mic audio may wait for the clean mic
+// track before new ones are dropped
+const cleanMicQueue = 50
+
+// cleanMic republishes a session's received mic audio, noise-suppressed and
+// levelled by AGC, as a track of its own, so people listening in the room
+// hear it cleaned up while the raw audio still reaches the bridge's
+// consumers. Processing runs on its own goroutine, off the receive path.
+type cleanMic struct {
+	trackName string
+	denoiser  *denoiser
+	audio     chan []byte
+}
+
+// startCleanMic starts republishing the session's mic audio on trackName
+func (s *RoomSession) startCleanMic(trackName string) {
+	mic := &cleanMic{
+		trackName: trackName,
+		denoiser:  newDenoiser(incomingSampleRate),
+		audio:     make(chan []byte, cleanMicQueue),
+	}
+	s.SetTrackAGC(trackName, true)
+	s.cleanMic = mic
+	s.spawn("clean_mic", func() { s.runCleanMic(mic) })
+	s.log().Info("Republishing processed mic audio", "track_name", trackName)
+}
+
+// push queues 16kHz mono mic audio for the clean mic track; never blocks
+func (m *cleanMic) push(pcmData []byte) {
+	if m == nil || len(pcmData) == 0 {
+		return
+	}
+	select {
+	case m.audio <- pcmData:
+	default:
+		framesDropped.WithLabelValues("clean_mic").Inc()
+	}
+}
+
+// runCleanMic denoises queued mic audio and writes it to the clean mic
+// track until the session closes
+func (s *RoomSession) runCleanMic(mic *cleanMic) {
+	failing := false
+	for {
+		select {
+		case pcmData := <-mic.audio:
+			samples := pooledSamples(pcmData)
+			err := s.writeSamplesToTrack(s.ctx, mic.denoiser.process(*samples), mic.trackName, incomingSampleRate, 1)
+			putSamples(samples)
+			switch {
+			case err != nil && !failing && s.ctx.Err() == nil:
+				s.log().Warn("Failed to write processed mic audio", "track_name", mic.trackName, "error", err)
+				failing = true
+			case err == nil:
+				failing = false
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
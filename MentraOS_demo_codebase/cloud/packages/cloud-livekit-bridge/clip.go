@@ -0,0 +1,215 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// clipLevel is the magnitude at which an int16 sample is at full scale
+const clipLevel = 32767
+
+// nearFullScaleDb is the frame peak above which audio counts toward a
+// sustained clipping episode
+const nearFullScaleDb = -1.0
+
+// Soft limiter shape: gain reduction starts softLimitKneeDb below the
+// ceiling and recovers at softLimitReleaseDb per 10ms frame
+const (
+	softLimitKneeDb    = 6.0
+	softLimitReleaseDb = 0.5
+)
+
+// ClipSettings configure clipping detection and the optional soft limiter
+// on the audio written to each PCM track
+type ClipSettings struct {
+	SustainedAfter time.Duration // near-full-scale audio this long is a clipping episode (0 = never reported)
+	SoftLimit      bool          // hold input peaks under CeilingDb before the rest of the chain
+	CeilingDb      float64
+}
+
+// clipStats counts a track's clipping since it was created
+type clipStats struct {
+	inputClipped  int64   // samples written at full scale
+	outputClipped int64   // samples the publish chain pushed past full scale
+	events        int64   // sustained near-full-scale episodes
+	softLimitDb   float64 // gain reduction the soft limiter is applying
+}
+
+// clipDetector watches the audio written to a track for clipped samples and
+// sustained near-full-scale audio, which are what make alerts sound
+// distorted, and optionally soft-limits it before gain, pan and the output
+// limiter push it further
+type clipDetector struct {
+	sustainedAfter time.Duration
+	soft           *softLimiter // nil = detection only
+
+	mu       sync.Mutex
+	stats    clipStats
+	hotFor   time.Duration // current run of near-full-scale frames
+	reported bool          // the current run has been reported
+
+	onClip func(stats clipStats, sustained time.Duration) // called when a run reaches sustainedAfter
+}
+
+// newClipDetector creates a detector for a player's 10ms frames
+func newClipDetector(settings ClipSettings, channels int) *clipDetector {
+	d := &clipDetector{sustainedAfter: settings.SustainedAfter}
+	if settings.SoftLimit {
+		d.soft = &softLimiter{ceilingDb: settings.CeilingDb, channels: max(1, channels)}
+	}
+	return d
+}
+
+// setHandler registers a callback for sustained clipping. It runs on the
+// pacing goroutine and must not block.
+func (d *clipDetector) setHandler(f func(stats clipStats, sustained time.Duration)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.onClip = f
+}
+
+// observeInput counts a frame's full-scale samples and tracks how long the
+// audio has stayed near full scale, then soft-limits it when enabled
+func (d *clipDetector) observeInput(frame []int16) {
+	clipped := int64(0)
+	peak := 0
+	for _, v := range frame {
+		mag := int(v)
+		if mag < 0 {
+			mag = -mag
+		}
+		if mag >= clipLevel {
+			clipped++
+		}
+		peak = max(peak, mag)
+	}
+
+	d.mu.Lock()
+	d.stats.inputClipped += clipped
+	if toDb(float64(peak)/32768) >= nearFullScaleDb {
+		d.hotFor += playbackFrameDuration
+	} else {
+		d.hotFor = 0
+		d.reported = false
+	}
+
+	var f func(clipStats, time.Duration)
+	if d.sustainedAfter > 0 && d.hotFor >= d.sustainedAfter && !d.reported {
+		d.reported = true
+		d.stats.events++
+		f = d.onClip
+	}
+	stats, sustained := d.stats, d.hotFor
+	d.mu.Unlock()
+
+	if clipped > 0 {
+		clippedSamples.WithLabelValues("input").Add(float64(clipped))
+	}
+	if f != nil {
+		clippingEvents.Inc()
+		f(stats, sustained)
+	}
+
+	if d.soft != nil {
+		reduction := d.soft.process(frame, peak)
+		d.mu.Lock()
+		d.stats.softLimitDb = reduction
+		d.mu.Unlock()
+	}
+}
+
+// observeOutput counts samples the publish chain has pushed past full
+// scale; they are soft clipped on the way to int16
+func (d *clipDetector) observeOutput(samples []float64) {
+	over := int64(0)
+	for _, v := range samples {
+		if math.Abs(v) > clipLevel {
+			over++
+		}
+	}
+	if over == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	d.stats.outputClipped += over
+	d.mu.Unlock()
+	clippedSamples.WithLabelValues("output").Add(float64(over))
+}
+
+// snapshot returns the track's clipping counts so far
+func (d *clipDetector) snapshot() clipStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.stats
+}
+
+// softLimiter holds peaks under a ceiling with a soft knee. It works a frame
+// at a time: the gain reduction a frame needs is reached across that frame
+// and released gradually afterwards, so hot input is pulled down smoothly
+// rather than squashed against full scale. Only used by the pacing goroutine.
+type softLimiter struct {
+	ceilingDb   float64
+	channels    int
+	reductionDb float64 // applied to the last frame
+}
+
+// process limits a frame whose absolute peak is peak, returning the gain
+// reduction now applied in dB
+func (l *softLimiter) process(frame []int16, peak int) float64 {
+	target := softLimitReduction(toDb(float64(peak)/32768), l.ceilingDb)
+	from := l.reductionDb
+	// Attack within the frame; release slowly so the gain doesn't pump
+	to := math.Max(target, from-softLimitReleaseDb)
+	if from == 0 && to == 0 {
+		return 0
+	}
+
+	applyGainRamp(frame, l.channels, math.Pow(10, -from/20), math.Pow(10, -to/20))
+	l.reductionDb = to
+	return to
+}
+
+// softLimitReduction is the gain reduction in dB for a peak at levelDb:
+// none below the knee, rising quadratically through it, and bringing the
+// peak down to ceilingDb above it
+func softLimitReduction(levelDb, ceilingDb float64) float64 {
+	over := levelDb - ceilingDb
+	switch {
+	case over <= -softLimitKneeDb/2:
+		return 0
+	case over < softLimitKneeDb/2:
+		knee := over + softLimitKneeDb/2
+		return knee * knee / (2 * softLimitKneeDb)
+	default:
+		return over
+	}
+}
+
+// onTrackClipping reports a track whose audio has stayed near full scale
+func (s *RoomSession) onTrackClipping(trackName string, stats clipStats, sustained time.Duration) {
+	s.log().Warn("Track audio is clipping", "track_name", trackName, "sustained", sustained,
+		"clipped_samples", stats.inputClipped, "output_clipped_samples", stats.outputClipped)
+	s.emitEvent(EventClipping, trackName, map[string]string{
+		"sustained_ms":           strconv.FormatInt(sustained.Milliseconds(), 10),
+		"clipped_samples":        strconv.FormatInt(stats.inputClipped, 10),
+		"output_clipped_samples": strconv.FormatInt(stats.outputClipped, 10),
+		"soft_limit_db":          strconv.FormatFloat(stats.softLimitDb, 'f', 1, 64),
+	})
+}
+
+// trackClipStats returns the clipping counts of every published PCM track
+func (s *RoomSession) trackClipStats() map[string]clipStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := make(map[string]clipStats, len(s.trackStates))
+	for trackName, state := range s.trackStates {
+		stats[trackName] = state.player.clip.snapshot()
+	}
+	return stats
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/pion/interceptor"
+	"github.com/pion/rtcp"
+)
+
+// ntpEpochOffset is the number of seconds from the NTP epoch (1900) to the
+// Unix epoch
+const ntpEpochOffset = 2208988800
+
+// rtpResyncPackets is how far, in 20ms packets, a track's running RTP
+// position may drift from the latest packet before it is reset to it
+const rtpResyncPackets = 3
+
+// captureStamp is where a frame of received audio sits on its sender's
+// clocks; zero for audio that didn't come over RTP (data packets)
+type captureStamp struct {
+	RTPTimestamp uint32
+	SenderTime   time.Time // per the sender's wall clock (zero until a sender report arrives)
+}
+
+// rtpClock follows one received RTP stream: the latest packet's timestamp
+// and the sender report that maps RTP time to the sender's NTP wall clock
+type rtpClock struct {
+	mu          sync.Mutex
+	clockRate   uint32
+	identity    string // participant and track the stream belongs to, once known
+	trackName   string
+	trackSID    string
+	lastRTP     uint32
+	lastArrival time.Time
+	next        uint32 // RTP timestamp of the next frame handed to deliver
+	started     bool
+
+	srRTP      uint32
+	srNTP      time.Time
+	srArrival  time.Time
+	srReceived bool
+}
+
+// received records an RTP packet of the stream
+func (c *rtpClock) received(timestamp uint32, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastRTP, c.lastArrival = timestamp, at
+}
+
+// senderReport records the stream's latest RTP/NTP mapping
+func (c *rtpClock) senderReport(rtpTime uint32, ntpTime time.Time, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.srRTP, c.srNTP, c.srArrival, c.srReceived = rtpTime, ntpTime, at, true
+}
+
+// stamp returns the capture stamp of the next samples decoded from the
+// stream at sampleRate. Frames follow on from each other in RTP time; the
+// position is reset to the latest packet at the start and after a gap.
+func (c *rtpClock) stamp(samples, sampleRate int) captureStamp {
+	if c == nil {
+		return captureStamp{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lastArrival.IsZero() || c.clockRate == 0 {
+		return captureStamp{}
+	}
+	if drift := int32(c.lastRTP - c.next); !c.started || drift > rtpResyncPackets*int32(c.clockRate)/50 || drift < -rtpResyncPackets*int32(c.clockRate)/50 {
+		c.next, c.started = c.lastRTP, true
+	}
+	stamp := captureStamp{RTPTimestamp: c.next}
+	c.next += uint32(int64(samples) * int64(c.clockRate) / int64(sampleRate))
+
+	if c.srReceived {
+		offset := time.Duration(int32(stamp.RTPTimestamp-c.srRTP)) * time.Second / time.Duration(c.clockRate)
+		stamp.SenderTime = c.srNTP.Add(offset)
+	}
+	return stamp
+}
+
+// ntpToTime converts a 64-bit NTP timestamp to a time
+func ntpToTime(ntp uint64) time.Time {
+	seconds := int64(ntp>>32) - ntpEpochOffset
+	fraction := ntp & 0xffffffff
+	return time.Unix(seconds, int64(fraction*1e9>>32))
+}
+
+// unixMilliOrZero returns t in milliseconds since the epoch, or 0 for the
+// zero time
+func unixMilliOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// rtpClocks follows every RTP stream a session's room connection receives,
+// by SSRC
+type rtpClocks struct {
+	mu      sync.Mutex
+	streams map[uint32]*rtpClock
+}
+
+// newRTPClocks creates an empty set of stream clocks
+func newRTPClocks() *rtpClocks {
+	return &rtpClocks{streams: make(map[uint32]*rtpClock)}
+}
+
+// get returns the clock of a stream, creating it on first use
+func (c *rtpClocks) get(ssrc uint32) *rtpClock {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clock, exists := c.streams[ssrc]
+	if !exists {
+		clock = &rtpClock{}
+		c.streams[ssrc] = clock
+	}
+	return clock
+}
+
+// reset forgets every stream, for a new room connection
+func (c *rtpClocks) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.streams = make(map[uint32]*rtpClock)
+}
+
+// clockInterceptorFactory builds the interceptor that feeds rtpClocks on
+// each peer connection
+type clockInterceptorFactory struct {
+	clocks *rtpClocks
+}
+
+// NewInterceptor implements interceptor.Factory
+func (f *clockInterceptorFactory) NewInterceptor(string) (interceptor.Interceptor, error) {
+	return &clockInterceptor{clocks: f.clocks}, nil
+}
+
+// clockInterceptor watches received RTP packets and RTCP sender reports
+type clockInterceptor struct {
+	interceptor.NoOp
+	clocks *rtpClocks
+}
+
+// BindRemoteStream records the RTP timestamp of every packet of a stream
+func (i *clockInterceptor) BindRemoteStream(info *interceptor.StreamInfo, reader interceptor.RTPReader) interceptor.RTPReader {
+	clock := i.clocks.get(info.SSRC)
+	clock.mu.Lock()
+	clock.clockRate = info.ClockRate
+	clock.mu.Unlock()
+
+	return interceptor.RTPReaderFunc(func(b []byte, a interceptor.Attributes) (int, interceptor.Attributes, error) {
+		n, a, err := reader.Read(b, a)
+		if err != nil {
+			return n, a, err
+		}
+		if a == nil {
+			a = make(interceptor.Attributes)
+		}
+		if header, err := a.GetRTPHeader(b[:n]); err == nil {
+			clock.received(header.Timestamp, time.Now())
+		}
+		return n, a, nil
+	})
+}
+
+// BindRTCPReader records the sender reports of received streams
+func (i *clockInterceptor) BindRTCPReader(reader interceptor.RTCPReader) interceptor.RTCPReader {
+	return interceptor.RTCPReaderFunc(func(b []byte, a interceptor.Attributes) (int, interceptor.Attributes, error) {
+		n, a, err := reader.Read(b, a)
+		if err != nil {
+			return n, a, err
+		}
+		if a == nil {
+			a = make(interceptor.Attributes)
+		}
+		packets, err := a.GetRTCPPackets(b[:n])
+		if err != nil {
+			return n, a, nil
+		}
+		now := time.Now()
+		for _, packet := range packets {
+			if sr, ok := packet.(*rtcp.SenderReport); ok {
+				i.clocks.get(sr.SSRC).senderReport(sr.RTPTime, ntpToTime(sr.NTPTime), now)
+			}
+		}
+		return n, a, nil
+	})
+}
+
+// remoteClock returns the clock of a subscribed remote track, labelled with
+// where it comes from; nil when WebRTC stats (and with them RTP clocks) are
+// off
+func (s *RoomSession) remoteClock(ssrc uint32, identity, trackName, trackSID string) *rtpClock {
+	if s.rtc == nil {
+		return nil
+	}
+	clock := s.rtc.clocks.get(ssrc)
+	clock.mu.Lock()
+	clock.identity, clock.trackName, clock.trackSID = identity, trackName, trackSID
+	clock.mu.Unlock()
+	return clock
+}
+
+// clockMappings reports, for every remote track heard from, how its RTP and
+// sender clocks line up with the bridge's
+func (s *RoomSession) clockMappings() []*pb.ClockMapping {
+	if s.rtc == nil {
+		return nil
+	}
+
+	s.rtc.clocks.mu.Lock()
+	streams := make(map[uint32]*rtpClock, len(s.rtc.clocks.streams))
+	for ssrc, clock := range s.rtc.clocks.streams {
+		streams[ssrc] = clock
+	}
+	s.rtc.clocks.mu.Unlock()
+
+	var mappings []*pb.ClockMapping
+	for ssrc, clock := range streams {
+		clock.mu.Lock()
+		if clock.identity != "" && !clock.lastArrival.IsZero() {
+			mapping := &pb.ClockMapping{
+				ParticipantIdentity: clock.identity,
+				TrackName:           clock.trackName,
+				TrackSid:            clock.trackSID,
+				Ssrc:                ssrc,
+				ClockRate:           clock.clockRate,
+				LastRtpTimestamp:    clock.lastRTP,
+				LastReceivedAt:      clock.lastArrival.UnixMilli(),
+			}
+			if clock.srReceived {
+				mapping.SrRtpTimestamp = clock.srRTP
+				mapping.SrSenderTime = clock.srNTP.UnixMilli()
+				mapping.SrReceivedAt = clock.srArrival.UnixMilli()
+				mapping.OffsetMs = clock.srArrival.Sub(clock.srNTP).Milliseconds()
+			}
+			mappings = append(mappings, mapping)
+		}
+		clock.mu.Unlock()
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		if mappings[i].ParticipantIdentity != mappings[j].ParticipantIdentity {
+			return mappings[i].ParticipantIdentity < mappings[j].ParticipantIdentity
+		}
+		return mappings[i].TrackName < mappings[j].TrackName
+	})
+	return mappings
+}
+
+// GetClockMapping reports how the clocks of a session's received audio line
+// up with the bridge's, for putting transcripts, captions and video events
+// on one timeline
+func (s *LiveKitBridgeService) GetClockMapping(
+	ctx context.Context,
+	req *pb.GetClockMappingRequest,
+) (*pb.GetClockMappingResponse, error) {
+	slog.Debug("GetClockMapping request", "user_id", req.UserId)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.GetClockMappingResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	if session.rtc == nil {
+		return &pb.GetClockMappingResponse{
+			Success: false,
+			Error:   "RTP clocks are not tracked (WEBRTC_STATS_ENABLED=false)",
+		}, nil
+	}
+	return &pb.GetClockMappingResponse{
+		Success:    true,
+		BridgeTime: time.Now().UnixMilli(),
+		Mappings:   session.clockMappings(),
+	}, nil
+}
@@ -2,27 +2,378 @@ package main
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds the service configuration
 type Config struct {
 	Port             string
+	MetricsPort      string        // Prometheus /metrics port ("" disables)
+	HealthPort       string        // /healthz and /readyz port ("" disables)
+	DrainTimeout     time.Duration // longest shutdown waits for in-flight playback
 	LiveKitURL       string
 	LiveKitAPIKey    string
 	LiveKitAPISecret string
 	LogLevel         string
+	LogFormat        string // json | text
 	PublishGain      float64
+	ResampleMode     string // "linear" or "sinc"
+	DitherMode       string // "none", "tpdf" or "shaped": reducing float and 24/32-bit audio to 16 bits
+	SampleRate       int    // rate of published tracks and received audio, unless a session sets its own
+	InterruptMode    string // "unpublish" or "flush"
+	NoiseSuppression bool   // denoise incoming mic audio for every session
+	CleanMicTrack    string // track every session republishes processed mic audio on ("" = off)
+	CaptionTopic     string // data topic for TTS captions ("" disables)
+
+	// ProgressInterval is how often PlayAudio sends PROGRESS events (0 = never)
+	ProgressInterval time.Duration
+
+	// FFmpegPath is the ffmpeg binary used to decode AAC, AMR and FLAC ("" disables)
+	FFmpegPath string
+
+	// StreamRetries is how many times a dropped audio_url download resumes
+	// with a range request before playback fails
+	StreamRetries int
+
+	// TrackNegotiationTimeout bounds how long the first write to a new track
+	// waits for WebRTC negotiation before writing anyway
+	TrackNegotiationTimeout time.Duration
+
+	// PlaybackQueueDuration is how much audio each track buffers ahead of
+	// real-time playout before writes block
+	PlaybackQueueDuration time.Duration
+
+	// WriteTimeout bounds how long a StreamAudio write waits for room in a
+	// full track queue before failing with ErrBackpressure (0 = no limit)
+	WriteTimeout time.Duration
+
+	// StopFadeDuration is the fade-out applied to tracks when playback is
+	// stopped, avoiding the click of cutting audio mid-waveform (0 = cut)
+	StopFadeDuration time.Duration
+
+	// KeepaliveMode is what a PCM track is fed when its queue runs dry
+	// ("none", "silence" or "noise"), for KeepaliveDuration after its last
+	// audio (0 = as long as the track exists)
+	KeepaliveMode     string
+	KeepaliveDuration time.Duration
+
+	// Sequenced chunks from the cloud (StreamAudio, IngestAudio) are held
+	// in a window of Reorder.Window chunks for up to Reorder.Timeout to put
+	// them back in order
+	Reorder ReorderSettings
+
+	// AckInterval is how often StreamAudio acknowledges the requests its
+	// chunks belong to (0 = never)
+	AckInterval time.Duration
+
+	// CatchUpRate is how much faster than real time a PCM track plays,
+	// without changing pitch, to make up a backlog of at least
+	// CatchUpThreshold left by a reconnect or stall (1 or less = keep the delay)
+	CatchUpRate      float64
+	CatchUpThreshold time.Duration
+
+	// Ducking: while DuckSpeechTrack plays, DuckTracks (all other tracks when
+	// empty) are attenuated by DuckAttenuationDb, ramping over DuckRamp and
+	// restoring once speech has been silent for DuckRelease
+	DuckSpeechTrack   string
+	DuckTracks        []string
+	DuckAttenuationDb float64
+	DuckRamp          time.Duration
+	DuckRelease       time.Duration
+
+	// Track priorities ("track=level", unlisted tracks rank 0): while a track
+	// plays, lower-ranked tracks are handled per PriorityPolicy ("none",
+	// "duck" by PriorityDuckDb, or "preempt"), using the ducking ramp and
+	// release. JoinRoom can override both per session.
+	TrackPriorities []string
+	PriorityPolicy  string
+	PriorityDuckDb  float64
+
+	// AGC: steer each track toward AGCTargetDb RMS, boosting by at most
+	// AGCMaxGainDb; AGCEnabled is the default for tracks not set via the API
+	AGCEnabled   bool
+	AGCTargetDb  float64
+	AGCMaxGainDb float64
+
+	// Limiter at the end of every track's publish chain (ratio <= 1 disables)
+	Limiter LimiterSettings
+
+	// Clipping: audio written to a track that stays near full scale for
+	// Clip.SustainedAfter is reported; Clip.SoftLimit holds it under
+	// Clip.CeilingDb before gain and the limiter
+	Clip ClipSettings
+
+	// Barge-in: when the user starts talking (VAD on incoming audio) while
+	// one of BargeInTracks plays, emit an event and apply BargeInAction
+	// ("none", "duck" by BargeInDuckDb, or "stop")
+	BargeInEnabled bool
+	BargeInTracks  []string
+	BargeInAction  string
+	BargeInDuckDb  float64
+	VADThresholdDb float64 // level above the noise floor that counts as speech
+	VADMinSpeech   time.Duration
+	VADHangover    time.Duration
+
+	// Reconnect: re-join the room with exponential backoff when the LiveKit
+	// connection drops, keeping queued playback
+	Reconnect ReconnectSettings
+
+	// Incoming mic audio is buffered for StreamAudio, IncomingBufferFrames
+	// frames per session; when it is full IncomingOverflowPolicy drops the
+	// newest or oldest frame, or grows the buffer to IncomingBufferLimit
+	IncomingBufferFrames   int
+	IncomingBufferLimit    int
+	IncomingOverflowPolicy string
+
+	// WebRTCStats records RTP loss, jitter, RTT and bitrate per track
+	WebRTCStats bool
+
+	// Session limits: at most MaxSessions concurrent sessions (0 = unlimited);
+	// joins beyond that are rejected or, with SessionLimitPolicy "queue",
+	// wait up to SessionQueueTimeout for a slot. Sessions with no audio or
+	// RPC activity for SessionIdleTimeout are evicted (0 = never)
+	MaxSessions         int
+	SessionLimitPolicy  string
+	SessionQueueTimeout time.Duration
+	SessionIdleTimeout  time.Duration
+
+	// Tracks that have had no audio for TrackIdleTimeout are unpublished
+	// (0 = kept until the session closes)
+	TrackIdleTimeout time.Duration
+
+	// Per-session quotas (0 = unlimited, JoinRoom can override): at most
+	// MaxSessionTracks tracks, and audio written at no more than
+	// SessionBandwidthKbps (before resampling, with a short burst allowed)
+	MaxSessionTracks     int
+	SessionBandwidthKbps int
+
+	// A session still holding goroutines, tracks or channels
+	// SessionLeakGrace after it closed is flagged as leaking (0 = off)
+	SessionLeakGrace time.Duration
+
+	// On shutdown, hand sessions off to other replicas (via the Redis
+	// registry) instead of waiting for their playback; each handoff may
+	// take HandoffTimeout
+	HandoffOnDrain bool
+	HandoffTimeout time.Duration
+
+	// With the Redis registry, each session's snapshot is saved every
+	// SnapshotInterval (0 = only on shutdown), and on start the bridge
+	// restores the sessions it or a dead replica left behind when
+	// RestoreOnStart is set
+	SnapshotInterval time.Duration
+	RestoreOnStart   bool
+
+	// Daily audio quotas (0 = unlimited; needs usage metering): minutes a
+	// user, and each app for a user, may publish and receive per UTC day.
+	// Past a limit new playback is rejected, or with DailyQuotaAction
+	// "degrade" allowed only on tracks ranked DailyQuotaMinPriority or
+	// higher. QuotaWebhookURL is POSTed the first time each day a quota is
+	// exceeded
+	DailyUserMinutes      int
+	DailyAppMinutes       int
+	DailyQuotaAction      string
+	DailyQuotaMinPriority int
+	QuotaWebhookURL       string
+
+	// StartRecording writes under RecordingDir, starting a new file once one
+	// reaches RecordingMaxFileBytes or RecordingMaxFileDuration
+	RecordingDir             string
+	RecordingMaxFileBytes    int64
+	RecordingMaxFileDuration time.Duration
+
+	// Each session keeps the last AudioRingDuration of incoming and
+	// published audio in memory (0 = off); DumpAudio writes it under
+	// AudioDumpDir
+	AudioRingDuration time.Duration
+	AudioDumpDir      string
+
+	// TTS streams can be POSTed to /tts/{userId}/{trackName} on
+	// TTSIngestPort ("" = off), with TTSIngestToken as a bearer token when set
+	TTSIngestPort  string
+	TTSIngestToken string
+
+	// Sessions listen for WakeWords (unless they pick their own) with
+	// Porcupine, which needs a Picovoice access key. Custom keywords are
+	// "<name>.ppn" files in WakeWordDir; WakeWordModelPath swaps in a
+	// non-English model.
+	WakeWords           []string
+	WakeWordSensitivity float64
+	WakeWordAccessKey   string
+	WakeWordDir         string
+	WakeWordModelPath   string
+
+	// Endpointing splits incoming mic audio into utterances (for every
+	// session when Endpointing is set): one ends after EndpointSilence of
+	// quiet or at EndpointMaxUtterance, and its audio, if attached, starts
+	// EndpointPreRoll before the speech. Uses VADThresholdDb and VADMinSpeech.
+	Endpointing          bool
+	EndpointAudio        bool
+	EndpointSilence      time.Duration
+	EndpointPreRoll      time.Duration
+	EndpointMaxUtterance time.Duration
+
+	// Sessions watch for SoundEvents (unless they pick their own) with the
+	// sound classifier: an event is reported when it scores
+	// SoundEventThreshold, classifying a window every SoundEventHop, and
+	// again only once it has gone unheard for SoundEventCooldown
+	SoundEvents         []string
+	SoundEventThreshold float64
+	SoundEventHop       time.Duration
+	SoundEventCooldown  time.Duration
+
+	// Diarization tags incoming mic audio with speaker IDs (for every
+	// session when set): a voice less than DiarizationThreshold (cosine)
+	// similar to every one heard on that mic is a new speaker, up to
+	// DiarizationMaxSpeakers. Speech is found with the VAD settings.
+	Diarization            bool
+	DiarizationThreshold   float64
+	DiarizationMaxSpeakers int
 }
 
 // loadConfig loads configuration from environment variables
 func loadConfig() *Config {
 	config := &Config{
 		Port:             getEnv("PORT", "9090"),
+		MetricsPort:      os.Getenv("METRICS_PORT"),
+		HealthPort:       os.Getenv("HEALTH_PORT"),
+		DrainTimeout:     getEnvDurationMs("DRAIN_TIMEOUT_MS", 25000),
 		LiveKitURL:       getEnv("LIVEKIT_URL", ""),
 		LiveKitAPIKey:    getEnv("LIVEKIT_API_KEY", ""),
 		LiveKitAPISecret: getEnv("LIVEKIT_API_SECRET", ""),
 		LogLevel:         getEnv("LOG_LEVEL", "info"),
+		LogFormat:        getEnv("LOG_FORMAT", "json"),
 		PublishGain:      1.0,
+		ResampleMode:     getEnv("RESAMPLE_MODE", "linear"),
+		DitherMode:       getEnv("DITHER_MODE", "none"),
+		SampleRate:       getEnvInt("AUDIO_SAMPLE_RATE", defaultSampleRate),
+		InterruptMode:    getEnv("INTERRUPT_MODE", "unpublish"),
+		NoiseSuppression: getEnvBool("NOISE_SUPPRESSION", false),
+		CleanMicTrack:    getEnv("CLEAN_MIC_TRACK", ""),
+		CaptionTopic:     getEnv("CAPTION_TOPIC", "captions"),
+		ProgressInterval: getEnvDurationMs("PROGRESS_INTERVAL_MS", 1000),
+		FFmpegPath:       getEnv("FFMPEG_PATH", "ffmpeg"),
+		StreamRetries:    getEnvInt("STREAM_RETRIES", 3),
+
+		TrackNegotiationTimeout: getEnvDurationMs("TRACK_NEGOTIATION_TIMEOUT_MS", 2000),
+		PlaybackQueueDuration:   getEnvDurationMs("PLAYBACK_QUEUE_MS", 2000),
+		WriteTimeout:            getEnvDurationMs("WRITE_TIMEOUT_MS", 5000),
+		StopFadeDuration:        getEnvDurationMs("STOP_FADE_MS", 100),
+		KeepaliveMode:           getEnv("KEEPALIVE_MODE", "none"),
+		KeepaliveDuration:       getEnvDurationMs("KEEPALIVE_MS", 5000),
+		CatchUpRate:             getEnvFloat("CATCHUP_RATE", 0),
+		CatchUpThreshold:        getEnvDurationMs("CATCHUP_THRESHOLD_MS", 100),
+		Reorder: ReorderSettings{
+			Window:  getEnvInt("CHUNK_REORDER_WINDOW", 32),
+			Timeout: getEnvDurationMs("CHUNK_REORDER_TIMEOUT_MS", 100),
+		},
+		AckInterval: getEnvDurationMs("ACK_INTERVAL_MS", 500),
+
+		DuckSpeechTrack:   getEnv("DUCK_SPEECH_TRACK", "tts"),
+		DuckTracks:        getEnvList("DUCK_TRACKS"),
+		DuckAttenuationDb: getEnvFloat("DUCK_ATTENUATION_DB", 12),
+		DuckRamp:          getEnvDurationMs("DUCK_RAMP_MS", 200),
+		DuckRelease:       getEnvDurationMs("DUCK_RELEASE_MS", 500),
+
+		TrackPriorities: getEnvListDefault("TRACK_PRIORITIES", []string{"alert=2", "tts=1"}),
+		PriorityPolicy:  getEnv("PRIORITY_POLICY", "none"),
+		PriorityDuckDb:  getEnvFloat("PRIORITY_DUCK_DB", 12),
+
+		AGCEnabled:   getEnvBool("AGC_ENABLED", false),
+		AGCTargetDb:  getEnvFloat("AGC_TARGET_DB", -20),
+		AGCMaxGainDb: getEnvFloat("AGC_MAX_GAIN_DB", 12),
+
+		Limiter: LimiterSettings{
+			ThresholdDb: getEnvFloat("LIMITER_THRESHOLD_DB", -1),
+			Ratio:       getEnvFloat("LIMITER_RATIO", 20),
+			Attack:      getEnvDurationMs("LIMITER_ATTACK_MS", 1),
+			Release:     getEnvDurationMs("LIMITER_RELEASE_MS", 100),
+		},
+		Clip: ClipSettings{
+			SustainedAfter: getEnvDurationMs("CLIP_SUSTAIN_MS", 200),
+			SoftLimit:      getEnvBool("SOFT_LIMITER_ENABLED", false),
+			CeilingDb:      getEnvFloat("SOFT_LIMITER_CEILING_DB", -3),
+		},
+
+		BargeInEnabled: getEnvBool("BARGE_IN_ENABLED", false),
+		BargeInTracks:  getEnvList("BARGE_IN_TRACKS"),
+		BargeInAction:  getEnv("BARGE_IN_ACTION", "none"),
+		BargeInDuckDb:  getEnvFloat("BARGE_IN_DUCK_DB", 18),
+		VADThresholdDb: getEnvFloat("VAD_THRESHOLD_DB", 9),
+		VADMinSpeech:   getEnvDurationMs("VAD_MIN_SPEECH_MS", 150),
+		VADHangover:    getEnvDurationMs("VAD_HANGOVER_MS", 400),
+
+		Reconnect: ReconnectSettings{
+			Enabled:      getEnvBool("RECONNECT_ENABLED", true),
+			InitialDelay: getEnvDurationMs("RECONNECT_INITIAL_DELAY_MS", 500),
+			MaxDelay:     getEnvDurationMs("RECONNECT_MAX_DELAY_MS", 30000),
+			MaxAttempts:  getEnvInt("RECONNECT_MAX_ATTEMPTS", 10),
+			Jitter:       getEnvFloat("RECONNECT_JITTER", 0.2),
+			Buffer:       getEnvDurationMs("RECONNECT_BUFFER_MS", 10000),
+		},
+
+		IncomingBufferFrames:   getEnvInt("INCOMING_BUFFER_FRAMES", 200),
+		IncomingBufferLimit:    getEnvInt("INCOMING_BUFFER_LIMIT", 1000),
+		IncomingOverflowPolicy: getEnv("INCOMING_OVERFLOW_POLICY", "drop-newest"),
+
+		WebRTCStats: getEnvBool("WEBRTC_STATS_ENABLED", true),
+
+		MaxSessions:         getEnvInt("MAX_SESSIONS", 0),
+		SessionLimitPolicy:  getEnv("SESSION_LIMIT_POLICY", "reject"),
+		SessionQueueTimeout: getEnvDurationMs("SESSION_QUEUE_TIMEOUT_MS", 10000),
+		SessionIdleTimeout:  getEnvDurationMs("SESSION_IDLE_TIMEOUT_MS", 0),
+
+		TrackIdleTimeout: getEnvDurationMs("TRACK_IDLE_TIMEOUT_MS", 0),
+
+		MaxSessionTracks:     getEnvInt("MAX_SESSION_TRACKS", 0),
+		SessionBandwidthKbps: getEnvInt("SESSION_BANDWIDTH_KBPS", 0),
+
+		SessionLeakGrace: getEnvDurationMs("SESSION_LEAK_GRACE_MS", 30000),
+
+		HandoffOnDrain: getEnvBool("HANDOFF_ON_DRAIN", false),
+		HandoffTimeout: getEnvDurationMs("HANDOFF_TIMEOUT_MS", 10000),
+
+		SnapshotInterval: getEnvDurationMs("SESSION_SNAPSHOT_INTERVAL_MS", 5000),
+		RestoreOnStart:   getEnvBool("RESTORE_SESSIONS_ON_START", true),
+
+		DailyUserMinutes:      getEnvInt("DAILY_USER_AUDIO_MINUTES", 0),
+		DailyAppMinutes:       getEnvInt("DAILY_APP_AUDIO_MINUTES", 0),
+		DailyQuotaAction:      getEnv("DAILY_QUOTA_ACTION", "reject"),
+		DailyQuotaMinPriority: getEnvInt("DAILY_QUOTA_MIN_PRIORITY", 2),
+		QuotaWebhookURL:       getEnv("QUOTA_WEBHOOK_URL", ""),
+
+		RecordingDir:             getEnv("RECORDING_DIR", "/tmp/livekit-bridge-recordings"),
+		RecordingMaxFileBytes:    int64(getEnvInt("RECORDING_MAX_FILE_BYTES", 50*1024*1024)),
+		RecordingMaxFileDuration: getEnvDurationMs("RECORDING_MAX_FILE_MS", 600000),
+
+		AudioRingDuration: getEnvDurationMs("AUDIO_RING_BUFFER_MS", 30000),
+		AudioDumpDir:      getEnv("AUDIO_DUMP_DIR", "/tmp/livekit-bridge-dumps"),
+
+		TTSIngestPort:  getEnv("TTS_INGEST_PORT", ""),
+		TTSIngestToken: getEnv("TTS_INGEST_TOKEN", ""),
+
+		WakeWords:           getEnvList("WAKE_WORDS"),
+		WakeWordSensitivity: getEnvFloat("WAKE_WORD_SENSITIVITY", 0.5),
+		WakeWordAccessKey:   getEnv("WAKE_WORD_ACCESS_KEY", ""),
+		WakeWordDir:         getEnv("WAKE_WORD_DIR", ""),
+		WakeWordModelPath:   getEnv("WAKE_WORD_MODEL_PATH", ""),
+
+		Endpointing:          getEnvBool("ENDPOINTING", false),
+		EndpointAudio:        getEnvBool("ENDPOINT_AUDIO", false),
+		EndpointSilence:      getEnvDurationMs("ENDPOINT_SILENCE_MS", 700),
+		EndpointPreRoll:      getEnvDurationMs("ENDPOINT_PRE_ROLL_MS", 300),
+		EndpointMaxUtterance: getEnvDurationMs("ENDPOINT_MAX_UTTERANCE_MS", 30000),
+
+		SoundEvents:         getEnvList("SOUND_EVENTS"),
+		SoundEventThreshold: getEnvFloat("SOUND_EVENT_THRESHOLD", 0.3),
+		SoundEventHop:       getEnvDurationMs("SOUND_EVENT_HOP_MS", 500),
+		SoundEventCooldown:  getEnvDurationMs("SOUND_EVENT_COOLDOWN_MS", 10000),
+
+		Diarization:            getEnvBool("DIARIZATION", false),
+		DiarizationThreshold:   getEnvFloat("DIARIZATION_THRESHOLD", 0.85),
+		DiarizationMaxSpeakers: getEnvInt("DIARIZATION_MAX_SPEAKERS", 4),
 	}
 
 	return config
@@ -35,3 +386,63 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvDurationMs reads a millisecond duration from the environment with a default
+func getEnvDurationMs(key string, defaultMs int) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+			return time.Duration(ms) * time.Millisecond
+		}
+	}
+	return time.Duration(defaultMs) * time.Millisecond
+}
+
+// getEnvInt reads a non-negative integer from the environment with a default
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+// getEnvFloat reads a float from the environment with a default
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return defaultValue
+}
+
+// getEnvBool reads a boolean from the environment with a default
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+// getEnvListDefault is getEnvList with a default for when the variable is
+// unset or empty
+func getEnvListDefault(key string, defaultValue []string) []string {
+	if list := getEnvList(key); len(list) > 0 {
+		return list
+	}
+	return defaultValue
+}
+
+// getEnvList reads a comma-separated list from the environment, skipping blanks
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
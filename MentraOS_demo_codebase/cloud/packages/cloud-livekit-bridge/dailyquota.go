@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/usage"
+)
+
+// Daily quotas a QuotaError can report
+const (
+	QuotaDailyUser = "daily_user" // audio minutes per user per day
+	QuotaDailyApp  = "daily_app"  // audio minutes per app per user per day
+)
+
+// QuotaAction is what happens to new playback once a daily quota is used up
+type QuotaAction string
+
+const (
+	QuotaReject  QuotaAction = "reject"  // refuse all new playback
+	QuotaDegrade QuotaAction = "degrade" // only play tracks ranked DAILY_QUOTA_MIN_PRIORITY or higher (e.g. alerts)
+)
+
+// parseQuotaAction maps a config string to a QuotaAction (default reject)
+func parseQuotaAction(action string) QuotaAction {
+	if QuotaAction(strings.ToLower(strings.TrimSpace(action))) == QuotaDegrade {
+		return QuotaDegrade
+	}
+	return QuotaReject
+}
+
+// dailyUsageTTL is how long a user's usage today is reused between checks,
+// so starting a clip doesn't query MongoDB each time
+const dailyUsageTTL = 30 * time.Second
+
+// quotaWebhookTimeout bounds one webhook delivery
+const quotaWebhookTimeout = 5 * time.Second
+
+// dailyUsage is a user's usage today, per app, as of fetched
+type dailyUsage struct {
+	perApp  map[string]time.Duration
+	fetched time.Time
+}
+
+// dailyQuotas checks new playback against per-user and per-app limits on
+// audio minutes a day, taken from the usage meter. The first time a user or
+// app goes over on a given day a quota_exceeded event is emitted and the
+// webhook, if configured, is called.
+type dailyQuotas struct {
+	userLimit   time.Duration // 0 = unlimited
+	appLimit    time.Duration // 0 = unlimited
+	action      QuotaAction
+	minPriority int
+	webhookURL  string
+	meter       *usage.Meter
+	client      *http.Client
+
+	mu       sync.Mutex
+	cache    map[string]*dailyUsage // by user ID
+	notified map[string]string      // "user/app" -> the day it was reported over quota
+}
+
+// newDailyQuotas builds the daily quotas from config; returns nil (no
+// checks) when no limit is set or usage isn't being metered
+func newDailyQuotas(config *Config, meter *usage.Meter) *dailyQuotas {
+	if config.DailyUserMinutes <= 0 && config.DailyAppMinutes <= 0 {
+		return nil
+	}
+	if !meter.Enabled() {
+		slog.Warn("Daily audio quotas need usage metering (MONGODB_URI); not enforcing them")
+		return nil
+	}
+	return &dailyQuotas{
+		userLimit:   time.Duration(config.DailyUserMinutes) * time.Minute,
+		appLimit:    time.Duration(config.DailyAppMinutes) * time.Minute,
+		action:      parseQuotaAction(config.DailyQuotaAction),
+		minPriority: config.DailyQuotaMinPriority,
+		webhookURL:  config.QuotaWebhookURL,
+		meter:       meter,
+		client:      &http.Client{Timeout: quotaWebhookTimeout},
+		cache:       make(map[string]*dailyUsage),
+		notified:    make(map[string]string),
+	}
+}
+
+// today returns a user's usage so far today, per app, from the cache while
+// it is fresh
+func (q *dailyQuotas) today(ctx context.Context, userId string) (map[string]time.Duration, error) {
+	q.mu.Lock()
+	cached, ok := q.cache[userId]
+	q.mu.Unlock()
+	if ok && time.Since(cached.fetched) < dailyUsageTTL && sameDay(cached.fetched, time.Now()) {
+		return cached.perApp, nil
+	}
+
+	perApp, err := q.meter.Today(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for user, cached := range q.cache {
+		if time.Since(cached.fetched) >= dailyUsageTTL {
+			delete(q.cache, user)
+		}
+	}
+	q.cache[userId] = &dailyUsage{perApp: perApp, fetched: time.Now()}
+	return perApp, nil
+}
+
+// sameDay reports whether two times fall on the same UTC day
+func sameDay(a, b time.Time) bool {
+	return a.UTC().Truncate(24 * time.Hour).Equal(b.UTC().Truncate(24 * time.Hour))
+}
+
+// check returns a QuotaError when new playback on trackName may not start
+// because the user, or the app owning the track, has used up its daily
+// audio. In degrade mode tracks ranked at least minPriority still play.
+// Usage that can't be read doesn't block playback.
+func (q *dailyQuotas) check(ctx context.Context, session *RoomSession, trackName string) error {
+	if q == nil {
+		return nil
+	}
+
+	perApp, err := q.today(ctx, session.userId)
+	if err != nil {
+		session.log().Warn("Failed to read daily usage, not enforcing quota", "error", err)
+		return nil
+	}
+
+	appId := trackOwner(trackName)
+	var total time.Duration
+	for _, used := range perApp {
+		total += used
+	}
+
+	var exceeded *QuotaError
+	used := total
+	switch {
+	case q.userLimit > 0 && total >= q.userLimit:
+		exceeded = &QuotaError{Quota: QuotaDailyUser, Limit: int(q.userLimit.Minutes())}
+	case q.appLimit > 0 && appId != "" && perApp[appId] >= q.appLimit:
+		exceeded = &QuotaError{Quota: QuotaDailyApp, Limit: int(q.appLimit.Minutes()), AppID: appId}
+		used = perApp[appId]
+	default:
+		return nil
+	}
+
+	q.notify(session, exceeded, used)
+	if q.action == QuotaDegrade {
+		if session.priorities.priority(trackName) >= q.minPriority {
+			return nil
+		}
+		exceeded.Degraded = true
+	}
+	return exceeded
+}
+
+// notify reports a quota going over once per user (or app) per day: as a
+// session event and to the webhook
+func (q *dailyQuotas) notify(session *RoomSession, exceeded *QuotaError, used time.Duration) {
+	now := time.Now()
+	day := now.UTC().Format(time.DateOnly)
+	key := session.userId + "/" + exceeded.AppID
+
+	q.mu.Lock()
+	if q.notified[key] == day {
+		q.mu.Unlock()
+		return
+	}
+	for reported, reportedDay := range q.notified {
+		if reportedDay != day {
+			delete(q.notified, reported)
+		}
+	}
+	q.notified[key] = day
+	q.mu.Unlock()
+
+	usedMinutes := strconv.FormatFloat(used.Minutes(), 'f', 1, 64)
+	session.log().Warn("Daily audio quota exceeded", "quota", exceeded.Quota, "app_id", exceeded.AppID,
+		"limit_minutes", exceeded.Limit, "used_minutes", usedMinutes, "action", q.action)
+	session.emitEvent(EventQuotaExceeded, "", map[string]string{
+		"quota":         exceeded.Quota,
+		"app_id":        exceeded.AppID,
+		"limit_minutes": strconv.Itoa(exceeded.Limit),
+		"used_minutes":  usedMinutes,
+		"action":        string(q.action),
+	})
+
+	if q.webhookURL == "" {
+		return
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"event":         EventQuotaExceeded,
+		"user_id":       session.userId,
+		"app_id":        exceeded.AppID,
+		"quota":         exceeded.Quota,
+		"limit_minutes": exceeded.Limit,
+		"used_minutes":  used.Minutes(),
+		"action":        q.action,
+		"timestamp_ms":  now.UnixMilli(),
+	})
+	if err != nil {
+		return
+	}
+	go q.postWebhook(session.userId, body)
+}
+
+// postWebhook delivers one quota notification; failures are only logged
+func (q *dailyQuotas) postWebhook(userId string, body []byte) {
+	resp, err := q.client.Post(q.webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		slog.Warn("Quota webhook failed", "user_id", userId, "error", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		slog.Warn("Quota webhook rejected", "user_id", userId, "status", resp.StatusCode)
+	}
+}
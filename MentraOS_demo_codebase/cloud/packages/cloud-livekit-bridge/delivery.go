@@ -0,0 +1,426 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// maxPlayerCuts bounds how many cuts a player remembers for the requests
+// still accounting for its audio
+const maxPlayerCuts = 32
+
+// playerCut records queued audio a player dropped, in frame positions
+// counted like playbackClock's (frames written plus frames queued). Audio
+// before heard still plays out; the rest, up to end, never will. Audio
+// queued afterwards starts at resume.
+type playerCut struct {
+	heard  int64
+	resume int64
+	end    int64
+}
+
+// recordCutLocked remembers that the audio queued from heard up to end is
+// being dropped; caller holds p.mu
+func (p *trackPlayer) recordCutLocked(heard, resume, end int64) {
+	if end <= heard {
+		return
+	}
+	p.cuts = append(p.cuts, playerCut{heard: heard, resume: resume, end: end})
+	if len(p.cuts) > maxPlayerCuts {
+		p.cuts = p.cuts[1:]
+	}
+	p.cutCount++
+}
+
+// cutsMade returns how many cuts the player has made so far
+func (p *trackPlayer) cutsMade() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.cutCount
+}
+
+// cutAfter returns the first cut the player made after its first n, and how
+// many it had made up to and including that one; ok is false if none
+func (p *trackPlayer) cutAfter(n int) (cut playerCut, made int, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Cuts older than the ones kept are long settled
+	i := max(0, n-(p.cutCount-len(p.cuts)))
+	if i >= len(p.cuts) {
+		return playerCut{}, n, false
+	}
+	return p.cuts[i], p.cutCount - len(p.cuts) + i + 1, true
+}
+
+// queuedEnd returns the position just past the audio queued on the player
+func (p *trackPlayer) queuedEnd() int64 {
+	return p.activity.framesWritten.Load() + int64(p.queuedFrames())
+}
+
+// deliveryReport is how much of one request's audio has reached the track
+type deliveryReport struct {
+	delivered time.Duration // played to the track
+	dropped   time.Duration // cut while queued, or refused before it was
+	sequence  uint64        // last of the request's chunks heard in full (0 = none)
+}
+
+// deliveredChunk is where a sequenced chunk's audio ends in the queue
+type deliveredChunk struct {
+	sequence uint64
+	end      int64
+}
+
+// deliveryLedger accounts for one request's audio on a PCM track: how much
+// of what it queued the track has played and how much was dropped by a
+// stop, crossfade or replacement before it could, so the cloud knows what
+// reached the listener. Like playbackClock, it takes everything queued on
+// the track after it starts as the request's, until sealed. A nil
+// *deliveryLedger is a no-op.
+type deliveryLedger struct {
+	session   *RoomSession
+	trackName string
+
+	mu       sync.Mutex
+	player   *trackPlayer
+	base     int64 // position where the request's audio still to settle starts
+	end      int64 // end of the request's audio queued so far
+	sealed   bool  // the request is done writing; end is final
+	cuts     int   // player cuts already settled
+	heard    int64 // frames settled as played
+	cut      int64 // frames settled as dropped
+	rejected time.Duration
+	chunks   []deliveredChunk // sequenced chunks not yet heard in full
+	sequence uint64
+}
+
+// newDeliveryLedger starts accounting for audio about to be written to trackName
+func (s *RoomSession) newDeliveryLedger(trackName string) *deliveryLedger {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+	l := &deliveryLedger{session: s, trackName: trackName}
+	if player := s.trackPlayer(trackName); player != nil {
+		l.player = player
+		l.base = player.queuedEnd()
+		l.end = l.base
+		l.cuts = player.cutsMade()
+	}
+	return l
+}
+
+// mark records a write of the request's audio that has just been queued,
+// ending with chunk sequence (0 = unsequenced)
+func (l *deliveryLedger) mark(sequence uint64) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	player := l.playerLocked()
+	if player == nil {
+		return
+	}
+	l.end = max(l.end, player.queuedEnd())
+	if sequence > 0 {
+		l.chunks = append(l.chunks, deliveredChunk{sequence: sequence, end: l.end})
+	}
+}
+
+// reject counts audio of the request that was refused instead of queued
+func (l *deliveryLedger) reject(d time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rejected += d
+}
+
+// seal ends the request: audio queued on the track from now on isn't its
+func (l *deliveryLedger) seal() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if player := l.playerLocked(); player != nil {
+		l.end = max(l.end, player.queuedEnd())
+	}
+	l.sealed = true
+}
+
+// playedOut reports whether all of a sealed request's audio has been written
+// to the track or dropped
+func (l *deliveryLedger) playedOut() bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	player := l.playerLocked()
+	return l.sealed && (player == nil || player.activity.framesWritten.Load() >= l.end)
+}
+
+// report returns how much of the request's audio has been delivered so far.
+// complete says every frame written has had time to be heard (the track
+// has played out), so none of it is still in the SDK's lead.
+func (l *deliveryLedger) report(complete bool) deliveryReport {
+	if l == nil {
+		return deliveryReport{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	heard := l.heard
+	if player := l.playerLocked(); player != nil {
+		end := l.end
+		if !l.sealed {
+			end = max(end, player.queuedEnd())
+		}
+		position := player.activity.framesWritten.Load()
+		if !complete {
+			// The player runs playbackLeadFrames ahead of what is audible
+			position -= playbackLeadFrames
+		}
+		heard += min(max(0, position-l.base), end-l.base)
+		l.ackChunksLocked(position)
+	}
+	return deliveryReport{
+		delivered: time.Duration(heard) * playbackFrameDuration,
+		dropped:   time.Duration(l.cut)*playbackFrameDuration + l.rejected,
+		sequence:  l.sequence,
+	}
+}
+
+// playerLocked returns the request's player, having settled any cuts it
+// made. A request still writing follows its track to a new player once the
+// old one has been replaced. Caller holds l.mu.
+func (l *deliveryLedger) playerLocked() *trackPlayer {
+	if l.player != nil {
+		l.settleLocked()
+	}
+
+	current := l.session.trackPlayer(l.trackName)
+	if current != nil && current != l.player && (l.player == nil || !l.sealed) {
+		// Created (or recreated) for this request: everything it plays is ours
+		l.player, l.base, l.end, l.cuts = current, 0, 0, 0
+		l.chunks = nil
+		l.settleLocked()
+	}
+	return l.player
+}
+
+// settleLocked splits the request's audio caught by each new cut into what
+// was heard before it and what it dropped; caller holds l.mu
+func (l *deliveryLedger) settleLocked() {
+	for {
+		cut, made, ok := l.player.cutAfter(l.cuts)
+		if !ok {
+			return
+		}
+		l.cuts = made
+
+		end := l.end
+		if !l.sealed {
+			end = max(end, cut.end)
+		}
+		if end > l.base {
+			heard := min(max(0, cut.heard-l.base), end-l.base)
+			l.heard += heard
+			l.cut += end - l.base - heard
+			l.ackChunksLocked(cut.heard)
+			l.chunks = nil
+		}
+		l.base, l.end = cut.resume, cut.resume
+	}
+}
+
+// ackChunksLocked marks the chunks ending by position as heard; caller
+// holds l.mu
+func (l *deliveryLedger) ackChunksLocked(position int64) {
+	for len(l.chunks) > 0 && l.chunks[0].end <= position {
+		l.sequence = l.chunks[0].sequence
+		l.chunks = l.chunks[1:]
+	}
+}
+
+// finish seals the request and returns its final report, counting it in
+// the delivery metrics; played says it ran to completion and was waited on
+// until heard
+func (l *deliveryLedger) finish(played bool) deliveryReport {
+	if l == nil {
+		return deliveryReport{}
+	}
+	l.seal()
+	report := l.report(played)
+	deliverySeconds.WithLabelValues("delivered").Add(report.delivered.Seconds())
+	deliverySeconds.WithLabelValues("dropped").Add(report.dropped.Seconds())
+	return report
+}
+
+// withDelivery fills in the delivery fields of a request's final event
+func withDelivery(event *pb.PlayAudioEvent, report deliveryReport) *pb.PlayAudioEvent {
+	event.DeliveredMs = report.delivered.Milliseconds()
+	event.DroppedMs = report.dropped.Milliseconds()
+	return event
+}
+
+// pcmDuration is how long a chunk of PCM16 audio plays
+func pcmDuration(pcmData []byte, sampleRate, channels int) time.Duration {
+	if sampleRate <= 0 {
+		sampleRate = defaultSampleRate
+	}
+	channels = max(1, channels)
+	return time.Duration(len(pcmData)/2/channels) * time.Second / time.Duration(sampleRate)
+}
+
+// streamAcks acknowledges the requests a StreamAudio stream plays. Each
+// request's chunks are followed on a deliveryLedger and reported back as
+// ack chunks every interval while the figures change, and once more, as
+// final, when a request that has ended has played out or been cut. A
+// request ends with its end_of_request chunk, or when the stream starts
+// another request on the same track. A nil *streamAcks is a no-op.
+type streamAcks struct {
+	session  *RoomSession
+	interval time.Duration
+
+	mu       sync.Mutex
+	requests map[string]*streamRequest
+}
+
+// streamRequest is a StreamAudio request being acknowledged
+type streamRequest struct {
+	trackName string
+	ledger    *deliveryLedger
+	ended     bool
+	drainedAt time.Time // when an ended request's audio was all written
+	last      deliveryReport
+	acked     bool // last has been sent
+}
+
+// newStreamAcks creates the acknowledger for a StreamAudio stream; returns
+// nil when acknowledgements are disabled (interval 0)
+func newStreamAcks(session *RoomSession, interval time.Duration) *streamAcks {
+	if interval <= 0 {
+		return nil
+	}
+	return &streamAcks{session: session, interval: interval, requests: make(map[string]*streamRequest)}
+}
+
+// ledger returns the ledger for the request a chunk belongs to, starting
+// one for a new request; nil for chunks outside any request and Opus
+// chunks, which aren't queued on a player
+func (a *streamAcks) ledger(chunk *pb.AudioChunk) *deliveryLedger {
+	if a == nil || chunk.RequestId == "" || chunk.Encoding == pb.AudioEncoding_OPUS {
+		return nil
+	}
+	trackName, err := appTrackName(chunk.AppId, "", chunk.TrackId)
+	if err != nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if req, exists := a.requests[chunk.RequestId]; exists {
+		return req.ledger
+	}
+	for _, req := range a.requests {
+		if req.trackName == trackName && !req.ended {
+			req.ended = true
+			req.ledger.seal()
+		}
+	}
+	req := &streamRequest{trackName: trackName, ledger: a.session.newDeliveryLedger(trackName)}
+	a.requests[chunk.RequestId] = req
+	return req.ledger
+}
+
+// ended marks a chunk's request as done if the chunk is its last
+func (a *streamAcks) ended(chunk *pb.AudioChunk) {
+	if a == nil || !chunk.EndOfRequest {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if req, exists := a.requests[chunk.RequestId]; exists && !req.ended {
+		req.ended = true
+		req.ledger.seal()
+	}
+}
+
+// run sends acks every interval until ctx ends or send fails
+func (a *streamAcks) run(ctx context.Context, send func(*pb.AudioChunk) error) {
+	if a == nil {
+		return
+	}
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		for _, ack := range a.pending() {
+			if err := send(ack); err != nil {
+				a.session.log().Warn("StreamAudio ack send failed", "error", err)
+				return
+			}
+		}
+	}
+}
+
+// pending returns the acks due now, forgetting requests given their final one
+func (a *streamAcks) pending() []*pb.AudioChunk {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	var acks []*pb.AudioChunk
+	for requestID, req := range a.requests {
+		final := false
+		if req.ended && req.ledger.playedOut() {
+			if req.drainedAt.IsZero() {
+				req.drainedAt = now
+			}
+			// What was written last still has the SDK's lead to play
+			final = now.Sub(req.drainedAt) >= playbackLeadFrames*playbackFrameDuration
+		}
+
+		var report deliveryReport
+		if final {
+			report = req.ledger.finish(true)
+			delete(a.requests, requestID)
+		} else {
+			report = req.ledger.report(false)
+			if req.acked && report == req.last {
+				continue
+			}
+		}
+		req.last, req.acked = report, true
+
+		acks = append(acks, &pb.AudioChunk{
+			Ack:          true,
+			RequestId:    requestID,
+			Sequence:     report.sequence,
+			DeliveredMs:  report.delivered.Milliseconds(),
+			DroppedMs:    report.dropped.Milliseconds(),
+			EndOfRequest: final,
+			TimestampMs:  now.UnixMilli(),
+		})
+	}
+	return acks
+}
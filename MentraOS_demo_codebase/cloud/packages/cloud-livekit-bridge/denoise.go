@@ -0,0 +1,228 @@
+package main
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// denoiseFrameSize is the FFT size of the noise suppressor at 16kHz (16ms);
+// higher rates use the next power of two that spans at least as long
+const denoiseFrameSize = 256
+
+// denoiseGainFloor limits suppression per bin (-20 dB) to avoid musical noise
+const denoiseGainFloor = 0.1
+
+// denoiser is a streaming spectral noise suppressor for incoming mic audio.
+// It estimates the stationary noise spectrum by minimum tracking and applies
+// a decision-directed Wiener gain per frequency bin, which removes steady
+// background (fans, traffic, wind hum) while leaving speech intact for ASR.
+// Output lags input by half a frame (hop samples).
+type denoiser struct {
+	size    int       // FFT size
+	hop     int       // step between analysis frames (50% overlap)
+	window  []float64 // sqrt-Hann, used for both analysis and synthesis
+	input   []float64 // unprocessed samples, at most one frame
+	overlap []float64 // second half of the previous synthesized frame
+
+	noise     []float64 // estimated noise power per bin
+	smoothed  []float64 // smoothed signal power per bin
+	prevGain  []float64
+	prevPost  []float64
+	haveNoise bool
+}
+
+// newDenoiser creates a noise suppressor for mono audio at sampleRate
+func newDenoiser(sampleRate int) *denoiser {
+	size := denoiseFrameSize
+	for size*incomingSampleRate < denoiseFrameSize*sampleRate {
+		size *= 2
+	}
+	window := make([]float64, size)
+	for i := range window {
+		window[i] = math.Sqrt(0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size)))
+	}
+
+	bins := size/2 + 1
+	return &denoiser{
+		size:     size,
+		hop:      size / 2,
+		window:   window,
+		overlap:  make([]float64, size/2),
+		noise:    make([]float64, bins),
+		smoothed: make([]float64, bins),
+		prevGain: make([]float64, bins),
+		prevPost: make([]float64, bins),
+	}
+}
+
+// process denoises mono samples and returns the output that is ready, which
+// may be shorter or longer than the input by less than a hop
+func (d *denoiser) process(samples []int16) []int16 {
+	out := make([]int16, 0, len(samples)+d.hop)
+
+	for _, s := range samples {
+		d.input = append(d.input, float64(s))
+		if len(d.input) < d.size {
+			continue
+		}
+
+		frame := d.processFrame(d.input)
+		for i := 0; i < d.hop; i++ {
+			out = append(out, clampInt16(frame[i]+d.overlap[i]))
+		}
+		copy(d.overlap, frame[d.hop:])
+
+		// Slide by one hop
+		copy(d.input, d.input[d.hop:])
+		d.input = d.input[:d.size-d.hop]
+	}
+
+	return out
+}
+
+// processFrame filters one windowed frame and returns it windowed again for
+// overlap-add
+func (d *denoiser) processFrame(in []float64) []float64 {
+	spectrum := make([]complex128, d.size)
+	for i, v := range in {
+		spectrum[i] = complex(v*d.window[i], 0)
+	}
+	fft(spectrum, false)
+
+	bins := len(d.noise)
+	gains := make([]float64, bins)
+	for k := 0; k < bins; k++ {
+		power := real(spectrum[k])*real(spectrum[k]) + imag(spectrum[k])*imag(spectrum[k])
+
+		if !d.haveNoise {
+			d.smoothed[k] = power
+			d.noise[k] = power
+		} else {
+			d.smoothed[k] = 0.8*d.smoothed[k] + 0.2*power
+			// Minimum tracking: follow drops at once, creep up slowly
+			if d.smoothed[k] < d.noise[k] {
+				d.noise[k] = d.smoothed[k]
+			} else {
+				d.noise[k] *= 1.004
+			}
+		}
+
+		noise := math.Max(d.noise[k], 1e-9)
+		post := power / noise
+		prio := 0.98*d.prevGain[k]*d.prevGain[k]*d.prevPost[k] + 0.02*math.Max(post-1, 0)
+		gain := math.Max(prio/(1+prio), denoiseGainFloor)
+
+		gains[k] = gain
+		d.prevGain[k] = gain
+		d.prevPost[k] = post
+	}
+	d.haveNoise = true
+
+	// Apply gains symmetrically so the inverse transform stays real
+	for k := 0; k < bins; k++ {
+		spectrum[k] *= complex(gains[k], 0)
+		if k > 0 && k < d.size/2 {
+			spectrum[d.size-k] *= complex(gains[k], 0)
+		}
+	}
+	fft(spectrum, true)
+
+	out := make([]float64, d.size)
+	for i := range out {
+		out[i] = real(spectrum[i]) * d.window[i]
+	}
+	return out
+}
+
+// fft is an in-place iterative radix-2 FFT; len(x) must be a power of two.
+// The inverse transform is scaled by 1/n.
+func fft(x []complex128, inverse bool) {
+	n := len(x)
+
+	// Bit-reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				a := x[start+k]
+				b := x[start+k+size/2] * w
+				x[start+k] = a + b
+				x[start+k+size/2] = a - b
+				w *= step
+			}
+		}
+	}
+
+	if inverse {
+		scale := complex(1/float64(n), 0)
+		for i := range x {
+			x[i] *= scale
+		}
+	}
+}
+
+// SetNoiseSuppression turns noise suppression of incoming mic audio on or off
+// for this session
+func (s *RoomSession) SetNoiseSuppression(enabled bool) {
+	s.denoiseMu.Lock()
+	defer s.denoiseMu.Unlock()
+
+	switch {
+	case !enabled:
+		s.denoisers = nil
+	case s.denoisers == nil:
+		s.denoisers = make(map[int]*denoiser)
+	}
+	s.log().Info("Set noise suppression", "enabled", enabled)
+}
+
+// denoiseIncoming runs incoming PCM at sampleRate through the session's
+// noise suppressor for that rate, returning it unchanged when suppression is
+// off
+func (s *RoomSession) denoiseIncoming(pcmData []byte, sampleRate int) []byte {
+	s.denoiseMu.Lock()
+	defer s.denoiseMu.Unlock()
+
+	if s.denoisers == nil {
+		return pcmData
+	}
+	d, exists := s.denoisers[sampleRate]
+	if !exists {
+		d = newDenoiser(sampleRate)
+		s.denoisers[sampleRate] = d
+	}
+	samples := pooledSamples(pcmData)
+	defer putSamples(samples)
+
+	return int16ToBytes(d.process(*samples))
+}
+
+// denoiseReceived returns received mic audio at the session rate and its
+// 16kHz ASR feed, each through noise suppression at its own rate when that
+// is on, so consumers at the native rate hear it denoised as ASR does. The
+// clean mic track takes the feed first, as it does its own processing.
+func (s *RoomSession) denoiseReceived(identity string, pcmData []byte) (pcm, feed []byte) {
+	feed = s.asrFeed(identity, pcmData)
+	s.cleanMic.push(feed)
+	feed = s.denoiseIncoming(feed, incomingSampleRate)
+	if s.sampleRate == incomingSampleRate {
+		return feed, feed
+	}
+	return s.denoiseIncoming(pcmData, s.sampleRate), feed
+}
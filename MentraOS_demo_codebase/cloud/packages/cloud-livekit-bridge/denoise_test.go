@@ -0,0 +1,75 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// rmsOf is the RMS level of 16-bit PCM
+func rmsOf(pcmData []byte) float64 {
+	samples := bytesToInt16(pcmData)
+	var sum float64
+	for _, s := range samples {
+		sum += float64(s) * float64(s)
+	}
+	return math.Sqrt(sum / float64(max(len(samples), 1)))
+}
+
+// receiveNoise passes seconds of steady white noise at the session's rate
+// through denoiseReceived in 10ms frames, as deliver does, and returns the
+// RMS of what came out for consumers and for the ASR feed over the last
+// second, and of what went in
+func receiveNoise(session *RoomSession, seconds int) (pcmRMS, feedRMS, inRMS float64) {
+	rng := rand.New(rand.NewSource(1))
+	frame := session.sampleRate / 100
+	var in, pcm, feed []byte
+	for i := 0; i < seconds*100; i++ {
+		samples := make([]int16, frame)
+		for j := range samples {
+			samples[j] = int16(rng.NormFloat64() * 2000)
+		}
+		data := int16ToBytes(samples)
+		out, outFeed := session.denoiseReceived("glasses", data)
+		if i >= (seconds-1)*100 {
+			in = append(in, data...)
+			pcm = append(pcm, out...)
+			feed = append(feed, outFeed...)
+		}
+	}
+	return rmsOf(pcm), rmsOf(feed), rmsOf(in)
+}
+
+// At a native rate above 16kHz, StreamAudio, SubscribeAudio and the mixdown
+// get the session's audio denoised, not only the ASR feed
+func TestDenoiseReceivedAtNativeRate(t *testing.T) {
+	for _, rate := range []int{incomingSampleRate, 24000, 48000} {
+		session := NewRoomSession("user", &Config{SampleRate: rate, NoiseSuppression: true})
+
+		pcmRMS, feedRMS, inRMS := receiveNoise(session, 3)
+		if pcmRMS > inRMS/2 {
+			t.Errorf("%dHz: consumers got noise at RMS %.0f from %.0f, want it suppressed", rate, pcmRMS, inRMS)
+		}
+		if feedRMS > inRMS/2 {
+			t.Errorf("%dHz: ASR feed kept noise at RMS %.0f from %.0f, want it suppressed", rate, feedRMS, inRMS)
+		}
+
+		// One suppressor per rate, each sized to it
+		if d := session.denoisers[rate]; d == nil || d.size*incomingSampleRate < denoiseFrameSize*rate {
+			t.Errorf("%dHz: no suppressor sized for the session rate", rate)
+		}
+		if rate != incomingSampleRate && session.denoisers[incomingSampleRate] == nil {
+			t.Errorf("%dHz: no suppressor for the ASR feed", rate)
+		}
+	}
+}
+
+// With suppression off the audio passes through as the mic sent it
+func TestDenoiseReceivedOff(t *testing.T) {
+	session := NewRoomSession("user", &Config{SampleRate: 48000})
+	data := int16ToBytes([]int16{1, -2, 300, -4000, 32767, -32768})
+	pcm, _ := session.denoiseReceived("glasses", data)
+	if string(pcm) != string(data) {
+		t.Fatalf("audio changed with suppression off: %v", bytesToInt16(pcm))
+	}
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"sync"
+)
+
+// diarizeFrameSize is the FFT size of the speaker features (32ms at 16kHz)
+const diarizeFrameSize = 512
+
+// diarizeHop is the step between feature frames (16ms)
+const diarizeHop = diarizeFrameSize / 2
+
+// diarizeMelBands and diarizeCoeffs shape the MFCCs: c1..c12 of 24 bands
+const (
+	diarizeMelBands = 24
+	diarizeCoeffs   = 12
+)
+
+// Speech that goes into a speaker decision: the last diarizeWindowFrames
+// feature frames of the utterance (1.5s), re-decided every
+// diarizeStepFrames (0.5s) once there are at least that many
+const (
+	diarizeWindowFrames = 94
+	diarizeStepFrames   = 31
+)
+
+// diarizeMaxWeight caps how many decisions a speaker's centroid averages, so
+// it keeps following the voice
+const diarizeMaxWeight = 50
+
+var (
+	diarizeOnce   sync.Once
+	diarizeWindow []float64   // Hamming
+	diarizeBank   [][]float64 // mel filter weights per band, by FFT bin
+)
+
+// initDiarizeTables builds the window and mel filterbank shared by all
+// diarizers
+func initDiarizeTables() {
+	diarizeWindow = make([]float64, diarizeFrameSize)
+	for i := range diarizeWindow {
+		diarizeWindow[i] = 0.54 - 0.46*math.Cos(2*math.Pi*float64(i)/float64(diarizeFrameSize-1))
+	}
+
+	mel := func(hz float64) float64 { return 2595 * math.Log10(1+hz/700) }
+	hz := func(mel float64) float64 { return 700 * (math.Pow(10, mel/2595) - 1) }
+	bins := diarizeFrameSize/2 + 1
+	low, high := mel(100), mel(7600)
+	edges := make([]float64, diarizeMelBands+2) // in FFT bins
+	for i := range edges {
+		edges[i] = hz(low+(high-low)*float64(i)/float64(diarizeMelBands+1)) * diarizeFrameSize / incomingSampleRate
+	}
+	diarizeBank = make([][]float64, diarizeMelBands)
+	for band := range diarizeBank {
+		weights := make([]float64, bins)
+		left, center, right := edges[band], edges[band+1], edges[band+2]
+		for k := range weights {
+			f := float64(k)
+			switch {
+			case f > left && f <= center:
+				weights[k] = (f - left) / (center - left)
+			case f > center && f < right:
+				weights[k] = (right - f) / (right - center)
+			}
+		}
+		diarizeBank[band] = weights
+	}
+}
+
+// speakerCluster is one voice the diarizer has heard
+type speakerCluster struct {
+	id       int32
+	centroid []float64
+	weight   int
+}
+
+// diarizer tells apart the voices sharing one participant's mic. During
+// speech (per its VAD) it describes the last 1.5s by the mean and spread of
+// its MFCCs, which mostly reflect the speaker's vocal tract, and assigns
+// that to the most similar voice heard so far, or to a new one when none is
+// similar enough. A change of speaker within an utterance needs two
+// decisions in a row; a new utterance may switch at its first.
+type diarizer struct {
+	threshold   float64 // cosine similarity needed to be the same speaker
+	maxSpeakers int
+
+	vad       *vad
+	input     []float64   // samples short of a frame
+	features  [][]float64 // the utterance's recent feature frames
+	sinceStep int
+	fresh     bool // no decision yet in this utterance
+
+	clusters  []*speakerCluster
+	current   int32 // 0 = none yet
+	candidate int32 // a different speaker seen once in a row
+}
+
+// newDiarizer creates a diarizer for one participant's audio
+func newDiarizer(config *Config) *diarizer {
+	diarizeOnce.Do(initDiarizeTables)
+	return &diarizer{
+		threshold:   config.DiarizationThreshold,
+		maxSpeakers: config.DiarizationMaxSpeakers,
+		vad:         newVAD(config.VADThresholdDb, config.VADMinSpeech, config.VADHangover),
+	}
+}
+
+// process feeds mono 16kHz samples and returns who is speaking in them
+// (0 when nobody is, or it isn't known yet) and the speaker before, when
+// that changed
+func (d *diarizer) process(samples []int16) (speaker int32, changed bool, previous int32) {
+	started, _ := d.vad.process(samples)
+	if started {
+		d.features = d.features[:0]
+		d.sinceStep = 0
+		d.fresh = true
+		d.candidate = 0
+	}
+
+	previous = d.current
+	for _, s := range samples {
+		d.input = append(d.input, float64(s)/32768)
+		if len(d.input) < diarizeFrameSize {
+			continue
+		}
+		if d.vad.speaking {
+			d.addFeatures(mfcc(d.input))
+		}
+		copy(d.input, d.input[diarizeHop:])
+		d.input = d.input[:diarizeFrameSize-diarizeHop]
+	}
+
+	if !d.vad.speaking {
+		return 0, false, previous
+	}
+	return d.current, d.current != previous, previous
+}
+
+// addFeatures adds a speech frame and decides the speaker every step
+func (d *diarizer) addFeatures(features []float64) {
+	if len(d.features) == diarizeWindowFrames {
+		copy(d.features, d.features[1:])
+		d.features = d.features[:diarizeWindowFrames-1]
+	}
+	d.features = append(d.features, features)
+
+	d.sinceStep++
+	if d.sinceStep < diarizeStepFrames {
+		return
+	}
+	d.sinceStep = 0
+
+	speaker := d.assign(embedding(d.features))
+	switch {
+	case speaker == d.current:
+		d.candidate = 0
+	case d.fresh || d.current == 0 || speaker == d.candidate:
+		d.current = speaker
+		d.candidate = 0
+	default:
+		d.candidate = speaker
+	}
+	d.fresh = false
+}
+
+// assign returns the speaker an embedding belongs to, starting a new one
+// when none is similar enough and there is room
+func (d *diarizer) assign(embedding []float64) int32 {
+	var best *speakerCluster
+	bestSimilarity := -1.0
+	for _, cluster := range d.clusters {
+		if similarity := cosineSimilarity(embedding, cluster.centroid); similarity > bestSimilarity {
+			best, bestSimilarity = cluster, similarity
+		}
+	}
+
+	if best == nil || (bestSimilarity < d.threshold && len(d.clusters) < d.maxSpeakers) {
+		cluster := &speakerCluster{id: int32(len(d.clusters) + 1), centroid: embedding, weight: 1}
+		d.clusters = append(d.clusters, cluster)
+		return cluster.id
+	}
+
+	// Move the centroid towards the new speech
+	best.weight = min(best.weight+1, diarizeMaxWeight)
+	for i, v := range embedding {
+		best.centroid[i] += (v - best.centroid[i]) / float64(best.weight)
+	}
+	return best.id
+}
+
+// mfcc returns c1..c12 of a frame's mel cepstrum
+func mfcc(frame []float64) []float64 {
+	spectrum := make([]complex128, diarizeFrameSize)
+	for i, v := range frame {
+		spectrum[i] = complex(v*diarizeWindow[i], 0)
+	}
+	fft(spectrum, false)
+
+	energies := make([]float64, diarizeMelBands)
+	for band, weights := range diarizeBank {
+		var sum float64
+		for k, w := range weights {
+			if w > 0 {
+				sum += w * (real(spectrum[k])*real(spectrum[k]) + imag(spectrum[k])*imag(spectrum[k]))
+			}
+		}
+		energies[band] = math.Log(sum + 1e-10)
+	}
+
+	// DCT-II, skipping c0 (loudness)
+	coeffs := make([]float64, diarizeCoeffs)
+	for c := range coeffs {
+		var sum float64
+		for band, e := range energies {
+			sum += e * math.Cos(math.Pi*float64(c+1)*(float64(band)+0.5)/diarizeMelBands)
+		}
+		coeffs[c] = sum
+	}
+	return coeffs
+}
+
+// embedding describes a voice by the mean and standard deviation of its
+// feature frames
+func embedding(frames [][]float64) []float64 {
+	out := make([]float64, 2*diarizeCoeffs)
+	n := float64(len(frames))
+	for _, frame := range frames {
+		for i, v := range frame {
+			out[i] += v / n
+		}
+	}
+	for _, frame := range frames {
+		for i, v := range frame {
+			diff := v - out[i]
+			out[diarizeCoeffs+i] += diff * diff / n
+		}
+	}
+	for i := diarizeCoeffs; i < len(out); i++ {
+		out[i] = math.Sqrt(out[i])
+	}
+	return out
+}
+
+// cosineSimilarity of two vectors of the same length
+func cosineSimilarity(a, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
+
+// diarizerSet keeps a diarizer per sender, since each mic is shared by its
+// own set of people
+type diarizerSet struct {
+	config     *Config
+	mu         sync.Mutex
+	byIdentity map[string]*diarizer
+}
+
+// newDiarizerSet creates an empty diarizer set
+func newDiarizerSet(config *Config) *diarizerSet {
+	return &diarizerSet{config: config, byIdentity: make(map[string]*diarizer)}
+}
+
+// process runs samples through the sender's diarizer
+func (ds *diarizerSet) process(identity string, samples []int16) (speaker int32, changed bool, previous int32) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	d, ok := ds.byIdentity[identity]
+	if !ok {
+		d = newDiarizer(ds.config)
+		ds.byIdentity[identity] = d
+	}
+	return d.process(samples)
+}
+
+// diarize tags a received mic frame with who is speaking on its sender's
+// mic, when diarization is on, and emits speaker_change when that changes
+func (s *RoomSession) diarize(frame *AudioFrame) {
+	if s.diarizers == nil {
+		return
+	}
+
+	samples := pooledSamples(frame.PCM)
+	speaker, changed, previous := s.diarizers.process(frame.ParticipantIdentity, *samples)
+	putSamples(samples)
+
+	frame.SpeakerID = speaker
+	if !changed {
+		return
+	}
+	speakerChanges.Inc()
+	s.log().Debug("Speaker changed", "participant", frame.ParticipantIdentity, "speaker_id", speaker, "previous", previous)
+	s.emitEvent(EventSpeakerChange, frame.TrackName, map[string]string{
+		"participant_identity": frame.ParticipantIdentity,
+		"speaker_id":           strconv.Itoa(int(speaker)),
+		"previous_speaker_id":  strconv.Itoa(int(previous)),
+	})
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+)
+
+// DitherMode selects how audio deeper than 16 bits (24/32-bit integer or
+// float PCM) is reduced to int16
+type DitherMode string
+
+const (
+	// DitherNone keeps the top 16 bits of integer samples and rounds float ones
+	DitherNone DitherMode = "none"
+	// DitherTPDF adds triangular noise of up to ±1 LSB before rounding, so
+	// quiet passages fade into a steady hiss instead of distortion
+	DitherTPDF DitherMode = "tpdf"
+	// DitherShaped is TPDF dither with error feedback that moves the noise
+	// toward high frequencies, where it is least audible
+	DitherShaped DitherMode = "shaped"
+)
+
+// ditherShapingMinRate is the lowest source rate given second-order noise
+// shaping; below it the noise would be pushed into the speech band, so
+// shaping is first-order
+const ditherShapingMinRate = 32000
+
+// parseDitherMode converts a config string into a DitherMode, defaulting to none
+func parseDitherMode(mode string) DitherMode {
+	switch DitherMode(strings.ToLower(mode)) {
+	case DitherTPDF:
+		return DitherTPDF
+	case DitherShaped:
+		return DitherShaped
+	default:
+		return DitherNone
+	}
+}
+
+// ffmpegDither returns ffmpeg's equivalent dither_method for a mode, or ""
+// to leave its conversion to s16 undithered
+func ffmpegDither(mode DitherMode) string {
+	switch mode {
+	case DitherTPDF:
+		return "triangular"
+	case DitherShaped:
+		return "shibata"
+	}
+	return ""
+}
+
+// ditherer quantizes interleaved samples to int16 with TPDF dither and
+// optional noise shaping, keeping each channel's error history across calls
+// so a stream converted in chunks is dithered as one. A nil ditherer just
+// rounds.
+type ditherer struct {
+	channels int
+	shaping  []float64   // error feedback coefficients (nil = plain TPDF)
+	errs     [][]float64 // per channel, most recent quantization error first
+	ch       int         // channel of the next sample
+	rng      *rand.Rand
+}
+
+// newDitherer creates a ditherer for audio at sampleRate; returns nil for
+// DitherNone
+func newDitherer(mode DitherMode, sampleRate, channels int) *ditherer {
+	if mode != DitherTPDF && mode != DitherShaped {
+		return nil
+	}
+
+	d := &ditherer{
+		channels: max(1, channels),
+		rng:      rand.New(rand.NewSource(rand.Int63())),
+	}
+	if mode == DitherShaped {
+		// Noise transfer (1 - z^-1)^2, or (1 - z^-1) at low rates
+		d.shaping = []float64{2, -1}
+		if sampleRate < ditherShapingMinRate {
+			d.shaping = []float64{1}
+		}
+	}
+	d.errs = make([][]float64, d.channels)
+	for c := range d.errs {
+		d.errs[c] = make([]float64, len(d.shaping))
+	}
+	return d
+}
+
+// quantize converts a sample in int16 units to int16; samples must be given
+// in interleaved order
+func (d *ditherer) quantize(v float64) int16 {
+	if d == nil {
+		return clampInt16(v)
+	}
+
+	errs := d.errs[d.ch]
+	d.ch = (d.ch + 1) % d.channels
+
+	for k, h := range d.shaping {
+		v -= h * errs[k]
+	}
+	q := float64(clampInt16(v + d.rng.Float64() - d.rng.Float64()))
+
+	if len(errs) > 0 {
+		copy(errs[1:], errs)
+		// Bounded so a run of clamped samples can't make the loop unstable
+		errs[0] = math.Max(-1, math.Min(1, q-v))
+	}
+	return int16(q)
+}
+
+// wideSample reads a 24/32-bit integer sample as a value in int16 units,
+// keeping the bits below the top 16 as a fraction
+func wideSample(b []byte, bigEndian, unsigned bool) float64 {
+	var u uint32
+	for k := range b {
+		shift := 8 * (4 - len(b) + k) // little-endian: last byte is most significant
+		if bigEndian {
+			shift = 8 * (3 - k)
+		}
+		u |= uint32(b[k]) << shift
+	}
+	if unsigned {
+		u ^= 0x80000000
+	}
+	return float64(int32(u)) / 65536
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// seededDitherer returns a ditherer whose noise is reproducible
+func seededDitherer(mode DitherMode, sampleRate, channels int, seed int64) *ditherer {
+	d := newDitherer(mode, sampleRate, channels)
+	d.rng = rand.New(rand.NewSource(seed))
+	return d
+}
+
+// Full-scale input, and input past it, stays at the rail it is on: dither
+// and error feedback never push a sample over and wrap it to the other side
+func TestDitherFullScaleNoWraparound(t *testing.T) {
+	inputs := []struct {
+		name    string
+		samples []float64
+	}{
+		{"positive full scale", []float64{32767, 32767, 32767, 32767}},
+		{"negative full scale", []float64{-32768, -32768, -32768, -32768}},
+		{"past full scale", []float64{40000, -40000, 1e9, -1e9}},
+		{"alternating rails", []float64{32767, -32768, 32767, -32768}},
+		{"just under full scale", []float64{32766.9, -32767.9, 32766.5, -32767.5}},
+	}
+	for _, mode := range []DitherMode{DitherTPDF, DitherShaped} {
+		for _, rate := range []int{16000, 48000} {
+			for _, in := range inputs {
+				d := seededDitherer(mode, rate, 2, 1)
+				for pass := 0; pass < 2000; pass++ {
+					for _, v := range in.samples {
+						got := d.quantize(v)
+						want := math.Max(-32768, math.Min(32767, v))
+						// TPDF adds ±1 LSB and rounding ½; shaping feeds back
+						// at most 3 LSB of bounded error
+						if math.Signbit(float64(got)) != math.Signbit(want) || math.Abs(float64(got)-want) > 4.5 {
+							t.Fatalf("%s at %dHz, %s: quantize(%v) = %d", mode, rate, in.name, v, got)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// 24/32-bit integer and float samples at full scale convert without
+// wrapping, through the same path playback uses
+func TestDitherWideSamplesAtFullScale(t *testing.T) {
+	le32 := func(v uint32) []byte { return binary.LittleEndian.AppendUint32(nil, v) }
+	le24 := func(v uint32) []byte { return le32(v)[:3] }
+	float32LE := func(f float32) []byte { return le32(math.Float32bits(f)) }
+
+	tests := []struct {
+		name   string
+		format wavFormat
+		data   []byte
+		want   int16 // sign of the output (+1 or -1)
+	}{
+		{"int32 max", wavFormat{audioFormat: wavFormatPCM, bitsPerSample: 32}, le32(0x7FFFFFFF), 1},
+		{"int32 min", wavFormat{audioFormat: wavFormatPCM, bitsPerSample: 32}, le32(0x80000000), -1},
+		{"int24 max", wavFormat{audioFormat: wavFormatPCM, bitsPerSample: 24}, le24(0x7FFFFF), 1},
+		{"int24 min", wavFormat{audioFormat: wavFormatPCM, bitsPerSample: 24}, le24(0x800000), -1},
+		{"float +1", wavFormat{audioFormat: wavFormatFloat, bitsPerSample: 32}, float32LE(1), 1},
+		{"float -1", wavFormat{audioFormat: wavFormatFloat, bitsPerSample: 32}, float32LE(-1), -1},
+		{"float over +1", wavFormat{audioFormat: wavFormatFloat, bitsPerSample: 32}, float32LE(1.5), 1},
+		{"float under -1", wavFormat{audioFormat: wavFormatFloat, bitsPerSample: 32}, float32LE(-1.5), -1},
+	}
+	for _, mode := range []DitherMode{DitherTPDF, DitherShaped} {
+		for _, tt := range tests {
+			format := tt.format
+			format.channels, format.sampleRate = 1, 48000
+			var data []byte
+			for i := 0; i < 1000; i++ {
+				data = append(data, tt.data...)
+			}
+			d := seededDitherer(mode, format.sampleRate, 1, 1)
+			for i, got := range wavToInt16(data, format, d) {
+				if tt.want > 0 && got < 32762 || tt.want < 0 && got > -32763 {
+					t.Fatalf("%s, %s: sample %d = %d", mode, tt.name, i, got)
+				}
+			}
+		}
+	}
+}
+
+// A stream converted in chunks is dithered as one: error feedback carries
+// from one call to the next within a track
+func TestDitherStateCarriesAcrossChunks(t *testing.T) {
+	format := wavFormat{audioFormat: wavFormatFloat, bitsPerSample: 32, channels: 2, sampleRate: 48000}
+	var data []byte
+	for i := 0; i < 4800; i++ {
+		v := float32(0.03 * math.Sin(float64(i)/7))
+		data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v))
+	}
+
+	whole := wavToInt16(data, format, seededDitherer(DitherShaped, 48000, 2, 7))
+
+	d := seededDitherer(DitherShaped, 48000, 2, 7)
+	var chunked []int16
+	for len(data) > 0 {
+		n := min(len(data), 4*2*37) // frames straddle no chunk boundary
+		chunked = append(chunked, wavToInt16(data[:n], format, d)...)
+		data = data[n:]
+	}
+
+	for i := range whole {
+		if chunked[i] != whole[i] {
+			t.Fatalf("sample %d: chunked %d, whole %d", i, chunked[i], whole[i])
+		}
+	}
+}
+
+// Each track gets a fresh ditherer, so the error feedback one track builds
+// up doesn't leak into the next
+func TestDitherStateResetsBetweenTracks(t *testing.T) {
+	trackB := make([]float64, 480)
+	for i := range trackB {
+		trackB[i] = 0.4 // below one LSB: shaped by feedback only
+	}
+
+	// Track A leaves error history on its ditherer
+	trackA := seededDitherer(DitherShaped, 48000, 2, 3)
+	for i := 0; i < 4800; i++ {
+		trackA.quantize(32767.6 * math.Sin(float64(i)))
+	}
+	history := false
+	for _, errs := range trackA.errs {
+		for _, e := range errs {
+			history = history || e != 0
+		}
+	}
+	if !history {
+		t.Fatal("track A left no error history; the test would prove nothing")
+	}
+
+	// The next track's ditherer starts clean
+	next := newDitherer(DitherShaped, 48000, 2)
+	if next.ch != 0 {
+		t.Fatalf("new ditherer starts on channel %d", next.ch)
+	}
+	for c, errs := range next.errs {
+		for k, e := range errs {
+			if e != 0 {
+				t.Fatalf("new ditherer has error %v at channel %d, tap %d", e, c, k)
+			}
+		}
+	}
+
+	// With the same noise, track B comes out as if track A never played;
+	// carrying A's ditherer over would change it
+	fresh := seededDitherer(DitherShaped, 48000, 2, 5)
+	next.rng = rand.New(rand.NewSource(5))
+	trackA.rng = rand.New(rand.NewSource(5))
+	differs := false
+	for i, v := range trackB {
+		want := fresh.quantize(v)
+		if got := next.quantize(v); got != want {
+			t.Fatalf("sample %d: next track %d, fresh %d", i, got, want)
+		}
+		differs = differs || trackA.quantize(v) != want
+	}
+	if !differs {
+		t.Fatal("reusing track A's ditherer gave the same output; state is not carried")
+	}
+}
+
+// Channels keep separate error histories
+func TestDitherChannelsIndependent(t *testing.T) {
+	stereo := seededDitherer(DitherShaped, 48000, 2, 9)
+	left := seededDitherer(DitherShaped, 48000, 1, 9)
+	right := seededDitherer(DitherShaped, 48000, 1, 9)
+
+	// Share one noise source between the mono ditherers in the order the
+	// stereo one draws from it
+	shared := rand.New(rand.NewSource(9))
+	left.rng, right.rng, stereo.rng = shared, shared, rand.New(rand.NewSource(9))
+
+	for i := 0; i < 1000; i++ {
+		l, r := 3000.3*math.Sin(float64(i)/5), -12000.8*math.Cos(float64(i)/9)
+		if got, want := stereo.quantize(l), left.quantize(l); got != want {
+			t.Fatalf("frame %d left: stereo %d, mono %d", i, got, want)
+		}
+		if got, want := stereo.quantize(r), right.quantize(r); got != want {
+			t.Fatalf("frame %d right: stereo %d, mono %d", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// drainPollInterval is how often a drain checks for playback still running
+const drainPollInterval = 100 * time.Millisecond
+
+// isPlaying reports whether the session still has audio being fetched,
+// queued or played out on any track. Paused tracks don't count: they may
+// never resume.
+func (s *RoomSession) isPlaying() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.playbackDone != nil {
+		select {
+		case <-s.playbackDone:
+		default:
+			return true
+		}
+	}
+	for _, state := range s.trackStates {
+		if state.player == nil || state.player.isPaused() {
+			continue
+		}
+		if state.player.isActive() || state.player.queuedFrames() > 0 {
+			return true
+		}
+	}
+	for _, track := range s.opusTracks {
+		if len(track.frames) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Drain puts the bridge into drain mode: new JoinRooms are refused, sessions
+// are handed off to other replicas when HANDOFF_ON_DRAIN is set, every
+// remaining session's event subscribers are told the bridge is going away,
+// and Drain returns once no session is playing audio or timeout has passed.
+// Sessions are left open for the caller to close.
+func (s *LiveKitBridgeService) Drain(timeout time.Duration) {
+	if !s.draining.CompareAndSwap(false, true) {
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+	s.sessions.registry.SetDraining(true)
+	if s.config.HandoffOnDrain {
+		s.handoffAll(deadline)
+	}
+
+	active, _, _ := s.sessions.Counts()
+	slog.Info("Draining bridge", "sessions", active, "timeout", timeout)
+	s.bsLogger.LogInfo("Draining bridge", map[string]interface{}{
+		"sessions":   active,
+		"timeout_ms": timeout.Milliseconds(),
+	})
+
+	s.sessions.Range(func(userId string, session *RoomSession) bool {
+		session.emitEvent(EventBridgeDraining, "", map[string]string{
+			"deadline_ms": strconv.FormatInt(deadline.UnixMilli(), 10),
+		})
+		return true
+	})
+
+	for {
+		playing := 0
+		s.sessions.Range(func(userId string, session *RoomSession) bool {
+			if session.isPlaying() {
+				playing++
+			}
+			return true
+		})
+
+		if playing == 0 {
+			slog.Info("Drain complete: no playback in flight")
+			return
+		}
+		if time.Now().After(deadline) {
+			slog.Warn("Drain timed out with playback still in flight", "sessions_playing", playing)
+			s.bsLogger.LogWarn("Drain timed out with playback still in flight", map[string]interface{}{
+				"sessions_playing": playing,
+			})
+			return
+		}
+		time.Sleep(drainPollInterval)
+	}
+}
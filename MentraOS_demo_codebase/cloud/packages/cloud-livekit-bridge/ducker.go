@@ -0,0 +1,147 @@
+package main
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// ducker lowers background tracks while the session's speech track plays,
+// so an assistant voice stays intelligible over music or app audio
+type ducker struct {
+	speechTrack string
+	targets     map[string]bool // tracks to duck; empty means every other track
+	level       float64         // linear gain applied to ducked tracks
+	ramp        time.Duration
+	release     time.Duration
+
+	mu           sync.Mutex
+	ducked       bool
+	releaseTimer *time.Timer
+	generation   int // bumped on every activity change to invalidate stale releases
+}
+
+// newDucker builds a ducker from config; returns nil when ducking is disabled
+func newDucker(config *Config) *ducker {
+	if config.DuckSpeechTrack == "" || config.DuckAttenuationDb <= 0 {
+		return nil
+	}
+
+	targets := make(map[string]bool)
+	for _, name := range config.DuckTracks {
+		targets[name] = true
+	}
+
+	return &ducker{
+		speechTrack: config.DuckSpeechTrack,
+		targets:     targets,
+		level:       math.Pow(10, -config.DuckAttenuationDb/20),
+		ramp:        config.DuckRamp,
+		release:     config.DuckRelease,
+	}
+}
+
+// appliesTo reports whether a track is ducked while speech plays
+func (d *ducker) appliesTo(trackName string) bool {
+	if trackName == d.speechTrack {
+		return false
+	}
+	return len(d.targets) == 0 || d.targets[trackName]
+}
+
+// isDucked reports whether background tracks should currently be lowered
+func (d *ducker) isDucked() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.ducked
+}
+
+// levelFor returns the ducking level a track should be at right now
+func (d *ducker) levelFor(trackName string) float64 {
+	if d.appliesTo(trackName) && d.isDucked() {
+		return d.level
+	}
+	return 1.0
+}
+
+// speechActivity records the speech track starting or stopping. Ducking
+// starts immediately; restoring waits for the release time so short gaps
+// between streamed TTS chunks don't pump the background level.
+// apply is invoked (asynchronously) whenever the ducked state changes.
+func (d *ducker) speechActivity(active bool, apply func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.generation++
+	if d.releaseTimer != nil {
+		d.releaseTimer.Stop()
+		d.releaseTimer = nil
+	}
+
+	if active {
+		if !d.ducked {
+			d.ducked = true
+			go apply()
+		}
+		return
+	}
+
+	if d.ducked {
+		generation := d.generation
+		d.releaseTimer = time.AfterFunc(d.release, func() {
+			d.mu.Lock()
+			if d.generation != generation {
+				// Speech resumed while this release was firing
+				d.mu.Unlock()
+				return
+			}
+			d.ducked = false
+			d.releaseTimer = nil
+			d.mu.Unlock()
+			apply()
+		})
+	}
+}
+
+// stop cancels a pending release
+func (d *ducker) stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.generation++
+	if d.releaseTimer != nil {
+		d.releaseTimer.Stop()
+		d.releaseTimer = nil
+	}
+}
+
+// onTrackActivity is the activity handler installed on every PCM track player
+func (s *RoomSession) onTrackActivity(trackName string, active bool) {
+	s.priorities.trackActivity(trackName, active, s.applyPriorities)
+	if s.ducker == nil || trackName != s.ducker.speechTrack {
+		return
+	}
+	s.ducker.speechActivity(active, s.applyDucking)
+}
+
+// applyDucking pushes the current ducking level to every affected track
+func (s *RoomSession) applyDucking() {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ducked := s.ducker.isDucked()
+	for trackName, state := range s.trackStates {
+		if state.player == nil || !s.ducker.appliesTo(trackName) {
+			continue
+		}
+		// Priority ducking may hold the track lower still
+		state.player.setDuck(s.duckLevel(trackName), s.ducker.ramp)
+	}
+
+	if ducked {
+		s.log().Info("Ducking background tracks", "speech_track", s.ducker.speechTrack)
+	} else {
+		s.log().Info("Restored background tracks")
+	}
+}
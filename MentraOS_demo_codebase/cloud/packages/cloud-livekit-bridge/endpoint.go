@@ -0,0 +1,181 @@
+package main
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Reasons an utterance ends
+const (
+	utteranceEndSilence   = "silence"    // the user stopped talking
+	utteranceEndMaxLength = "max_length" // cut at ENDPOINT_MAX_UTTERANCE_MS; the next one starts straight away
+)
+
+// utterance is speech the endpointer is collecting
+type utterance struct {
+	id         int
+	identity   string    // participant whose audio started it
+	start      int64     // sample offset where speech began
+	startAt    time.Time // when that audio reached the bridge
+	audioStart int64     // sample offset of audio[0] (pre-roll included)
+	audio      []int16   // kept only when the endpointer includes audio
+}
+
+// utteranceEvent is an utterance starting or ending
+type utteranceEvent struct {
+	ended  bool
+	reason string // why it ended
+	end    int64
+	endAt  time.Time
+	utterance
+}
+
+// endpointer splits a session's incoming mic audio into utterances with
+// the VAD: one starts when speech is detected and ends after silence of the
+// VAD's hangover, or when it reaches maxLength. Optionally it collects each
+// utterance's audio, from preRoll before the speech started.
+type endpointer struct {
+	minSpeech    int64 // samples of speech the VAD needs before it reports a start
+	silence      int64 // samples of quiet that end an utterance
+	preRoll      int64
+	maxLength    int64
+	includeAudio bool
+
+	mu        sync.Mutex
+	vad       *vad
+	recent    []int16 // audio before the current utterance, for its pre-roll
+	current   *utterance
+	processed int64 // samples seen
+	nextID    int
+}
+
+// newEndpointer builds an endpointer from config; silence overrides
+// ENDPOINT_SILENCE_MS when set
+func newEndpointer(config *Config, silence time.Duration, includeAudio bool) *endpointer {
+	if silence <= 0 {
+		silence = config.EndpointSilence
+	}
+	samples := func(d time.Duration) int64 {
+		return int64(d.Seconds() * incomingSampleRate)
+	}
+	return &endpointer{
+		minSpeech:    samples(config.VADMinSpeech),
+		silence:      samples(silence),
+		preRoll:      samples(config.EndpointPreRoll),
+		maxLength:    samples(config.EndpointMaxUtterance),
+		includeAudio: includeAudio,
+		vad:          newVAD(config.VADThresholdDb, config.VADMinSpeech, silence),
+		nextID:       1,
+	}
+}
+
+// process feeds received mic samples, captured at capturedAt, and returns
+// the utterances that started or ended in them
+func (e *endpointer) process(samples []int16, identity string, capturedAt time.Time) []utteranceEvent {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var events []utteranceEvent
+	started, ended := e.vad.process(samples)
+	e.processed += int64(len(samples))
+	end := e.processed
+
+	// When a sample offset within this chunk reached the bridge
+	at := func(offset int64) time.Time {
+		return capturedAt.Add(-time.Duration(end-offset) * time.Second / incomingSampleRate)
+	}
+
+	if e.current != nil && e.includeAudio {
+		e.current.audio = append(e.current.audio, samples...)
+	}
+	if e.current == nil && e.includeAudio {
+		e.recent = append(e.recent, samples...)
+		if keep := int(e.preRoll + e.minSpeech); len(e.recent) > keep {
+			e.recent = e.recent[:copy(e.recent, e.recent[len(e.recent)-keep:])]
+		}
+	}
+
+	if ended && e.current != nil {
+		// The VAD only gives up after the hangover; the speech ended before it
+		events = append(events, e.finish(max(end-e.silence, e.current.start), at, utteranceEndSilence))
+	}
+	if started && e.current == nil {
+		events = append(events, e.begin(max(end-e.minSpeech, 0), identity, at))
+	}
+	if e.current != nil && e.maxLength > 0 && end-e.current.start >= e.maxLength {
+		events = append(events, e.finish(end, at, utteranceEndMaxLength))
+		events = append(events, e.begin(end, identity, at))
+	}
+	return events
+}
+
+// begin starts an utterance at offset, taking the pre-roll from the recent
+// audio
+func (e *endpointer) begin(offset int64, identity string, at func(int64) time.Time) utteranceEvent {
+	u := &utterance{
+		id:         e.nextID,
+		identity:   identity,
+		start:      offset,
+		startAt:    at(offset),
+		audioStart: e.processed,
+	}
+	e.nextID++
+	if e.includeAudio {
+		preRoll := min(int64(len(e.recent)), e.processed-max(offset-e.preRoll, 0))
+		u.audio = append(u.audio, e.recent[int64(len(e.recent))-preRoll:]...)
+		u.audioStart = e.processed - preRoll
+		e.recent = e.recent[:0]
+	}
+	e.current = u
+	return utteranceEvent{utterance: *u}
+}
+
+// finish ends the current utterance at offset, trimming its audio there
+func (e *endpointer) finish(offset int64, at func(int64) time.Time, reason string) utteranceEvent {
+	u := e.current
+	e.current = nil
+	if e.includeAudio {
+		u.audio = u.audio[:min(int64(len(u.audio)), max(offset-u.audioStart, 0))]
+	}
+	return utteranceEvent{ended: true, reason: reason, end: offset, endAt: at(offset), utterance: *u}
+}
+
+// detectUtterances runs a received mic frame through the session's
+// endpointer, if any, and emits utterance_start and utterance_end events
+func (s *RoomSession) detectUtterances(frame AudioFrame) {
+	e := s.endpointer
+	if e == nil {
+		return
+	}
+
+	samples := pooledSamples(frame.PCM)
+	events := e.process(*samples, frame.ParticipantIdentity, frame.CapturedAt)
+	putSamples(samples)
+
+	for _, event := range events {
+		attributes := map[string]string{
+			"utterance_id":         strconv.Itoa(event.id),
+			"participant_identity": event.identity,
+			"start_ms":             strconv.FormatInt(event.start*1000/incomingSampleRate, 10),
+			"start_at_ms":          strconv.FormatInt(event.startAt.UnixMilli(), 10),
+		}
+		if !event.ended {
+			s.emitEvent(EventUtteranceStart, frame.TrackName, attributes)
+			continue
+		}
+
+		attributes["end_ms"] = strconv.FormatInt(event.end*1000/incomingSampleRate, 10)
+		attributes["end_at_ms"] = strconv.FormatInt(event.endAt.UnixMilli(), 10)
+		attributes["duration_ms"] = strconv.FormatInt((event.end-event.start)*1000/incomingSampleRate, 10)
+		attributes["reason"] = event.reason
+		var audio []byte
+		if e.includeAudio {
+			audio = int16ToBytes(event.audio)
+			attributes["audio_start_ms"] = strconv.FormatInt(event.audioStart*1000/incomingSampleRate, 10)
+			attributes["audio_format"] = "pcm_s16le"
+			attributes["sample_rate"] = strconv.Itoa(incomingSampleRate)
+		}
+		s.emitAudioEvent(EventUtteranceEnd, frame.TrackName, attributes, audio)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/eventstore"
+)
+
+// Session event types pushed to the cloud over StreamEvents
+const (
+	EventBargeIn                = "barge_in"                 // user started speaking over a playing track
+	EventConnectionState        = "connection_state"         // LiveKit connection state changed (see ConnectionState)
+	EventTrackPublished         = "track_published"          // the bridge published (or republished) a track
+	EventTrackUnpublished       = "track_unpublished"        // the bridge unpublished a track
+	EventTrackIdle              = "track_idle"               // a track had no audio for TRACK_IDLE_TIMEOUT_MS and is being unpublished (idle_ms)
+	EventParticipantJoined      = "participant_joined"       // a remote participant joined the room
+	EventParticipantLeft        = "participant_left"         // a remote participant left the room
+	EventRemoteTrackPublished   = "remote_track_published"   // a remote participant published a track
+	EventRemoteTrackUnpublished = "remote_track_unpublished" // a remote participant unpublished a track
+	EventRemoteTrackMuted       = "remote_track_muted"       // a participant muted a track
+	EventRemoteTrackUnmuted     = "remote_track_unmuted"     // a participant unmuted a track
+	EventBridgeDraining         = "bridge_draining"          // the bridge is shutting down; the session closes by deadline_ms
+	EventActiveSpeakers         = "active_speakers"          // LiveKit's active speakers changed (empty list = nobody talking)
+	EventPlaybackStarted        = "playback_started"         // a PlayAudio/EnqueueAudio request started (request_id)
+	EventPlaybackCompleted      = "playback_completed"       // a request's audio finished playing out (request_id, duration_ms)
+	EventPlaybackInterrupted    = "playback_interrupted"     // a request was stopped, replaced or dequeued (request_id, error)
+	EventPlaybackFailed         = "playback_failed"          // a request failed (request_id, error)
+	EventSessionCreated         = "session_created"          // the session joined its room (room_name)
+	EventSessionClosed          = "session_closed"           // the session closed (reason: leave_room, replaced, stream_error, idle, shutdown, handoff, closed)
+	EventQuotaExceeded          = "quota_exceeded"           // the user or an app used up its daily audio (quota, app_id, limit_minutes, used_minutes, action)
+	EventTranscript             = "transcript"               // the speech recognizer heard the user (text, final, start_ms, end_ms, confidence, language)
+	EventWakeWord               = "wake_word"                // a wake word was heard (keyword, participant_identity, audio_ms, captured_at_ms, latency_ms)
+	EventUtteranceStart         = "utterance_start"          // the user started talking (utterance_id, participant_identity, start_ms, start_at_ms)
+	EventUtteranceEnd           = "utterance_end"            // the user stopped talking (utterance_id, start_ms, end_ms, duration_ms, reason, and the audio if requested)
+	EventSoundEvent             = "sound_event"              // a watched sound was heard (event, class, score, participant_identity, window_start_ms; or event name_called with name, source)
+	EventSpeakerChange          = "speaker_change"           // a different person is talking on a shared mic (participant_identity, speaker_id, previous_speaker_id)
+	EventLoopbackLatency        = "loopback_latency"         // echoed audio's time through the bridge since the last report (frames, dropped, delay_ms, latency_ms, min_latency_ms, max_latency_ms)
+	EventMirrorStopped          = "mirror_stopped"           // the mirror room's connection dropped and mirroring stopped (reason)
+	EventClipping               = "clipping"                 // a track's audio stayed near full scale (sustained_ms, clipped_samples, output_clipped_samples, soft_limit_db)
+)
+
+// isStatusEvent reports whether an event changes what GetStatus returns
+func isStatusEvent(eventType string) bool {
+	switch eventType {
+	case EventConnectionState, EventTrackPublished, EventTrackUnpublished,
+		EventParticipantJoined, EventParticipantLeft, EventBridgeDraining,
+		EventRemoteTrackPublished, EventRemoteTrackUnpublished, EventRemoteTrackMuted, EventRemoteTrackUnmuted:
+		return true
+	}
+	return false
+}
+
+// eventSubscriberBuffer is how many events a slow subscriber may lag behind
+// before new events are dropped for it
+const eventSubscriberBuffer = 64
+
+// SessionEvent is something that happened in a RoomSession that the cloud
+// may want to react to without polling
+type SessionEvent struct {
+	Type       string
+	TrackName  string
+	Timestamp  time.Time
+	Attributes map[string]string
+	Audio      []byte // utterance_end: the utterance as PCM16, when requested
+}
+
+// eventBus fans session events out to any number of subscribers. Publishing
+// never blocks: a subscriber that falls behind misses events.
+type eventBus struct {
+	userId      string
+	mu          sync.Mutex
+	subscribers map[int]chan SessionEvent
+	nextID      int
+	closed      bool
+}
+
+// newEventBus creates an empty event bus for a user's session
+func newEventBus(userId string) *eventBus {
+	return &eventBus{userId: userId, subscribers: make(map[int]chan SessionEvent)}
+}
+
+// subscribe registers a subscriber; the channel closes when the bus closes
+func (b *eventBus) subscribe() (int, <-chan SessionEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan SessionEvent, eventSubscriberBuffer)
+	if b.closed {
+		close(ch)
+		return -1, ch
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = ch
+	return id, ch
+}
+
+// subscriberCount returns how many subscriber channels are open
+func (b *eventBus) subscriberCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subscribers)
+}
+
+// unsubscribe removes a subscriber and closes its channel
+func (b *eventBus) unsubscribe(id int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if ch, exists := b.subscribers[id]; exists {
+		close(ch)
+		delete(b.subscribers, id)
+	}
+}
+
+// publish delivers an event to every subscriber that has room for it
+func (b *eventBus) publish(event SessionEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for id, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Event subscriber is full, dropping event", "user_id", b.userId, "subscriber", id, "event", event.Type)
+		}
+	}
+}
+
+// close closes every subscriber channel; later publishes are no-ops
+func (b *eventBus) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for id, ch := range b.subscribers {
+		close(ch)
+		delete(b.subscribers, id)
+	}
+}
+
+// emitEvent publishes a session event stamped with the current time
+func (s *RoomSession) emitEvent(eventType, trackName string, attributes map[string]string) {
+	s.emitAudioEvent(eventType, trackName, attributes, nil)
+}
+
+// emitAudioEvent is emitEvent with audio for StreamEvents subscribers; the
+// session history keeps only the attributes
+func (s *RoomSession) emitAudioEvent(eventType, trackName string, attributes map[string]string, audio []byte) {
+	now := time.Now()
+	s.events.publish(SessionEvent{
+		Type:       eventType,
+		TrackName:  trackName,
+		Timestamp:  now,
+		Attributes: attributes,
+		Audio:      audio,
+	})
+	s.eventStore.Record(eventstore.Event{
+		UserID:     s.userId,
+		Type:       eventType,
+		TrackName:  trackName,
+		Attributes: attributes,
+		Time:       now,
+	})
+}
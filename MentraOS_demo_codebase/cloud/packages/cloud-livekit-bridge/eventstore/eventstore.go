@@ -0,0 +1,280 @@
+package eventstore
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Event is one session lifecycle event as stored in MongoDB
+type Event struct {
+	UserID     string            `bson:"user_id"`
+	Type       string            `bson:"type"`
+	TrackName  string            `bson:"track_name,omitempty"`
+	Attributes map[string]string `bson:"attributes,omitempty"`
+	Time       time.Time         `bson:"time"`
+	Bridge     string            `bson:"bridge,omitempty"` // host that recorded it
+}
+
+// Query selects stored events; zero fields match everything
+type Query struct {
+	UserID string
+	Types  []string
+	Since  time.Time
+	Until  time.Time
+	Limit  int // newest first; capped at MaxQueryLimit
+}
+
+// MaxQueryLimit caps how many events one query returns
+const MaxQueryLimit = 1000
+
+// Config for Store
+type Config struct {
+	URI           string // MongoDB connection string
+	Database      string
+	Collection    string
+	Retention     time.Duration // events expire after this long (0 = kept forever)
+	BatchSize     int
+	FlushInterval time.Duration
+	MaxBuffered   int // events held while MongoDB is slow; newer ones are dropped
+	Enabled       bool
+}
+
+// Store records session events to MongoDB in batches and queries them back.
+// A disabled (or nil) store drops events and answers queries with an error.
+type Store struct {
+	cfg        Config
+	client     *mongo.Client
+	collection *mongo.Collection
+	host       string
+	buffer     []Event
+	bufferMu   sync.Mutex
+	dropped    int64
+	kick       chan struct{} // a full batch is waiting
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+}
+
+// New connects to MongoDB and starts the batch writer. Connection and index
+// errors are logged and leave the store disabled, so the bridge runs on
+// without history.
+func New(cfg Config) *Store {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.FlushInterval == 0 {
+		cfg.FlushInterval = time.Second
+	}
+	if cfg.MaxBuffered == 0 {
+		cfg.MaxBuffered = 10000
+	}
+	if cfg.Database == "" {
+		cfg.Database = "mentraos"
+	}
+	if cfg.Collection == "" {
+		cfg.Collection = "livekit_bridge_events"
+	}
+
+	s := &Store{cfg: cfg, kick: make(chan struct{}, 1), stopCh: make(chan struct{})}
+	s.host, _ = os.Hostname()
+	if !cfg.Enabled {
+		return s
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		slog.Error("Session event store disabled: failed to connect to MongoDB", "error", err)
+		s.cfg.Enabled = false
+		return s
+	}
+	s.client = client
+	s.collection = client.Database(cfg.Database).Collection(cfg.Collection)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.ensureIndexes(ctx); err != nil {
+		// Writes still work; queries are just slower until an index exists
+		slog.Warn("Failed to create session event indexes", "error", err)
+	}
+
+	s.wg.Add(1)
+	go s.flushLoop()
+	slog.Info("Session event store enabled", "database", cfg.Database, "collection", cfg.Collection)
+	return s
+}
+
+// NewFromEnv creates a store from MONGODB_URI and friends; the store is off
+// unless MONGODB_URI is set
+func NewFromEnv() *Store {
+	uri := os.Getenv("MONGODB_URI")
+	retentionDays, _ := strconv.Atoi(os.Getenv("EVENT_RETENTION_DAYS"))
+	if _, set := os.LookupEnv("EVENT_RETENTION_DAYS"); !set {
+		retentionDays = 30
+	}
+	return New(Config{
+		URI:        uri,
+		Database:   os.Getenv("MONGODB_DATABASE"),
+		Collection: os.Getenv("MONGODB_EVENTS_COLLECTION"),
+		Retention:  time.Duration(retentionDays) * 24 * time.Hour,
+		Enabled:    uri != "",
+	})
+}
+
+// ensureIndexes indexes events by user and time for history queries, and
+// expires them after the retention period
+func (s *Store) ensureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "time", Value: -1}}},
+	}
+	if s.cfg.Retention > 0 {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "time", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(s.cfg.Retention.Seconds())),
+		})
+	}
+	_, err := s.collection.Indexes().CreateMany(ctx, indexes)
+	return err
+}
+
+// Enabled reports whether events are being stored
+func (s *Store) Enabled() bool {
+	return s != nil && s.cfg.Enabled
+}
+
+// Record queues an event for the next batch; it never blocks. While MongoDB
+// is unreachable events pile up to MaxBuffered, then new ones are dropped.
+func (s *Store) Record(event Event) {
+	if !s.Enabled() {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Bridge = s.host
+
+	s.bufferMu.Lock()
+	if len(s.buffer) >= s.cfg.MaxBuffered {
+		s.dropped++
+		if s.dropped%1000 == 1 {
+			slog.Warn("Session event buffer full, dropping events", "dropped", s.dropped)
+		}
+		s.bufferMu.Unlock()
+		return
+	}
+	s.buffer = append(s.buffer, event)
+	full := len(s.buffer) >= s.cfg.BatchSize
+	s.bufferMu.Unlock()
+
+	if full {
+		select {
+		case s.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flushLoop writes buffered events every flush interval, or as soon as a
+// batch fills, until Close
+func (s *Store) flushLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flush()
+		case <-s.kick:
+			s.flush()
+		case <-s.stopCh:
+			s.flush()
+			return
+		}
+	}
+}
+
+// flush writes every buffered event; a failed batch is put back to retry
+func (s *Store) flush() {
+	s.bufferMu.Lock()
+	if len(s.buffer) == 0 {
+		s.bufferMu.Unlock()
+		return
+	}
+	events := s.buffer
+	s.buffer = nil
+	s.bufferMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := s.collection.InsertMany(ctx, events); err != nil {
+		slog.Warn("Failed to store session events", "events", len(events), "error", err)
+
+		s.bufferMu.Lock()
+		keep := max(0, min(len(events), s.cfg.MaxBuffered-len(s.buffer)))
+		s.buffer = append(events[:keep:keep], s.buffer...)
+		s.bufferMu.Unlock()
+	}
+}
+
+// Query returns stored events matching q, newest first
+func (s *Store) Query(ctx context.Context, q Query) ([]Event, error) {
+	if !s.Enabled() {
+		return nil, fmt.Errorf("session event store is not configured")
+	}
+
+	filter := bson.D{}
+	if q.UserID != "" {
+		filter = append(filter, bson.E{Key: "user_id", Value: q.UserID})
+	}
+	if len(q.Types) > 0 {
+		filter = append(filter, bson.E{Key: "type", Value: bson.D{{Key: "$in", Value: q.Types}}})
+	}
+	timeRange := bson.D{}
+	if !q.Since.IsZero() {
+		timeRange = append(timeRange, bson.E{Key: "$gte", Value: q.Since})
+	}
+	if !q.Until.IsZero() {
+		timeRange = append(timeRange, bson.E{Key: "$lt", Value: q.Until})
+	}
+	if len(timeRange) > 0 {
+		filter = append(filter, bson.E{Key: "time", Value: timeRange})
+	}
+
+	limit := q.Limit
+	if limit <= 0 || limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "time", Value: -1}}).SetLimit(int64(limit))
+
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session events: %w", err)
+	}
+	var events []Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("failed to read session events: %w", err)
+	}
+	return events, nil
+}
+
+// Close writes out buffered events and disconnects
+func (s *Store) Close() {
+	if !s.Enabled() {
+		return
+	}
+	close(s.stopCh)
+	s.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.client.Disconnect(ctx)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// flacMagic starts every FLAC stream, followed by its STREAMINFO block
+const flacMagic = "fLaC"
+
+// flacStreamInfo is what the bridge needs from a FLAC stream's STREAMINFO
+type flacStreamInfo struct {
+	sampleRate    int
+	channels      int
+	bitsPerSample int
+	totalSamples  int64 // per channel; 0 = unknown (streamed encoder)
+}
+
+// peekFLACStreamInfo reads the STREAMINFO block at the start of a FLAC
+// stream without consuming it, so the whole stream can go to the decoder
+func peekFLACStreamInfo(br *bufio.Reader) (flacStreamInfo, error) {
+	header, err := br.Peek(4 + 4 + 34)
+	if err != nil {
+		return flacStreamInfo{}, fmt.Errorf("failed to read FLAC header: %w", err)
+	}
+	if string(header[0:4]) != flacMagic {
+		return flacStreamInfo{}, fmt.Errorf("not a valid FLAC file")
+	}
+	if header[4]&0x7F != 0 {
+		return flacStreamInfo{}, fmt.Errorf("FLAC stream doesn't start with STREAMINFO")
+	}
+
+	// 20 bits of sample rate, 3 of channels - 1, 5 of bits per sample - 1
+	// and 36 of total samples, 10 bytes into the block
+	v := binary.BigEndian.Uint64(header[8+10 : 8+18])
+	info := flacStreamInfo{
+		sampleRate:    int(v >> 44),
+		channels:      int(v>>41&0x7) + 1,
+		bitsPerSample: int(v>>36&0x1F) + 1,
+		totalSamples:  int64(v & 0xFFFFFFFFF),
+	}
+	if info.sampleRate == 0 {
+		return flacStreamInfo{}, fmt.Errorf("invalid FLAC sample rate")
+	}
+	return info, nil
+}
+
+// playFLAC decodes and plays FLAC with ffmpeg, at the stream's own rate so
+// the track's resampler is the only one involved; more than two channels
+// are downmixed to stereo
+func (s *LiveKitBridgeService) playFLAC(
+	ctx context.Context,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	progress *playbackProgress,
+) (int64, error) {
+	info, err := peekFLACStreamInfo(br)
+	if err != nil {
+		return 0, err
+	}
+	sink.log().Info("Decoding FLAC", "request_id", req.RequestId, "sample_rate", info.sampleRate,
+		"channels", info.channels, "bits_per_sample", info.bitsPerSample, "total_samples", info.totalSamples)
+
+	if info.totalSamples > 0 {
+		progress.setDuration(time.Duration(info.totalSamples) * time.Second / time.Duration(info.sampleRate))
+	}
+	sampleRate := info.sampleRate
+	if sampleRate < minRawSampleRate || sampleRate > maxRawSampleRate {
+		sampleRate = ffmpegSampleRate
+	}
+	return s.playFFmpeg(ctx, br, req, sink, trackName, "FLAC", sampleRate, min(info.channels, 2))
+}
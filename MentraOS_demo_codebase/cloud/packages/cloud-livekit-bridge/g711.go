@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// telephonySampleRate is the rate G.711 audio is at unless it says
+// otherwise
+const telephonySampleRate = 8000
+
+// rawContentTypes maps the MIME types of headerless audio to their
+// encoding. audio/pcm and audio/l16 are 16-bit little-endian, as ingest
+// has always taken them.
+var rawContentTypes = map[string]pb.AudioFormat_Encoding{
+	"audio/pcm":        pb.AudioFormat_SIGNED,
+	"audio/l16":        pb.AudioFormat_SIGNED,
+	"audio/pcmu":       pb.AudioFormat_MULAW,
+	"audio/basic":      pb.AudioFormat_MULAW,
+	"audio/x-mulaw":    pb.AudioFormat_MULAW,
+	"audio/mulaw":      pb.AudioFormat_MULAW,
+	"audio/g711-mulaw": pb.AudioFormat_MULAW,
+	"audio/pcma":       pb.AudioFormat_ALAW,
+	"audio/x-alaw":     pb.AudioFormat_ALAW,
+	"audio/alaw":       pb.AudioFormat_ALAW,
+	"audio/g711-alaw":  pb.AudioFormat_ALAW,
+}
+
+// rawURLExtensions maps the extensions of headerless G.711 files to their
+// encoding
+var rawURLExtensions = map[string]pb.AudioFormat_Encoding{
+	".ul":   pb.AudioFormat_MULAW,
+	".ulaw": pb.AudioFormat_MULAW,
+	".al":   pb.AudioFormat_ALAW,
+	".alaw": pb.AudioFormat_ALAW,
+}
+
+// rawContentTypeFormat describes the audio of a raw content type such as
+// "audio/PCMU;rate=16000", taking the rate and channels from its parameters
+// or else from sampleRate and channels (0 = the encoding's default). An
+// unknown content type falls back to the URL's extension; returns nil when
+// neither says the audio is raw.
+func rawContentTypeFormat(contentType, url string, sampleRate, channels int32) *pb.AudioFormat {
+	// A bad parameter still leaves the media type
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	encoding, ok := rawContentTypes[mediaType]
+	if !ok {
+		for ext, e := range rawURLExtensions {
+			if strings.HasSuffix(strings.ToLower(url), ext) {
+				encoding, ok = e, true
+			}
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	if rate, err := strconv.Atoi(params["rate"]); err == nil {
+		sampleRate = int32(rate)
+	}
+	if n, err := strconv.Atoi(params["channels"]); err == nil {
+		channels = int32(n)
+	}
+	if sampleRate <= 0 && encoding != pb.AudioFormat_SIGNED {
+		sampleRate = telephonySampleRate
+	}
+	return &pb.AudioFormat{
+		Encoding:   encoding,
+		SampleRate: max(sampleRate, 0),
+		Channels:   max(channels, 0),
+	}
+}
+
+// Sun/NeXT .au encodings, as found in telephony archives
+const (
+	auMuLaw    = 1
+	auLinear8  = 2
+	auLinear16 = 3
+	auLinear24 = 4
+	auLinear32 = 5
+	auFloat    = 6
+	auDouble   = 7
+	auALaw     = 27
+)
+
+// readAUHeader parses a Sun .au header, leaving br positioned at the first
+// sample. The samples are big-endian.
+func readAUHeader(br *bufio.Reader) (wavFormat, error) {
+	header := make([]byte, 24)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return wavFormat{}, fmt.Errorf("failed to read AU header: %w", err)
+	}
+	if string(header[0:4]) != ".snd" {
+		return wavFormat{}, fmt.Errorf("not a valid AU file")
+	}
+	offset := binary.BigEndian.Uint32(header[4:8])
+	size := binary.BigEndian.Uint32(header[8:12])
+	encoding := binary.BigEndian.Uint32(header[12:16])
+
+	format := wavFormat{
+		audioFormat: wavFormatPCM,
+		sampleRate:  int(binary.BigEndian.Uint32(header[16:20])),
+		channels:    int(binary.BigEndian.Uint32(header[20:24])),
+		bigEndian:   true,
+		dataBytes:   int64(size),
+	}
+	if size == 0xFFFFFFFF {
+		format.dataBytes = -1
+	}
+	switch encoding {
+	case auMuLaw:
+		format.audioFormat, format.bitsPerSample = wavFormatMuLaw, 8
+	case auALaw:
+		format.audioFormat, format.bitsPerSample = wavFormatALaw, 8
+	case auLinear8, auLinear16, auLinear24, auLinear32:
+		format.bitsPerSample = 8 * int(encoding-1)
+	case auFloat:
+		format.audioFormat, format.bitsPerSample = wavFormatFloat, 32
+	case auDouble:
+		format.audioFormat, format.bitsPerSample = wavFormatFloat, 64
+	default:
+		return wavFormat{}, fmt.Errorf("%w: AU encoding %d", errUnsupportedFormat, encoding)
+	}
+	if format.channels != 1 && format.channels != 2 {
+		return wavFormat{}, fmt.Errorf("%w: only mono/stereo AU supported", errUnsupportedFormat)
+	}
+	if format.sampleRate <= 0 {
+		return wavFormat{}, fmt.Errorf("invalid AU sample rate")
+	}
+
+	// An annotation may sit between the header and the data
+	if offset > 24 {
+		if _, err := io.CopyN(io.Discard, br, int64(offset-24)); err != nil {
+			return wavFormat{}, fmt.Errorf("failed to skip AU annotation: %w", err)
+		}
+	}
+	return format, nil
+}
+
+// playAU decodes and plays a Sun .au file: G.711, or integer or float PCM
+func (s *LiveKitBridgeService) playAU(
+	ctx context.Context,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	progress *playbackProgress,
+) (int64, error) {
+	format, err := readAUHeader(br)
+	if err != nil {
+		return 0, err
+	}
+	return s.playPCM(ctx, br, format, req, sink, trackName, progress)
+}
+
+// muLawToInt16 expands a G.711 µ-law sample
+func muLawToInt16(b byte) int16 {
+	u := ^b
+	t := (int(u&0x0F)<<3 + 0x84) << ((u & 0x70) >> 4)
+	if u&0x80 != 0 {
+		return int16(0x84 - t)
+	}
+	return int16(t - 0x84)
+}
+
+// aLawToInt16 expands a G.711 A-law sample
+func aLawToInt16(b byte) int16 {
+	a := b ^ 0x55
+	t := int(a&0x0F) << 4
+	switch segment := (a & 0x70) >> 4; segment {
+	case 0:
+		t += 8
+	case 1:
+		t += 0x108
+	default:
+		t = (t + 0x108) << (segment - 1)
+	}
+	if a&0x80 != 0 {
+		return int16(t)
+	}
+	return int16(-t)
+}
@@ -0,0 +1,128 @@
+package main
+
+import "testing"
+
+// Reference decoder outputs from ITU-T G.711 Tables 1 and 2, scaled from
+// the 13-bit (A-law) and 14-bit (µ-law) linear ranges to 16 bits
+func TestG711KnownVectors(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func(byte) int16
+		code   byte
+		want   int16
+	}{
+		{"mu-law +0", muLawToInt16, 0xFF, 0},
+		{"mu-law -0", muLawToInt16, 0x7F, 0},
+		{"mu-law smallest step", muLawToInt16, 0xFE, 8},
+		{"mu-law end of segment 0", muLawToInt16, 0xF0, 120},
+		{"mu-law start of segment 1", muLawToInt16, 0xEF, 132},
+		{"mu-law start of segment 6", muLawToInt16, 0x9F, 8316},
+		{"mu-law positive full scale", muLawToInt16, 0x80, 32124},
+		{"mu-law negative full scale", muLawToInt16, 0x00, -32124},
+		{"A-law smallest positive", aLawToInt16, 0xD5, 8},
+		{"A-law smallest negative", aLawToInt16, 0x55, -8},
+		{"A-law second step", aLawToInt16, 0xD4, 24},
+		{"A-law start of segment 1", aLawToInt16, 0xC5, 264},
+		{"A-law start of segment 3", aLawToInt16, 0xE5, 1056},
+		{"A-law positive full scale", aLawToInt16, 0xAA, 32256},
+		{"A-law negative full scale", aLawToInt16, 0x2A, -32256},
+	}
+	for _, tt := range tests {
+		if got := tt.decode(tt.code); got != tt.want {
+			t.Errorf("%s: decode(%#02x) = %d, want %d", tt.name, tt.code, got, tt.want)
+		}
+	}
+}
+
+// Every code decodes to the value G.711 defines for its segment and step
+func TestG711MatchesSegmentFormula(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		code := byte(c)
+
+		// µ-law: bits are inverted; magnitude is ((2q+33) << s) - 33 in 14 bits
+		u := ^code
+		s, q := int(u>>4&0x07), int(u&0x0F)
+		mu := ((2*q+33)<<s - 33) * 4
+		if u&0x80 != 0 {
+			mu = -mu
+		}
+		if got := muLawToInt16(code); int(got) != mu {
+			t.Errorf("muLawToInt16(%#02x) = %d, want %d", code, got, mu)
+		}
+
+		// A-law: even bits are inverted; magnitude is 2q+1 in segment 0 and
+		// (2q+33) << (s-1) above it, in 13 bits
+		a := code ^ 0x55
+		s, q = int(a>>4&0x07), int(a&0x0F)
+		al := 2*q + 1
+		if s > 0 {
+			al = (2*q + 33) << (s - 1)
+		}
+		al *= 8
+		if a&0x80 == 0 {
+			al = -al
+		}
+		if got := aLawToInt16(code); int(got) != al {
+			t.Errorf("aLawToInt16(%#02x) = %d, want %d", code, got, al)
+		}
+	}
+}
+
+// Decoding then re-encoding gives back the code, so no two codes share a
+// value (apart from µ-law's two zeros)
+func TestG711RoundTrip(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		code := byte(c)
+
+		want := code
+		if code == 0x7F {
+			want = 0xFF // -0 encodes as +0
+		}
+		if got := linearToMuLaw(muLawToInt16(code)); got != want {
+			t.Errorf("mu-law %#02x -> %d -> %#02x", code, muLawToInt16(code), got)
+		}
+		if got := linearToALaw(aLawToInt16(code)); got != code {
+			t.Errorf("A-law %#02x -> %d -> %#02x", code, aLawToInt16(code), got)
+		}
+	}
+}
+
+// linearToMuLaw is the reference G.711 µ-law encoder for 16-bit samples
+func linearToMuLaw(sample int16) byte {
+	const bias, clip = 0x84, 32635
+	pcm := int(sample)
+	var sign byte
+	if pcm < 0 {
+		sign, pcm = 0x80, -pcm
+	}
+	pcm = min(pcm, clip) + bias
+
+	exponent := 7
+	for mask := 0x4000; pcm&mask == 0 && exponent > 0; mask >>= 1 {
+		exponent--
+	}
+	mantissa := pcm >> (exponent + 3) & 0x0F
+	return ^(sign | byte(exponent<<4) | byte(mantissa))
+}
+
+// linearToALaw is the reference G.711 A-law encoder for 16-bit samples
+func linearToALaw(sample int16) byte {
+	pcm := int(sample) >> 3
+	mask := byte(0xD5)
+	if pcm < 0 {
+		mask, pcm = 0x55, -pcm-1
+	}
+
+	segment := 0
+	for end := 0x1F; pcm > end; end = end<<1 | 1 {
+		segment++
+		if segment == 8 {
+			return 0x7F ^ mask
+		}
+	}
+	shift := segment
+	if segment < 2 {
+		shift = 1
+	}
+	return (byte(segment<<4) | byte(pcm>>shift&0x0F)) ^ mask
+}
@@ -0,0 +1,70 @@
+package main
+
+import "math"
+
+// maxTrackGain caps per-track volume; louder requests are clamped
+const maxTrackGain = 4.0
+
+// softClipKnee is the level above which samples are compressed smoothly
+// toward full scale instead of being hard-clipped
+const softClipKnee = 0.8 * 32767
+
+// applyGainRamp scales samples by a gain that moves linearly from `from` to
+// `to` across the frame (avoiding zipper noise on volume changes), then soft
+// clips the result. Interleaved channels share the same gain per frame.
+func applyGainRamp(samples []int16, channels int, from, to float64) {
+	if from == 1.0 && to == 1.0 {
+		return
+	}
+	if channels < 1 {
+		channels = 1
+	}
+
+	frames := len(samples) / channels
+	if frames == 0 {
+		return
+	}
+
+	step := (to - from) / float64(frames)
+	gain := from
+	for i := 0; i < len(samples); i += channels {
+		gain += step
+		for c := 0; c < channels && i+c < len(samples); c++ {
+			samples[i+c] = softClip(float64(samples[i+c]) * gain)
+		}
+	}
+}
+
+// rampGain is applyGainRamp for float samples, without clipping, for stages
+// that keep processing before the final conversion to int16
+func rampGain(samples []float64, channels int, from, to float64) {
+	if channels < 1 {
+		channels = 1
+	}
+
+	frames := len(samples) / channels
+	if frames == 0 || (from == 1.0 && to == 1.0) {
+		return
+	}
+
+	step := (to - from) / float64(frames)
+	gain := from
+	for i := 0; i < len(samples); i += channels {
+		gain += step
+		for c := 0; c < channels && i+c < len(samples); c++ {
+			samples[i+c] *= gain
+		}
+	}
+}
+
+// softClip converts a scaled sample back to int16, passing it through
+// unchanged below the knee and compressing it with tanh above
+func softClip(v float64) int16 {
+	mag := math.Abs(v)
+	if mag > softClipKnee {
+		headroom := 32767 - softClipKnee
+		mag = softClipKnee + headroom*math.Tanh((mag-softClipKnee)/headroom)
+		v = math.Copysign(mag, v)
+	}
+	return clampInt16(v)
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+// handoffConcurrency is how many sessions a draining bridge hands off (or a
+// starting one restores) at once
+const handoffConcurrency = 8
+
+// maxResumeAge is the oldest snapshot whose playback is resumed; audio
+// from longer ago is no longer what the user expects to hear
+const maxResumeAge = 30 * time.Second
+
+// resumeOffsetKey carries where handed-off playback picks up in its context
+type resumeOffsetKey struct{}
+
+// withResumeOffset makes seekable playback under ctx start offset into the
+// clip instead of at the beginning
+func withResumeOffset(ctx context.Context, offset time.Duration) context.Context {
+	return context.WithValue(ctx, resumeOffsetKey{}, offset)
+}
+
+// resumeOffset returns the offset set on ctx (0 when not resuming)
+func resumeOffset(ctx context.Context) time.Duration {
+	offset, _ := ctx.Value(resumeOffsetKey{}).(time.Duration)
+	return offset
+}
+
+// resumePlayback queues a handed-off session's playback on its tracks, in
+// the snapshot's order, and returns how many clips were queued. A clip that
+// was playing picks up where it was heard, plus the time the handoff took.
+func (s *LiveKitBridgeService) resumePlayback(session *RoomSession, snapshot *pb.SessionSnapshot) int {
+	elapsed := time.Since(time.UnixMilli(snapshot.TakenAtMs))
+	if elapsed > maxResumeAge && len(snapshot.Playback) > 0 {
+		session.log().Info("Not resuming stale playback", "clips", len(snapshot.Playback), "age", elapsed.Round(time.Second))
+		return 0
+	}
+	resumed := 0
+	for _, clip := range snapshot.Playback {
+		if clip.Request == nil {
+			continue
+		}
+		var offset time.Duration
+		if clip.PositionMs > 0 {
+			offset = time.Duration(clip.PositionMs)*time.Millisecond + max(elapsed, 0)
+			clip.Request.StartAtMs = 0 // already started
+		}
+
+		queue := session.audioQueue(clip.TrackName)
+		item := queue.push(clip.Request)
+		trackName := clip.TrackName
+		session.spawn("resumed_playback", func() {
+			s.playResumed(session, queue, item, trackName, offset)
+		})
+		resumed++
+	}
+	return resumed
+}
+
+// playResumed plays one handed-off clip when its turn on the track comes.
+// Nobody is waiting on a stream for it, so its lifecycle is only reported
+// to StreamEvents subscribers.
+func (s *LiveKitBridgeService) playResumed(session *RoomSession, queue *audioQueue, item *queuedAudio, trackName string, offset time.Duration) {
+	defer queue.done(item)
+	req := item.req
+
+	select {
+	case <-item.turn:
+	case <-item.removed:
+		emitPlaybackEvent(session, trackName, &pb.PlayAudioEvent{
+			Type:      pb.PlayAudioEvent_INTERRUPTED,
+			RequestId: req.RequestId,
+			Error:     errRemovedFromQueue.Error(),
+		})
+		return
+	case <-session.ctx.Done():
+		return
+	}
+
+	emitPlaybackEvent(session, trackName, &pb.PlayAudioEvent{
+		Type:      pb.PlayAudioEvent_STARTED,
+		RequestId: req.RequestId,
+	})
+
+	ctx := session.ctx
+	if offset > 0 {
+		// Word timings are relative to the start of the clip, so captions
+		// only follow clips that start from the beginning
+		ctx = withResumeOffset(ctx, offset)
+	} else {
+		captionCtx, cancelCaptions := context.WithCancel(ctx)
+		defer cancelCaptions()
+		session.startCaptions(captionCtx, s.config.CaptionTopic, req.RequestId, trackName, req.WordTimings)
+	}
+
+	start := session.playoutMark(trackName)
+	decoded, err := s.playAudioFile(deferPlayout(ctx), req, session, nil, trackName)
+	end := session.playoutMark(trackName)
+	queue.done(item)
+	if err == nil {
+		err = end.wait(ctx)
+	}
+	if err != nil {
+		emitPlaybackEvent(session, trackName, &pb.PlayAudioEvent{
+			Type:      playbackOutcome(err),
+			RequestId: req.RequestId,
+			Error:     err.Error(),
+		})
+		return
+	}
+
+	duration := end.since(start).Milliseconds()
+	if duration == 0 {
+		duration = decoded
+	}
+	emitPlaybackEvent(session, trackName, &pb.PlayAudioEvent{
+		Type:       pb.PlayAudioEvent_COMPLETED,
+		RequestId:  req.RequestId,
+		DurationMs: duration,
+	})
+	session.log().Info("Resumed playback completed", "request_id", req.RequestId, "track_name", trackName)
+}
+
+// handoffTargets returns the replicas a session may be handed to: every
+// other live replica that isn't draining, or just the one named
+func (s *LiveKitBridgeService) handoffTargets(ctx context.Context, targetID string) ([]registry.Instance, error) {
+	instances, err := s.sessions.registry.Instances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	self := s.sessions.registry.InstanceID()
+	var targets []registry.Instance
+	for _, instance := range instances {
+		if instance.ID == self || instance.Draining || instance.Address == "" {
+			continue
+		}
+		if targetID != "" && instance.ID != targetID {
+			continue
+		}
+		targets = append(targets, instance)
+	}
+	if len(targets) == 0 {
+		if targetID != "" {
+			return nil, fmt.Errorf("instance %s is not available for handoff", targetID)
+		}
+		return nil, fmt.Errorf("no other bridge instance is available for handoff")
+	}
+	return targets, nil
+}
+
+// leastLoaded returns the index of the target with the fewest sessions
+func leastLoaded(targets []registry.Instance) int {
+	best := 0
+	for i, target := range targets {
+		if target.Sessions < targets[best].Sessions {
+			best = i
+		}
+	}
+	return best
+}
+
+// handoff moves a session to target: the target joins the room under the
+// same identity (LiveKit then drops this replica's participant) and resumes
+// playback, after which the session here is closed. On failure the session
+// is left running here.
+func (s *LiveKitBridgeService) handoff(ctx context.Context, session *RoomSession, target registry.Instance, token string) (int32, error) {
+	snapshot := session.snapshot()
+	if snapshot.Join == nil {
+		return 0, fmt.Errorf("session has no join request to hand off")
+	}
+	if token != "" {
+		join := proto.Clone(snapshot.Join).(*pb.JoinRoomRequest)
+		join.Token = token
+		snapshot.Join = join
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.HandoffTimeout)
+	defer cancel()
+
+	conn, err := grpc.NewClient(target.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to dial %s: %w", target.Address, err)
+	}
+	defer conn.Close()
+
+	resp, err := pb.NewLiveKitBridgeClient(conn).AcceptSession(ctx, &pb.AcceptSessionRequest{Snapshot: snapshot})
+	if err != nil {
+		return 0, fmt.Errorf("instance %s failed to accept session: %w", target.ID, err)
+	}
+	if !resp.Success {
+		return 0, fmt.Errorf("instance %s refused session: %s", target.ID, resp.Error)
+	}
+
+	session.log().Info("Handed off session", "instance", target.ID, "address", target.Address,
+		"resumed_playback", resp.ResumedPlayback)
+	s.bsLogger.LogInfo("Handed off bridge session", map[string]interface{}{
+		"user_id":          session.userId,
+		"instance":         target.ID,
+		"resumed_playback": resp.ResumedPlayback,
+	})
+	s.sessions.Remove(session)
+	session.CloseWithReason("handoff")
+	return resp.ResumedPlayback, nil
+}
+
+// handoffAll hands every session to other replicas, spreading them by load,
+// until deadline. Sessions that can't be handed off stay here to drain.
+func (s *LiveKitBridgeService) handoffAll(deadline time.Time) {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	targets, err := s.handoffTargets(ctx, "")
+	if err != nil {
+		slog.Warn("Not handing off sessions", "error", err)
+		return
+	}
+
+	var sessions []*RoomSession
+	s.sessions.Range(func(userId string, session *RoomSession) bool {
+		sessions = append(sessions, session)
+		return true
+	})
+	slog.Info("Handing off sessions", "sessions", len(sessions), "instances", len(targets))
+
+	var (
+		mu     sync.Mutex
+		failed int
+		wg     sync.WaitGroup
+	)
+	slots := make(chan struct{}, handoffConcurrency)
+	for _, session := range sessions {
+		if ctx.Err() != nil {
+			break
+		}
+		mu.Lock()
+		i := leastLoaded(targets)
+		targets[i].Sessions++
+		target := targets[i]
+		mu.Unlock()
+
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if _, err := s.handoff(ctx, session, target, ""); err != nil {
+				session.log().Warn("Handoff failed, draining session here", "instance", target.ID, "error", err)
+				mu.Lock()
+				failed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		s.bsLogger.LogWarn("Some sessions could not be handed off", map[string]interface{}{
+			"failed":   failed,
+			"sessions": len(sessions),
+		})
+	}
+}
+
+// HandoffSession moves a user's session to another replica, for
+// rebalancing or ahead of a deploy
+func (s *LiveKitBridgeService) HandoffSession(
+	ctx context.Context,
+	req *pb.HandoffSessionRequest,
+) (*pb.HandoffSessionResponse, error) {
+	slog.Info("HandoffSession request", "user_id", req.UserId, "target_instance", req.TargetInstance)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.HandoffSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	targets, err := s.handoffTargets(ctx, req.TargetInstance)
+	if err != nil {
+		return &pb.HandoffSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	target := targets[leastLoaded(targets)]
+
+	resumed, err := s.handoff(ctx, session, target, req.Token)
+	if err != nil {
+		session.log().Warn("Handoff failed", "instance", target.ID, "error", err)
+		return &pb.HandoffSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.HandoffSessionResponse{
+		Success:         true,
+		InstanceId:      target.ID,
+		Address:         target.Address,
+		ResumedPlayback: resumed,
+	}, nil
+}
+
+// AcceptSession takes over a session another replica is handing off
+func (s *LiveKitBridgeService) AcceptSession(
+	ctx context.Context,
+	req *pb.AcceptSessionRequest,
+) (*pb.AcceptSessionResponse, error) {
+	slog.Info("AcceptSession request", "user_id", req.Snapshot.GetJoin().GetUserId(),
+		"playback", len(req.Snapshot.GetPlayback()))
+
+	participantID, resumed, err := s.restore(ctx, req.Snapshot)
+	if err != nil {
+		return &pb.AcceptSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.AcceptSessionResponse{
+		Success:         true,
+		ParticipantId:   participantID,
+		ResumedPlayback: int32(resumed),
+	}, nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// livekitProbeInterval is how often readiness re-checks that LiveKit answers
+const livekitProbeInterval = 10 * time.Second
+
+// livekitProbeTimeout bounds a single LiveKit reachability check
+const livekitProbeTimeout = 3 * time.Second
+
+// livekitProbe periodically checks that the LiveKit server answers HTTP, so
+// readiness doesn't depend on a join happening to fail first
+type livekitProbe struct {
+	url    string // http(s) form of LIVEKIT_URL; "" = not configured
+	client *http.Client
+
+	mu      sync.RWMutex
+	checked bool
+	err     error
+}
+
+// newLiveKitProbe creates a probe for a ws(s):// or http(s):// LiveKit URL
+func newLiveKitProbe(livekitURL string) *livekitProbe {
+	url := strings.TrimSpace(livekitURL)
+	switch {
+	case strings.HasPrefix(url, "wss://"):
+		url = "https://" + strings.TrimPrefix(url, "wss://")
+	case strings.HasPrefix(url, "ws://"):
+		url = "http://" + strings.TrimPrefix(url, "ws://")
+	}
+	return &livekitProbe{url: url, client: &http.Client{Timeout: livekitProbeTimeout}}
+}
+
+// run checks LiveKit every livekitProbeInterval until ctx ends
+func (p *livekitProbe) run(ctx context.Context) {
+	if p.url == "" {
+		return
+	}
+
+	ticker := time.NewTicker(livekitProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.check(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check makes one request to LiveKit; any non-5xx answer counts as reachable
+func (p *livekitProbe) check(ctx context.Context) {
+	var err error
+	req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if reqErr != nil {
+		err = reqErr
+	} else if resp, doErr := p.client.Do(req); doErr != nil {
+		err = doErr
+	} else {
+		resp.Body.Close()
+		if resp.StatusCode >= 500 {
+			err = fmt.Errorf("LiveKit returned %s", resp.Status)
+		}
+	}
+
+	p.mu.Lock()
+	wasOK := !p.checked || p.err == nil
+	p.checked = true
+	p.err = err
+	p.mu.Unlock()
+
+	if err != nil && wasOK {
+		slog.Warn("LiveKit is unreachable", "url", p.url, "error", err)
+	} else if err == nil && !wasOK {
+		slog.Info("LiveKit is reachable again", "url", p.url)
+	}
+}
+
+// status reports the last check's result; "" means reachable (or unchecked
+// because no LIVEKIT_URL is configured)
+func (p *livekitProbe) status() string {
+	if p.url == "" {
+		return ""
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	switch {
+	case !p.checked:
+		return "not checked yet"
+	case p.err != nil:
+		return p.err.Error()
+	}
+	return ""
+}
+
+// readiness reports whether the bridge should receive new sessions, with
+// the reason for each failing check
+func (s *LiveKitBridgeService) readiness() (bool, map[string]string) {
+	failures := make(map[string]string)
+
+	if s.draining.Load() {
+		failures["draining"] = "bridge is shutting down"
+	}
+	if active, max, _ := s.sessions.Counts(); max > 0 && active >= max {
+		failures["session_cap"] = fmt.Sprintf("%d of %d sessions in use", active, max)
+	}
+	if reason := s.livekit.status(); reason != "" {
+		failures["livekit"] = reason
+	}
+	return len(failures) == 0, failures
+}
+
+// registerHealthHandlers adds /healthz and /readyz to mux. /healthz only
+// says the process is alive; /readyz fails while the bridge can't take new
+// sessions, so Kubernetes stops routing to it without restarting it.
+func registerHealthHandlers(mux *http.ServeMux, service *LiveKitBridgeService) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte("ok\n"))
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		ready, failures := service.readiness()
+		active, max, queued := service.sessions.Counts()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ready":           ready,
+			"failures":        failures,
+			"active_sessions": active,
+			"max_sessions":    max,
+			"queued_joins":    queued,
+		})
+	})
+}
+
+// startHTTPEndpoints serves /metrics on METRICS_PORT and the health
+// endpoints on HEALTH_PORT; when both name the same port they share a server
+func startHTTPEndpoints(config *Config, service *LiveKitBridgeService) {
+	muxes := make(map[string]*http.ServeMux)
+	muxFor := func(port string) *http.ServeMux {
+		if muxes[port] == nil {
+			muxes[port] = http.NewServeMux()
+		}
+		return muxes[port]
+	}
+
+	if config.MetricsPort != "" {
+		muxFor(config.MetricsPort).Handle("/metrics", metricsHandler())
+		slog.Info("Serving Prometheus metrics", "port", config.MetricsPort, "path", "/metrics")
+	}
+	if config.HealthPort != "" {
+		registerHealthHandlers(muxFor(config.HealthPort), service)
+		slog.Info("Serving health endpoints", "port", config.HealthPort, "paths", "/healthz,/readyz")
+	}
+	if config.TTSIngestPort != "" {
+		registerIngestHandlers(muxFor(config.TTSIngestPort), service, config.TTSIngestToken)
+		slog.Info("Serving TTS ingestion", "port", config.TTSIngestPort, "path", "/tts/{userId}/{trackName}",
+			"auth", config.TTSIngestToken != "")
+	}
+
+	for port, mux := range muxes {
+		go func() {
+			if err := http.ListenAndServe(":"+port, mux); err != nil {
+				slog.Error("HTTP endpoint server stopped", "port", port, "error", err)
+			}
+		}()
+	}
+}
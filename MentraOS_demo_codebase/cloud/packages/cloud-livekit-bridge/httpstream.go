@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// streamRetryDelay is the pause before re-requesting a dropped audio stream
+const streamRetryDelay = 250 * time.Millisecond
+
+// httpStream reads an HTTP(S) audio response progressively, so decoding and
+// playback start before the download ends and a slow track throttles the
+// download. If the connection drops mid-body and the server accepts range
+// requests, it re-requests from the last byte read, making up to retries
+// attempts per drop.
+type httpStream struct {
+	ctx         context.Context
+	url         string
+	retries     int
+	logger      *slog.Logger
+	body        io.ReadCloser
+	contentType string
+	offset      int64 // bytes delivered so far
+	ranges      bool  // server advertised Accept-Ranges: bytes
+}
+
+// openHTTPStream starts fetching url
+func openHTTPStream(ctx context.Context, url string, retries int, logger *slog.Logger) (*httpStream, error) {
+	s := &httpStream{ctx: ctx, url: url, retries: retries, logger: logger}
+
+	_, fetchSpan := tracing.Start(ctx, "audio.fetch", attribute.String("url", url))
+	resp, err := s.request(0)
+	tracing.RecordError(fetchSpan, err)
+	fetchSpan.End()
+	if err != nil {
+		return nil, err
+	}
+
+	s.body = resp.Body
+	s.contentType = strings.ToLower(resp.Header.Get("Content-Type"))
+	s.ranges = strings.EqualFold(resp.Header.Get("Accept-Ranges"), "bytes")
+	return s, nil
+}
+
+// request issues a GET, from offset onward when offset > 0
+func (s *httpStream) request(offset int64) (*http.Response, error) {
+	httpReq, err := http.NewRequestWithContext(s.ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if offset > 0 {
+		httpReq.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	tracing.Inject(s.ctx, httpReq.Header)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audio: %w", err)
+	}
+
+	// A resume must continue from offset; a server ignoring Range restarts at 0
+	failed := resp.StatusCode < 200 || resp.StatusCode >= 300
+	if offset > 0 {
+		failed = resp.StatusCode != http.StatusPartialContent
+	}
+	if failed {
+		resp.Body.Close()
+		return nil, fmt.Errorf("HTTP error: %d %s", resp.StatusCode, resp.Status)
+	}
+	return resp, nil
+}
+
+// Read implements io.Reader, resuming the download after a dropped connection
+func (s *httpStream) Read(p []byte) (int, error) {
+	n, err := s.body.Read(p)
+	s.offset += int64(n)
+	if err == nil || errors.Is(err, io.EOF) {
+		return n, err
+	}
+	if n > 0 {
+		return n, nil // the broken body fails again on the next read
+	}
+	if s.ctx.Err() != nil {
+		return n, s.ctx.Err()
+	}
+
+	for attempt := 1; attempt <= s.retries && s.ranges; attempt++ {
+		s.logger.Warn("Audio stream dropped, resuming", "url", s.url, "offset", s.offset,
+			"attempt", attempt, "error", err)
+
+		select {
+		case <-time.After(streamRetryDelay):
+		case <-s.ctx.Done():
+			return 0, s.ctx.Err()
+		}
+
+		resp, reqErr := s.request(s.offset)
+		if reqErr != nil {
+			err = reqErr
+			continue
+		}
+		s.body.Close()
+		s.body = resp.Body
+		return s.Read(p)
+	}
+	return 0, fmt.Errorf("audio stream failed after %d bytes: %w", s.offset, err)
+}
+
+// Close releases the current response body
+func (s *httpStream) Close() error {
+	return s.body.Close()
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// OverflowPolicy is what happens to incoming mic audio when StreamAudio
+// falls behind and a session's incoming buffer is full
+type OverflowPolicy string
+
+const (
+	OverflowDropNewest OverflowPolicy = "drop-newest"   // drop the frame that just arrived
+	OverflowDropOldest OverflowPolicy = "drop-oldest"   // drop the oldest buffered frame, keeping the latest audio
+	OverflowGrow       OverflowPolicy = "grow-to-limit" // buffer past the size up to the limit, then drop the newest
+)
+
+// parseOverflowPolicy maps a config string to an OverflowPolicy (default
+// drop-newest)
+func parseOverflowPolicy(policy string) OverflowPolicy {
+	switch OverflowPolicy(strings.ToLower(strings.TrimSpace(policy))) {
+	case OverflowDropOldest:
+		return OverflowDropOldest
+	case OverflowGrow:
+		return OverflowGrow
+	default:
+		return OverflowDropNewest
+	}
+}
+
+// overflowPolicies maps JoinRoom's overflow policy to an OverflowPolicy;
+// the default maps to "" (keep the bridge's)
+var overflowPolicies = map[pb.JoinRoomRequest_OverflowPolicy]OverflowPolicy{
+	pb.JoinRoomRequest_OVERFLOW_DROP_NEWEST: OverflowDropNewest,
+	pb.JoinRoomRequest_OVERFLOW_DROP_OLDEST: OverflowDropOldest,
+	pb.JoinRoomRequest_OVERFLOW_GROW:        OverflowGrow,
+}
+
+// incomingBuffer holds mic audio received from LiveKit until StreamAudio
+// sends it on. It is a mutex-guarded bounded ring: several goroutines push
+// (LiveKit's track callbacks and the mixdown) and drop-oldest pops from the
+// pushing side, so every operation takes mu. The slots are preallocated, so
+// buffering a frame takes no allocation, and mu is only held to copy a
+// frame in or out. Pushing never waits on StreamAudio; once the buffer is
+// full the policy decides which frame is lost.
+type incomingBuffer struct {
+	mu      sync.Mutex
+	ring    *frameRing
+	dropped atomic.Int64
+	ready   chan struct{} // signalled when a frame arrives
+	done    chan struct{} // closed on close
+	once    sync.Once
+}
+
+// frameRing is a bounded queue of frames over preallocated slots; the
+// caller guards it
+type frameRing struct {
+	policy   OverflowPolicy
+	size     int // frames held before overflow
+	limit    int // most frames grow-to-limit may hold
+	capacity int // frames held before overflow (limit when growing)
+	slots    []AudioFrame
+	head     int // next pop
+	count    int // frames waiting
+}
+
+// newFrameRing preallocates a ring for policy; grow-to-limit reserves the
+// limit up front so growing never allocates
+func newFrameRing(policy OverflowPolicy, size, limit int) *frameRing {
+	size = max(size, 1)
+	capacity := size
+	if policy == OverflowGrow {
+		capacity = max(limit, size)
+	}
+	return &frameRing{
+		policy:   policy,
+		size:     size,
+		limit:    limit,
+		capacity: capacity,
+		slots:    make([]AudioFrame, capacity),
+	}
+}
+
+// push adds a frame, returning false when the ring is full
+func (r *frameRing) push(frame AudioFrame) bool {
+	if r.count == r.capacity {
+		return false
+	}
+	r.slots[(r.head+r.count)%r.capacity] = frame
+	r.count++
+	return true
+}
+
+// pop removes the oldest frame, returning false when the ring is empty
+func (r *frameRing) pop() (AudioFrame, bool) {
+	if r.count == 0 {
+		return AudioFrame{}, false
+	}
+	frame := r.slots[r.head]
+	r.slots[r.head] = AudioFrame{} // let the PCM go
+	r.head = (r.head + 1) % r.capacity
+	r.count--
+	return frame, true
+}
+
+// newIncomingBuffer builds a session's incoming buffer from the bridge
+// defaults
+func newIncomingBuffer(config *Config) *incomingBuffer {
+	return &incomingBuffer{
+		ring: newFrameRing(parseOverflowPolicy(config.IncomingOverflowPolicy),
+			config.IncomingBufferFrames, config.IncomingBufferLimit),
+		ready: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+}
+
+// configure replaces the policy (unless empty) and the size (when set) with
+// a fresh ring. Only used when joining, before audio flows: frames still in
+// the old ring are lost.
+func (b *incomingBuffer) configure(policy OverflowPolicy, size, limit int) {
+	if policy == "" && size <= 0 && limit <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	old := b.ring
+	if policy == "" {
+		policy = old.policy
+	}
+	if size <= 0 {
+		size = old.size
+	}
+	if limit <= 0 {
+		limit = old.limit
+	}
+	b.ring = newFrameRing(policy, size, limit)
+}
+
+// push buffers a frame, reporting whether a frame (this one or, with
+// drop-oldest, the oldest) was dropped to make room. Pushes after close
+// are ignored.
+func (b *incomingBuffer) push(frame AudioFrame) bool {
+	select {
+	case <-b.done:
+		return false
+	default:
+	}
+
+	dropped, buffered := b.pushRing(frame)
+	if !buffered {
+		b.dropped.Add(1)
+		return true
+	}
+
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+	return dropped
+}
+
+// pushRing adds a frame to the ring, making room under drop-oldest, and
+// reports whether the oldest frame was dropped and whether this one was
+// buffered
+func (b *incomingBuffer) pushRing(frame AudioFrame) (dropped, buffered bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ring.push(frame) {
+		return false, true
+	}
+	if b.ring.policy != OverflowDropOldest {
+		return false, false
+	}
+	// Make room by dropping the oldest frame
+	b.ring.pop()
+	b.dropped.Add(1)
+	return true, b.ring.push(frame)
+}
+
+// pop returns the oldest buffered frame, waiting for one until the buffer
+// closes or stop closes (ok is false then)
+func (b *incomingBuffer) pop(stop <-chan struct{}) (frame AudioFrame, ok bool) {
+	for {
+		if frame, ok = b.popRing(); ok {
+			return frame, true
+		}
+		select {
+		case <-b.ready:
+		case <-b.done:
+			// Hand out what was buffered before closing
+			return b.popRing()
+		case <-stop:
+			return AudioFrame{}, false
+		}
+	}
+}
+
+// popRing takes the oldest frame off the ring
+func (b *incomingBuffer) popRing() (AudioFrame, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.ring.pop()
+}
+
+// len returns how many frames are waiting
+func (b *incomingBuffer) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.ring.count
+}
+
+// overflowPolicy returns the policy in force
+func (b *incomingBuffer) overflowPolicy() OverflowPolicy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.ring.policy
+}
+
+// droppedFrames returns how many frames have been lost to overflow
+func (b *incomingBuffer) droppedFrames() int64 {
+	return b.dropped.Load()
+}
+
+// close wakes a waiting pop; frames still buffered can be popped, and later
+// pushes are ignored
+func (b *incomingBuffer) close() {
+	b.once.Do(func() { close(b.done) })
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestIncomingBufferOverflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      OverflowPolicy
+		size, limit int
+		want        []uint64 // sequences popped after pushing 1..6
+		wantDropped int64
+	}{
+		{name: "drop newest", policy: OverflowDropNewest, size: 3, limit: 5, want: []uint64{1, 2, 3}, wantDropped: 3},
+		{name: "drop oldest", policy: OverflowDropOldest, size: 3, limit: 5, want: []uint64{4, 5, 6}, wantDropped: 3},
+		{name: "grow to limit", policy: OverflowGrow, size: 3, limit: 5, want: []uint64{1, 2, 3, 4, 5}, wantDropped: 1},
+		{name: "room for all", policy: OverflowDropNewest, size: 8, limit: 8, want: []uint64{1, 2, 3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newIncomingBuffer(&Config{})
+			b.configure(tt.policy, tt.size, tt.limit)
+			for seq := uint64(1); seq <= 6; seq++ {
+				b.push(AudioFrame{Sequence: seq})
+			}
+			if got := b.len(); got != len(tt.want) {
+				t.Fatalf("len = %d, want %d", got, len(tt.want))
+			}
+			b.close()
+
+			var got []uint64
+			for {
+				frame, ok := b.pop(nil)
+				if !ok {
+					break
+				}
+				got = append(got, frame.Sequence)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("popped %v, want %v", got, tt.want)
+			}
+			if dropped := b.droppedFrames(); dropped != tt.wantDropped {
+				t.Fatalf("dropped %d, want %d", dropped, tt.wantDropped)
+			}
+		})
+	}
+}
+
+// Pushers on several goroutines (the deliver callbacks and the mixdown) and
+// StreamAudio popping never lose or repeat a frame beyond what is counted
+// as dropped
+func TestIncomingBufferConcurrent(t *testing.T) {
+	for _, policy := range []OverflowPolicy{OverflowDropNewest, OverflowDropOldest, OverflowGrow} {
+		b := newIncomingBuffer(&Config{})
+		b.configure(policy, 4, 16)
+
+		const pushers, perPusher = 4, 2000
+		var wg sync.WaitGroup
+		for p := 0; p < pushers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < perPusher; i++ {
+					b.push(AudioFrame{Sequence: uint64(p*perPusher + i + 1)})
+				}
+			}(p)
+		}
+
+		seen := make(map[uint64]bool)
+		popped := make(chan struct{})
+		go func() {
+			defer close(popped)
+			for {
+				frame, ok := b.pop(nil)
+				if !ok {
+					return
+				}
+				if seen[frame.Sequence] {
+					t.Errorf("%s: frame %d popped twice", policy, frame.Sequence)
+				}
+				seen[frame.Sequence] = true
+			}
+		}()
+
+		wg.Wait()
+		b.close()
+		<-popped
+
+		if got := int64(len(seen)) + b.droppedFrames(); got != pushers*perPusher {
+			t.Errorf("%s: popped %d + dropped %d, want %d frames accounted for",
+				policy, len(seen), b.droppedFrames(), pushers*perPusher)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ingestAudio plays a TTS stream on a session's track as its bytes arrive
+// from body, returning how long it played. Like PlayAudio, stop_other
+// interrupts other playback and otherwise only the track's own; lifecycle
+// events go to StreamEvents subscribers.
+func (s *LiveKitBridgeService) ingestAudio(ctx context.Context, header *pb.IngestAudioRequest, body io.Reader) (int64, error) {
+	session, ok := s.sessions.Load(header.UserId)
+	if !ok {
+		return 0, status.Errorf(codes.NotFound, "session not found for user %s", header.UserId)
+	}
+	session.touch()
+
+	trackName, err := appTrackName(header.AppId, header.TrackName, 0)
+	if err != nil {
+		return 0, trackNameStatus(err)
+	}
+	if err := s.daily.check(ctx, session, trackName); err != nil {
+		return 0, err
+	}
+
+	req := &pb.PlayAudioRequest{
+		UserId:      header.UserId,
+		RequestId:   header.RequestId,
+		AppId:       header.AppId,
+		TrackName:   header.TrackName,
+		StopOther:   header.StopOther,
+		Volume:      header.Volume,
+		ContentType: header.ContentType,
+		Format:      header.Format,
+	}
+
+	// Raw content types (audio/pcm, audio/PCMU, ...) play at sample_rate and
+	// channels unless their parameters say otherwise
+	contentType := strings.ToLower(header.ContentType)
+	if req.Format == nil {
+		req.Format = rawContentTypeFormat(contentType, "", header.SampleRate, header.Channels)
+	}
+	if err := validateAudioFormat(req); err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Sniff the format before interrupting anything, so a bad stream
+	// doesn't cut off what is playing
+	br := bufio.NewReader(body)
+	format := requestAudioFormat(req, contentType, br)
+	if format == "" {
+		return 0, status.Errorf(codes.InvalidArgument, "%v: %s", errUnsupportedFormat, header.ContentType)
+	}
+
+	if !interruptPlayback(ctx, session, req) {
+		session.stopTrackPlayback(trackName)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	defer session.endPlayback(session.startPlayback(trackName, req.RequestId, cancel, done))
+
+	session.log().Info("Playing ingested audio", "request_id", req.RequestId, "track_name", trackName,
+		"content_type", header.ContentType, "format", format)
+	emitPlaybackEvent(session, trackName, &pb.PlayAudioEvent{
+		Type:      pb.PlayAudioEvent_STARTED,
+		RequestId: req.RequestId,
+	})
+
+	ledger := session.newDeliveryLedger(trackName)
+	duration, err := s.decodeAudio(ctx, format, br, req, session, trackName, nil)
+	if err != nil {
+		emitPlaybackEvent(session, trackName, withDelivery(&pb.PlayAudioEvent{
+			Type:      playbackOutcome(err),
+			RequestId: req.RequestId,
+			Error:     err.Error(),
+		}, ledger.finish(false)))
+		return 0, err
+	}
+
+	emitPlaybackEvent(session, trackName, withDelivery(&pb.PlayAudioEvent{
+		Type:       pb.PlayAudioEvent_COMPLETED,
+		RequestId:  req.RequestId,
+		DurationMs: duration,
+	}, ledger.finish(true)))
+	session.log().Info("Ingested audio completed", "request_id", req.RequestId, "track_name", trackName,
+		"duration_ms", duration)
+	return duration, nil
+}
+
+// ingestRequestID fills in a request ID when the caller has none
+func ingestRequestID(requestID string) string {
+	if requestID != "" {
+		return requestID
+	}
+	return fmt.Sprintf("ingest-%d", time.Now().UnixNano())
+}
+
+// IngestAudio plays a TTS stream sent as a sequence of chunks; the first
+// message says where, and the call returns once the audio has played out
+func (s *LiveKitBridgeService) IngestAudio(stream pb.LiveKitBridge_IngestAudioServer) error {
+	header, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header.RequestId = ingestRequestID(header.RequestId)
+	slog.Info("IngestAudio request", "user_id", header.UserId, "request_id", header.RequestId,
+		"track_name", header.TrackName, "content_type", header.ContentType)
+
+	// Chunks are piped to the decoder as they arrive, in sequence order;
+	// closing the reader when playback ends early unblocks the receiver
+	reader, writer := io.Pipe()
+	defer reader.Close()
+	go func() {
+		first := true
+		recv := func() (*pb.IngestAudioRequest, error) {
+			if first {
+				first = false
+				return header, nil
+			}
+			return stream.Recv()
+		}
+		logger := slog.With("user_id", header.UserId, "request_id", header.RequestId)
+		err := recvInOrder(stream.Context(), logger, s.config.Reorder, recv,
+			(*pb.IngestAudioRequest).GetSequence,
+			func(chunk *pb.IngestAudioRequest) error {
+				_, err := writer.Write(chunk.Data)
+				return err
+			})
+		writer.CloseWithError(err) // nil closes with EOF
+	}()
+
+	duration, err := s.ingestAudio(stream.Context(), header, reader)
+	if err != nil {
+		return stream.SendAndClose(&pb.IngestAudioResponse{
+			Success:   false,
+			Error:     err.Error(),
+			RequestId: header.RequestId,
+		})
+	}
+	return stream.SendAndClose(&pb.IngestAudioResponse{
+		Success:    true,
+		RequestId:  header.RequestId,
+		DurationMs: duration,
+	})
+}
+
+// registerIngestHandlers serves POST /tts/{userId}/{trackName}: the body is
+// a TTS provider's audio stream (chunked or not), played as it arrives.
+// Query parameters: app_id, request_id, stop_other, volume, and sample_rate
+// and channels for raw PCM (Content-Type audio/pcm). With a token set,
+// requests must carry "Authorization: Bearer <token>".
+func registerIngestHandlers(mux *http.ServeMux, service *LiveKitBridgeService, token string) {
+	mux.HandleFunc("POST /tts/{userId}/{trackName}", func(w http.ResponseWriter, r *http.Request) {
+		if token != "" {
+			given := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		query := r.URL.Query()
+		header := &pb.IngestAudioRequest{
+			UserId:      r.PathValue("userId"),
+			TrackName:   r.PathValue("trackName"),
+			AppId:       query.Get("app_id"),
+			RequestId:   ingestRequestID(query.Get("request_id")),
+			ContentType: r.Header.Get("Content-Type"),
+		}
+		header.StopOther, _ = strconv.ParseBool(query.Get("stop_other"))
+		if volume, err := strconv.ParseFloat(query.Get("volume"), 32); err == nil {
+			header.Volume = float32(volume)
+		}
+		if rate, err := strconv.Atoi(query.Get("sample_rate")); err == nil {
+			header.SampleRate = int32(rate)
+		}
+		if channels, err := strconv.Atoi(query.Get("channels")); err == nil {
+			header.Channels = int32(channels)
+		}
+		slog.Info("TTS ingest request", "user_id", header.UserId, "request_id", header.RequestId,
+			"track_name", header.TrackName, "content_type", header.ContentType)
+
+		duration, err := service.ingestAudio(r.Context(), header, r.Body)
+
+		w.Header().Set("Content-Type", "application/json")
+		resp := map[string]interface{}{"success": err == nil, "request_id": header.RequestId}
+		if err != nil {
+			resp["error"] = err.Error()
+			w.WriteHeader(ingestHTTPStatus(err))
+		} else {
+			resp["duration_ms"] = duration
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+}
+
+// ingestHTTPStatus maps an ingest error to an HTTP status code
+func ingestHTTPStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	}
+	if playbackOutcome(err) == pb.PlayAudioEvent_INTERRUPTED {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// KeepaliveMode is what a PCM track is fed when its queue runs dry
+type KeepaliveMode string
+
+const (
+	KeepaliveNone    KeepaliveMode = "none"    // write nothing; the track starves until audio resumes
+	KeepaliveSilence KeepaliveMode = "silence" // write digital silence
+	KeepaliveNoise   KeepaliveMode = "noise"   // write faint comfort noise
+)
+
+// comfortNoiseDb is the level of KeepaliveNoise frames (dBFS peak)
+const comfortNoiseDb = -70
+
+// parseKeepaliveMode maps a config string to a KeepaliveMode (default none)
+func parseKeepaliveMode(mode string) KeepaliveMode {
+	switch KeepaliveMode(strings.ToLower(strings.TrimSpace(mode))) {
+	case KeepaliveSilence:
+		return KeepaliveSilence
+	case KeepaliveNoise:
+		return KeepaliveNoise
+	default:
+		return KeepaliveNone
+	}
+}
+
+// setKeepalive sets what the player writes when its queue underruns, for
+// up to window after the last audio (0 = for as long as the track lives)
+func (p *trackPlayer) setKeepalive(mode KeepaliveMode, window time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keepalive = mode
+	p.keepaliveFor = window
+}
+
+// keepaliveFrame returns the frame to write when the queue has run dry
+// sinceAudio after the last audio frame, or nil to let the track go quiet.
+// Keeping the track fed keeps the receivers' jitter buffers primed, so the
+// first word of the next utterance isn't clipped.
+func (p *trackPlayer) keepaliveFrame(sinceAudio time.Duration) []int16 {
+	p.mu.Lock()
+	mode, window, muted := p.keepalive, p.keepaliveFor, p.muted
+	p.mu.Unlock()
+
+	if mode == KeepaliveNone || mode == "" || (window > 0 && sinceAudio > window) {
+		return nil
+	}
+
+	frame := make([]int16, p.frameSamples)
+	if mode == KeepaliveNoise && !muted {
+		amplitude := int(32767 * math.Pow(10, comfortNoiseDb/20.0))
+		for i := range frame {
+			frame[i] = int16(rand.Intn(2*amplitude+1) - amplitude)
+		}
+	}
+	return frame
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// LimiterSettings configure the compressor/limiter at the end of each
+// track's publish chain
+type LimiterSettings struct {
+	ThresholdDb float64 // level (dBFS) above which gain is reduced
+	Ratio       float64 // input:output ratio above the threshold (>= 1)
+	Attack      time.Duration
+	Release     time.Duration
+}
+
+// sessionLimiterSettings returns the limiter settings a session joins with:
+// the bridge's defaults, with each one the request sets overriding it
+func sessionLimiterSettings(defaults LimiterSettings, req *pb.JoinRoomRequest) (LimiterSettings, error) {
+	settings := defaults
+	if req.LimiterThresholdDb != nil {
+		settings.ThresholdDb = float64(*req.LimiterThresholdDb)
+	}
+	if req.LimiterRatio != nil {
+		if *req.LimiterRatio < 1 {
+			return settings, fmt.Errorf("limiter_ratio must be at least 1, got %g", *req.LimiterRatio)
+		}
+		settings.Ratio = float64(*req.LimiterRatio)
+	}
+	if req.LimiterAttackMs != nil {
+		if *req.LimiterAttackMs <= 0 {
+			return settings, fmt.Errorf("limiter_attack_ms must be above 0, got %d", *req.LimiterAttackMs)
+		}
+		settings.Attack = time.Duration(*req.LimiterAttackMs) * time.Millisecond
+	}
+	if req.LimiterReleaseMs != nil {
+		if *req.LimiterReleaseMs <= 0 {
+			return settings, fmt.Errorf("limiter_release_ms must be above 0, got %d", *req.LimiterReleaseMs)
+		}
+		settings.Release = time.Duration(*req.LimiterReleaseMs) * time.Millisecond
+	}
+	return settings, nil
+}
+
+// limiter is a feed-forward peak compressor. With a high ratio it acts as a
+// limiter that keeps mixed and boosted tracks from hitting int16 full scale.
+type limiter struct {
+	thresholdDb float64
+	slope       float64 // 1 - 1/ratio
+	attackCoef  float64
+	releaseCoef float64
+	envelopeDb  float64
+	channels    int
+}
+
+// newLimiter creates a limiter for audio at sampleRate; returns nil when the
+// settings disable it (ratio <= 1)
+func newLimiter(settings LimiterSettings, sampleRate, channels int) *limiter {
+	if settings.Ratio <= 1 {
+		return nil
+	}
+
+	coef := func(d time.Duration) float64 {
+		if d <= 0 {
+			return 0
+		}
+		return math.Exp(-1 / (d.Seconds() * float64(sampleRate)))
+	}
+
+	return &limiter{
+		thresholdDb: settings.ThresholdDb,
+		slope:       1 - 1/settings.Ratio,
+		attackCoef:  coef(settings.Attack),
+		releaseCoef: coef(settings.Release),
+		envelopeDb:  -120,
+		channels:    max(1, channels),
+	}
+}
+
+// process compresses interleaved full-scale samples (±32768) in place
+func (l *limiter) process(samples []float64) {
+	for i := 0; i < len(samples); i += l.channels {
+		end := min(i+l.channels, len(samples))
+
+		// Detect on the loudest channel so stereo image is preserved
+		peak := 0.0
+		for _, v := range samples[i:end] {
+			peak = math.Max(peak, math.Abs(v))
+		}
+		levelDb := -120.0
+		if peak > 0 {
+			levelDb = 20 * math.Log10(peak/32768)
+		}
+
+		coef := l.releaseCoef
+		if levelDb > l.envelopeDb {
+			coef = l.attackCoef
+		}
+		l.envelopeDb = coef*l.envelopeDb + (1-coef)*levelDb
+
+		over := l.envelopeDb - l.thresholdDb
+		if over <= 0 {
+			continue
+		}
+		gain := math.Pow(10, -over*l.slope/20)
+		for j := i; j < end; j++ {
+			samples[j] *= gain
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+func TestSessionLimiterSettings(t *testing.T) {
+	defaults := LimiterSettings{ThresholdDb: -1, Ratio: 20, Attack: time.Millisecond, Release: 100 * time.Millisecond}
+	f := func(v float32) *float32 { return &v }
+	i := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name    string
+		req     *pb.JoinRoomRequest
+		want    LimiterSettings
+		wantErr bool
+		off     bool // the settings turn the limiter off
+	}{
+		{name: "bridge defaults", req: &pb.JoinRoomRequest{}, want: defaults},
+		{
+			name: "all overridden",
+			req: &pb.JoinRoomRequest{
+				LimiterThresholdDb: f(-6), LimiterRatio: f(4),
+				LimiterAttackMs: i(5), LimiterReleaseMs: i(250),
+			},
+			want: LimiterSettings{ThresholdDb: -6, Ratio: 4, Attack: 5 * time.Millisecond, Release: 250 * time.Millisecond},
+		},
+		{
+			name: "threshold at full scale",
+			req:  &pb.JoinRoomRequest{LimiterThresholdDb: f(0)},
+			want: LimiterSettings{ThresholdDb: 0, Ratio: 20, Attack: time.Millisecond, Release: 100 * time.Millisecond},
+		},
+		{
+			name: "ratio 1 turns it off",
+			req:  &pb.JoinRoomRequest{LimiterRatio: f(1)},
+			want: LimiterSettings{ThresholdDb: -1, Ratio: 1, Attack: time.Millisecond, Release: 100 * time.Millisecond},
+			off:  true,
+		},
+		{name: "ratio below 1", req: &pb.JoinRoomRequest{LimiterRatio: f(0.5)}, wantErr: true},
+		{name: "ratio 0", req: &pb.JoinRoomRequest{LimiterRatio: f(0)}, wantErr: true},
+		{name: "attack 0", req: &pb.JoinRoomRequest{LimiterAttackMs: i(0)}, wantErr: true},
+		{name: "negative release", req: &pb.JoinRoomRequest{LimiterReleaseMs: i(-5)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sessionLimiterSettings(defaults, tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			if off := newLimiter(got, 48000, 1) == nil; off != tt.off {
+				t.Fatalf("limiter off = %v, want %v", off, tt.off)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+)
+
+// logLevel is the minimum level written; SetLogLevel changes it at runtime
+var logLevel = new(slog.LevelVar)
+
+// initLogging installs the default structured logger. Output is JSON unless
+// format is "text"; anything still using the log package goes through it too.
+func initLogging(level, format string) {
+	if l, err := parseLogLevel(level); err == nil {
+		logLevel.Set(l)
+	}
+
+	opts := &slog.HandlerOptions{Level: logLevel}
+	var handler slog.Handler
+	if strings.EqualFold(format, "text") {
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	}
+	slog.SetDefault(slog.New(handler))
+}
+
+// parseLogLevel maps debug, info, warn or error to a slog level
+func parseLogLevel(level string) (slog.Level, error) {
+	var l slog.Level
+	err := l.UnmarshalText([]byte(strings.TrimSpace(level)))
+	return l, err
+}
+
+// log returns the session's logger, tagged with its user and room
+func (s *RoomSession) log() *slog.Logger {
+	return s.logger.Load()
+}
+
+// annotateLogs adds the room name and SID to the session's log lines. The
+// SID arrives asynchronously, so this waits for it in the background
+// (room.SID unblocks on disconnect too).
+func (s *RoomSession) annotateLogs(room *lksdk.Room) {
+	go func() {
+		sid := room.SID()
+		s.logger.Store(slog.With("user_id", s.userId, "room_name", room.Name(), "room_sid", sid))
+	}()
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// maxLoopDuration caps the loop region kept in memory for replaying
+const maxLoopDuration = 2 * time.Minute
+
+// errLoopEnd stops the first pass of a looping clip at the loop region's end
+var errLoopEnd = errors.New("reached loop end")
+
+// loopCapture records the loop region of a looping clip while its first
+// pass plays, at the decoder's rate and channel count and with the
+// request's volume already applied
+type loopCapture struct {
+	startMs, endMs int64 // loop region; endMs 0 = end of the clip
+	sampleRate     int
+	channels       int
+	position       int64   // frames written so far
+	region         []int16 // the loop region, once captured
+}
+
+// loopCaptureKey carries a looping request's capture in its context
+type loopCaptureKey struct{}
+
+// loopCaptureFrom returns the capture set on ctx (nil when not looping)
+func loopCaptureFrom(ctx context.Context) *loopCapture {
+	capture, _ := ctx.Value(loopCaptureKey{}).(*loopCapture)
+	return capture
+}
+
+// add records decoded samples on their way to the track. It returns the
+// part to play, cut short with errLoopEnd once the region's end is reached.
+func (c *loopCapture) add(samples []int16, sampleRate, channels int) ([]int16, error) {
+	if c.sampleRate == 0 {
+		c.sampleRate, c.channels = sampleRate, channels
+	}
+	frames := int64(len(samples) / channels)
+	from := c.position
+
+	var err error
+	if c.endMs > 0 {
+		end := c.endMs * int64(c.sampleRate) / 1000
+		if from+frames >= end {
+			frames = max(0, end-from)
+			samples = samples[:frames*int64(channels)]
+			err = errLoopEnd
+		}
+	}
+	c.position += frames
+
+	start := c.startMs * int64(c.sampleRate) / 1000
+	if c.position > start {
+		skip := max(0, start-from)
+		c.region = append(c.region, samples[skip*int64(channels):]...)
+	}
+	if time.Duration(len(c.region)/c.channels)*time.Second/time.Duration(c.sampleRate) > maxLoopDuration {
+		return nil, fmt.Errorf("loop region is longer than %s", maxLoopDuration)
+	}
+	return samples, err
+}
+
+// playLooped plays a clip from the top to the end of its loop region, then
+// replays the region from memory loop_count - 1 more times (forever when
+// loop_count is 0) until stopped. The source is fetched and decoded once.
+func (s *LiveKitBridgeService) playLooped(
+	ctx context.Context,
+	format string,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	session *RoomSession,
+	trackName string,
+) (int64, error) {
+	if req.Passthrough {
+		return 0, fmt.Errorf("loop is not supported with passthrough")
+	}
+	if req.LoopEndMs > 0 && req.LoopEndMs <= req.LoopStartMs {
+		return 0, fmt.Errorf("loop_end_ms must be after loop_start_ms")
+	}
+
+	capture := &loopCapture{startMs: req.LoopStartMs, endMs: req.LoopEndMs}
+	generation := session.finishGeneration(trackName)
+	startTime := time.Now()
+
+	// First pass: no playout wait, so the repeats follow without a gap. The
+	// total length is unknown, so no duration goes to progress events.
+	firstPass := deferPlayout(context.WithValue(ctx, loopCaptureKey{}, capture))
+	if _, err := s.decodeAudio(firstPass, format, br, req, session, trackName, nil); err != nil && !errors.Is(err, errLoopEnd) {
+		return 0, err
+	}
+
+	passes := 1
+	if len(capture.region) == 0 {
+		session.log().Warn("Loop region is empty, playing once", "request_id", req.RequestId,
+			"loop_start_ms", req.LoopStartMs, "loop_end_ms", req.LoopEndMs)
+	} else {
+		// A finish-current stop lets the pass playing now end the loop
+		for (req.LoopCount <= 0 || passes < int(req.LoopCount)) && session.finishGeneration(trackName) == generation {
+			// The track processes samples in place, so each pass gets a copy
+			samples := append([]int16(nil), capture.region...)
+			if err := session.writeSamplesToTrack(ctx, samples, trackName, capture.sampleRate, capture.channels); err != nil {
+				return 0, fmt.Errorf("failed to write audio: %w", err)
+			}
+			passes++
+		}
+	}
+
+	if err := session.waitForTrackPlayout(ctx, trackName); err != nil {
+		return 0, fmt.Errorf("playout interrupted: %w", err)
+	}
+
+	duration := time.Since(startTime).Milliseconds()
+	session.log().Info("Looped playback complete", "request_id", req.RequestId, "track_name", trackName,
+		"passes", passes, "duration_ms", duration)
+
+	return duration, nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// defaultLoopbackTrack is the track echoed audio plays on unless SetLoopback
+// names another
+const defaultLoopbackTrack = "loopback"
+
+// maxLoopbackDelay bounds the delay SetLoopback may add to the echo
+const maxLoopbackDelay = 5 * time.Second
+
+// loopbackReportInterval is how often loopback_latency events are emitted
+// while audio is being echoed
+const loopbackReportInterval = 5 * time.Second
+
+// loopbackQueue is how many frames may wait to be echoed: enough for the
+// longest delay, plus a second of slack
+const loopbackQueue = int((maxLoopbackDelay+time.Second)/playbackFrameDuration) + 1
+
+// loopback echoes a session's received mic audio back into the room on a
+// track of its own, so the glasses can hear their own audio path, and
+// measures how long audio spends in the bridge on the way through
+type loopback struct {
+	trackName string
+	delay     time.Duration // held back before echoing, to hear it apart from talking
+	frames    chan AudioFrame
+	ctx       context.Context // ends when loopback stops or the session closes
+	cancel    context.CancelFunc
+
+	// Latency since the last report: receipt to playout
+	count    int
+	dropped  int
+	total    time.Duration
+	min, max time.Duration
+}
+
+// setLoopback starts echoing mic audio on trackName after delay, replacing
+// any echo already running, or stops it when enabled is false
+func (s *RoomSession) setLoopback(enabled bool, trackName string, delay time.Duration) {
+	var next *loopback
+	if enabled {
+		next = &loopback{
+			trackName: trackName,
+			delay:     delay,
+			frames:    make(chan AudioFrame, loopbackQueue),
+		}
+		next.ctx, next.cancel = context.WithCancel(s.ctx)
+	}
+
+	if previous := s.loopback.Swap(next); previous != nil {
+		previous.cancel()
+	}
+	if next == nil {
+		s.log().Info("Loopback stopped")
+		return
+	}
+	s.spawn("loopback", func() { s.runLoopback(next) })
+	s.log().Info("Loopback started", "track_name", trackName, "delay_ms", delay.Milliseconds())
+}
+
+// echoIncoming queues a received frame for the loopback track, if
+// loopback is on; never blocks
+func (s *RoomSession) echoIncoming(frame AudioFrame) {
+	lb := s.loopback.Load()
+	if lb == nil {
+		return
+	}
+	select {
+	case lb.frames <- frame:
+	default:
+		framesDropped.WithLabelValues("loopback").Inc()
+	}
+}
+
+// runLoopback writes queued frames to the loopback track once their delay
+// is up, until loopback stops or the session closes; a stopped loopback
+// unpublishes its track unless the next one reuses it
+func (s *RoomSession) runLoopback(lb *loopback) {
+	defer func() {
+		lb.cancel()
+		if s.ctx.Err() != nil {
+			return
+		}
+		if current := s.loopback.Load(); current == nil || current.trackName != lb.trackName {
+			s.closeTrack(lb.trackName)
+		}
+	}()
+
+	ctx := lb.ctx
+	report := time.NewTicker(loopbackReportInterval)
+	defer report.Stop()
+
+	for {
+		select {
+		case frame := <-lb.frames:
+			if wait := time.Until(frame.CapturedAt.Add(lb.delay)); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// The frame plays out after what is already queued on the track
+			var queued int
+			if player := s.trackPlayer(lb.trackName); player != nil {
+				queued = player.queuedFrames()
+			}
+			if err := s.writeAudioToTrackAt(frame.PCM, lb.trackName, frame.SampleRate, 1); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				lb.dropped++
+				s.log().Debug("Failed to echo audio", "track_name", lb.trackName, "error", err)
+				continue
+			}
+			lb.observe(time.Since(frame.CapturedAt) + time.Duration(queued)*playbackFrameDuration)
+		case <-report.C:
+			s.reportLoopback(lb)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// observe adds one echoed frame's latency to the running report
+func (lb *loopback) observe(latency time.Duration) {
+	if lb.count == 0 || latency < lb.min {
+		lb.min = latency
+	}
+	if latency > lb.max {
+		lb.max = latency
+	}
+	lb.count++
+	lb.total += latency
+}
+
+// reportLoopback emits the latency measured since the last report and
+// starts a new one; nothing is emitted while no audio arrives
+func (s *RoomSession) reportLoopback(lb *loopback) {
+	if lb.count == 0 && lb.dropped == 0 {
+		return
+	}
+	attributes := map[string]string{
+		"frames":   strconv.Itoa(lb.count),
+		"dropped":  strconv.Itoa(lb.dropped),
+		"delay_ms": strconv.FormatInt(lb.delay.Milliseconds(), 10),
+	}
+	if lb.count > 0 {
+		attributes["latency_ms"] = strconv.FormatInt((lb.total / time.Duration(lb.count)).Milliseconds(), 10)
+		attributes["min_latency_ms"] = strconv.FormatInt(lb.min.Milliseconds(), 10)
+		attributes["max_latency_ms"] = strconv.FormatInt(lb.max.Milliseconds(), 10)
+	}
+	s.emitEvent(EventLoopbackLatency, lb.trackName, attributes)
+	lb.count, lb.dropped, lb.total, lb.min, lb.max = 0, 0, 0, 0, 0
+}
+
+// SetLoopback echoes a session's incoming audio back into the room, or stops
+func (s *LiveKitBridgeService) SetLoopback(
+	ctx context.Context,
+	req *pb.SetLoopbackRequest,
+) (*pb.SetLoopbackResponse, error) {
+	slog.Info("SetLoopback request", "user_id", req.UserId, "enabled", req.Enabled,
+		"track_name", req.TrackName, "delay_ms", req.DelayMs)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetLoopbackResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	delay := time.Duration(req.DelayMs) * time.Millisecond
+	if delay < 0 || delay > maxLoopbackDelay {
+		return &pb.SetLoopbackResponse{
+			Success: false,
+			Error:   fmt.Sprintf("delay_ms must be between 0 and %d", maxLoopbackDelay.Milliseconds()),
+		}, nil
+	}
+	trackName := req.TrackName
+	if trackName == "" {
+		trackName = defaultLoopbackTrack
+	}
+
+	session.setLoopback(req.Enabled, trackName, delay)
+	return &pb.SetLoopbackResponse{
+		Success:   true,
+		TrackName: trackName,
+	}, nil
+}
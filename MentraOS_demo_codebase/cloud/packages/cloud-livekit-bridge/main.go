@@ -1,15 +1,22 @@
 package main
 
 import (
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/asr"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/eventstore"
 	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/logger"
 	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/sounds"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/tracing"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/usage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -17,18 +24,55 @@ import (
 )
 
 func main() {
+	// Load configuration
+	config := loadConfig()
+
+	// Structured logs to stderr; the level can be changed later via SetLogLevel
+	initLogging(config.LogLevel, config.LogFormat)
+
+	// "replay" plays a recording back into a room instead of serving
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		if err := runReplay(os.Args[2:], config); err != nil {
+			slog.Error("Replay failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Initialize Better Stack logger
 	bsLogger := logger.NewFromEnv()
 	defer bsLogger.Close()
 
-	log.Println("Starting LiveKit gRPC Bridge...")
+	// Initialize OpenTelemetry tracing (off unless OTEL_EXPORTER_OTLP_ENDPOINT is set)
+	shutdownTracing := tracing.Setup()
+	defer shutdownTracing()
+
+	// Session history in MongoDB (off unless MONGODB_URI is set)
+	eventStore := eventstore.NewFromEnv()
+	defer eventStore.Close()
+
+	// Audio usage per user and app for billing (off unless MONGODB_URI is set)
+	usageMeter := usage.NewFromEnv()
+	defer usageMeter.Close()
+
+	// Speech recognition of mic audio (off unless ASR_PROVIDER is set)
+	asrForwarder := asr.NewFromEnv()
+	defer asrForwarder.Close()
+
+	// Sound event classification of mic audio (off unless SOUND_MODEL_PATH is set)
+	soundClassifier := sounds.NewFromEnv()
+	defer soundClassifier.Close()
+
+	// Register sessions in Redis so replicas can share a load balancer (off unless REDIS_URL is set)
+	sessionRegistry := registry.NewFromEnv()
+	defer sessionRegistry.Close()
+
+	slog.Info("Starting LiveKit gRPC Bridge")
 	bsLogger.LogInfo("LiveKit gRPC Bridge starting", map[string]interface{}{
 		"version": "1.0.0",
 	})
 
-	// Load configuration
-	config := loadConfig()
-	log.Printf("Configuration loaded: Port=%s, LiveKitURL=%s", config.Port, config.LiveKitURL)
+	slog.Info("Configuration loaded", "port", config.Port, "livekit_url", config.LiveKitURL)
 	bsLogger.LogInfo("Configuration loaded", map[string]interface{}{
 		"port":        config.Port,
 		"livekit_url": config.LiveKitURL,
@@ -38,12 +82,19 @@ func main() {
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(1024*1024*10), // 10MB max message size
 		grpc.MaxSendMsgSize(1024*1024*10),
+		// Continue the cloud's traces (traceparent metadata) in our spans
+		grpc.StatsHandler(tracing.ServerHandler()),
 	)
 
 	// Register LiveKit bridge service
-	bridgeService := NewLiveKitBridgeService(config, bsLogger)
+	bridgeService := NewLiveKitBridgeService(config, bsLogger, eventStore, usageMeter, asrForwarder, soundClassifier, sessionRegistry)
 	pb.RegisterLiveKitBridgeServer(grpcServer, bridgeService)
 
+	// Bring back sessions this bridge (or a replica that died) left in the registry
+	if config.RestoreOnStart && sessionRegistry.Enabled() {
+		go bridgeService.restoreSessions()
+	}
+
 	// Register health check service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
@@ -52,6 +103,9 @@ func main() {
 	// Register reflection service (for debugging with grpcurl)
 	reflection.Register(grpcServer)
 
+	// Expose Prometheus metrics and health/readiness probes over HTTP
+	startHTTPEndpoints(config, bridgeService)
+
 	// Determine if we should use Unix socket or TCP
 	var lis net.Listener
 	var err error
@@ -62,7 +116,8 @@ func main() {
 		// Remove existing socket file if it exists
 		if err := os.RemoveAll(socketPath); err != nil {
 			bsLogger.LogError("Failed to remove existing socket", err, nil)
-			log.Fatalf("Failed to remove existing socket: %v", err)
+			slog.Error("Failed to remove existing socket", "error", err)
+			os.Exit(1)
 		}
 
 		// Ensure directory exists
@@ -71,7 +126,8 @@ func main() {
 			bsLogger.LogError("Failed to create socket directory", err, map[string]interface{}{
 				"socket_dir": socketDir,
 			})
-			log.Fatalf("Failed to create socket directory: %v", err)
+			slog.Error("Failed to create socket directory", "socket_dir", socketDir, "error", err)
+			os.Exit(1)
 		}
 
 		lis, err = net.Listen("unix", socketPath)
@@ -79,16 +135,18 @@ func main() {
 			bsLogger.LogError("Failed to listen on Unix socket", err, map[string]interface{}{
 				"socket_path": socketPath,
 			})
-			log.Fatalf("Failed to listen on Unix socket %s: %v", socketPath, err)
+			slog.Error("Failed to listen on Unix socket", "socket_path", socketPath, "error", err)
+			os.Exit(1)
 		}
 
 		// Set socket permissions to allow access
 		if err := os.Chmod(socketPath, 0666); err != nil {
 			bsLogger.LogError("Failed to set socket permissions", err, nil)
-			log.Fatalf("Failed to set socket permissions: %v", err)
+			slog.Error("Failed to set socket permissions", "error", err)
+			os.Exit(1)
 		}
 
-		log.Printf("✅ LiveKit gRPC Bridge listening on Unix socket: %s", socketPath)
+		slog.Info("LiveKit gRPC Bridge listening on Unix socket", "socket_path", socketPath)
 		bsLogger.LogInfo("Server listening on Unix socket", map[string]interface{}{
 			"socket_path": socketPath,
 		})
@@ -99,15 +157,16 @@ func main() {
 			bsLogger.LogError("Failed to listen on TCP", err, map[string]interface{}{
 				"port": config.Port,
 			})
-			log.Fatalf("Failed to listen on port %s: %v", config.Port, err)
+			slog.Error("Failed to listen on TCP port", "port", config.Port, "error", err)
+			os.Exit(1)
 		}
-		log.Printf("✅ LiveKit gRPC Bridge listening on TCP port: %s", config.Port)
+		slog.Info("LiveKit gRPC Bridge listening on TCP port", "port", config.Port)
 		bsLogger.LogInfo("Server listening on TCP", map[string]interface{}{
 			"port": config.Port,
 		})
 	}
 
-	log.Println("Ready to accept connections...")
+	slog.Info("Ready to accept connections")
 	bsLogger.LogInfo("gRPC server ready to accept connections", nil)
 
 	// Handle graceful shutdown
@@ -116,14 +175,33 @@ func main() {
 
 	go func() {
 		<-sigCh
-		bsLogger.LogInfo("Received shutdown signal, gracefully stopping", nil)
-		log.Println("Received shutdown signal, gracefully stopping...")
-		grpcServer.GracefulStop()
+		bsLogger.LogInfo("Received shutdown signal, draining", nil)
+		slog.Info("Received shutdown signal, draining")
+
+		// Stop new sessions and let in-flight playback finish, then close
+		// every session, which ends their streams so GracefulStop can return
+		healthServer.SetServingStatus("mentra.livekit.bridge.LiveKitBridge", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		bridgeService.Drain(config.DrainTimeout)
+		bridgeService.sessions.CloseAll()
+		bridgeService.sessions.Close()
+
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			slog.Warn("gRPC streams still open after drain, forcing stop")
+			grpcServer.Stop()
+		}
 	}()
 
 	// Start serving
 	if err := grpcServer.Serve(lis); err != nil {
 		bsLogger.LogError("Server failed", err, nil)
-		log.Fatalf("Failed to serve: %v", err)
+		slog.Error("Failed to serve", "error", err)
+		os.Exit(1)
 	}
 }
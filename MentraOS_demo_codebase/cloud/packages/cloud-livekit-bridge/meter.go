@@ -0,0 +1,167 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// meterWindow is how much recent audio the rolling RMS/peak levels cover
+const meterWindow = 300 * time.Millisecond
+
+// meterSilenceDb is the level below which a block counts as silence
+const meterSilenceDb = -60.0
+
+// meterFloorDb is reported when there is no audio in the window at all
+const meterFloorDb = -120.0
+
+// levelBlock is the energy and peak of one chunk of metered audio
+type levelBlock struct {
+	at         time.Time
+	sumSquares float64
+	samples    int
+	peak       float64 // absolute, 0..1
+}
+
+// levelMeter keeps rolling RMS and peak levels of an audio stream, and how
+// long it has been silent, for live VU meters in the status RPC
+type levelMeter struct {
+	mu        sync.Mutex
+	blocks    []levelBlock // oldest first, all within meterWindow
+	lastSound time.Time    // last block above meterSilenceDb
+}
+
+// newLevelMeter creates a meter; silence is counted from its creation
+func newLevelMeter() *levelMeter {
+	return &levelMeter{lastSound: time.Now()}
+}
+
+// observe records a chunk of samples (interleaved channels are fine)
+func (m *levelMeter) observe(samples []int16) {
+	if len(samples) == 0 {
+		return
+	}
+
+	block := levelBlock{at: time.Now(), samples: len(samples)}
+	for _, s := range samples {
+		f := float64(s) / 32768
+		block.sumSquares += f * f
+		block.peak = math.Max(block.peak, math.Abs(f))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pruneLocked(block.at)
+	m.blocks = append(m.blocks, block)
+	if toDb(math.Sqrt(block.sumSquares/float64(block.samples))) > meterSilenceDb {
+		m.lastSound = block.at
+	}
+}
+
+// pruneLocked drops blocks that have left the window; caller holds m.mu
+func (m *levelMeter) pruneLocked(now time.Time) {
+	i := 0
+	for i < len(m.blocks) && now.Sub(m.blocks[i].at) > meterWindow {
+		i++
+	}
+	if i > 0 {
+		m.blocks = append(m.blocks[:0], m.blocks[i:]...)
+	}
+}
+
+// levels returns the rolling RMS and peak in dBFS and how long the stream
+// has had no audio above meterSilenceDb
+func (m *levelMeter) levels() (rmsDb, peakDb float64, silentFor time.Duration) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pruneLocked(now)
+
+	var sumSquares, peak float64
+	var samples int
+	for _, block := range m.blocks {
+		sumSquares += block.sumSquares
+		samples += block.samples
+		peak = math.Max(peak, block.peak)
+	}
+
+	rmsDb, peakDb = meterFloorDb, meterFloorDb
+	if samples > 0 {
+		rmsDb = toDb(math.Sqrt(sumSquares / float64(samples)))
+		peakDb = toDb(peak)
+	}
+	return rmsDb, peakDb, now.Sub(m.lastSound)
+}
+
+// toDb converts a linear amplitude to dBFS, clamped at meterFloorDb
+func toDb(amplitude float64) float64 {
+	if amplitude <= 0 {
+		return meterFloorDb
+	}
+	return math.Max(20*math.Log10(amplitude), meterFloorDb)
+}
+
+// incomingMeter returns the meter for audio received from a participant,
+// creating it on first use
+func (s *RoomSession) incomingMeter(identity string) *levelMeter {
+	s.meterMu.Lock()
+	defer s.meterMu.Unlock()
+
+	meter, exists := s.incomingMeters[identity]
+	if !exists {
+		meter = newLevelMeter()
+		s.incomingMeters[identity] = meter
+	}
+	return meter
+}
+
+// trackLevels reports the current levels of every published PCM track and
+// every participant whose audio the bridge receives
+func (s *RoomSession) trackLevels() []*pb.TrackLevel {
+	var levels []*pb.TrackLevel
+
+	s.mu.RLock()
+	for trackName, state := range s.trackStates {
+		level := meterLevel(state.player.meter, trackName, "outgoing", "")
+		clip := state.player.clip.snapshot()
+		level.ClippedSamples = clip.inputClipped
+		level.OutputClippedSamples = clip.outputClipped
+		level.ClipEvents = clip.events
+		level.SoftLimitDb = clip.softLimitDb
+		levels = append(levels, level)
+	}
+	s.mu.RUnlock()
+
+	s.meterMu.Lock()
+	for identity, meter := range s.incomingMeters {
+		levels = append(levels, meterLevel(meter, "", "incoming", identity))
+	}
+	s.meterMu.Unlock()
+
+	sort.Slice(levels, func(i, j int) bool {
+		if levels[i].Direction != levels[j].Direction {
+			return levels[i].Direction > levels[j].Direction // outgoing first
+		}
+		return levels[i].TrackName+levels[i].ParticipantIdentity < levels[j].TrackName+levels[j].ParticipantIdentity
+	})
+	return levels
+}
+
+// meterLevel converts a meter reading into its status message
+func meterLevel(meter *levelMeter, trackName, direction, identity string) *pb.TrackLevel {
+	rmsDb, peakDb, silentFor := meter.levels()
+	return &pb.TrackLevel{
+		TrackName:           trackName,
+		Direction:           direction,
+		ParticipantIdentity: identity,
+		RmsDbfs:             rmsDb,
+		PeakDbfs:            peakDb,
+		SilentForMs:         silentFor.Milliseconds(),
+	}
+}
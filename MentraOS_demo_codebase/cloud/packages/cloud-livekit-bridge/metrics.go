@@ -0,0 +1,253 @@
+package main
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// metricsRegistry holds the metrics served on /metrics
+var metricsRegistry = prometheus.NewRegistry()
+
+// bridgeMetrics creates metrics registered with metricsRegistry
+var bridgeMetrics = promauto.With(metricsRegistry)
+
+var (
+	pcmBytesWritten = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_pcm_bytes_written_total",
+		Help: "PCM bytes written to published tracks.",
+	})
+	incomingBytes = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_incoming_bytes_total",
+		Help: "Mic audio bytes received from LiveKit.",
+	})
+	framesDropped = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_frames_dropped_total",
+		Help: "Audio frames dropped, by direction.",
+	}, []string{"direction"})
+	incomingOverflows = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_incoming_overflow_total",
+		Help: "Mic audio frames dropped from full session buffers, by overflow policy.",
+	}, []string{"policy"})
+	sessionLeaks = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_session_leaks_total",
+		Help: "Closed sessions still holding goroutines, tracks or channels after the leak grace period.",
+	})
+	reconnects = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_reconnects_total",
+		Help: "Room reconnect attempts by result (success, failure, abandoned).",
+	}, []string{"result"})
+	asrTranscripts = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_asr_transcripts_total",
+		Help: "Transcripts received from the speech recognizer, by type (interim, final).",
+	}, []string{"type"})
+	wakeWordDetections = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_wake_word_detections_total",
+		Help: "Wake words detected in mic audio, by keyword.",
+	}, []string{"keyword"})
+	soundEvents = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_sound_events_total",
+		Help: "Sound events detected in mic audio, by event (siren, doorbell, alarm, name_called, ...).",
+	}, []string{"event"})
+	speakerChanges = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_speaker_changes_total",
+		Help: "Changes of speaker detected on shared mics by diarization.",
+	})
+	presentationCorrections = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_pts_corrections_total",
+		Help: "Timestamped chunks padded, trimmed or dropped to play at their presentation time, by correction.",
+	}, []string{"correction"})
+	clippedSamples = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_clipped_samples_total",
+		Help: "Track samples written at full scale (input) or pushed past it by the publish chain (output), by stage.",
+	}, []string{"stage"})
+	clippingEvents = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_clipping_events_total",
+		Help: "Episodes of sustained near-full-scale audio on tracks.",
+	})
+	chunksReordered = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_chunks_reordered_total",
+		Help: "Sequenced audio chunks from the cloud held for reordering, dropped as duplicate or stale, or skipped as missing, by result.",
+	}, []string{"result"})
+	deliverySeconds = bridgeMetrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "livekit_bridge_delivery_seconds_total",
+		Help: "Audio of finished playback requests played to the track (delivered) or cut and refused instead (dropped), by result.",
+	}, []string{"result"})
+	catchUpSeconds = bridgeMetrics.NewCounter(prometheus.CounterOpts{
+		Name: "livekit_bridge_catchup_seconds_total",
+		Help: "Playback delay made up by time-stretching tracks that fell behind.",
+	})
+	writeLatency = bridgeMetrics.NewHistogram(prometheus.HistogramOpts{
+		Name:    "livekit_bridge_write_latency_seconds",
+		Help:    "Time to queue a chunk of PCM onto a track, including backpressure.",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	})
+)
+
+// registerSessionMetrics adds gauges computed from the live sessions at
+// scrape time
+func registerSessionMetrics(sessions *SessionManager) {
+	bridgeMetrics.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "livekit_bridge_sessions_active",
+		Help: "Room sessions currently held by the bridge.",
+	}, func() float64 {
+		active, _, _ := sessions.Counts()
+		return float64(active)
+	})
+	bridgeMetrics.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "livekit_bridge_join_queue_depth",
+		Help: "JoinRoom calls waiting for a session slot.",
+	}, func() float64 {
+		_, _, queued := sessions.Counts()
+		return float64(queued)
+	})
+	bridgeMetrics.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "livekit_bridge_tracks_published",
+		Help: "Tracks currently published across all sessions.",
+	}, func() float64 {
+		total := 0
+		sessions.Range(func(userId string, session *RoomSession) bool {
+			session.mu.RLock()
+			total += len(session.publications)
+			session.mu.RUnlock()
+			return true
+		})
+		return float64(total)
+	})
+	bridgeMetrics.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "livekit_bridge_audio_from_livekit_depth",
+		Help: "Mic audio frames waiting in session channels, summed across sessions.",
+	}, func() float64 {
+		total := 0
+		sessions.Range(func(userId string, session *RoomSession) bool {
+			total += session.audioFromLiveKit.len()
+			return true
+		})
+		return float64(total)
+	})
+
+	newGaugeVecFunc(
+		"livekit_bridge_track_clipped_samples",
+		"Samples clipped on each published track since it was created, by stage (input, output).",
+		[]string{"user_id", "track", "stage"},
+		func(emit func(float64, ...string)) {
+			sessions.Range(func(userId string, session *RoomSession) bool {
+				for trackName, st := range session.trackClipStats() {
+					emit(float64(st.inputClipped), userId, trackName, "input")
+					emit(float64(st.outputClipped), userId, trackName, "output")
+				}
+				return true
+			})
+		})
+	newGaugeVecFunc(
+		"livekit_bridge_track_soft_limit_db",
+		"Gain reduction the soft limiter is applying to each published track.",
+		[]string{"user_id", "track"},
+		func(emit func(float64, ...string)) {
+			sessions.Range(func(userId string, session *RoomSession) bool {
+				for trackName, st := range session.trackClipStats() {
+					emit(st.softLimitDb, userId, trackName)
+				}
+				return true
+			})
+		})
+
+	// Per-track WebRTC stats; one collection pass feeds every family of a scrape
+	rtc := &rtcSnapshot{sessions: sessions}
+	trackLabels := []string{"user_id", "track", "direction"}
+	for _, family := range []struct {
+		name, help string
+		value      func(*pb.TrackRTCStats) float64
+	}{
+		{"livekit_bridge_track_packet_loss_ratio", "Fraction of RTP packets lost per track.",
+			func(st *pb.TrackRTCStats) float64 { return st.FractionLost }},
+		{"livekit_bridge_track_jitter_seconds", "RTP interarrival jitter per track.",
+			func(st *pb.TrackRTCStats) float64 { return st.JitterMs / 1000 }},
+		{"livekit_bridge_track_rtt_seconds", "Round-trip time to the SFU per track.",
+			func(st *pb.TrackRTCStats) float64 { return st.RttMs / 1000 }},
+		{"livekit_bridge_track_bitrate_bps", "RTP bitrate per track.",
+			func(st *pb.TrackRTCStats) float64 { return st.BitrateBps }},
+	} {
+		value := family.value
+		newGaugeVecFunc(family.name, family.help, trackLabels,
+			func(emit func(float64, ...string)) {
+				for _, st := range rtc.get() {
+					emit(value(st.stats), st.userId, st.stats.TrackName, st.stats.Direction)
+				}
+			})
+	}
+}
+
+// rtcSnapshot caches every session's track stats briefly, so the gauges of
+// one scrape share a single collection pass
+type rtcSnapshot struct {
+	sessions *SessionManager
+
+	mu     sync.Mutex
+	at     time.Time
+	tracks []userTrackStats
+}
+
+// userTrackStats is one track's stats tagged with its session's user
+type userTrackStats struct {
+	userId string
+	stats  *pb.TrackRTCStats
+}
+
+// get returns the cached stats, collecting them again once they are stale
+func (r *rtcSnapshot) get() []userTrackStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.at) < time.Second {
+		return r.tracks
+	}
+
+	r.tracks = nil
+	r.sessions.Range(func(userId string, session *RoomSession) bool {
+		for _, st := range session.trackRTCStats() {
+			r.tracks = append(r.tracks, userTrackStats{userId: userId, stats: st})
+		}
+		return true
+	})
+	r.at = time.Now()
+	return r.tracks
+}
+
+// metricsHandler serves the bridge's metrics for Prometheus to scrape
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{})
+}
+
+// gaugeVecFunc is a labelled gauge whose samples are produced at scrape
+// time: collect calls emit once per label set
+type gaugeVecFunc struct {
+	desc    *prometheus.Desc
+	collect func(emit func(value float64, values ...string))
+}
+
+// newGaugeVecFunc registers a gaugeVecFunc with the bridge's metrics
+func newGaugeVecFunc(name, help string, labels []string, collect func(emit func(value float64, values ...string))) {
+	metricsRegistry.MustRegister(&gaugeVecFunc{
+		desc:    prometheus.NewDesc(name, help, labels, nil),
+		collect: collect,
+	})
+}
+
+// Describe implements prometheus.Collector
+func (g *gaugeVecFunc) Describe(ch chan<- *prometheus.Desc) {
+	ch <- g.desc
+}
+
+// Collect implements prometheus.Collector
+func (g *gaugeVecFunc) Collect(ch chan<- prometheus.Metric) {
+	g.collect(func(value float64, values ...string) {
+		ch <- prometheus.MustNewConstMetric(g.desc, prometheus.GaugeValue, value, values...)
+	})
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+)
+
+// roomMirror publishes a copy of each of a session's PCM tracks into a
+// second room, e.g. a monitoring or recording room, so supervisors and
+// archival participants can listen without joining the user's own. Every
+// track's player writes the frames it plays to the mirror track as well,
+// after gain, ducking and limiting, so the mirror carries what the user
+// hears.
+type roomMirror struct {
+	room *lksdk.Room
+	done chan struct{} // closed once mirroring stops
+
+	mu     sync.Mutex
+	tracks map[*trackPlayer]*mirrorTrack
+	closed bool
+}
+
+// mirrorTrack is one track's copy in the mirror room
+type mirrorTrack struct {
+	track       *lkmedia.PCMLocalTrack
+	publication *lksdk.LocalTrackPublication
+}
+
+// startMirror connects to a second room with token and mirrors every
+// current and future PCM track of the session into it, replacing any mirror
+// already running
+func (s *RoomSession) startMirror(url, token string) (*lksdk.Room, error) {
+	m := &roomMirror{
+		done:   make(chan struct{}),
+		tracks: make(map[*trackPlayer]*mirrorTrack),
+	}
+	callback := &lksdk.RoomCallback{
+		OnDisconnectedWithReason: func(reason lksdk.DisconnectionReason) {
+			if s.mirror.CompareAndSwap(m, nil) {
+				s.log().Warn("Mirror room disconnected", "reason", string(reason))
+				s.emitEvent(EventMirrorStopped, "", map[string]string{"reason": string(reason)})
+				m.stop()
+			}
+		},
+	}
+	room, err := lksdk.ConnectToRoomWithToken(url, token, callback, lksdk.WithAutoSubscribe(false))
+	if err != nil {
+		return nil, err
+	}
+	m.room = room
+
+	// Swapped under the session lock, so tracks created meanwhile are
+	// mirrored once, either here or as their player starts
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if previous := s.mirror.Swap(m); previous != nil {
+		previous.stop()
+	}
+	for trackName, state := range s.trackStates {
+		if state.player != nil {
+			s.mirrorTrackLocked(m, trackName, state.player, state.channels)
+		}
+	}
+	s.log().Info("Mirroring tracks", "mirror_room", room.Name(), "tracks", len(m.tracks))
+	return room, nil
+}
+
+// stopMirror stops mirroring, if it is on
+func (s *RoomSession) stopMirror() {
+	if m := s.mirror.Swap(nil); m != nil {
+		m.stop()
+		s.log().Info("Stopped mirroring tracks")
+	}
+}
+
+// mirrorTrackLocked publishes a copy of a player's track in the mirror room
+// for as long as the player runs; s.mu must be held
+func (s *RoomSession) mirrorTrackLocked(m *roomMirror, trackName string, player *trackPlayer, channels int) {
+	track, err := lkmedia.NewPCMLocalTrack(s.sampleRate, channels, nil)
+	if err != nil {
+		s.log().Warn("Failed to create mirror track", "track_name", trackName, "error", err)
+		return
+	}
+	publication, err := m.room.LocalParticipant.PublishTrack(track, &lksdk.TrackPublicationOptions{
+		Name:   trackName,
+		Stereo: channels == 2,
+	})
+	if err != nil {
+		track.Close()
+		s.log().Warn("Failed to publish mirror track", "track_name", trackName, "error", err)
+		return
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		m.room.LocalParticipant.UnpublishTrack(publication.SID())
+		track.Close()
+		return
+	}
+	m.tracks[player] = &mirrorTrack{track: track, publication: publication}
+	m.mu.Unlock()
+
+	player.setMirror(track)
+	s.spawn("mirror_track", func() {
+		select {
+		case <-player.stopped:
+		case <-m.done:
+		}
+		m.remove(player)
+	})
+}
+
+// remove unpublishes a player's mirror track
+func (m *roomMirror) remove(player *trackPlayer) {
+	m.mu.Lock()
+	mirrored, exists := m.tracks[player]
+	delete(m.tracks, player)
+	m.mu.Unlock()
+
+	if !exists {
+		return
+	}
+	player.setMirror(nil)
+	m.room.LocalParticipant.UnpublishTrack(mirrored.publication.SID())
+	mirrored.track.Close()
+}
+
+// stop unpublishes every mirror track and leaves the mirror room
+func (m *roomMirror) stop() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	players := make([]*trackPlayer, 0, len(m.tracks))
+	for player := range m.tracks {
+		players = append(players, player)
+	}
+	m.mu.Unlock()
+
+	for _, player := range players {
+		m.remove(player)
+	}
+	close(m.done)
+	m.room.Disconnect()
+}
+
+// SetMirrorRoom starts or stops publishing a session's tracks into a second
+// room as well as its own
+func (s *LiveKitBridgeService) SetMirrorRoom(
+	ctx context.Context,
+	req *pb.SetMirrorRoomRequest,
+) (*pb.SetMirrorRoomResponse, error) {
+	slog.Info("SetMirrorRoom request", "user_id", req.UserId, "enabled", req.Enabled, "livekit_url", req.LivekitUrl)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetMirrorRoomResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	if !req.Enabled {
+		session.stopMirror()
+		return &pb.SetMirrorRoomResponse{Success: true}, nil
+	}
+	if req.Token == "" {
+		return &pb.SetMirrorRoomResponse{
+			Success: false,
+			Error:   "token is required to join the mirror room",
+		}, nil
+	}
+	url := req.LivekitUrl
+	if url == "" {
+		url = session.joinRequest.LivekitUrl
+	}
+
+	room, err := session.startMirror(url, req.Token)
+	if err != nil {
+		session.log().Warn("Failed to join mirror room", "livekit_url", url, "error", err)
+		return &pb.SetMirrorRoomResponse{
+			Success: false,
+			Error:   "failed to join mirror room: " + err.Error(),
+		}, nil
+	}
+	return &pb.SetMirrorRoomResponse{
+		Success:       true,
+		RoomName:      room.Name(),
+		ParticipantId: string(room.LocalParticipant.Identity()),
+	}, nil
+}
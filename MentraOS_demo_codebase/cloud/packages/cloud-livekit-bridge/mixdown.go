@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// mixdownFrame is how much audio each mixed frame carries
+const mixdownFrame = 10 * time.Millisecond
+
+// mixdownPrebuffer is how much of a source's audio is held before it joins
+// the mix, riding out the jitter between its packets
+const mixdownPrebuffer = 20 * time.Millisecond
+
+// mixdownMaxLag is how far a source may fall behind the mix before its
+// oldest audio is dropped
+const mixdownMaxLag = 200 * time.Millisecond
+
+// mixdownIdle is how long a silent source is kept before it is forgotten
+const mixdownIdle = 5 * time.Second
+
+// mixdown sums the audio of every remote participant the session hears into
+// one stream, each weighted by its participant's gain, for consumers that
+// want everything the user hears rather than a channel per participant.
+// Sources are buffered briefly and mixed in 10ms frames on a clock of the
+// mixer's own, so participants sending at different moments line up.
+type mixdown struct {
+	sampleRate int
+	direction  string // frames_dropped label for audio that falls too far behind
+
+	mu      sync.Mutex
+	sources map[string]*mixSource // by participant identity and track name
+	gains   map[string]float64    // by participant identity (gain key); 1.0 when unset
+}
+
+// mixSource is one source's audio waiting to be mixed
+type mixSource struct {
+	gainKey   string // whose gain applies, e.g. the participant's identity
+	pending   []int16
+	primed    bool // held mixdownPrebuffer and is being mixed
+	lastHeard time.Time
+}
+
+// startMixdown starts mixing the session's incoming audio into
+// audioFromLiveKit alongside the per-participant frames
+func (s *RoomSession) startMixdown(gains map[string]float32) {
+	m := newMixdown(s.sampleRate, "mixdown")
+	m.setGains(gains)
+	s.mixdown = m
+	s.spawn("mixdown", func() { s.runMixdown(m) })
+	s.log().Info("Mixing down remote participants", "gains", len(gains))
+}
+
+// newMixdown creates a mixer at sampleRate with no sources
+func newMixdown(sampleRate int, direction string) *mixdown {
+	return &mixdown{
+		sampleRate: sampleRate,
+		direction:  direction,
+		sources:    make(map[string]*mixSource),
+	}
+}
+
+// setGains replaces the participants' weights in the mix, clamped to
+// 0..maxTrackGain
+func (m *mixdown) setGains(gains map[string]float32) {
+	weights := make(map[string]float64, len(gains))
+	for identity, gain := range gains {
+		weights[identity] = max(0, min(maxTrackGain, float64(gain)))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.gains = weights
+}
+
+// push queues a received frame for the mix; never blocks
+func (m *mixdown) push(frame AudioFrame) {
+	if m == nil || len(frame.PCM) == 0 {
+		return
+	}
+	samples := pooledSamples(frame.PCM)
+	m.add(frame.ParticipantIdentity+"/"+frame.TrackName, frame.ParticipantIdentity, *samples)
+	putSamples(samples)
+}
+
+// add queues samples from the source key, weighted by the gain under
+// gainKey, for the mix
+func (m *mixdown) add(key, gainKey string, samples []int16) {
+	maxLag := m.samples(mixdownMaxLag)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	source, exists := m.sources[key]
+	if !exists {
+		source = &mixSource{gainKey: gainKey}
+		m.sources[key] = source
+	}
+	source.pending = append(source.pending, samples...)
+	source.lastHeard = time.Now()
+	if excess := len(source.pending) - maxLag; excess > 0 {
+		source.pending = append(source.pending[:0], source.pending[excess:]...)
+		framesDropped.WithLabelValues(m.direction).Inc()
+	}
+}
+
+// samples returns how many samples make up d at the mix's rate
+func (m *mixdown) samples(d time.Duration) int {
+	return int(int64(m.sampleRate) * int64(d) / int64(time.Second))
+}
+
+// mix takes the next frame from every primed source and sums them; ok is
+// false when no source had audio
+func (m *mixdown) mix(now time.Time) (pcm []byte, ok bool) {
+	frameSamples := m.samples(mixdownFrame)
+	prebuffer := m.samples(mixdownPrebuffer)
+	sum := make([]float64, frameSamples)
+
+	m.mu.Lock()
+	for key, source := range m.sources {
+		if !source.primed {
+			if len(source.pending) < prebuffer {
+				if len(source.pending) == 0 && now.Sub(source.lastHeard) > mixdownIdle {
+					delete(m.sources, key)
+				}
+				continue
+			}
+			source.primed = true
+		}
+
+		gain, exists := m.gains[source.gainKey]
+		if !exists {
+			gain = 1
+		}
+		n := min(frameSamples, len(source.pending))
+		for i, sample := range source.pending[:n] {
+			sum[i] += float64(sample) * gain
+		}
+		source.pending = append(source.pending[:0], source.pending[n:]...)
+		if len(source.pending) == 0 {
+			// Ran dry: hold it back until it has built up again
+			source.primed = false
+		}
+		ok = true
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+	pcm = make([]byte, frameSamples*2)
+	for i, value := range sum {
+		sample := softClip(value)
+		pcm[2*i] = byte(sample)
+		pcm[2*i+1] = byte(uint16(sample) >> 8)
+	}
+	return pcm, true
+}
+
+// runMixdown hands a mixed frame to StreamAudio every 10ms that any
+// participant is heard, until the session closes
+func (s *RoomSession) runMixdown(m *mixdown) {
+	ticker := time.NewTicker(mixdownFrame)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			pcm, ok := m.mix(now)
+			if !ok {
+				continue
+			}
+			frame := s.newAudioFrame(pcm, "", "", captureStamp{})
+			frame.Mixed = true
+			if s.audioFromLiveKit.push(frame) {
+				framesDropped.WithLabelValues("incoming").Inc()
+				incomingOverflows.WithLabelValues(string(s.audioFromLiveKit.overflowPolicy())).Inc()
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// SetMixdownGains replaces the per-participant weights of a session's
+// mixdown
+func (s *LiveKitBridgeService) SetMixdownGains(
+	ctx context.Context,
+	req *pb.SetMixdownGainsRequest,
+) (*pb.SetMixdownGainsResponse, error) {
+	slog.Info("SetMixdownGains request", "user_id", req.UserId, "gains", len(req.Gains))
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetMixdownGainsResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	if session.mixdown == nil {
+		return &pb.SetMixdownGainsResponse{
+			Success: false,
+			Error:   "mixdown is not enabled for this session",
+		}, nil
+	}
+	session.mixdown.setGains(req.Gains)
+	session.log().Info("Mixdown gains updated", "gains", req.Gains)
+	return &pb.SetMixdownGainsResponse{Success: true}, nil
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"gopkg.in/hraban/opus.v2"
+)
+
+// opusDecodeRate is the rate Ogg/Opus is decoded at; Opus always runs at 48kHz
+const opusDecodeRate = 48000
+
+// opusMaxFrameSamples is the longest Opus packet (120ms) in samples per channel
+const opusMaxFrameSamples = opusDecodeRate * 120 / 1000
+
+// oggPacketReader splits an Ogg stream into packets, joining packets that
+// continue across pages. CRCs aren't checked; a corrupt page shows up as a
+// decode error instead.
+type oggPacketReader struct {
+	r       io.Reader
+	pending [][]byte // complete packets from the current page
+	partial []byte   // packet continued on the next page
+}
+
+// next returns the next packet, or io.EOF at the end of the stream
+func (o *oggPacketReader) next() ([]byte, error) {
+	for len(o.pending) == 0 {
+		if err := o.readPage(); err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				err = io.EOF // truncated final page
+			}
+			return nil, err
+		}
+	}
+	packet := o.pending[0]
+	o.pending = o.pending[1:]
+	return packet, nil
+}
+
+// readPage reads one page and queues the packets it completes
+func (o *oggPacketReader) readPage() error {
+	header := make([]byte, 27)
+	if _, err := io.ReadFull(o.r, header); err != nil {
+		return err
+	}
+	if string(header[0:4]) != "OggS" {
+		return fmt.Errorf("lost Ogg page sync")
+	}
+
+	lacing := make([]byte, header[26])
+	if _, err := io.ReadFull(o.r, lacing); err != nil {
+		return err
+	}
+	size := 0
+	for _, l := range lacing {
+		size += int(l)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(o.r, data); err != nil {
+		return err
+	}
+
+	// A lacing value below 255 ends a packet; 255 means it continues
+	offset := 0
+	for _, l := range lacing {
+		o.partial = append(o.partial, data[offset:offset+int(l)]...)
+		offset += int(l)
+		if l < 255 {
+			o.pending = append(o.pending, o.partial)
+			o.partial = nil
+		}
+	}
+	return nil
+}
+
+// opusHead is the identification header at the start of an Ogg/Opus stream
+type opusHead struct {
+	channels int
+	preSkip  int // samples (at 48kHz, per channel) to drop from the start
+}
+
+// readOpusHeaders reads the OpusHead and OpusTags packets
+func readOpusHeaders(o *oggPacketReader) (opusHead, error) {
+	var head opusHead
+
+	packet, err := o.next()
+	if err != nil {
+		return head, fmt.Errorf("failed to read OpusHead: %w", err)
+	}
+	if len(packet) < 19 || !bytes.HasPrefix(packet, []byte("OpusHead")) {
+		return head, fmt.Errorf("not an Ogg/Opus stream")
+	}
+	head.channels = int(packet[9])
+	head.preSkip = int(binary.LittleEndian.Uint16(packet[10:12]))
+	if head.channels != 1 && head.channels != 2 {
+		return head, fmt.Errorf("only mono/stereo Opus supported (got %d channels)", head.channels)
+	}
+
+	tags, err := o.next()
+	if err != nil {
+		return head, fmt.Errorf("failed to read OpusTags: %w", err)
+	}
+	if !bytes.HasPrefix(tags, []byte("OpusTags")) {
+		return head, fmt.Errorf("missing OpusTags header")
+	}
+	return head, nil
+}
+
+// opusPacketDuration reads a packet's duration from its TOC byte (RFC 6716
+// section 3.1); returns 0 for a malformed packet
+func opusPacketDuration(packet []byte) time.Duration {
+	if len(packet) == 0 {
+		return 0
+	}
+	toc := packet[0]
+	config := toc >> 3
+
+	var frame time.Duration
+	switch {
+	case config < 12: // SILK: 10, 20, 40, 60ms
+		frame = [...]time.Duration{10, 20, 40, 60}[config%4] * time.Millisecond
+	case config < 16: // Hybrid: 10, 20ms
+		frame = [...]time.Duration{10, 20}[config%2] * time.Millisecond
+	default: // CELT: 2.5, 5, 10, 20ms
+		frame = [...]time.Duration{2500, 5000, 10000, 20000}[config%4] * time.Microsecond
+	}
+
+	switch toc & 0x3 {
+	case 0:
+		return frame
+	case 1, 2:
+		return 2 * frame
+	default:
+		if len(packet) < 2 {
+			return 0
+		}
+		return time.Duration(packet[1]&0x3F) * frame
+	}
+}
+
+// playOggOpus demuxes Ogg/Opus and either decodes it to PCM (so volume,
+// ducking and the rest of the PCM chain apply) or, with passthrough,
+// publishes the Opus packets untouched on an Opus track
+func (s *LiveKitBridgeService) playOggOpus(
+	ctx context.Context,
+	r io.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+) (int64, error) {
+	// Passthrough publishes the Opus packets to one session's track as-is
+	session, isSession := sink.(*RoomSession)
+	if req.Passthrough && !isSession {
+		return 0, fmt.Errorf("passthrough playback needs a single session")
+	}
+
+	packets := &oggPacketReader{r: r}
+	head, err := readOpusHeaders(packets)
+	if err != nil {
+		return 0, err
+	}
+
+	var dec *opus.Decoder
+	var pcm []int16
+	if !req.Passthrough {
+		if dec, err = opus.NewDecoder(opusDecodeRate, head.channels); err != nil {
+			return 0, fmt.Errorf("failed to create Opus decoder: %w", err)
+		}
+		pcm = make([]int16, opusMaxFrameSamples*head.channels)
+	}
+
+	skip := head.preSkip * head.channels
+	var totalPackets int64
+	startTime := time.Now()
+
+	for {
+		// Check for cancellation
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+		}
+
+		packet, err := packets.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("Ogg read error: %w", err)
+		}
+		if len(packet) == 0 {
+			continue
+		}
+		totalPackets++
+
+		if req.Passthrough {
+			if err := waitForScheduledStart(ctx); err != nil {
+				return 0, err
+			}
+			if err := session.writeOpusToTrack(packet, trackName, opusPacketDuration(packet)); err != nil {
+				return 0, fmt.Errorf("failed to write audio: %w", err)
+			}
+			continue
+		}
+
+		n, err := dec.Decode(packet, pcm)
+		if err != nil {
+			return 0, fmt.Errorf("Opus decode error: %w", err)
+		}
+		samples := pcm[:n*head.channels]
+
+		// Drop the encoder's pre-skip priming samples
+		if skip > 0 {
+			drop := min(skip, len(samples))
+			samples = samples[drop:]
+			skip -= drop
+		}
+		if len(samples) == 0 {
+			continue
+		}
+
+		// Apply volume
+		if req.Volume > 0 && req.Volume != 1.0 {
+			applyGain(samples, float64(req.Volume))
+		}
+
+		// Write to LiveKit (resampled to the publish rate by the track)
+		if err := sink.writeSamplesToTrack(ctx, samples, trackName, opusDecodeRate, head.channels); err != nil {
+			return 0, fmt.Errorf("failed to write audio: %w", err)
+		}
+	}
+
+	// Drain the track's queue before reporting completion
+	if req.Passthrough {
+		err = session.waitForOpusPlayout(ctx, trackName)
+	} else {
+		err = sink.waitForTrackPlayout(ctx, trackName)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("playout interrupted: %w", err)
+	}
+
+	duration := time.Since(startTime).Milliseconds()
+	sink.log().Info("Ogg/Opus playback complete", "request_id", req.RequestId, "track_name", trackName,
+		"packets", totalPackets, "passthrough", req.Passthrough, "duration_ms", duration)
+
+	return duration, nil
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media"
+)
+
+// defaultOpusFrameDuration is assumed when the cloud doesn't specify one
+const defaultOpusFrameDuration = 20 * time.Millisecond
+
+// opusQueueSize bounds buffered Opus packets per track (~10s at 20ms)
+const opusQueueSize = 500
+
+// opusFrame is a single pre-encoded Opus packet waiting to be published
+type opusFrame struct {
+	data     []byte
+	duration time.Duration
+}
+
+// opusTrack publishes pre-encoded Opus packets directly to LiveKit,
+// skipping the PCM decode/re-encode done by PCMLocalTrack.
+//
+// LocalTrack.WriteSample does not pace its input, so a writer goroutine
+// releases packets in real time based on each packet's duration.
+type opusTrack struct {
+	track     *lksdk.LocalTrack
+	ready     chan struct{} // closed once WebRTC negotiation finished (or timed out)
+	frames    chan opusFrame
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+	logger    *slog.Logger
+	activity  *trackActivity
+	stopped   chan struct{} // closed once writeLoop has returned
+}
+
+// newOpusTrack creates an Opus sample track and starts its pacing goroutine
+func newOpusTrack(logger *slog.Logger) (*opusTrack, error) {
+	track, err := lksdk.NewLocalSampleTrack(webrtc.RTPCodecCapability{
+		MimeType:  webrtc.MimeTypeOpus,
+		ClockRate: 48000,
+		Channels:  2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &opusTrack{
+		track:    track,
+		frames:   make(chan opusFrame, opusQueueSize),
+		ctx:      ctx,
+		cancel:   cancel,
+		logger:   logger,
+		activity: newTrackActivity(),
+		stopped:  make(chan struct{}),
+	}
+	go t.writeLoop()
+	return t, nil
+}
+
+// enqueue queues one Opus packet, blocking while the queue is full so the
+// caller (and ultimately the gRPC stream) feels backpressure
+func (t *opusTrack) enqueue(packet []byte, duration time.Duration) error {
+	if duration <= 0 {
+		duration = defaultOpusFrameDuration
+	}
+
+	// Copy: the gRPC buffer backing packet may be reused after we return
+	data := make([]byte, len(packet))
+	copy(data, packet)
+
+	select {
+	case t.frames <- opusFrame{data: data, duration: duration}:
+		return nil
+	case <-t.ctx.Done():
+		return fmt.Errorf("opus track closed")
+	}
+}
+
+// writeLoop writes queued packets to the track at real-time pace
+func (t *opusTrack) writeLoop() {
+	defer close(t.stopped)
+
+	var next time.Time
+
+	for {
+		select {
+		case frame := <-t.frames:
+			now := time.Now()
+			if next.Before(now) {
+				// Queue ran dry (or first packet): restart the clock
+				next = now
+			} else if wait := next.Sub(now); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-t.ctx.Done():
+					return
+				}
+			}
+
+			if err := t.track.WriteSample(media.Sample{
+				Data:     frame.data,
+				Duration: frame.duration,
+			}, nil); err != nil {
+				t.logger.Warn("Failed to write Opus sample", "error", err)
+			} else {
+				t.activity.recordWrite()
+			}
+			next = next.Add(frame.duration)
+
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+// Close stops the pacing goroutine and closes the underlying track
+func (t *opusTrack) Close() {
+	t.closeOnce.Do(func() {
+		t.cancel()
+		t.track.Close()
+	})
+}
+
+// getOrCreateOpusTrack gets or creates a named Opus passthrough track
+func (s *RoomSession) getOrCreateOpusTrack(trackName string) (*opusTrack, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.room == nil {
+		return nil, fmt.Errorf("room not connected")
+	}
+
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	if track, exists := s.opusTracks[trackName]; exists {
+		return track, nil
+	}
+
+	// A name can only be published once, either as PCM or as Opus
+	if _, exists := s.tracks[trackName]; exists {
+		return nil, fmt.Errorf("track '%s' is already published as PCM", trackName)
+	}
+
+	if err := s.quota.checkTracks(len(s.tracks) + len(s.opusTracks)); err != nil {
+		s.log().Warn("Track limit reached", "track_name", trackName, "error", err)
+		return nil, err
+	}
+
+	track, err := newOpusTrack(s.log().With("track_name", trackName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opus track: %w", err)
+	}
+	s.watch("opus_pacer", track.stopped)
+
+	onBind, ready := s.negotiationGate(trackName)
+	track.ready = ready
+	track.track.OnBind(onBind)
+
+	publication, err := s.room.LocalParticipant.PublishTrack(track.track, &lksdk.TrackPublicationOptions{
+		Name: s.publishName(trackName),
+	})
+	if err != nil {
+		track.Close()
+		return nil, fmt.Errorf("failed to publish track: %w", err)
+	}
+
+	s.opusTracks[trackName] = track
+	s.publications[trackName] = publication
+
+	s.log().Info("Published Opus passthrough track", "track_name", trackName, "track_sid", publication.SID())
+	s.emitEvent(EventTrackPublished, trackName, map[string]string{"track_sid": publication.SID(), "encoding": "opus"})
+	return track, nil
+}
+
+// writeOpusToTrack publishes one pre-encoded Opus packet on a named track
+func (s *RoomSession) writeOpusToTrack(packet []byte, trackName string, duration time.Duration) error {
+	if len(packet) == 0 {
+		return nil
+	}
+	s.touch()
+
+	if err := s.quota.spend(len(packet)); err != nil {
+		return err
+	}
+
+	track, err := s.getOrCreateOpusTrack(trackName)
+	if err != nil {
+		return err
+	}
+
+	s.waitForNegotiation(track.ready)
+	if err := track.enqueue(packet, duration); err != nil {
+		return err
+	}
+	s.usage.AddPublished(s.userId, trackOwner(trackName), duration)
+	return nil
+}
+
+// waitForOpusPlayout blocks until a named Opus track has released every
+// queued packet; it returns at once for a queued clip (see deferPlayout)
+func (s *RoomSession) waitForOpusPlayout(ctx context.Context, trackName string) error {
+	if playoutDeferred(ctx) {
+		return nil
+	}
+	s.mu.RLock()
+	track, exists := s.opusTracks[trackName]
+	s.mu.RUnlock()
+	if !exists {
+		return nil
+	}
+
+	ticker := time.NewTicker(defaultOpusFrameDuration)
+	defer ticker.Stop()
+
+	for len(track.frames) > 0 {
+		select {
+		case <-ticker.C:
+		case <-track.ctx.Done():
+			return errPlayerClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// closeOpusTrackLocked closes a named Opus track; caller must hold s.mu
+func (s *RoomSession) closeOpusTrackLocked(trackName string) {
+	if track, exists := s.opusTracks[trackName]; exists {
+		track.Close()
+		delete(s.opusTracks, trackName)
+		s.log().Info("Closed Opus track", "track_name", trackName)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// maxInterauralDelay is how much later a sound from straight to the side
+// reaches the far ear
+const maxInterauralDelay = 660 * time.Microsecond
+
+// headShadowCutoff is the far ear's low-pass cutoff for a sound from
+// straight to the side; the head blocks less as the source moves to the front
+const headShadowCutoff = 1500.0
+
+// farEarLevel is the far ear's level for a sound from straight to the side
+const farEarLevel = 0.7
+
+// trackPan places a track between the left and right ear
+type trackPan struct {
+	pan     float64 // -1 (left) .. 0 (center) .. 1 (right)
+	spatial bool    // render with interaural delay and head shadow instead of a plain pan
+}
+
+// panner applies a track's pan to its stereo frames as they play. A plain
+// pan is a constant-power pan, compensated so the center is unchanged, and
+// ramped across each frame when it moves. Spatial rendering ("HRTF-lite")
+// treats the track as a point source at the pan's azimuth (±1 = 90°): the
+// far ear hears it later, quieter and low-passed, as the head shadows it.
+type panner struct {
+	sampleRate   int
+	started      bool
+	gainL, gainR float64   // plain pan gains at the end of the last frame
+	history      []float64 // recent source samples, for delaying the far ear
+	pos          int
+	shadow       float64 // far ear's low-pass state
+}
+
+// newPanner creates a panner for stereo frames at sampleRate
+func newPanner(sampleRate int) *panner {
+	delay := int(math.Ceil(maxInterauralDelay.Seconds() * float64(sampleRate)))
+	return &panner{sampleRate: sampleRate, history: make([]float64, delay+1)}
+}
+
+// panGains returns the left and right gains of a constant-power pan, 1 each
+// at the center
+func panGains(pan float64) (float64, float64) {
+	theta := (pan + 1) * math.Pi / 4
+	return math.Cos(theta) * math.Sqrt2, math.Sin(theta) * math.Sqrt2
+}
+
+// process pans an interleaved stereo frame in place
+func (p *panner) process(frame []int16, setting trackPan) {
+	if setting.spatial {
+		p.spatialize(frame, setting.pan)
+		return
+	}
+
+	left, right := panGains(setting.pan)
+	if !p.started {
+		p.gainL, p.gainR = left, right
+		p.started = true
+	}
+	frames := len(frame) / 2
+	stepL := (left - p.gainL) / float64(frames)
+	stepR := (right - p.gainR) / float64(frames)
+	for i := 0; i < frames; i++ {
+		gainL := p.gainL + stepL*float64(i+1)
+		gainR := p.gainR + stepR*float64(i+1)
+		frame[2*i] = softClip(float64(frame[2*i]) * gainL)
+		frame[2*i+1] = softClip(float64(frame[2*i+1]) * gainR)
+	}
+	p.gainL, p.gainR = left, right
+}
+
+// spatialize renders a stereo frame's downmix as a source at pan's azimuth
+func (p *panner) spatialize(frame []int16, pan float64) {
+	side := math.Abs(pan)
+	delay := int(math.Round(maxInterauralDelay.Seconds() * side * float64(p.sampleRate)))
+	shadow := side * math.Exp(-2*math.Pi*headShadowCutoff/float64(p.sampleRate))
+	farGain := 1 - (1-farEarLevel)*side
+
+	n := len(p.history)
+	for i := 0; i < len(frame)/2; i++ {
+		source := (float64(frame[2*i]) + float64(frame[2*i+1])) / 2
+		p.history[p.pos] = source
+		delayed := p.history[(p.pos-delay+n)%n]
+		p.pos = (p.pos + 1) % n
+
+		p.shadow = (1-shadow)*delayed + shadow*p.shadow
+		near, far := softClip(source), softClip(p.shadow*farGain)
+		if pan < 0 {
+			frame[2*i], frame[2*i+1] = near, far
+		} else {
+			frame[2*i], frame[2*i+1] = far, near
+		}
+	}
+}
+
+// SetTrackPan places a named track between the ears, or centers it again
+// when pan is nil. Panned tracks are published in stereo, so a track
+// already published in mono is panned from the next time it is created.
+// The setting survives the track being recreated by later playback.
+// Reports whether the track is playing in stereo now.
+func (s *RoomSession) SetTrackPan(trackName string, pan *trackPan) bool {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	s.mu.Lock()
+	if pan == nil {
+		delete(s.trackPans, trackName)
+	} else {
+		s.trackPans[trackName] = *pan
+	}
+	state, exists := s.trackStates[trackName]
+	s.mu.Unlock()
+
+	stereo := exists && state.channels == 2
+	if exists && state.player != nil {
+		state.player.setPan(pan)
+	}
+
+	if pan == nil {
+		s.log().Info("Cleared track pan", "track_name", trackName)
+	} else {
+		s.log().Info("Set track pan", "track_name", trackName, "pan", pan.pan, "spatial", pan.spatial, "stereo", stereo)
+	}
+	return stereo
+}
+
+// SetTrackPan sets where a track sits between the left and right ear
+func (s *LiveKitBridgeService) SetTrackPan(
+	ctx context.Context,
+	req *pb.SetTrackPanRequest,
+) (*pb.SetTrackPanResponse, error) {
+	slog.Info("SetTrackPan request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "pan", req.Pan, "spatial", req.Spatial, "clear", req.Clear)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetTrackPanResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.SetTrackPanResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	if math.IsNaN(float64(req.Pan)) || req.Pan < -1 || req.Pan > 1 {
+		return &pb.SetTrackPanResponse{
+			Success: false,
+			Error:   fmt.Sprintf("pan must be between -1 and 1, got %v", req.Pan),
+		}, nil
+	}
+
+	var pan *trackPan
+	if !req.Clear {
+		pan = &trackPan{pan: float64(req.Pan), spatial: req.Spatial}
+	}
+	return &pb.SetTrackPanResponse{
+		Success: true,
+		Stereo:  session.SetTrackPan(trackName, pan),
+	}, nil
+}
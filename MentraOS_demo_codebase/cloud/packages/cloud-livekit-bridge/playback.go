@@ -2,13 +2,16 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
-	"log"
-	"net/http"
+	"log/slog"
+	"math"
+	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,13 +21,14 @@ import (
 
 // playAudioFile handles downloading and playing audio files
 func (s *LiveKitBridgeService) playAudioFile(
+	ctx context.Context,
 	req *pb.PlayAudioRequest,
 	session *RoomSession,
 	stream pb.LiveKitBridge_PlayAudioServer,
 	trackName string,
 ) (int64, error) {
 	// Create cancellable context for playback
-	ctx, cancel := context.WithCancel(stream.Context())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	// Create done channel to signal when playback stops
@@ -37,39 +41,195 @@ func (s *LiveKitBridgeService) playAudioFile(
 	session.playbackDone = done
 	session.mu.Unlock()
 
-	// Fetch audio file
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, req.AudioUrl, nil)
-	if err != nil {
-		return 0, fmt.Errorf("invalid URL: %w", err)
+	// Registered by track too, so a stop aimed at other tracks leaves it be
+	defer session.endPlayback(session.startPlayback(trackName, req.RequestId, cancel, done))
+
+	// Scheduled playback fetches and decodes now but holds the first sample
+	// until the start time; a time already past plays right away
+	if req.StartAtMs > 0 {
+		at := time.UnixMilli(req.StartAtMs)
+		if late := time.Since(at); late > 0 {
+			session.log().Warn("Scheduled playback is late, starting now", "request_id", req.RequestId,
+				"track_name", trackName, "late_ms", late.Milliseconds())
+		}
+		ctx = withScheduledStart(ctx, at)
 	}
 
-	resp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return 0, fmt.Errorf("failed to fetch audio: %w", err)
+	// Loops replay from memory and passthrough skips the PCM chain, so only
+	// plain file playback can seek
+	if req.Loop || req.Passthrough {
+		return s.playSource(ctx, req, session, stream, trackName, 0)
 	}
-	defer resp.Body.Close()
+	return s.playSeekable(ctx, req, session, stream, trackName)
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return 0, fmt.Errorf("HTTP error: %d %s", resp.StatusCode, resp.Status)
+// playSource fetches (or reads inline) the request's audio and plays it
+// from offset, decoding from the start and dropping the audio before it
+func (s *LiveKitBridgeService) playSource(
+	ctx context.Context,
+	req *pb.PlayAudioRequest,
+	session *RoomSession,
+	stream pb.LiveKitBridge_PlayAudioServer,
+	trackName string,
+	offset time.Duration,
+) (int64, error) {
+	if offset > 0 {
+		ctx = withSeekSkip(ctx, offset)
+	}
+
+	// Inline audio (PlayBytes) skips the fetch
+	var body io.Reader
+	contentType := strings.ToLower(req.ContentType)
+	if len(req.AudioData) > 0 {
+		body = bytes.NewReader(req.AudioData)
+	} else {
+		if req.AudioUrl == "" {
+			return 0, fmt.Errorf("audio_url or audio_data is required")
+		}
+
+		// Stream the audio: decoding starts on the first bytes and the
+		// track's queue throttles the download to playback speed
+		httpStream, err := openHTTPStream(ctx, req.AudioUrl, s.config.StreamRetries, session.log())
+		if err != nil {
+			return 0, err
+		}
+		defer httpStream.Close()
+
+		body = httpStream
+		if contentType == "" {
+			contentType = httpStream.contentType
+		}
 	}
 
-	// Detect content type
-	contentType := strings.ToLower(resp.Header.Get("Content-Type"))
-	url := strings.ToLower(req.AudioUrl)
+	br := bufio.NewReader(body)
+	format := requestAudioFormat(req, contentType, br)
 
-	log.Printf("Playing audio: url=%s, contentType=%s", req.AudioUrl, contentType)
+	session.log().Info("Playing audio", "request_id", req.RequestId, "track_name", trackName,
+		"url", req.AudioUrl, "inline_bytes", len(req.AudioData), "content_type", contentType, "format", format,
+		"offset_ms", offset.Milliseconds())
 
-	// Route to appropriate decoder
-	if strings.Contains(contentType, "audio/mpeg") || strings.HasSuffix(url, ".mp3") {
-		return s.playMP3(ctx, resp.Body, req, session, trackName)
-	} else if strings.Contains(contentType, "audio/wav") ||
+	if format == "" {
+		return 0, fmt.Errorf("%w: %s", errUnsupportedFormat, contentType)
+	}
+
+	progress := s.startProgress(ctx, stream, req, session, trackName, offset)
+	defer progress.stop()
+
+	if req.Loop {
+		return s.playLooped(ctx, format, br, req, session, trackName)
+	}
+	return s.decodeAudio(ctx, format, br, req, session, trackName, progress)
+}
+
+// pcmSink is where decoders write the audio they decode: a session's track,
+// or the same track in every room of a broadcast
+type pcmSink interface {
+	writeSamplesToTrack(ctx context.Context, samples []int16, trackName string, sampleRate, channels int) error
+	waitForTrackPlayout(ctx context.Context, trackName string) error
+	log() *slog.Logger
+}
+
+// decodeAudio routes audio to the decoder for its format
+func (s *LiveKitBridgeService) decodeAudio(
+	ctx context.Context,
+	format string,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	progress *playbackProgress,
+) (int64, error) {
+	switch format {
+	case "mp3":
+		// A seekable source lets the decoder report the duration
+		var r io.Reader = br
+		if len(req.AudioData) > 0 {
+			r = bytes.NewReader(req.AudioData)
+		}
+		return s.playMP3(ctx, r, req, sink, trackName, progress)
+	case "wav":
+		return s.playWAV(ctx, br, req, sink, trackName, progress)
+	case "aac":
+		return s.playFFmpeg(ctx, br, req, sink, trackName, "AAC", ffmpegSampleRate, 2)
+	case "flac":
+		return s.playFLAC(ctx, br, req, sink, trackName, progress)
+	case "amr":
+		return s.playAMR(ctx, br, req, sink, trackName, false)
+	case "amr-wb":
+		return s.playAMR(ctx, br, req, sink, trackName, true)
+	case "ogg":
+		return s.playOggOpus(ctx, br, req, sink, trackName)
+	case "au":
+		return s.playAU(ctx, br, req, sink, trackName, progress)
+	case "raw":
+		dataBytes := int64(-1)
+		if len(req.AudioData) > 0 {
+			dataBytes = int64(len(req.AudioData))
+		}
+		raw, err := rawAudioFormat(req.Format, dataBytes)
+		if err != nil {
+			return 0, err
+		}
+		return s.playPCM(ctx, br, raw, req, sink, trackName, progress)
+	}
+
+	return 0, fmt.Errorf("%w: %s", errUnsupportedFormat, format)
+}
+
+// detectAudioFormat picks a decoder from the content type, the URL's
+// extension or, failing both, the first bytes of the audio
+func detectAudioFormat(contentType, url string, br *bufio.Reader) string {
+	switch {
+	case strings.Contains(contentType, "audio/mpeg") || strings.HasSuffix(url, ".mp3"):
+		return "mp3"
+	case strings.Contains(contentType, "audio/aac") ||
+		strings.Contains(contentType, "audio/mp4") ||
+		strings.Contains(contentType, "audio/x-m4a") ||
+		strings.HasSuffix(url, ".aac") ||
+		strings.HasSuffix(url, ".m4a"):
+		return "aac"
+	case strings.Contains(contentType, "audio/ogg") ||
+		strings.Contains(contentType, "audio/opus") ||
+		strings.HasSuffix(url, ".ogg") ||
+		strings.HasSuffix(url, ".opus"):
+		return "ogg"
+	case strings.Contains(contentType, "audio/wav") ||
 		strings.Contains(contentType, "audio/x-wav") ||
 		strings.Contains(contentType, "audio/wave") ||
-		strings.HasSuffix(url, ".wav") {
-		return s.playWAV(ctx, resp.Body, req, session, trackName)
+		strings.HasSuffix(url, ".wav"):
+		return "wav"
+	case strings.HasSuffix(url, ".au") || strings.HasSuffix(url, ".snd"):
+		return "au"
+	case strings.Contains(contentType, "audio/flac") ||
+		strings.Contains(contentType, "audio/x-flac") ||
+		strings.HasSuffix(url, ".flac"):
+		return "flac"
+	case strings.Contains(contentType, "audio/amr-wb") || strings.HasSuffix(url, ".awb"):
+		return "amr-wb"
+	case strings.Contains(contentType, "audio/amr") || strings.HasSuffix(url, ".amr"):
+		return "amr"
 	}
 
-	return 0, fmt.Errorf("unsupported audio format: %s", contentType)
+	magic, _ := br.Peek(12)
+	switch {
+	case len(magic) >= 12 && string(magic[0:4]) == "RIFF" && string(magic[8:12]) == "WAVE":
+		return "wav"
+	case len(magic) >= 4 && string(magic[0:4]) == "OggS":
+		return "ogg"
+	case len(magic) >= 4 && string(magic[0:4]) == flacMagic:
+		return "flac"
+	case strings.HasPrefix(string(magic), amrWBMagic):
+		return "amr-wb"
+	case strings.HasPrefix(string(magic), amrMagic):
+		return "amr"
+	case len(magic) >= 8 && string(magic[4:8]) == "ftyp",
+		len(magic) >= 2 && magic[0] == 0xFF && magic[1]&0xF6 == 0xF0: // MP4/M4A, or ADTS (MPEG layer bits 00)
+		return "aac"
+	case len(magic) >= 3 && string(magic[0:3]) == "ID3",
+		len(magic) >= 2 && magic[0] == 0xFF && magic[1]&0xE0 == 0xE0:
+		return "mp3"
+	}
+	return ""
 }
 
 // playMP3 decodes and plays MP3 audio
@@ -77,8 +237,9 @@ func (s *LiveKitBridgeService) playMP3(
 	ctx context.Context,
 	r io.Reader,
 	req *pb.PlayAudioRequest,
-	session *RoomSession,
+	sink pcmSink,
 	trackName string,
+	progress *playbackProgress,
 ) (int64, error) {
 	// Create MP3 decoder
 	dec, err := mp3.NewDecoder(r)
@@ -90,11 +251,13 @@ func (s *LiveKitBridgeService) playMP3(
 	if srcSR <= 0 {
 		return 0, fmt.Errorf("invalid MP3 sample rate")
 	}
-
-	const dstSR = 16000
-	resampler := &resampleState{step: float64(srcSR) / float64(dstSR)}
+	if length := dec.Length(); length > 0 {
+		// Known when the source is seekable; 4 bytes per stereo frame
+		progress.setDuration(time.Duration(length/4) * time.Second / time.Duration(srcSR))
+	}
 
 	buf := make([]byte, 4096)
+	var samples []int16 // reused; writes copy what they keep
 	var totalSamples int64
 	startTime := time.Now()
 
@@ -106,94 +269,206 @@ func (s *LiveKitBridgeService) playMP3(
 		default:
 		}
 
-		n, err := dec.Read(buf)
+		// Read whole buffers so stereo frames never straddle two reads
+		n, err := io.ReadFull(dec, buf)
 		if n > 0 {
-			// Convert bytes to int16 samples
-			samples := bytesToInt16(buf[:n])
-
-			// Downmix stereo to mono (MP3 is typically stereo)
-			if len(samples) >= 2 {
-				mono := make([]int16, len(samples)/2)
-				for i := 0; i+1 < len(samples); i += 2 {
-					v := int32(samples[i]) + int32(samples[i+1])
-					mono[i/2] = int16(v / 2)
-				}
-				samples = mono
-			}
+			// Convert bytes to int16 samples (go-mp3 always decodes to interleaved stereo)
+			samples = bytesToInt16Into(samples, buf[:n])
 
-			// Resample to 16kHz
-			resampled := resampler.push(samples)
-			if len(resampled) > 0 {
+			if len(samples) > 0 {
 				// Apply volume
 				if req.Volume > 0 && req.Volume != 1.0 {
-					applyGain(resampled, float64(req.Volume))
+					applyGain(samples, float64(req.Volume))
 				}
 
-				// Write to LiveKit in 10ms chunks
-				if err := session.writeAudioToTrack(int16ToBytes(resampled), trackName); err != nil {
+				// Write to LiveKit (resampled to the publish rate by the track)
+				if err := sink.writeSamplesToTrack(ctx, samples, trackName, srcSR, 2); err != nil {
 					return 0, fmt.Errorf("failed to write audio: %w", err)
 				}
 
-				totalSamples += int64(len(resampled))
+				totalSamples += int64(len(samples))
 			}
 		}
 
 		if err != nil {
-			if !errors.Is(err, io.EOF) {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
 				return 0, fmt.Errorf("MP3 read error: %w", err)
 			}
 			break
 		}
 	}
 
+	// Writes return as soon as audio is queued; report completion only once
+	// it has actually been played out
+	if err := sink.waitForTrackPlayout(ctx, trackName); err != nil {
+		return 0, fmt.Errorf("playout interrupted: %w", err)
+	}
+
 	duration := time.Since(startTime).Milliseconds()
-	log.Printf("MP3 playback complete: samples=%d, duration=%dms", totalSamples, duration)
+	sink.log().Info("MP3 playback complete", "request_id", req.RequestId, "track_name", trackName,
+		"samples", totalSamples, "duration_ms", duration)
 
 	return duration, nil
 }
 
-// playWAV decodes and plays WAV audio
-func (s *LiveKitBridgeService) playWAV(
+// ffmpegSampleRate is the rate ffmpeg decodes music codecs to; the track
+// resamples it
+const ffmpegSampleRate = 48000
+
+// playFFmpeg decodes audio Go has no decoder for (AAC, AMR, FLAC) by piping it
+// through ffmpeg as PCM16 at sampleRate with channels. Input arrives on a
+// pipe, so M4A files need their moov atom up front ("faststart"); ADTS
+// streams always work.
+func (s *LiveKitBridgeService) playFFmpeg(
 	ctx context.Context,
 	r io.Reader,
 	req *pb.PlayAudioRequest,
-	session *RoomSession,
+	sink pcmSink,
 	trackName string,
+	codec string,
+	sampleRate, channels int,
 ) (int64, error) {
-	br := bufio.NewReader(r)
+	if s.config.FFmpegPath == "" {
+		return 0, fmt.Errorf("%s playback needs ffmpeg (FFMPEG_PATH is empty)", codec)
+	}
+
+	args := []string{"-hide_banner", "-loglevel", "error", "-i", "pipe:0"}
+	if method := ffmpegDither(parseDitherMode(s.config.DitherMode)); method != "" {
+		// Dither ffmpeg's own conversion of decoded float or 24-bit audio to s16
+		args = append(args, "-af", fmt.Sprintf("aresample=%d:osf=s16:dither_method=%s", sampleRate, method))
+	}
+	args = append(args, "-f", "s16le", "-ac", strconv.Itoa(channels), "-ar", strconv.Itoa(sampleRate), "pipe:1")
+	cmd := exec.CommandContext(ctx, s.config.FFmpegPath, args...)
+	cmd.Stdin = r
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, fmt.Errorf("ffmpeg setup failed: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	// Bailing out early must not leave ffmpeg blocked on a full pipe
+	waited := false
+	defer func() {
+		if !waited {
+			cmd.Process.Kill()
+			cmd.Wait()
+		}
+	}()
+
+	buf := make([]byte, 4096)
+	var samples []int16 // reused; writes copy what they keep
+	var totalSamples int64
+	startTime := time.Now()
+
+	for {
+		// Read whole buffers so stereo frames never straddle two reads
+		n, err := io.ReadFull(stdout, buf)
+		if n > 0 {
+			samples = bytesToInt16Into(samples, buf[:n-n%(2*channels)])
+
+			if len(samples) > 0 {
+				// Apply volume
+				if req.Volume > 0 && req.Volume != 1.0 {
+					applyGain(samples, float64(req.Volume))
+				}
+
+				// Write to LiveKit (resampled to the publish rate by the track)
+				if err := sink.writeSamplesToTrack(ctx, samples, trackName, sampleRate, channels); err != nil {
+					return 0, fmt.Errorf("failed to write audio: %w", err)
+				}
+
+				totalSamples += int64(len(samples))
+			}
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return 0, fmt.Errorf("%s read error: %w", codec, err)
+			}
+			break
+		}
+	}
+
+	waited = true
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		return 0, fmt.Errorf("%s decode failed: %v: %s", codec, err, strings.TrimSpace(stderr.String()))
+	}
+
+	// Drain the track's queue before reporting completion
+	if err := sink.waitForTrackPlayout(ctx, trackName); err != nil {
+		return 0, fmt.Errorf("playout interrupted: %w", err)
+	}
+
+	duration := time.Since(startTime).Milliseconds()
+	sink.log().Info("Playback complete", "codec", codec, "request_id", req.RequestId, "track_name", trackName,
+		"samples", totalSamples, "duration_ms", duration)
+
+	return duration, nil
+}
+
+// WAV format codes from the fmt chunk
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatALaw       = 6
+	wavFormatMuLaw      = 7
+	wavFormatExtensible = 0xFFFE
+)
+
+// wavFormat describes the audio in a WAV file's data chunk, or in a raw
+// stream described by an AudioFormat
+type wavFormat struct {
+	audioFormat   uint16 // wavFormatPCM, wavFormatFloat, wavFormatALaw or wavFormatMuLaw (extensible is resolved)
+	channels      int
+	sampleRate    int
+	bitsPerSample int
+	bigEndian     bool  // raw audio only; WAV is little-endian
+	unsigned      bool  // integer PCM is offset by half its range (8-bit WAV)
+	dataBytes     int64 // -1 = unknown (streamed WAV), read until EOF
+}
+
+// bytesPerFrame returns the size of one sample across all channels
+func (f wavFormat) bytesPerFrame() int {
+	return f.bitsPerSample / 8 * f.channels
+}
+
+// readWAVHeader parses the RIFF header and chunks up to the start of the
+// data chunk, leaving br positioned at the first sample
+func readWAVHeader(br *bufio.Reader) (wavFormat, error) {
+	var format wavFormat
 
-	// Parse RIFF header
 	header := make([]byte, 12)
 	if _, err := io.ReadFull(br, header); err != nil {
-		return 0, fmt.Errorf("failed to read WAV header: %w", err)
+		return format, fmt.Errorf("failed to read WAV header: %w", err)
 	}
-
 	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
-		return 0, fmt.Errorf("not a valid WAV file")
+		return format, fmt.Errorf("not a valid WAV file")
 	}
 
-	var numChannels uint16
-	var sampleRate uint32
-	var bitsPerSample uint16
-	var dataBytes uint32
-
 	haveFmt := false
-	haveData := false
 
 	// Read chunks until we find fmt and data
 	for {
 		hdr := make([]byte, 8)
 		if _, err := io.ReadFull(br, hdr); err != nil {
-			return 0, fmt.Errorf("failed to read chunk header: %w", err)
+			return format, fmt.Errorf("failed to read chunk header: %w", err)
 		}
 
 		chunkID := string(hdr[0:4])
 		size := binary.LittleEndian.Uint32(hdr[4:8])
 
-		if chunkID == "fmt " {
+		switch chunkID {
+		case "fmt ":
 			buf := make([]byte, size)
 			if _, err := io.ReadFull(br, buf); err != nil {
-				return 0, fmt.Errorf("failed to read fmt chunk: %w", err)
+				return format, fmt.Errorf("failed to read fmt chunk: %w", err)
 			}
 
 			// Consume padding byte if odd size
@@ -202,63 +477,163 @@ func (s *LiveKitBridgeService) playWAV(
 			}
 
 			if size < 16 {
-				return 0, fmt.Errorf("fmt chunk too short")
+				return format, fmt.Errorf("fmt chunk too short")
 			}
 
-			audioFormat := binary.LittleEndian.Uint16(buf[0:2])
-			numChannels = binary.LittleEndian.Uint16(buf[2:4])
-			sampleRate = binary.LittleEndian.Uint32(buf[4:8])
-			bitsPerSample = binary.LittleEndian.Uint16(buf[14:16])
+			format.audioFormat = binary.LittleEndian.Uint16(buf[0:2])
+			format.channels = int(binary.LittleEndian.Uint16(buf[2:4]))
+			format.sampleRate = int(binary.LittleEndian.Uint32(buf[4:8]))
+			format.bitsPerSample = int(binary.LittleEndian.Uint16(buf[14:16]))
 
-			if audioFormat != 1 {
-				return 0, fmt.Errorf("only PCM WAV supported")
+			// WAVE_FORMAT_EXTENSIBLE keeps the real format code at the
+			// start of its sub-format GUID
+			if format.audioFormat == wavFormatExtensible {
+				if size < 40 {
+					return format, fmt.Errorf("extensible fmt chunk too short")
+				}
+				format.audioFormat = binary.LittleEndian.Uint16(buf[24:26])
 			}
-			if bitsPerSample != 16 {
-				return 0, fmt.Errorf("only 16-bit WAV supported")
+
+			switch {
+			case format.audioFormat == wavFormatPCM &&
+				(format.bitsPerSample == 8 || format.bitsPerSample == 16 || format.bitsPerSample == 24 || format.bitsPerSample == 32):
+			case format.audioFormat == wavFormatFloat && (format.bitsPerSample == 32 || format.bitsPerSample == 64):
+			case (format.audioFormat == wavFormatALaw || format.audioFormat == wavFormatMuLaw) && format.bitsPerSample == 8:
+			default:
+				return format, fmt.Errorf("%w: WAV format %d, %d-bit", errUnsupportedFormat, format.audioFormat, format.bitsPerSample)
+			}
+			format.unsigned = format.audioFormat == wavFormatPCM && format.bitsPerSample == 8
+			if format.channels != 1 && format.channels != 2 {
+				return format, fmt.Errorf("%w: only mono/stereo WAV supported", errUnsupportedFormat)
 			}
-			if numChannels != 1 && numChannels != 2 {
-				return 0, fmt.Errorf("only mono/stereo WAV supported")
+			if format.sampleRate <= 0 {
+				return format, fmt.Errorf("invalid WAV sample rate")
 			}
 
 			haveFmt = true
 
-		} else if chunkID == "data" {
-			dataBytes = size
-			haveData = true
-			break
-		} else {
+		case "data":
+			if !haveFmt {
+				return format, fmt.Errorf("missing fmt chunk before data")
+			}
+			// Streaming encoders write 0 or 0xFFFFFFFF when the length
+			// isn't known up front
+			format.dataBytes = int64(size)
+			if size == 0 || size == 0xFFFFFFFF {
+				format.dataBytes = -1
+			}
+			return format, nil
+
+		default:
 			// Skip unknown chunk
 			if _, err := io.CopyN(io.Discard, br, int64(size)); err != nil {
-				return 0, fmt.Errorf("failed to skip chunk: %w", err)
+				return format, fmt.Errorf("failed to skip chunk: %w", err)
 			}
 			if size%2 == 1 {
 				br.ReadByte()
 			}
 		}
 	}
+}
+
+// wavToInt16 converts whole frames of WAV or raw sample data to 16-bit PCM,
+// dithering float and 24/32-bit samples with d when it is set
+func wavToInt16(data []byte, format wavFormat, d *ditherer) []int16 {
+	width := format.bitsPerSample / 8
+	samples := make([]int16, len(data)/width)
+
+	var order binary.ByteOrder = binary.LittleEndian
+	top := width - 2 // where the most significant 16 bits start
+	if format.bigEndian {
+		order, top = binary.BigEndian, 0
+	}
 
-	if !haveFmt || !haveData {
-		return 0, fmt.Errorf("missing fmt or data chunk")
+	for i := range samples {
+		b := data[i*width : (i+1)*width]
+		var v uint16
+		switch {
+		case format.audioFormat == wavFormatFloat && width == 4:
+			samples[i] = d.quantize(float64(math.Float32frombits(order.Uint32(b))) * 32767)
+			continue
+		case format.audioFormat == wavFormatFloat:
+			samples[i] = d.quantize(math.Float64frombits(order.Uint64(b)) * 32767)
+			continue
+		case format.audioFormat == wavFormatMuLaw:
+			samples[i] = muLawToInt16(b[0])
+			continue
+		case format.audioFormat == wavFormatALaw:
+			samples[i] = aLawToInt16(b[0])
+			continue
+		case width == 1:
+			v = uint16(b[0]) << 8
+		case d != nil && width > 2:
+			samples[i] = d.quantize(wideSample(b, format.bigEndian, format.unsigned))
+			continue
+		default:
+			// 24/32-bit: keep the top 16 bits
+			v = order.Uint16(b[top : top+2])
+		}
+		if format.unsigned {
+			v ^= 0x8000
+		}
+		samples[i] = int16(v)
 	}
+	return samples
+}
 
-	const dstSR = 16000
-	resampler := &resampleState{step: float64(sampleRate) / float64(dstSR)}
+// playWAV decodes and plays WAV audio: 8/16/24/32-bit integer or 32/64-bit
+// float PCM or G.711, mono or stereo, at any sample rate
+func (s *LiveKitBridgeService) playWAV(
+	ctx context.Context,
+	br *bufio.Reader,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	progress *playbackProgress,
+) (int64, error) {
+	format, err := readWAVHeader(br)
+	if err != nil {
+		return 0, err
+	}
+	return s.playPCM(ctx, br, format, req, sink, trackName, progress)
+}
 
-	bytesPerFrame := int(bitsPerSample/8) * int(numChannels)
+// playPCM plays the PCM in br, described by format, from the current
+// position: a WAV file's data chunk, or a raw PCM stream
+func (s *LiveKitBridgeService) playPCM(
+	ctx context.Context,
+	br *bufio.Reader,
+	format wavFormat,
+	req *pb.PlayAudioRequest,
+	sink pcmSink,
+	trackName string,
+	progress *playbackProgress,
+) (int64, error) {
+	bytesPerFrame := format.bytesPerFrame()
 	if bytesPerFrame <= 0 {
 		return 0, fmt.Errorf("invalid frame size")
 	}
+	if format.dataBytes > 0 {
+		frames := format.dataBytes / int64(bytesPerFrame)
+		progress.setDuration(time.Duration(frames) * time.Second / time.Duration(format.sampleRate))
+	}
 
-	readLeft := int64(dataBytes)
+	readLeft := format.dataBytes
 	buf := make([]byte, 4096-(4096%bytesPerFrame))
 	if len(buf) == 0 {
 		buf = make([]byte, bytesPerFrame)
 	}
 
+	// Only audio deeper than 16 bits loses precision on the way to int16
+	var dither *ditherer
+	if format.bitsPerSample > 16 {
+		dither = newDitherer(parseDitherMode(s.config.DitherMode), format.sampleRate, format.channels)
+	}
+
 	var totalSamples int64
 	startTime := time.Now()
 
-	for readLeft > 0 {
+	for readLeft != 0 {
 		// Check for cancellation
 		select {
 		case <-ctx.Done():
@@ -267,7 +642,7 @@ func (s *LiveKitBridgeService) playWAV(
 		}
 
 		toRead := int64(len(buf))
-		if toRead > readLeft {
+		if readLeft > 0 && toRead > readLeft {
 			toRead = readLeft
 		}
 
@@ -275,53 +650,46 @@ func (s *LiveKitBridgeService) playWAV(
 		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
 			return 0, fmt.Errorf("failed to read audio data: %w", err)
 		}
+		n -= n % bytesPerFrame
 		if n <= 0 {
 			break
 		}
 
-		readLeft -= int64(n)
-		data := buf[:n]
-
-		// Convert to mono int16 samples
-		samples := bytesToInt16(data)
-		var mono []int16
-
-		if numChannels == 1 {
-			mono = samples
-		} else {
-			// Downmix stereo to mono
-			mono = make([]int16, len(samples)/2)
-			for i := 0; i+1 < len(samples); i += 2 {
-				v := int32(samples[i]) + int32(samples[i+1])
-				mono[i/2] = int16(v / 2)
-			}
+		if readLeft > 0 {
+			readLeft -= int64(n)
 		}
 
-		// Resample if needed
-		var output []int16
-		if int(sampleRate) != dstSR {
-			output = resampler.push(mono)
-		} else {
-			output = mono
-		}
+		// Convert to interleaved int16 samples
+		samples := wavToInt16(buf[:n], format, dither)
 
-		if len(output) > 0 {
+		if len(samples) > 0 {
 			// Apply volume
 			if req.Volume > 0 && req.Volume != 1.0 {
-				applyGain(output, float64(req.Volume))
+				applyGain(samples, float64(req.Volume))
 			}
 
-			// Write to LiveKit
-			if err := session.writeAudioToTrack(int16ToBytes(output), trackName); err != nil {
+			// Write to LiveKit (resampled to the publish rate by the track)
+			if err := sink.writeSamplesToTrack(ctx, samples, trackName, format.sampleRate, format.channels); err != nil {
 				return 0, fmt.Errorf("failed to write audio: %w", err)
 			}
 
-			totalSamples += int64(len(output))
+			totalSamples += int64(len(samples))
+		}
+
+		if err != nil {
+			break // EOF on a streamed WAV
 		}
 	}
 
+	// Drain the track's queue before reporting completion
+	if err := sink.waitForTrackPlayout(ctx, trackName); err != nil {
+		return 0, fmt.Errorf("playout interrupted: %w", err)
+	}
+
 	duration := time.Since(startTime).Milliseconds()
-	log.Printf("WAV playback complete: samples=%d, duration=%dms", totalSamples, duration)
+	sink.log().Info("PCM playback complete", "request_id", req.RequestId, "track_name", trackName,
+		"samples", totalSamples, "duration_ms", duration, "sample_rate", format.sampleRate,
+		"bits_per_sample", format.bitsPerSample)
 
 	return duration, nil
 }
@@ -341,55 +709,3 @@ func applyGain(samples []int16, gain float64) {
 		samples[i] = int16(v)
 	}
 }
-
-// resampleState holds state for audio resampling
-type resampleState struct {
-	buf  []int16
-	pos  float64
-	step float64
-}
-
-// push adds samples to the resampler and returns resampled output
-func (r *resampleState) push(in []int16) []int16 {
-	r.buf = append(r.buf, in...)
-	if len(r.buf) < 2 {
-		return nil
-	}
-
-	var out []int16
-	for {
-		i := int(r.pos)
-		if i+1 >= len(r.buf) {
-			break
-		}
-
-		// Linear interpolation
-		frac := r.pos - float64(i)
-		s0 := float64(r.buf[i])
-		s1 := float64(r.buf[i+1])
-		v := s0 + (s1-s0)*frac
-
-		if v > 32767 {
-			v = 32767
-		} else if v < -32768 {
-			v = -32768
-		}
-
-		out = append(out, int16(v))
-		r.pos += r.step
-	}
-
-	// Keep unconsumed samples
-	drop := int(r.pos)
-	if drop > 0 {
-		if drop >= len(r.buf) {
-			r.buf = r.buf[:0]
-			r.pos = 0
-		} else {
-			r.buf = r.buf[drop:]
-			r.pos -= float64(drop)
-		}
-	}
-
-	return out
-}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// Playback rates SetPlaybackRate accepts
+const (
+	minPlaybackRate = 0.5
+	maxPlaybackRate = 2.0
+)
+
+// rateCorrelationPoints bounds the samples compared per candidate when
+// searching for the best-matching segment
+const rateCorrelationPoints = 120
+
+// rateStretcher plays a track faster or slower without changing its pitch,
+// by WSOLA: each 10ms frame out is the overlap-add of 20ms Hann-windowed
+// segments of the input, taken rate times 10ms further along each time,
+// with each segment's start nudged by up to 5ms to where it best continues
+// the previous one, so the waveforms line up and nothing phases. When the
+// input runs dry, what the segments haven't covered plays out unstretched,
+// so a clip's end isn't lost and the next audio starts cleanly. Guarded by
+// the player's mu.
+type rateStretcher struct {
+	rate     float64
+	channels int
+	hop      int       // per-channel samples per output frame (10ms)
+	search   int       // how far a segment's start may move either way
+	window   []float64 // Hann, 2*hop long
+
+	in      []int16 // input not yet covered, from inStart (per-channel index)
+	inStart int
+	pos     float64 // where the next segment would start at exactly rate
+	prev    int     // where the last segment started
+	started bool
+	accum   []float64 // the last segment's windowed second half
+}
+
+// newRateStretcher creates a stretcher for frames at sampleRate playing at
+// rate
+func newRateStretcher(sampleRate, channels int, rate float64) *rateStretcher {
+	hop := sampleRate / 100
+	window := make([]float64, 2*hop)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(math.Pi*float64(i)/float64(hop))
+	}
+	return &rateStretcher{
+		rate:     rate,
+		channels: channels,
+		hop:      hop,
+		search:   hop / 2,
+		window:   window,
+		accum:    make([]float64, hop*channels),
+	}
+}
+
+// buffered returns the input samples not yet played
+func (r *rateStretcher) buffered() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.in)
+}
+
+// reset returns the input not yet played, unstretched, and starts over
+func (r *rateStretcher) reset() []int16 {
+	if r == nil {
+		return nil
+	}
+	if r.started {
+		r.in = r.in[(r.prev+r.hop-r.inStart)*r.channels:]
+	}
+	in := r.in
+	r.in, r.inStart, r.pos, r.prev, r.started = nil, 0, 0, 0, false
+	return in
+}
+
+// next returns the next frame to play, pulling input as needed. Returns nil
+// when nothing is left.
+func (r *rateStretcher) next(pull func() []int16) []int16 {
+	ch := r.channels
+	size := 2 * r.hop
+	for {
+		need := size
+		if r.started {
+			need = max(int(math.Round(r.pos))+r.search, r.prev+r.hop) + size - r.inStart
+		}
+		if len(r.in)/ch >= need {
+			break
+		}
+		frame := pull()
+		if frame == nil {
+			return r.drain()
+		}
+		r.in = append(r.in, frame...)
+	}
+
+	// The first segment plays from its start; later ones fade in over the
+	// last one's fade out, from wherever they best continue it
+	s := 0
+	if r.started {
+		center := int(math.Round(r.pos))
+		s = r.bestMatch(r.prev+r.hop, max(center-r.search, r.inStart), center+r.search)
+	}
+	base := (s - r.inStart) * ch
+	frame := make([]int16, r.hop*ch)
+	for i := range frame {
+		v := float64(r.in[base+i])
+		if r.started {
+			v = r.accum[i] + r.window[i/ch]*v
+		}
+		frame[i] = clampInt16(v)
+	}
+	for i := range r.accum {
+		r.accum[i] = r.window[r.hop+i/ch] * float64(r.in[base+r.hop*ch+i])
+	}
+	r.started = true
+	r.prev = s
+	r.pos += float64(r.hop) * r.rate
+
+	// Drop input no later segment can reach
+	keep := min(int(math.Round(r.pos))-r.search, r.prev+r.hop)
+	if drop := keep - r.inStart; drop > 0 {
+		r.in = append(r.in[:0], r.in[drop*ch:]...)
+		r.inStart = keep
+	}
+	return frame
+}
+
+// drain plays the input the segments haven't covered, unstretched; the last
+// segment's fade out plus that audio is exactly the input, so it follows on
+// without a seam
+func (r *rateStretcher) drain() []int16 {
+	in := r.reset()
+	n := min(len(in), r.hop*r.channels)
+	if n == 0 {
+		return nil
+	}
+	frame := make([]int16, n)
+	copy(frame, in)
+	r.in = in[n:]
+	return frame
+}
+
+// bestMatch returns the start in [lo, hi] whose audio best matches the
+// audio at natural, the seamless continuation of the last segment
+func (r *rateStretcher) bestMatch(natural, lo, hi int) int {
+	ch := r.channels
+	stride := max(1, r.hop/rateCorrelationPoints)
+	target := (natural - r.inStart) * ch
+
+	best, bestScore := lo, math.Inf(-1)
+	for c := lo; c <= hi; c++ {
+		start := (c - r.inStart) * ch
+		var dot, energy float64
+		for i := 0; i < r.hop; i += stride {
+			for j := 0; j < ch; j++ {
+				a, b := float64(r.in[target+i*ch+j]), float64(r.in[start+i*ch+j])
+				dot += a * b
+				energy += b * b
+			}
+		}
+		if score := dot / math.Sqrt(energy+1); score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
+
+// setRate sets the speed the player plays its audio at, keeping its pitch
+// (1 = as written)
+func (p *trackPlayer) setRate(rate float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.speed != nil && p.speed.rate == rate {
+		return
+	}
+	p.requeueStretchedLocked()
+	p.speed = nil
+	if rate != 1 {
+		p.speed = newRateStretcher(p.frameSamples/p.channels*100, p.channels, rate)
+	}
+}
+
+// SetPlaybackRate plays a named track faster or slower, keeping its pitch;
+// rate 1 plays it as written again. The setting survives the track being
+// recreated by later playback.
+func (s *RoomSession) SetPlaybackRate(trackName string, rate float64) {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	s.mu.Lock()
+	if rate == 1 {
+		delete(s.trackRates, trackName)
+	} else {
+		s.trackRates[trackName] = rate
+	}
+	state, exists := s.trackStates[trackName]
+	s.mu.Unlock()
+
+	if exists && state.player != nil {
+		state.player.setRate(rate)
+	}
+	s.log().Info("Set playback rate", "track_name", trackName, "rate", rate)
+}
+
+// SetPlaybackRate sets the speed a track plays at, keeping its pitch
+func (s *LiveKitBridgeService) SetPlaybackRate(
+	ctx context.Context,
+	req *pb.SetPlaybackRateRequest,
+) (*pb.SetPlaybackRateResponse, error) {
+	slog.Info("SetPlaybackRate request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "rate", req.Rate)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetPlaybackRateResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.SetPlaybackRateResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	rate := float64(req.Rate)
+	if rate == 0 {
+		rate = 1
+	}
+	if math.IsNaN(rate) || rate < minPlaybackRate || rate > maxPlaybackRate {
+		return &pb.SetPlaybackRateResponse{
+			Success: false,
+			Error:   fmt.Sprintf("rate must be between %v and %v, got %v", minPlaybackRate, maxPlaybackRate, req.Rate),
+		}, nil
+	}
+
+	session.SetPlaybackRate(trackName, rate)
+	return &pb.SetPlaybackRateResponse{Success: true}, nil
+}
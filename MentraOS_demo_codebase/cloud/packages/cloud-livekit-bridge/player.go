@@ -0,0 +1,846 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+)
+
+// playbackFrameDuration is the size of each frame fed to a track
+const playbackFrameDuration = 10 * time.Millisecond
+
+// playbackLeadFrames is how many frames the player keeps ahead of real time
+// inside the PCMLocalTrack buffer, absorbing scheduler jitter without letting
+// the SDK's own (unbounded) queue grow
+const playbackLeadFrames = 4
+
+// errPlayerClosed is returned when writing to a track whose player has stopped
+var errPlayerClosed = fmt.Errorf("track player closed")
+
+// trackPlayer feeds a published PCM track from a bounded queue of 10ms frames
+// at real-time pace. Writers block once the queue is full, so a 30-second
+// clip sent in one call no longer lands in the SDK buffer all at once, and
+// queued audio can be paused, flushed or inspected precisely.
+type trackPlayer struct {
+	trackName    string
+	logger       *slog.Logger           // tagged with the session's user/room and the track name
+	track        *lkmedia.PCMLocalTrack // guarded by mu; replaced after a reconnect
+	channels     int
+	frameSamples int // samples per 10ms frame across all channels
+	maxFrames    int
+	holdFrames   int           // queue limit while suspended for a reconnect
+	limiter      *limiter      // last stage before the track; nil = soft clip only
+	clip         *clipDetector // clipping in the audio written and sent
+	meter        *levelMeter   // levels of the audio actually sent
+	activity     *trackActivity
+
+	mu        sync.Mutex
+	queue     [][]int16
+	partial   []int16       // trailing samples that don't fill a frame yet
+	signal    chan struct{} // closed and replaced whenever queue state changes
+	paused    bool          // queued audio is held (not dropped) while paused
+	suspended bool          // held while the room reconnects, until the new track is ready
+	heldSince time.Time     // when output was suspended
+	muted     bool          // queued audio keeps playing out, as silence
+	gain      float64       // applied to each frame as it is written
+	duck      float64       // ducking level the player ramps toward (1 = none)
+	duckStep  float64       // per-frame change while ramping the ducking level
+	sealed    bool          // no more writes accepted; queued audio still plays
+	active    bool          // audio is currently being played out
+	closed    bool
+	closing   chan struct{} // closed once by close()
+	stopped   chan struct{} // closed once run has returned
+
+	keepalive    KeepaliveMode // written on underrun (see keepaliveFrame)
+	keepaliveFor time.Duration // how long after the last audio (0 = always)
+
+	// Crossfade state: the first `tail` queued frames are fading out; new
+	// audio is mixed in at tailOffset samples from the queue head, fading in
+	// over fadeSpan samples of which fadeIn have been mixed so far
+	tail       int
+	tailOffset int
+	fadeSpan   int
+	fadeIn     int
+
+	stretch *timeStretcher // catches up on backlogs by playing faster (nil = off)
+	speed   *rateStretcher // plays at a rate other than 1, keeping pitch (nil = as written)
+	popped  int64          // frames taken from the queue since run last counted them
+
+	cuts     []playerCut // queued audio dropped lately, oldest first (see recordCutLocked)
+	cutCount int         // cuts made since the player was created
+
+	onActivity func(active bool) // called when the track starts/stops producing audio
+
+	mirror *lkmedia.PCMLocalTrack // copy of the track in a mirror room (nil = none); guarded by mu
+	pan    *trackPan              // where the track sits between the ears (nil = as played); guarded by mu
+	voice  *voiceEffect           // transforms the voice played (nil = as played); guarded by mu
+	bus    *trackBus              // mixes frames into one published track instead of writing them to track (nil = none); guarded by mu
+}
+
+// newTrackPlayer creates a player for a track and starts its pacing goroutine.
+// Output starts once ready closes (WebRTC negotiation finished).
+func newTrackPlayer(trackName string, logger *slog.Logger, track *lkmedia.PCMLocalTrack, sampleRate, channels int, gain float64, limiter *limiter, clip *clipDetector, queueDuration time.Duration, ready <-chan struct{}) *trackPlayer {
+	maxFrames := int(queueDuration / playbackFrameDuration)
+	if maxFrames < playbackLeadFrames {
+		maxFrames = playbackLeadFrames
+	}
+
+	p := &trackPlayer{
+		trackName:    trackName,
+		logger:       logger,
+		track:        track,
+		channels:     channels,
+		frameSamples: sampleRate / 100 * channels,
+		maxFrames:    maxFrames,
+		limiter:      limiter,
+		clip:         clip,
+		meter:        newLevelMeter(),
+		activity:     newTrackActivity(),
+		gain:         gain,
+		duck:         1.0,
+		duckStep:     1.0,
+		signal:       make(chan struct{}),
+		closing:      make(chan struct{}),
+		stopped:      make(chan struct{}),
+	}
+	go p.run(ready)
+	return p
+}
+
+// broadcastLocked wakes everyone waiting on a queue state change; caller holds p.mu
+func (p *trackPlayer) broadcastLocked() {
+	close(p.signal)
+	p.signal = make(chan struct{})
+}
+
+// enqueue splits samples into 10ms frames and queues them, blocking while
+// the queue is full until space frees up, the player closes or ctx ends
+func (p *trackPlayer) enqueue(ctx context.Context, samples []int16) error {
+	// An interrupted writer must not leak a last chunk into a fresh player
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	if p.closed || p.sealed {
+		p.mu.Unlock()
+		return errPlayerClosed
+	}
+	buf := make([]int16, 0, len(p.partial)+len(samples))
+	buf = append(buf, p.partial...)
+	buf = append(buf, samples...)
+	p.partial = nil
+	buf = p.crossfadeIntoTailLocked(buf)
+	p.mu.Unlock()
+
+	for len(buf) >= p.frameSamples {
+		p.mu.Lock()
+		if p.closed || p.sealed {
+			p.mu.Unlock()
+			return errPlayerClosed
+		}
+		if len(p.queue) < p.capacityLocked() {
+			p.queue = append(p.queue, buf[:p.frameSamples:p.frameSamples])
+			buf = buf[p.frameSamples:]
+			p.broadcastLocked()
+			p.mu.Unlock()
+			continue
+		}
+		wait := p.signal
+		p.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-p.closing:
+			return errPlayerClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Keep the remainder; it is completed by the next write or flushed
+	// (zero-padded by the SDK) once the queue runs dry
+	if len(buf) > 0 {
+		p.mu.Lock()
+		if p.closed || p.sealed {
+			p.mu.Unlock()
+			return errPlayerClosed
+		}
+		p.partial = append(p.partial, buf...)
+		p.broadcastLocked()
+		p.mu.Unlock()
+	}
+
+	return nil
+}
+
+// nextFrame pops the next frame to play, time-stretched to the playback
+// rate and while catching up on a backlog. Returns nil when there is
+// nothing to play.
+func (p *trackPlayer) nextFrame() []int16 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var frame []int16
+	if p.speed != nil {
+		frame = p.speed.next(p.catchUpFrameLocked)
+	} else {
+		frame = p.catchUpFrameLocked()
+	}
+	if frame == nil {
+		return nil
+	}
+
+	p.broadcastLocked()
+	return frame
+}
+
+// catchUpFrameLocked returns the next queued frame, time-stretched while
+// catching up on a backlog; caller holds p.mu
+func (p *trackPlayer) catchUpFrameLocked() []int16 {
+	if p.stretch.active() {
+		return p.stretch.next(p.popFrameLocked)
+	}
+	return p.popFrameLocked()
+}
+
+// takePopped returns how many frames have been taken from the queue since
+// it was last called: the audio a written frame stands for, which differs
+// from one frame while the audio is time-stretched
+func (p *trackPlayer) takePopped() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	popped := p.popped
+	p.popped = 0
+	return popped
+}
+
+// popFrameLocked pops the next queued frame, falling back to a partial
+// frame when nothing else is queued, or returns nil; caller holds p.mu
+func (p *trackPlayer) popFrameLocked() []int16 {
+	var frame []int16
+	if len(p.queue) > 0 {
+		frame = p.queue[0]
+		p.queue[0] = nil
+		p.queue = p.queue[1:]
+		if p.tail > 0 {
+			p.tail--
+			p.tailOffset = max(0, p.tailOffset-p.frameSamples)
+			if p.tail == 0 {
+				p.resetTailLocked()
+			}
+		}
+	} else if len(p.partial) > 0 {
+		frame = p.partial
+		p.partial = nil
+	}
+	if frame != nil {
+		p.popped++
+	}
+	return frame
+}
+
+// run paces queued frames into the track against the monotonic clock
+func (p *trackPlayer) run(ready <-chan struct{}) {
+	defer close(p.stopped)
+
+	select {
+	case <-ready:
+	case <-p.closing:
+		return
+	}
+
+	ticker := time.NewTicker(playbackFrameDuration)
+	defer ticker.Stop()
+
+	// start/written track the current run of continuous audio; frames are
+	// released so that written stays playbackLeadFrames ahead of real time
+	var start time.Time
+	var written int
+
+	// Gain actually applied to the last frame, ramped toward the target;
+	// duckLevel follows p.duck at p.duckStep per frame
+	appliedGain := p.currentGain()
+	duckLevel := 1.0
+	active := false
+	defer func() { p.setActive(active, false) }()
+
+	// lastAudio is when the last real (not keepalive) frame was written
+	var lastAudio time.Time
+
+	panner := newPanner(p.frameSamples / p.channels * 100)
+	var shifter *voiceShifter // created once a voice effect is first set
+
+	for {
+		if p.isHeld() {
+			// Hold queued audio in place; the clock restarts on resume
+			written = 0
+			active = p.setActive(active, false)
+		} else {
+			if written == 0 {
+				start = time.Now()
+			}
+			due := int(time.Since(start)/playbackFrameDuration) + playbackLeadFrames
+
+			// After a stall, catch up on the frames that fell behind rather
+			// than burst them into the SDK, where they'd only add delay
+			if behind := due - written - playbackLeadFrames; written > 0 && behind > 0 &&
+				p.catchUp(time.Duration(behind)*playbackFrameDuration) {
+				start = time.Now()
+				written = 0
+				due = playbackLeadFrames
+			}
+
+			for written < due {
+				frame := p.nextFrame()
+				keepalive := frame == nil
+				if keepalive {
+					active = p.setActive(active, false)
+					if !lastAudio.IsZero() {
+						frame = p.keepaliveFrame(time.Since(lastAudio))
+					}
+					if frame == nil {
+						// Underrun: restart the clock when audio resumes
+						written = 0
+						break
+					}
+				} else {
+					active = p.setActive(active, true)
+					lastAudio = time.Now()
+
+					gain, duck, duckStep := p.levels()
+					duckLevel = rampToward(duckLevel, duck, duckStep)
+					gain *= duckLevel
+					if p.isMuted() {
+						gain = 0
+					}
+					p.clip.observeInput(frame)
+					if voice := p.currentVoice(); voice != nil {
+						if shifter == nil {
+							shifter = newVoiceShifter(p.frameSamples/p.channels*100, p.channels)
+						}
+						shifter.process(frame, *voice)
+					}
+					if pan := p.currentPan(); pan != nil && p.channels == 2 {
+						panner.process(frame, *pan)
+					}
+					p.applyLevels(frame, appliedGain, gain)
+					appliedGain = gain
+				}
+
+				if bus := p.currentBus(); bus != nil {
+					// Keepalive frames only keep a track of its own alive
+					if !keepalive {
+						bus.write(p.trackName, frame, p.channels)
+					}
+				} else if err := p.currentTrack().WriteSample(frame); err != nil {
+					if p.isHeld() {
+						// The room dropped under us; wait for the replacement track
+						written = 0
+						active = p.setActive(active, false)
+						break
+					}
+					p.logger.Error("Track player write failed", "error", err)
+					p.close()
+					return
+				}
+				written++
+				if keepalive {
+					// Keepalive frames aren't audio: they don't count as
+					// played, metered or written for idle detection
+					continue
+				}
+				if mirror := p.mirrorTrack(); mirror != nil {
+					mirror.WriteSample(frame)
+				}
+				pcmBytesWritten.Add(float64(len(frame) * 2))
+				p.meter.observe(frame)
+				p.activity.recordFrames(p.takePopped())
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-p.closing:
+			return
+		}
+	}
+}
+
+// fadeOutTail keeps only the next `fade` of queued audio, ramped down to
+// silence, and drops the rest. Audio enqueued while that tail is still
+// queued is mixed over it with a matching fade-in, so replacing what a
+// track plays crossfades instead of cutting off with a pop.
+func (p *trackPlayer) fadeOutTail(fade time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	written := p.activity.framesWritten.Load()
+	queuedEnd := written + int64(p.queuedFramesLocked())
+
+	p.requeueStretchedLocked()
+	if len(p.partial) > 0 {
+		// Pad the partial frame so the tail is made of whole frames
+		frame := make([]int16, p.frameSamples)
+		copy(frame, p.partial)
+		p.queue = append(p.queue, frame)
+		p.partial = nil
+	}
+
+	n := int(fade / playbackFrameDuration)
+	if n > len(p.queue) {
+		n = len(p.queue)
+	}
+	for i := n; i < len(p.queue); i++ {
+		p.queue[i] = nil
+	}
+	p.queue = p.queue[:n]
+
+	for i, frame := range p.queue {
+		from := 1 - float64(i)/float64(n)
+		to := 1 - float64(i+1)/float64(n)
+		applyGainRamp(frame, p.channels, from, to)
+	}
+	p.resetTailLocked()
+	p.tail = n
+	p.recordCutLocked(written+int64(n), written+int64(n), queuedEnd)
+	p.broadcastLocked()
+}
+
+// fadeOut fades the queued tail to silence over fade and stops accepting
+// writes, so a track can be torn down without a click once it has played
+func (p *trackPlayer) fadeOut(fade time.Duration) {
+	p.fadeOutTail(fade)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sealed = true
+	p.resetTailLocked()
+	p.broadcastLocked()
+}
+
+// crossfadeIntoTailLocked mixes the start of buf, faded in, over any queued
+// fade-out tail and returns what is left of buf; caller holds p.mu
+func (p *trackPlayer) crossfadeIntoTailLocked(buf []int16) []int16 {
+	if p.tail == 0 {
+		return buf
+	}
+
+	end := p.tail * p.frameSamples
+	if p.fadeSpan == 0 {
+		p.fadeSpan = end - p.tailOffset
+	}
+
+	for p.tailOffset < end && len(buf) > 0 {
+		frame := p.queue[p.tailOffset/p.frameSamples]
+		in := float64(p.fadeIn/p.channels*p.channels) / float64(p.fadeSpan)
+		j := p.tailOffset % p.frameSamples
+		frame[j] = softClip(float64(frame[j]) + float64(buf[0])*in)
+
+		buf = buf[1:]
+		p.tailOffset++
+		p.fadeIn++
+	}
+
+	if p.tailOffset >= end {
+		p.resetTailLocked()
+	}
+	return buf
+}
+
+// resetTailLocked forgets the fade-out tail; caller holds p.mu
+func (p *trackPlayer) resetTailLocked() {
+	p.tail = 0
+	p.tailOffset = 0
+	p.fadeSpan = 0
+	p.fadeIn = 0
+}
+
+// applyLevels ramps a frame's gain and runs the limiter, soft clipping
+// whatever still exceeds int16 range
+func (p *trackPlayer) applyLevels(frame []int16, from, to float64) {
+	if p.limiter == nil && from == 1.0 && to == 1.0 {
+		return
+	}
+
+	buf := make([]float64, len(frame))
+	for i, v := range frame {
+		buf[i] = float64(v)
+	}
+	rampGain(buf, p.channels, from, to)
+	if p.limiter != nil {
+		p.limiter.process(buf)
+	}
+	p.clip.observeOutput(buf)
+	for i, v := range buf {
+		frame[i] = softClip(v)
+	}
+}
+
+// setPaused holds or releases queued audio. Frames already handed to the SDK
+// (at most playbackLeadFrames) still play out, so resume continues within
+// a few tens of milliseconds of where pause took effect.
+func (p *trackPlayer) setPaused(paused bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = paused
+	p.broadcastLocked()
+}
+
+// setGain changes the volume applied to frames from the next write on;
+// the change is ramped over one frame
+func (p *trackPlayer) setGain(gain float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.gain = gain
+}
+
+// currentGain returns the volume the player is targeting
+func (p *trackPlayer) currentGain() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.gain
+}
+
+// setDuck sets the ducking level (1 = none) reached over ramp; called by the
+// session when its speech track starts or stops
+func (p *trackPlayer) setDuck(level float64, ramp time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.duck = level
+	p.duckStep = 1.0
+	if frames := int(ramp / playbackFrameDuration); frames > 0 {
+		p.duckStep = 1.0 / float64(frames)
+	}
+}
+
+// levels returns the target gain and ducking settings for the next frame
+func (p *trackPlayer) levels() (gain, duck, duckStep float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.gain, p.duck, p.duckStep
+}
+
+// setActivityHandler registers a callback for audio start/stop transitions.
+// It runs on the pacing goroutine and must not block.
+func (p *trackPlayer) setActivityHandler(f func(active bool)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.onActivity = f
+}
+
+// setActive reports an activity transition to the handler and returns the new state
+func (p *trackPlayer) setActive(was, now bool) bool {
+	if was == now {
+		return now
+	}
+
+	p.mu.Lock()
+	p.active = now
+	f := p.onActivity
+	p.mu.Unlock()
+
+	if f != nil {
+		f(now)
+	}
+	return now
+}
+
+// setMuted silences the player without stopping it: frames keep being
+// consumed in real time so the track's timing is unaffected
+func (p *trackPlayer) setMuted(muted bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.muted = muted
+}
+
+// isMuted reports whether the player is writing silence
+func (p *trackPlayer) isMuted() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.muted
+}
+
+// isActive reports whether the player is currently playing audio
+func (p *trackPlayer) isActive() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.active
+}
+
+// isPaused reports whether the player is holding its queue
+func (p *trackPlayer) isPaused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.paused
+}
+
+// isHeld reports whether output is on hold, either paused or suspended
+func (p *trackPlayer) isHeld() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.paused || p.suspended
+}
+
+// suspendOutput holds the queue while the room is down. Writers keep
+// queueing, up to buffer of audio, instead of failing; replaceTrack resumes
+// output and the backlog then plays out in real time.
+func (p *trackPlayer) suspendOutput(buffer time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.suspended {
+		p.heldSince = time.Now()
+	}
+	p.suspended = true
+	p.holdFrames = int(buffer / playbackFrameDuration)
+	p.broadcastLocked()
+}
+
+// capacityLocked is how many frames writers may queue; caller must hold p.mu
+func (p *trackPlayer) capacityLocked() int {
+	if p.suspended && p.holdFrames > p.maxFrames {
+		return p.holdFrames
+	}
+	return p.maxFrames
+}
+
+// replaceTrack points the player at a track republished after a reconnect
+// and resumes suspended output once ready closes (negotiation finished)
+func (p *trackPlayer) replaceTrack(track *lkmedia.PCMLocalTrack, ready <-chan struct{}) {
+	p.mu.Lock()
+	p.track = track
+	p.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ready:
+		case <-p.closing:
+			return
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		// The audio queued while the room was down is a backlog to catch
+		// up on, as far as it covers the outage
+		if p.suspended && p.stretch != nil {
+			samples := len(p.queue)*p.frameSamples + len(p.partial)
+			queued := time.Duration(samples) * playbackFrameDuration / time.Duration(p.frameSamples)
+			p.catchUpLocked(min(time.Since(p.heldSince), queued))
+		}
+		p.suspended = false
+		p.broadcastLocked()
+	}()
+}
+
+// setMirror sets the mirror room track that gets a copy of every frame
+// played (nil = none)
+func (p *trackPlayer) setMirror(track *lkmedia.PCMLocalTrack) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mirror = track
+}
+
+// mirrorTrack returns the mirror room track, if any
+func (p *trackPlayer) mirrorTrack() *lkmedia.PCMLocalTrack {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.mirror
+}
+
+// setPan sets where the track sits between the ears (nil = as played)
+func (p *trackPlayer) setPan(pan *trackPan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pan = pan
+}
+
+// currentPan returns the track's pan, if any
+func (p *trackPlayer) currentPan() *trackPan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.pan
+}
+
+// setVoice sets the voice effect applied to the track (nil = as played)
+func (p *trackPlayer) setVoice(voice *voiceEffect) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.voice = voice
+}
+
+// currentVoice returns the track's voice effect, if any
+func (p *trackPlayer) currentVoice() *voiceEffect {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.voice
+}
+
+// setBus makes the player mix its frames into bus rather than write them
+// to its own track
+func (p *trackPlayer) setBus(bus *trackBus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.bus = bus
+}
+
+// currentBus returns the bus the player mixes into, if any
+func (p *trackPlayer) currentBus() *trackBus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.bus
+}
+
+// currentTrack returns the track the player is feeding
+func (p *trackPlayer) currentTrack() *lkmedia.PCMLocalTrack {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.track
+}
+
+// queuedFrames returns the number of frames waiting to be played
+func (p *trackPlayer) queuedFrames() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.queuedFramesLocked()
+}
+
+// queuedFramesLocked is queuedFrames; caller holds p.mu
+func (p *trackPlayer) queuedFramesLocked() int {
+	n := len(p.queue)
+	if len(p.partial) > 0 || p.stretch.buffered() > 0 || p.speed.buffered() > 0 {
+		n++
+	}
+	return n
+}
+
+// playoutDelay estimates how long audio enqueued now waits before it is
+// heard: everything queued ahead of it, plus the lead already handed to the
+// SDK while the track is playing
+func (p *trackPlayer) playoutDelay() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	samples := len(p.queue)*p.frameSamples + len(p.partial) + p.stretch.buffered() + p.speed.buffered()
+	delay := time.Duration(samples) * playbackFrameDuration / time.Duration(p.frameSamples)
+	if p.speed != nil {
+		delay = time.Duration(float64(delay) / p.speed.rate)
+	}
+	if p.active {
+		delay += playbackLeadFrames * playbackFrameDuration
+	}
+	return delay
+}
+
+// flush drops all queued audio, including what the SDK has buffered
+func (p *trackPlayer) flush() {
+	p.mu.Lock()
+	written := p.activity.framesWritten.Load()
+	heard := written
+	if p.active {
+		// Clearing the SDK's queue drops the lead it holds too
+		heard -= playbackLeadFrames
+	}
+	p.recordCutLocked(heard, written, written+int64(p.queuedFramesLocked()))
+	p.queue = nil
+	p.partial = nil
+	p.stretch.reset()
+	p.speed.reset()
+	p.resetTailLocked()
+	p.broadcastLocked()
+	p.mu.Unlock()
+
+	p.currentTrack().ClearQueue()
+}
+
+// waitForPlayout blocks until every queued frame has been handed to the track
+// and the lead buffered inside the SDK has had time to play
+func (p *trackPlayer) waitForPlayout(ctx context.Context) error {
+	for {
+		p.mu.Lock()
+		empty := len(p.queue) == 0 && len(p.partial) == 0 && p.stretch.buffered() == 0 && p.speed.buffered() == 0
+		closed := p.closed
+		wait := p.signal
+		p.mu.Unlock()
+
+		if closed {
+			return errPlayerClosed
+		}
+		if empty {
+			break
+		}
+
+		select {
+		case <-wait:
+		case <-p.closing:
+			return errPlayerClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case <-time.After(playbackLeadFrames * playbackFrameDuration):
+		return nil
+	case <-p.closing:
+		return errPlayerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close stops the pacing goroutine and releases blocked writers. The track
+// itself is owned (and closed) by the session.
+func (p *trackPlayer) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+	p.closed = true
+	written := p.activity.framesWritten.Load()
+	p.recordCutLocked(written, written, written+int64(p.queuedFramesLocked()))
+	p.queue = nil
+	p.partial = nil
+	p.resetTailLocked()
+	close(p.closing)
+	p.broadcastLocked()
+}
+
+// rampToward moves v toward target by at most step
+func rampToward(v, target, step float64) float64 {
+	if v < target {
+		return math.Min(v+step, target)
+	}
+	return math.Max(v-step, target)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// presentationTolerance is how far a chunk may play out from its
+// presentation time before the track is corrected, by inserting silence
+// when it would be early or trimming audio when it would be late
+const presentationTolerance = 20 * time.Millisecond
+
+// presentationHold is the most silence inserted ahead of a chunk; a chunk
+// due later than that is held back until it isn't
+const presentationHold = 200 * time.Millisecond
+
+// maxPresentationLead bounds how far in the future a presentation time may be
+const maxPresentationLead = 10 * time.Second
+
+// errPresentationLate is returned when a chunk's presentation time has
+// passed by more than the chunk lasts; it is dropped
+var errPresentationLate = errors.New("presentation time has passed")
+
+// errPresentationTooFar is returned for a presentation time further ahead
+// than maxPresentationLead; the chunk is dropped
+var errPresentationTooFar = fmt.Errorf("presentation time is more than %v ahead", maxPresentationLead)
+
+// writeAudioToTrackPresented writes interleaved PCM so that its first sample
+// plays out at presentAt on the bridge's clock. The chunk is held until it
+// is due, then lined up against what the track has queued: silence fills a
+// gap and a late start is trimmed, so a stream of timestamped chunks stays
+// in sync however its delivery or the track's pacing drifts. Offsets within
+// presentationTolerance are left alone, so contiguous chunks join without a
+// seam. A paused track can't keep time.
+func (s *RoomSession) writeAudioToTrackPresented(pcmData []byte, trackName string, sampleRate, channels int, presentAt time.Time) error {
+	if sampleRate <= 0 {
+		sampleRate = defaultSampleRate
+	}
+	if channels <= 0 {
+		channels = 1
+	}
+	if time.Until(presentAt) > maxPresentationLead {
+		presentationCorrections.WithLabelValues("dropped").Inc()
+		return errPresentationTooFar
+	}
+
+	// Publish the track first so negotiation can't make the chunk late
+	if _, err := s.getOrCreateTrackWithChannels(s.ctx, trackName, channels); err != nil {
+		return err
+	}
+	player := s.trackPlayer(trackName)
+	if player == nil {
+		return fmt.Errorf("track '%s' was closed", trackName)
+	}
+
+	// Hold the chunk until at most presentationHold of silence lines it up
+	var offset time.Duration
+	for {
+		offset = time.Until(presentAt) - player.playoutDelay()
+		if offset <= presentationHold {
+			break
+		}
+		timer := time.NewTimer(offset - presentationHold)
+		select {
+		case <-timer.C:
+		case <-s.ctx.Done():
+			timer.Stop()
+			return s.ctx.Err()
+		}
+	}
+
+	frameBytes := 2 * channels
+	bytesFor := func(d time.Duration) int {
+		return int(d*time.Duration(sampleRate)/time.Second) * frameBytes
+	}
+	pcmData = pcmData[:len(pcmData)-len(pcmData)%frameBytes]
+
+	switch {
+	case offset > presentationTolerance:
+		padded := make([]byte, bytesFor(offset), bytesFor(offset)+len(pcmData))
+		pcmData = append(padded, pcmData...)
+		presentationCorrections.WithLabelValues("pad").Inc()
+		s.log().Debug("Padding chunk to its presentation time", "track_name", trackName,
+			"early_ms", offset.Milliseconds())
+	case offset < -presentationTolerance:
+		trim := bytesFor(-offset)
+		if trim >= len(pcmData) {
+			presentationCorrections.WithLabelValues("dropped").Inc()
+			return fmt.Errorf("%w: %dms late", errPresentationLate, (-offset).Milliseconds())
+		}
+		pcmData = pcmData[trim:]
+		presentationCorrections.WithLabelValues("trim").Inc()
+		s.log().Debug("Trimming chunk to its presentation time", "track_name", trackName,
+			"late_ms", (-offset).Milliseconds())
+	}
+
+	return s.writeAudioToTrackAt(pcmData, trackName, sampleRate, channels)
+}
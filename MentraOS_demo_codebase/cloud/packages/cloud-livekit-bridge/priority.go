@@ -0,0 +1,286 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// PriorityPolicy is what playback on a track does to lower-priority tracks
+// playing at the same time
+type PriorityPolicy string
+
+const (
+	PriorityNone    PriorityPolicy = "none"    // tracks play over each other
+	PriorityDuck    PriorityPolicy = "duck"    // lower the lower-priority tracks until it ends
+	PriorityPreempt PriorityPolicy = "preempt" // stop the lower-priority tracks
+)
+
+// parsePriorityPolicy maps a config string to a PriorityPolicy (default none)
+func parsePriorityPolicy(policy string) PriorityPolicy {
+	switch PriorityPolicy(strings.ToLower(strings.TrimSpace(policy))) {
+	case PriorityDuck:
+		return PriorityDuck
+	case PriorityPreempt:
+		return PriorityPreempt
+	default:
+		return PriorityNone
+	}
+}
+
+// priorityPolicies maps JoinRoom's policy to a PriorityPolicy; the default
+// maps to "" (keep the bridge's)
+var priorityPolicies = map[pb.JoinRoomRequest_PriorityPolicy]PriorityPolicy{
+	pb.JoinRoomRequest_POLICY_NONE:    PriorityNone,
+	pb.JoinRoomRequest_POLICY_DUCK:    PriorityDuck,
+	pb.JoinRoomRequest_POLICY_PREEMPT: PriorityPreempt,
+}
+
+// parseTrackPriorities reads "track=level" entries such as "alert=2";
+// malformed entries are skipped
+func parseTrackPriorities(entries []string) map[string]int {
+	priorities := make(map[string]int)
+	for _, entry := range entries {
+		name, value, found := strings.Cut(entry, "=")
+		if !found {
+			continue
+		}
+		level, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		priorities[strings.TrimSpace(name)] = level
+	}
+	return priorities
+}
+
+// noPriority is the top priority while no PCM track is playing
+const noPriority = math.MinInt
+
+// trackPriorities ranks a session's tracks (e.g. alerts above assistant
+// speech above music) and tracks which are playing, so the highest-ranked
+// playback can duck or stop the rest. Unlisted tracks rank 0.
+type trackPriorities struct {
+	level   float64 // linear gain applied to ducked tracks
+	ramp    time.Duration
+	release time.Duration
+
+	mu           sync.Mutex
+	policy       PriorityPolicy
+	priorities   map[string]int
+	active       map[string]bool // PCM tracks playing now
+	top          int             // highest priority playing, held for release after it stops
+	ducked       map[string]bool // tracks last set to the ducked level
+	releaseTimer *time.Timer
+	generation   int // bumped on every activity change to invalidate stale releases
+}
+
+// newTrackPriorities builds the bridge's default priorities from config
+func newTrackPriorities(config *Config) *trackPriorities {
+	return &trackPriorities{
+		level:      math.Pow(10, -config.PriorityDuckDb/20),
+		ramp:       config.DuckRamp,
+		release:    config.DuckRelease,
+		policy:     parsePriorityPolicy(config.PriorityPolicy),
+		priorities: parseTrackPriorities(config.TrackPriorities),
+		active:     make(map[string]bool),
+		top:        noPriority,
+		ducked:     make(map[string]bool),
+	}
+}
+
+// configure replaces the policy (unless empty) and, when any are given, the
+// priorities
+func (p *trackPriorities) configure(policy PriorityPolicy, priorities map[string]int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if policy != "" {
+		p.policy = policy
+	}
+	if len(priorities) > 0 {
+		p.priorities = priorities
+	}
+}
+
+// priorityLocked returns a track's rank, looking an app's track ("appX:tts")
+// up by its own name when it has no entry; caller must hold p.mu
+func (p *trackPriorities) priorityLocked(trackName string) int {
+	if level, exists := p.priorities[trackName]; exists {
+		return level
+	}
+	if _, name, found := strings.Cut(trackName, ":"); found {
+		return p.priorities[name]
+	}
+	return 0
+}
+
+// priority returns a track's rank
+func (p *trackPriorities) priority(trackName string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.priorityLocked(trackName)
+}
+
+// activeTopLocked returns the highest priority among the playing tracks;
+// caller must hold p.mu
+func (p *trackPriorities) activeTopLocked() int {
+	top := noPriority
+	for trackName := range p.active {
+		top = max(top, p.priorityLocked(trackName))
+	}
+	return top
+}
+
+// outrankedLocked reports whether a track ranks below what is playing;
+// caller must hold p.mu
+func (p *trackPriorities) outrankedLocked(trackName string) bool {
+	return p.top != noPriority && p.priorityLocked(trackName) < p.top
+}
+
+// levelFor returns the priority ducking level a track should be at now. A
+// ducked track is remembered so a later apply restores it.
+func (p *trackPriorities) levelFor(trackName string) float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.policy == PriorityDuck && p.outrankedLocked(trackName) {
+		p.ducked[trackName] = true
+		return p.level
+	}
+	return 1.0
+}
+
+// duckChanged reports whether a track's priority ducking differs from the
+// level it was last set to, recording the new state
+func (p *trackPriorities) duckChanged(trackName string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ducked := p.policy == PriorityDuck && p.outrankedLocked(trackName)
+	if ducked == p.ducked[trackName] {
+		return false
+	}
+	if ducked {
+		p.ducked[trackName] = true
+	} else {
+		delete(p.ducked, trackName)
+	}
+	return true
+}
+
+// trackActivity records a PCM track starting or stopping. A higher-ranked
+// track takes over immediately; when it stops, the lower ones are restored
+// only after the release time, so gaps between streamed chunks don't pump
+// them. apply is invoked (asynchronously) whenever tracks must be ducked,
+// restored or stopped.
+func (p *trackPriorities) trackActivity(trackName string, active bool, apply func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if active {
+		p.active[trackName] = true
+	} else {
+		delete(p.active, trackName)
+	}
+	if p.policy == PriorityNone {
+		return
+	}
+
+	p.generation++
+	if p.releaseTimer != nil {
+		p.releaseTimer.Stop()
+		p.releaseTimer = nil
+	}
+
+	top := p.activeTopLocked()
+	if top >= p.top {
+		raised := top > p.top
+		p.top = top
+		if raised || (active && p.outrankedLocked(trackName)) {
+			go apply()
+		}
+		return
+	}
+
+	generation := p.generation
+	p.releaseTimer = time.AfterFunc(p.release, func() {
+		p.mu.Lock()
+		if p.generation != generation {
+			// Activity changed while this release was firing
+			p.mu.Unlock()
+			return
+		}
+		p.top = p.activeTopLocked()
+		p.releaseTimer = nil
+		p.mu.Unlock()
+		apply()
+	})
+}
+
+// outranked returns the playing tracks that rank below the top one
+func (p *trackPriorities) outranked() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var tracks []string
+	for trackName := range p.active {
+		if p.outrankedLocked(trackName) {
+			tracks = append(tracks, trackName)
+		}
+	}
+	return tracks
+}
+
+// stop cancels a pending release
+func (p *trackPriorities) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.generation++
+	if p.releaseTimer != nil {
+		p.releaseTimer.Stop()
+		p.releaseTimer = nil
+	}
+}
+
+// duckLevel combines speech ducking and priority ducking for a track
+func (s *RoomSession) duckLevel(trackName string) float64 {
+	level := s.priorities.levelFor(trackName)
+	if s.ducker != nil {
+		level = min(level, s.ducker.levelFor(trackName))
+	}
+	return level
+}
+
+// applyPriorities carries out the session's priority policy after the top
+// playing priority changed: outranked tracks are stopped (preempt), or
+// lowered and the ones no longer outranked restored (duck)
+func (s *RoomSession) applyPriorities() {
+	p := s.priorities
+	p.mu.Lock()
+	policy := p.policy
+	p.mu.Unlock()
+
+	if policy == PriorityPreempt {
+		for _, trackName := range p.outranked() {
+			s.log().Info("Preempting lower-priority track", "track_name", trackName)
+			s.stopMatchingPlayback(func(name string) bool { return name == trackName }, s.stopFade)
+		}
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for trackName, state := range s.trackStates {
+		if state.player != nil && p.duckChanged(trackName) {
+			state.player.setDuck(s.duckLevel(trackName), p.ramp)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/cmplx"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// Probe chirps sweep 18-20kHz, above most people's hearing, where the
+// session's rate carries it, and 1-4kHz otherwise (or when asked to be
+// audible)
+const (
+	probeChirpDuration = 40 * time.Millisecond
+	probeChirpLevel    = 0.25 // peak, of full scale
+)
+
+// probeThreshold is the normalized correlation with the chirp at which
+// incoming audio counts as the chirp coming back
+const probeThreshold = 0.5
+
+// ProbeLatency listens for the chirp for defaultProbeTimeout unless the
+// request sets a timeout, up to maxProbeTimeout
+const (
+	defaultProbeTimeout = 3 * time.Second
+	maxProbeTimeout     = 10 * time.Second
+)
+
+// probeCheckInterval is how often newly received audio is searched for the
+// chirp
+const probeCheckInterval = 100 * time.Millisecond
+
+// errProbeRunning is returned when a session is asked for a second probe
+// while one is listening
+var errProbeRunning = errors.New("a latency probe is already running")
+
+// latencyProbe collects one sender's incoming audio after a chirp was
+// played, for ProbeLatency to search
+type latencyProbe struct {
+	identity string // sender to listen to; set by the first frame when empty
+	rate     int
+
+	mu    sync.Mutex
+	heard []float64 // samples at rate, from start on
+	start time.Time // when heard[0] was captured
+	limit int       // most samples kept
+}
+
+// latencyResult is the last completed probe, for GetStatus
+type latencyResult struct {
+	trackName   string
+	latency     time.Duration
+	correlation float64
+	probedAt    time.Time
+}
+
+// probeChirp returns a Hann-windowed linear sweep at rate
+func probeChirp(rate int, audible bool) []float64 {
+	low, high := 18000.0, 20000.0
+	if audible || float64(rate)/2 <= high {
+		low, high = 1000, 4000
+	}
+	n := int(probeChirpDuration.Seconds() * float64(rate))
+	duration := probeChirpDuration.Seconds()
+
+	chirp := make([]float64, n)
+	for i := range chirp {
+		t := float64(i) / float64(rate)
+		phase := 2 * math.Pi * (low*t + (high-low)*t*t/(2*duration))
+		window := 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(n-1))
+		chirp[i] = probeChirpLevel * window * math.Sin(phase)
+	}
+	return chirp
+}
+
+// probeIncoming hands raw received mic audio to a listening probe
+func (s *RoomSession) probeIncoming(pcmData []byte, identity string, capturedAt time.Time) {
+	p := s.probe.Load()
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.identity == "" {
+		p.identity = identity
+	}
+	if identity != p.identity || len(p.heard) >= p.limit {
+		return
+	}
+	samples := pooledSamples(pcmData)
+	defer putSamples(samples)
+
+	if len(p.heard) == 0 {
+		p.start = capturedAt.Add(-time.Duration(len(*samples)) * time.Second / time.Duration(p.rate))
+	}
+	for _, sample := range *samples {
+		p.heard = append(p.heard, float64(sample)/32768)
+	}
+}
+
+// probeLatency plays a chirp on trackName and measures how long it takes to
+// come back in the audio the session receives, as it will when the other end
+// loops its output into its mic (or the glasses' speaker is heard by their
+// mic). Only one probe runs per session at a time.
+func (s *RoomSession) probeLatency(ctx context.Context, trackName, identity string, audible bool, timeout time.Duration) (*latencyResult, error) {
+	p := &latencyProbe{
+		identity: identity,
+		rate:     s.sampleRate,
+		limit:    int((timeout + time.Second).Seconds() * float64(s.sampleRate)),
+	}
+	if !s.probe.CompareAndSwap(nil, p) {
+		return nil, errProbeRunning
+	}
+	defer s.probe.Store(nil)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	chirp := probeChirp(s.sampleRate, audible)
+	samples := make([]int16, len(chirp))
+	for i, v := range chirp {
+		samples[i] = int16(v * 32767)
+	}
+
+	// The chirp plays out after whatever is already queued on the track
+	if _, err := s.getOrCreateTrackWithChannels(ctx, trackName, 1); err != nil {
+		return nil, err
+	}
+	var queued int
+	if player := s.trackPlayer(trackName); player != nil {
+		queued = player.queuedFrames()
+	}
+	sentAt := time.Now().Add(time.Duration(queued) * playbackFrameDuration)
+	if err := s.writeSamplesToTrack(ctx, samples, trackName, s.sampleRate, 1); err != nil {
+		return nil, fmt.Errorf("failed to play chirp: %w", err)
+	}
+
+	ticker := time.NewTicker(probeCheckInterval)
+	defer ticker.Stop()
+
+	checked := 0 // heard before this has been searched
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			if s.ctx.Err() != nil {
+				return nil, s.ctx.Err()
+			}
+			return nil, fmt.Errorf("chirp not heard back within %v", timeout)
+		}
+
+		p.mu.Lock()
+		from := max(0, checked-len(chirp)+1)
+		heard := append([]float64(nil), p.heard[from:]...)
+		start := p.start
+		p.mu.Unlock()
+
+		offset, correlation := findChirp(heard, chirp)
+		checked = from + len(heard)
+		if correlation < probeThreshold {
+			continue
+		}
+
+		heardAt := start.Add(time.Duration(from+offset) * time.Second / time.Duration(s.sampleRate))
+		result := &latencyResult{
+			trackName:   trackName,
+			latency:     max(0, heardAt.Sub(sentAt)),
+			correlation: correlation,
+			probedAt:    time.Now(),
+		}
+		s.lastProbe.Store(result)
+		s.log().Info("Latency probe heard back", "track_name", trackName, "participant", p.identity,
+			"latency_ms", result.latency.Milliseconds(), "correlation", correlation)
+		return result, nil
+	}
+}
+
+// findChirp returns where in samples the chirp matches best and how well, as
+// a normalized correlation (1 = exactly, scaled)
+func findChirp(samples, chirp []float64) (int, float64) {
+	m := len(chirp)
+	if len(samples) < m {
+		return -1, 0
+	}
+
+	// Cross-correlate by FFT, padded so nothing wraps around
+	n := 1
+	for n < len(samples)+m {
+		n <<= 1
+	}
+	a := make([]complex128, n)
+	b := make([]complex128, n)
+	for i, v := range samples {
+		a[i] = complex(v, 0)
+	}
+	var chirpEnergy float64
+	for i, v := range chirp {
+		b[i] = complex(v, 0)
+		chirpEnergy += v * v
+	}
+	fft(a, false)
+	fft(b, false)
+	for i := range a {
+		a[i] *= cmplx.Conj(b[i])
+	}
+	fft(a, true)
+
+	// Normalize each lag by the energy of the audio under the chirp
+	var window float64
+	for _, v := range samples[:m] {
+		window += v * v
+	}
+	best, bestScore := -1, 0.0
+	for lag := 0; lag+m <= len(samples); lag++ {
+		if lag > 0 {
+			window += samples[lag+m-1]*samples[lag+m-1] - samples[lag-1]*samples[lag-1]
+		}
+		if window <= 1e-12 {
+			continue
+		}
+		if score := real(a[lag]) / math.Sqrt(chirpEnergy*window); score > bestScore {
+			best, bestScore = lag, score
+		}
+	}
+	return best, bestScore
+}
+
+// ProbeLatency measures a session's round-trip audio latency with a chirp
+func (s *LiveKitBridgeService) ProbeLatency(
+	ctx context.Context,
+	req *pb.ProbeLatencyRequest,
+) (*pb.ProbeLatencyResponse, error) {
+	slog.Info("ProbeLatency request", "user_id", req.UserId, "track_name", req.TrackName,
+		"participant", req.ParticipantIdentity, "audible", req.Audible)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.ProbeLatencyResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName := req.TrackName
+	if trackName == "" {
+		trackName = "speaker"
+	}
+	timeout := defaultProbeTimeout
+	if req.TimeoutMs > 0 {
+		timeout = min(time.Duration(req.TimeoutMs)*time.Millisecond, maxProbeTimeout)
+	}
+
+	result, err := session.probeLatency(ctx, trackName, req.ParticipantIdentity, req.Audible, timeout)
+	if err != nil {
+		session.log().Warn("Latency probe failed", "track_name", trackName, "error", err)
+		return &pb.ProbeLatencyResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &pb.ProbeLatencyResponse{
+		Success:     true,
+		LatencyMs:   result.latency.Milliseconds(),
+		Correlation: float32(result.correlation),
+	}, nil
+}
+
+// latencyProbeStatus returns the session's last probe result for GetStatus,
+// nil when none has succeeded
+func (s *RoomSession) latencyProbeStatus() *pb.LatencyProbe {
+	result := s.lastProbe.Load()
+	if result == nil {
+		return nil
+	}
+	return &pb.LatencyProbe{
+		TrackName:   result.trackName,
+		LatencyMs:   result.latency.Milliseconds(),
+		Correlation: float32(result.correlation),
+		ProbedAt:    result.probedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// playbackProgress sends PROGRESS events for a PlayAudio request at
+// PROGRESS_INTERVAL_MS, with the position taken from what the track has
+// actually played out. A nil *playbackProgress is a no-op.
+type playbackProgress struct {
+	stream    pb.LiveKitBridge_PlayAudioServer
+	requestID string
+	clock     *playbackClock
+	offset    time.Duration // where in the clip the clock started (after a seek)
+	duration  atomic.Int64  // total audio length in ms, 0 = unknown
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// startProgress starts reporting progress for audio about to be written to
+// trackName, starting offset into the clip, at the request's interval or
+// the bridge default; returns nil when progress events are disabled
+func (s *LiveKitBridgeService) startProgress(ctx context.Context, stream pb.LiveKitBridge_PlayAudioServer, req *pb.PlayAudioRequest, session *RoomSession, trackName string, offset time.Duration) *playbackProgress {
+	interval := s.config.ProgressInterval
+	if req.ProgressIntervalMs != 0 {
+		interval = time.Duration(req.ProgressIntervalMs) * time.Millisecond
+	}
+	if interval <= 0 || stream == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &playbackProgress{
+		stream:    stream,
+		requestID: req.RequestId,
+		clock:     session.newPlaybackClock(trackName),
+		offset:    offset,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	go p.run(ctx, interval)
+	return p
+}
+
+// setDuration records the audio's total length once the decoder knows it
+func (p *playbackProgress) setDuration(d time.Duration) {
+	if p == nil {
+		return
+	}
+	p.duration.Store(d.Milliseconds())
+}
+
+// run sends a PROGRESS event every interval until stopped
+func (p *playbackProgress) run(ctx context.Context, interval time.Duration) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		position := (p.offset + p.clock.position()).Milliseconds()
+		duration := p.duration.Load()
+		event := &pb.PlayAudioEvent{
+			Type:       pb.PlayAudioEvent_PROGRESS,
+			RequestId:  p.requestID,
+			DurationMs: duration,
+			PositionMs: position,
+		}
+		if duration > 0 {
+			event.Metadata = map[string]string{
+				"percent": strconv.FormatFloat(min(100, float64(position)*100/float64(duration)), 'f', 1, 64),
+			}
+		}
+		if err := p.stream.Send(event); err != nil {
+			return
+		}
+	}
+}
+
+// stop ends reporting and waits for any Send in flight, so the caller can
+// use the stream again
+func (p *playbackProgress) stop() {
+	if p == nil {
+		return
+	}
+	p.cancel()
+	<-p.done
+}
+
+// playbackOutcome picks the event that ends a request from its playback
+// error: playback that was stopped, replaced, dequeued or cancelled is
+// INTERRUPTED rather than FAILED
+func playbackOutcome(err error) pb.PlayAudioEvent_EventType {
+	switch {
+	case err == nil:
+		return pb.PlayAudioEvent_COMPLETED
+	case errors.Is(err, context.Canceled), errors.Is(err, errPlayerClosed), errors.Is(err, errRemovedFromQueue):
+		return pb.PlayAudioEvent_INTERRUPTED
+	}
+	return pb.PlayAudioEvent_FAILED
+}
+
+// playbackEventTypes maps lifecycle events to the session events mirroring
+// them; PROGRESS stays on the request's own stream
+var playbackEventTypes = map[pb.PlayAudioEvent_EventType]string{
+	pb.PlayAudioEvent_STARTED:     EventPlaybackStarted,
+	pb.PlayAudioEvent_COMPLETED:   EventPlaybackCompleted,
+	pb.PlayAudioEvent_FAILED:      EventPlaybackFailed,
+	pb.PlayAudioEvent_INTERRUPTED: EventPlaybackInterrupted,
+}
+
+// sendPlaybackEvent sends a lifecycle event on the request's stream and
+// mirrors it to StreamEvents subscribers, so the cloud can follow every
+// request from one place
+func sendPlaybackEvent(stream pb.LiveKitBridge_PlayAudioServer, session *RoomSession, trackName string, event *pb.PlayAudioEvent) error {
+	emitPlaybackEvent(session, trackName, event)
+	return stream.Send(event)
+}
+
+// emitPlaybackEvent mirrors a lifecycle event to StreamEvents subscribers
+// only, for playback no caller is waiting on
+func emitPlaybackEvent(session *RoomSession, trackName string, event *pb.PlayAudioEvent) {
+	if eventType, ok := playbackEventTypes[event.Type]; ok {
+		attrs := map[string]string{"request_id": event.RequestId}
+		if event.DurationMs > 0 {
+			attrs["duration_ms"] = strconv.FormatInt(event.DurationMs, 10)
+		}
+		if event.DeliveredMs > 0 || event.DroppedMs > 0 {
+			attrs["delivered_ms"] = strconv.FormatInt(event.DeliveredMs, 10)
+			attrs["dropped_ms"] = strconv.FormatInt(event.DroppedMs, 10)
+		}
+		if event.Error != "" {
+			attrs["error"] = event.Error
+		}
+		session.emitEvent(eventType, trackName, attrs)
+	}
+}
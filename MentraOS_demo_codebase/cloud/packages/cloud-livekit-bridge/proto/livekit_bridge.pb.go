@@ -21,14 +21,268 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Audio payload encoding
+type AudioEncoding int32
+
+const (
+	AudioEncoding_PCM16 AudioEncoding = 0 // Raw PCM16 LE samples
+	AudioEncoding_OPUS  AudioEncoding = 1 // Pre-encoded Opus packets (48kHz RTP clock)
+)
+
+// Enum value maps for AudioEncoding.
+var (
+	AudioEncoding_name = map[int32]string{
+		0: "PCM16",
+		1: "OPUS",
+	}
+	AudioEncoding_value = map[string]int32{
+		"PCM16": 0,
+		"OPUS":  1,
+	}
+)
+
+func (x AudioEncoding) Enum() *AudioEncoding {
+	p := new(AudioEncoding)
+	*p = x
+	return p
+}
+
+func (x AudioEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[0].Descriptor()
+}
+
+func (AudioEncoding) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[0]
+}
+
+func (x AudioEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioEncoding.Descriptor instead.
+func (AudioEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{0}
+}
+
+// What playback does to lower-priority tracks playing alongside it
+type JoinRoomRequest_PriorityPolicy int32
+
+const (
+	JoinRoomRequest_POLICY_DEFAULT JoinRoomRequest_PriorityPolicy = 0 // The bridge's PRIORITY_POLICY
+	JoinRoomRequest_POLICY_NONE    JoinRoomRequest_PriorityPolicy = 1 // Tracks play over each other
+	JoinRoomRequest_POLICY_DUCK    JoinRoomRequest_PriorityPolicy = 2 // Lower them until it ends (PRIORITY_DUCK_DB)
+	JoinRoomRequest_POLICY_PREEMPT JoinRoomRequest_PriorityPolicy = 3 // Stop them
+)
+
+// Enum value maps for JoinRoomRequest_PriorityPolicy.
+var (
+	JoinRoomRequest_PriorityPolicy_name = map[int32]string{
+		0: "POLICY_DEFAULT",
+		1: "POLICY_NONE",
+		2: "POLICY_DUCK",
+		3: "POLICY_PREEMPT",
+	}
+	JoinRoomRequest_PriorityPolicy_value = map[string]int32{
+		"POLICY_DEFAULT": 0,
+		"POLICY_NONE":    1,
+		"POLICY_DUCK":    2,
+		"POLICY_PREEMPT": 3,
+	}
+)
+
+func (x JoinRoomRequest_PriorityPolicy) Enum() *JoinRoomRequest_PriorityPolicy {
+	p := new(JoinRoomRequest_PriorityPolicy)
+	*p = x
+	return p
+}
+
+func (x JoinRoomRequest_PriorityPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinRoomRequest_PriorityPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[1].Descriptor()
+}
+
+func (JoinRoomRequest_PriorityPolicy) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[1]
+}
+
+func (x JoinRoomRequest_PriorityPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinRoomRequest_PriorityPolicy.Descriptor instead.
+func (JoinRoomRequest_PriorityPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type JoinRoomRequest_OverflowPolicy int32
+
+const (
+	JoinRoomRequest_OVERFLOW_DEFAULT     JoinRoomRequest_OverflowPolicy = 0 // The bridge's INCOMING_OVERFLOW_POLICY
+	JoinRoomRequest_OVERFLOW_DROP_NEWEST JoinRoomRequest_OverflowPolicy = 1 // Drop the frame that just arrived
+	JoinRoomRequest_OVERFLOW_DROP_OLDEST JoinRoomRequest_OverflowPolicy = 2 // Drop the oldest buffered frame
+	JoinRoomRequest_OVERFLOW_GROW        JoinRoomRequest_OverflowPolicy = 3 // Grow up to INCOMING_BUFFER_LIMIT, then drop the newest
+)
+
+// Enum value maps for JoinRoomRequest_OverflowPolicy.
+var (
+	JoinRoomRequest_OverflowPolicy_name = map[int32]string{
+		0: "OVERFLOW_DEFAULT",
+		1: "OVERFLOW_DROP_NEWEST",
+		2: "OVERFLOW_DROP_OLDEST",
+		3: "OVERFLOW_GROW",
+	}
+	JoinRoomRequest_OverflowPolicy_value = map[string]int32{
+		"OVERFLOW_DEFAULT":     0,
+		"OVERFLOW_DROP_NEWEST": 1,
+		"OVERFLOW_DROP_OLDEST": 2,
+		"OVERFLOW_GROW":        3,
+	}
+)
+
+func (x JoinRoomRequest_OverflowPolicy) Enum() *JoinRoomRequest_OverflowPolicy {
+	p := new(JoinRoomRequest_OverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x JoinRoomRequest_OverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinRoomRequest_OverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[2].Descriptor()
+}
+
+func (JoinRoomRequest_OverflowPolicy) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[2]
+}
+
+func (x JoinRoomRequest_OverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinRoomRequest_OverflowPolicy.Descriptor instead.
+func (JoinRoomRequest_OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type AudioFormat_Encoding int32
+
+const (
+	AudioFormat_SIGNED   AudioFormat_Encoding = 0 // Signed integer PCM (8, 16, 24 or 32 bits)
+	AudioFormat_UNSIGNED AudioFormat_Encoding = 1 // Unsigned integer PCM (8, 16, 24 or 32 bits)
+	AudioFormat_FLOAT    AudioFormat_Encoding = 2 // IEEE float PCM (32 or 64 bits)
+	AudioFormat_MULAW    AudioFormat_Encoding = 3 // G.711 µ-law (8 bits)
+	AudioFormat_ALAW     AudioFormat_Encoding = 4 // G.711 A-law (8 bits)
+)
+
+// Enum value maps for AudioFormat_Encoding.
+var (
+	AudioFormat_Encoding_name = map[int32]string{
+		0: "SIGNED",
+		1: "UNSIGNED",
+		2: "FLOAT",
+		3: "MULAW",
+		4: "ALAW",
+	}
+	AudioFormat_Encoding_value = map[string]int32{
+		"SIGNED":   0,
+		"UNSIGNED": 1,
+		"FLOAT":    2,
+		"MULAW":    3,
+		"ALAW":     4,
+	}
+)
+
+func (x AudioFormat_Encoding) Enum() *AudioFormat_Encoding {
+	p := new(AudioFormat_Encoding)
+	*p = x
+	return p
+}
+
+func (x AudioFormat_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioFormat_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[3].Descriptor()
+}
+
+func (AudioFormat_Encoding) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[3]
+}
+
+func (x AudioFormat_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioFormat_Encoding.Descriptor instead.
+func (AudioFormat_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type AudioFormat_Endianness int32
+
+const (
+	AudioFormat_LITTLE AudioFormat_Endianness = 0
+	AudioFormat_BIG    AudioFormat_Endianness = 1
+)
+
+// Enum value maps for AudioFormat_Endianness.
+var (
+	AudioFormat_Endianness_name = map[int32]string{
+		0: "LITTLE",
+		1: "BIG",
+	}
+	AudioFormat_Endianness_value = map[string]int32{
+		"LITTLE": 0,
+		"BIG":    1,
+	}
+)
+
+func (x AudioFormat_Endianness) Enum() *AudioFormat_Endianness {
+	p := new(AudioFormat_Endianness)
+	*p = x
+	return p
+}
+
+func (x AudioFormat_Endianness) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioFormat_Endianness) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[4].Descriptor()
+}
+
+func (AudioFormat_Endianness) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[4]
+}
+
+func (x AudioFormat_Endianness) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioFormat_Endianness.Descriptor instead.
+func (AudioFormat_Endianness) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{6, 1}
+}
+
 // Event type
 type PlayAudioEvent_EventType int32
 
 const (
-	PlayAudioEvent_STARTED   PlayAudioEvent_EventType = 0 // Playback started
-	PlayAudioEvent_PROGRESS  PlayAudioEvent_EventType = 1 // Playback progress update
-	PlayAudioEvent_COMPLETED PlayAudioEvent_EventType = 2 // Playback finished successfully
-	PlayAudioEvent_FAILED    PlayAudioEvent_EventType = 3 // Playback failed with error
+	PlayAudioEvent_STARTED     PlayAudioEvent_EventType = 0 // Playback started
+	PlayAudioEvent_PROGRESS    PlayAudioEvent_EventType = 1 // Playback progress update
+	PlayAudioEvent_COMPLETED   PlayAudioEvent_EventType = 2 // Playback finished successfully
+	PlayAudioEvent_FAILED      PlayAudioEvent_EventType = 3 // Playback failed with error
+	PlayAudioEvent_INTERRUPTED PlayAudioEvent_EventType = 4 // Stopped, replaced or removed from the queue before it finished
 )
 
 // Enum value maps for PlayAudioEvent_EventType.
@@ -38,12 +292,14 @@ var (
 		1: "PROGRESS",
 		2: "COMPLETED",
 		3: "FAILED",
+		4: "INTERRUPTED",
 	}
 	PlayAudioEvent_EventType_value = map[string]int32{
-		"STARTED":   0,
-		"PROGRESS":  1,
-		"COMPLETED": 2,
-		"FAILED":    3,
+		"STARTED":     0,
+		"PROGRESS":    1,
+		"COMPLETED":   2,
+		"FAILED":      3,
+		"INTERRUPTED": 4,
 	}
 )
 
@@ -58,11 +314,11 @@ func (x PlayAudioEvent_EventType) String() string {
 }
 
 func (PlayAudioEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_livekit_bridge_proto_enumTypes[0].Descriptor()
+	return file_proto_livekit_bridge_proto_enumTypes[5].Descriptor()
 }
 
 func (PlayAudioEvent_EventType) Type() protoreflect.EnumType {
-	return &file_proto_livekit_bridge_proto_enumTypes[0]
+	return &file_proto_livekit_bridge_proto_enumTypes[5]
 }
 
 func (x PlayAudioEvent_EventType) Number() protoreflect.EnumNumber {
@@ -71,7 +327,106 @@ func (x PlayAudioEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayAudioEvent_EventType.Descriptor instead.
 func (PlayAudioEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{6, 0}
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{13, 0}
+}
+
+// How to stop
+type StopAudioRequest_StopMode int32
+
+const (
+	StopAudioRequest_FLUSH          StopAudioRequest_StopMode = 0 // Fade out (STOP_FADE_MS) and drop everything queued
+	StopAudioRequest_IMMEDIATE      StopAudioRequest_StopMode = 1 // Cut off now, with no fade
+	StopAudioRequest_FINISH_CURRENT StopAudioRequest_StopMode = 2 // Let what is playing finish; drop queued clips and end loops
+)
+
+// Enum value maps for StopAudioRequest_StopMode.
+var (
+	StopAudioRequest_StopMode_name = map[int32]string{
+		0: "FLUSH",
+		1: "IMMEDIATE",
+		2: "FINISH_CURRENT",
+	}
+	StopAudioRequest_StopMode_value = map[string]int32{
+		"FLUSH":          0,
+		"IMMEDIATE":      1,
+		"FINISH_CURRENT": 2,
+	}
+)
+
+func (x StopAudioRequest_StopMode) Enum() *StopAudioRequest_StopMode {
+	p := new(StopAudioRequest_StopMode)
+	*p = x
+	return p
+}
+
+func (x StopAudioRequest_StopMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopAudioRequest_StopMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[6].Descriptor()
+}
+
+func (StopAudioRequest_StopMode) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[6]
+}
+
+func (x StopAudioRequest_StopMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopAudioRequest_StopMode.Descriptor instead.
+func (StopAudioRequest_StopMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type TrackInfo_PlaybackState int32
+
+const (
+	TrackInfo_IDLE    TrackInfo_PlaybackState = 0 // Nothing playing or queued
+	TrackInfo_PLAYING TrackInfo_PlaybackState = 1 // Playback is running or audio is still playing out
+	TrackInfo_PAUSED  TrackInfo_PlaybackState = 2 // Holding its queued audio until resumed
+)
+
+// Enum value maps for TrackInfo_PlaybackState.
+var (
+	TrackInfo_PlaybackState_name = map[int32]string{
+		0: "IDLE",
+		1: "PLAYING",
+		2: "PAUSED",
+	}
+	TrackInfo_PlaybackState_value = map[string]int32{
+		"IDLE":    0,
+		"PLAYING": 1,
+		"PAUSED":  2,
+	}
+)
+
+func (x TrackInfo_PlaybackState) Enum() *TrackInfo_PlaybackState {
+	p := new(TrackInfo_PlaybackState)
+	*p = x
+	return p
+}
+
+func (x TrackInfo_PlaybackState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackInfo_PlaybackState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[7].Descriptor()
+}
+
+func (TrackInfo_PlaybackState) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[7]
+}
+
+func (x TrackInfo_PlaybackState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackInfo_PlaybackState.Descriptor instead.
+func (TrackInfo_PlaybackState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{33, 0}
 }
 
 // Service status
@@ -111,11 +466,11 @@ func (x HealthCheckResponse_ServingStatus) String() string {
 }
 
 func (HealthCheckResponse_ServingStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_livekit_bridge_proto_enumTypes[1].Descriptor()
+	return file_proto_livekit_bridge_proto_enumTypes[8].Descriptor()
 }
 
 func (HealthCheckResponse_ServingStatus) Type() protoreflect.EnumType {
-	return &file_proto_livekit_bridge_proto_enumTypes[1]
+	return &file_proto_livekit_bridge_proto_enumTypes[8]
 }
 
 func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
@@ -124,7 +479,53 @@ func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HealthCheckResponse_ServingStatus.Descriptor instead.
 func (HealthCheckResponse_ServingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{10, 0}
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type StartRecordingRequest_Format int32
+
+const (
+	StartRecordingRequest_WAV      StartRecordingRequest_Format = 0 // PCM16 WAV
+	StartRecordingRequest_OGG_OPUS StartRecordingRequest_Format = 1 // Opus in Ogg, about a tenth the size
+)
+
+// Enum value maps for StartRecordingRequest_Format.
+var (
+	StartRecordingRequest_Format_name = map[int32]string{
+		0: "WAV",
+		1: "OGG_OPUS",
+	}
+	StartRecordingRequest_Format_value = map[string]int32{
+		"WAV":      0,
+		"OGG_OPUS": 1,
+	}
+)
+
+func (x StartRecordingRequest_Format) Enum() *StartRecordingRequest_Format {
+	p := new(StartRecordingRequest_Format)
+	*p = x
+	return p
+}
+
+func (x StartRecordingRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartRecordingRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_livekit_bridge_proto_enumTypes[9].Descriptor()
+}
+
+func (StartRecordingRequest_Format) Type() protoreflect.EnumType {
+	return &file_proto_livekit_bridge_proto_enumTypes[9]
+}
+
+func (x StartRecordingRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartRecordingRequest_Format.Descriptor instead.
+func (StartRecordingRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{83, 0}
 }
 
 // Audio chunk (PCM16 mono)
@@ -149,7 +550,66 @@ type AudioChunk struct {
 	// 1: app_audio (app-specific audio)
 	// 2: tts (text-to-speech audio)
 	// >2: custom app tracks
-	TrackId       int32 `protobuf:"varint,6,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackId int32 `protobuf:"varint,6,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// Payload encoding (optional, defaults to PCM16)
+	// OPUS: pcm_data carries exactly one raw Opus packet (no Ogg framing),
+	// which is published as-is without decoding/re-encoding
+	Encoding AudioEncoding `protobuf:"varint,7,opt,name=encoding,proto3,enum=mentra.livekit.bridge.AudioEncoding" json:"encoding,omitempty"`
+	// Duration of the Opus packet in milliseconds (OPUS only, defaults to 20)
+	FrameDurationMs int32 `protobuf:"varint,8,opt,name=frame_duration_ms,json=frameDurationMs,proto3" json:"frame_duration_ms,omitempty"`
+	// Replace audio still queued on the track with this chunk, crossfading
+	// over this many milliseconds (PCM16 only, 0 = append as usual)
+	CrossfadeMs int32 `protobuf:"varint,9,opt,name=crossfade_ms,json=crossfadeMs,proto3" json:"crossfade_ms,omitempty"`
+	// Bridge → client only: the participant that sent the audio
+	ParticipantIdentity string `protobuf:"bytes,10,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Bridge → client only: the sender's data packet topic, if any
+	TrackName string `protobuf:"bytes,11,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// Bridge → client: per-session frame counter starting at 1; a gap means
+	// the bridge dropped frames under backpressure.
+	// Client → bridge (optional): per-stream chunk counter starting at 1.
+	// Chunks are played in this order, with repeats dropped; a missing chunk
+	// is waited for up to CHUNK_REORDER_TIMEOUT_MS (0 = play as received).
+	Sequence uint64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Bridge → client only: who is talking on the sender's mic when the
+	// session diarizes (1, 2, ... per sender; 0 = nobody or not yet known)
+	SpeakerId int32 `protobuf:"varint,14,opt,name=speaker_id,json=speakerId,proto3" json:"speaker_id,omitempty"`
+	// Bridge → client only: RTP timestamp of the chunk's first sample (audio
+	// from remote tracks; 0 for data packets)
+	RtpTimestamp uint32 `protobuf:"varint,15,opt,name=rtp_timestamp,json=rtpTimestamp,proto3" json:"rtp_timestamp,omitempty"`
+	// Bridge → client only: when the chunk's first sample was captured, on the
+	// sender's wall clock per its RTCP sender reports (milliseconds since
+	// epoch; 0 until one arrives, and for data packets). See GetClockMapping.
+	CaptureTimeMs int64 `protobuf:"varint,16,opt,name=capture_time_ms,json=captureTimeMs,proto3" json:"capture_time_ms,omitempty"`
+	// Bridge → client only: the chunk is the mixdown of every remote
+	// participant (JoinRoom mixdown) rather than one sender's audio; it has
+	// no participant_identity, track_name or capture times
+	Mixed bool `protobuf:"varint,18,opt,name=mixed,proto3" json:"mixed,omitempty"`
+	// Client → bridge only: app the audio is sent for (optional); it is
+	// written to the app's own track, e.g. "appX:speaker"
+	AppId string `protobuf:"bytes,13,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Client → bridge only: when the chunk's first sample should play out, in
+	// milliseconds since epoch on the bridge's clock (PCM16 only; 0 = as soon
+	// as possible). The bridge holds, pads or trims chunks to keep to it.
+	PresentationTimeMs int64 `protobuf:"varint,17,opt,name=presentation_time_ms,json=presentationTimeMs,proto3" json:"presentation_time_ms,omitempty"`
+	// Client → bridge (optional): playback request the chunk belongs to. The
+	// bridge acknowledges each request's PCM16 audio as it plays (see ack).
+	// Bridge → client: on an ack, the request acknowledged.
+	RequestId string `protobuf:"bytes,19,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Client → bridge: the chunk is the last of request_id, so the bridge
+	// sends a final ack once the request's audio has played out or been cut.
+	// Bridge → client: on an ack, it is the request's final one.
+	EndOfRequest bool `protobuf:"varint,20,opt,name=end_of_request,json=endOfRequest,proto3" json:"end_of_request,omitempty"`
+	// Bridge → client only: the message acknowledges request_id instead of
+	// carrying audio; sequence is the last of the request's chunks heard in
+	// full (0 = none yet, or unsequenced)
+	Ack bool `protobuf:"varint,21,opt,name=ack,proto3" json:"ack,omitempty"`
+	// Bridge → client only, on an ack: how much of the request's audio has
+	// been played to the track so far
+	DeliveredMs int64 `protobuf:"varint,22,opt,name=delivered_ms,json=deliveredMs,proto3" json:"delivered_ms,omitempty"`
+	// Bridge → client only, on an ack: how much of the request's audio was
+	// dropped instead of played: cut by an interruption while queued, or
+	// refused on quota, backpressure or presentation time
+	DroppedMs     int64 `protobuf:"varint,23,opt,name=dropped_ms,json=droppedMs,proto3" json:"dropped_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -226,51 +686,259 @@ func (x *AudioChunk) GetTrackId() int32 {
 	return 0
 }
 
-// Join LiveKit room request
-type JoinRoomRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (also used as room participant identity)
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// LiveKit room name (typically same as user_id)
-	RoomName string `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	// LiveKit JWT token (minted by TypeScript with appropriate grants)
-	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
-	// LiveKit server URL (wss://...)
-	LivekitUrl string `protobuf:"bytes,4,opt,name=livekit_url,json=livekitUrl,proto3" json:"livekit_url,omitempty"`
-	// Optional: Identity to subscribe to (typically user_id for self-audio)
-	// If set, bridge will subscribe to this participant's DataChannel packets
-	TargetIdentity string `protobuf:"bytes,5,opt,name=target_identity,json=targetIdentity,proto3" json:"target_identity,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AudioChunk) GetEncoding() AudioEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return AudioEncoding_PCM16
 }
 
-func (x *JoinRoomRequest) Reset() {
-	*x = JoinRoomRequest{}
-	mi := &file_proto_livekit_bridge_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AudioChunk) GetFrameDurationMs() int32 {
+	if x != nil {
+		return x.FrameDurationMs
+	}
+	return 0
 }
 
-func (x *JoinRoomRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AudioChunk) GetCrossfadeMs() int32 {
+	if x != nil {
+		return x.CrossfadeMs
+	}
+	return 0
 }
 
-func (*JoinRoomRequest) ProtoMessage() {}
+func (x *AudioChunk) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
 
-func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_livekit_bridge_proto_msgTypes[1]
+func (x *AudioChunk) GetTrackName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TrackName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
-func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+func (x *AudioChunk) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetSpeakerId() int32 {
+	if x != nil {
+		return x.SpeakerId
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetRtpTimestamp() uint32 {
+	if x != nil {
+		return x.RtpTimestamp
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetCaptureTimeMs() int64 {
+	if x != nil {
+		return x.CaptureTimeMs
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetMixed() bool {
+	if x != nil {
+		return x.Mixed
+	}
+	return false
+}
+
+func (x *AudioChunk) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AudioChunk) GetPresentationTimeMs() int64 {
+	if x != nil {
+		return x.PresentationTimeMs
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AudioChunk) GetEndOfRequest() bool {
+	if x != nil {
+		return x.EndOfRequest
+	}
+	return false
+}
+
+func (x *AudioChunk) GetAck() bool {
+	if x != nil {
+		return x.Ack
+	}
+	return false
+}
+
+func (x *AudioChunk) GetDeliveredMs() int64 {
+	if x != nil {
+		return x.DeliveredMs
+	}
+	return 0
+}
+
+func (x *AudioChunk) GetDroppedMs() int64 {
+	if x != nil {
+		return x.DroppedMs
+	}
+	return 0
+}
+
+// Join LiveKit room request
+type JoinRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (also used as room participant identity)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// LiveKit room name (typically same as user_id)
+	RoomName string `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	// LiveKit JWT token (minted by TypeScript with appropriate grants)
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// LiveKit server URL (wss://...)
+	LivekitUrl string `protobuf:"bytes,4,opt,name=livekit_url,json=livekitUrl,proto3" json:"livekit_url,omitempty"`
+	// Optional: Identity to subscribe to (typically user_id for self-audio)
+	// If set, bridge will subscribe to this participant's DataChannel packets
+	TargetIdentity string `protobuf:"bytes,5,opt,name=target_identity,json=targetIdentity,proto3" json:"target_identity,omitempty"`
+	// Optional: denoise audio received from the glasses before it is
+	// forwarded (improves ASR in noisy environments)
+	NoiseSuppression bool `protobuf:"varint,6,opt,name=noise_suppression,json=noiseSuppression,proto3" json:"noise_suppression,omitempty"`
+	// Optional: per-session track priorities (e.g. {"alert": 2, "tts": 1}),
+	// replacing the bridge's TRACK_PRIORITIES; unlisted tracks rank 0 and an
+	// app's track ("appX:tts") falls back to its own name's rank
+	TrackPriorities map[string]int32               `protobuf:"bytes,7,rep,name=track_priorities,json=trackPriorities,proto3" json:"track_priorities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	PriorityPolicy  JoinRoomRequest_PriorityPolicy `protobuf:"varint,8,opt,name=priority_policy,json=priorityPolicy,proto3,enum=mentra.livekit.bridge.JoinRoomRequest_PriorityPolicy" json:"priority_policy,omitempty"`
+	// Optional: per-session quotas overriding MAX_SESSION_TRACKS and
+	// SESSION_BANDWIDTH_KBPS (0 = bridge default). Going over either fails
+	// the write with RESOURCE_EXHAUSTED; StreamAudio drops the audio instead.
+	MaxTracks          int32 `protobuf:"varint,9,opt,name=max_tracks,json=maxTracks,proto3" json:"max_tracks,omitempty"`
+	BandwidthLimitKbps int32 `protobuf:"varint,10,opt,name=bandwidth_limit_kbps,json=bandwidthLimitKbps,proto3" json:"bandwidth_limit_kbps,omitempty"`
+	// Optional: how many mic audio frames (10ms) the session buffers for
+	// StreamAudio, and what happens when a burst fills the buffer; overrides
+	// INCOMING_BUFFER_FRAMES and INCOMING_OVERFLOW_POLICY
+	IncomingBufferFrames   int32                          `protobuf:"varint,11,opt,name=incoming_buffer_frames,json=incomingBufferFrames,proto3" json:"incoming_buffer_frames,omitempty"`
+	IncomingOverflowPolicy JoinRoomRequest_OverflowPolicy `protobuf:"varint,12,opt,name=incoming_overflow_policy,json=incomingOverflowPolicy,proto3,enum=mentra.livekit.bridge.JoinRoomRequest_OverflowPolicy" json:"incoming_overflow_policy,omitempty"`
+	// Optional: stream the session's mic audio to the bridge's speech
+	// recognizer (ASR_PROVIDER) and emit "transcript" events on StreamEvents;
+	// asr_language overrides ASR_LANGUAGE (e.g. "en-US")
+	Asr         bool   `protobuf:"varint,13,opt,name=asr,proto3" json:"asr,omitempty"`
+	AsrLanguage string `protobuf:"bytes,14,opt,name=asr_language,json=asrLanguage,proto3" json:"asr_language,omitempty"`
+	// Optional: keywords to listen for on the session's mic audio (see
+	// SetWakeWords), overriding WAKE_WORDS; sensitivity 0-1, 0 = default
+	WakeWords           []string `protobuf:"bytes,15,rep,name=wake_words,json=wakeWords,proto3" json:"wake_words,omitempty"`
+	WakeWordSensitivity float32  `protobuf:"fixed32,16,opt,name=wake_word_sensitivity,json=wakeWordSensitivity,proto3" json:"wake_word_sensitivity,omitempty"`
+	// Optional: split the session's mic audio into utterances, emitting
+	// utterance_start and utterance_end events (ENDPOINTING turns it on for
+	// every session); endpointing_audio attaches each utterance's audio, and
+	// endpointing_silence_ms overrides ENDPOINT_SILENCE_MS
+	Endpointing          bool  `protobuf:"varint,17,opt,name=endpointing,proto3" json:"endpointing,omitempty"`
+	EndpointingAudio     bool  `protobuf:"varint,18,opt,name=endpointing_audio,json=endpointingAudio,proto3" json:"endpointing_audio,omitempty"`
+	EndpointingSilenceMs int32 `protobuf:"varint,19,opt,name=endpointing_silence_ms,json=endpointingSilenceMs,proto3" json:"endpointing_silence_ms,omitempty"`
+	// Optional: sounds to report as "sound_event" events, overriding
+	// SOUND_EVENTS: "siren", "doorbell", "alarm", any AudioSet class name
+	// (e.g. "Baby cry, infant cry"), or "name_called" for one of
+	// sound_event_names heard in a transcript (asr) or as a wake word
+	SoundEvents     []string `protobuf:"bytes,20,rep,name=sound_events,json=soundEvents,proto3" json:"sound_events,omitempty"`
+	SoundEventNames []string `protobuf:"bytes,21,rep,name=sound_event_names,json=soundEventNames,proto3" json:"sound_event_names,omitempty"`
+	// Optional: tell apart people sharing a mic, tagging audio chunks with
+	// speaker_id and emitting "speaker_change" events (DIARIZATION turns it
+	// on for every session)
+	Diarization bool `protobuf:"varint,22,opt,name=diarization,proto3" json:"diarization,omitempty"`
+	// Optional: sample rate in Hz of the session's published tracks and the
+	// audio it receives (8000-48000, default AUDIO_SAMPLE_RATE), e.g. 48000
+	// for full-band quality; speech recognition and the other listeners run
+	// on a 16kHz mono copy either way (SubscribeAudio asr_feed)
+	SampleRate int32 `protobuf:"varint,23,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	// Optional: republish the mic audio the session receives, noise-suppressed
+	// and levelled by AGC, as a track of this name (e.g. "mic-clean") for
+	// people listening in the room, overriding CLEAN_MIC_TRACK; what the
+	// session's own consumers get still depends on noise_suppression only
+	CleanMicTrack string `protobuf:"bytes,24,opt,name=clean_mic_track,json=cleanMicTrack,proto3" json:"clean_mic_track,omitempty"`
+	// Optional: conference mode, for several MentraOS users in one LiveKit
+	// room. Sessions joined with it share one bridge connection per room
+	// (the first one's token connects it). Each publishes its tracks as
+	// "<user_id>/<track name>", takes in only audio sent by its
+	// target_identity (required), and gets its captions sent to that
+	// participant alone. Everyone in the room must join at the same
+	// sample_rate.
+	SharedRoom bool `protobuf:"varint,25,opt,name=shared_room,json=sharedRoom,proto3" json:"shared_room,omitempty"`
+	// Optional: also send StreamAudio one mixed stream of every remote
+	// participant the session takes in, in 10ms chunks marked mixed, for
+	// consumers that want everything the user hears. Each participant is
+	// weighted by its entry in mixdown_gains (0-4, default 1); see
+	// SetMixdownGains.
+	Mixdown      bool               `protobuf:"varint,26,opt,name=mixdown,proto3" json:"mixdown,omitempty"`
+	MixdownGains map[string]float32 `protobuf:"bytes,27,rep,name=mixdown_gains,json=mixdownGains,proto3" json:"mixdown_gains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	// Optional: for devices that can only render one remote audio track,
+	// mix every PCM track the session plays (speech, earcons, music) into a
+	// single mono track published as mix_track_name (default "speaker").
+	// Tracks keep their own volume, ducking, priorities and playback
+	// controls; only what is published changes. Opus passthrough tracks are
+	// still published on their own.
+	MixTracks    bool   `protobuf:"varint,28,opt,name=mix_tracks,json=mixTracks,proto3" json:"mix_tracks,omitempty"`
+	MixTrackName string `protobuf:"bytes,29,opt,name=mix_track_name,json=mixTrackName,proto3" json:"mix_track_name,omitempty"`
+	// Optional: how much faster than real time PCM tracks play, without
+	// changing pitch, to make up a backlog left by a reconnect or stall,
+	// e.g. 1.05 (0 = CATCHUP_RATE; 1 or less = keep the delay)
+	CatchupRate float32 `protobuf:"fixed32,30,opt,name=catchup_rate,json=catchupRate,proto3" json:"catchup_rate,omitempty"`
+	// Optional: the session's output limiter at the end of every track's
+	// publish chain; each field set overrides its LIMITER_* default. The
+	// ratio must be at least 1 (1 turns the limiter off) and the attack and
+	// release above 0.
+	LimiterThresholdDb *float32 `protobuf:"fixed32,31,opt,name=limiter_threshold_db,json=limiterThresholdDb,proto3,oneof" json:"limiter_threshold_db,omitempty"`
+	LimiterRatio       *float32 `protobuf:"fixed32,32,opt,name=limiter_ratio,json=limiterRatio,proto3,oneof" json:"limiter_ratio,omitempty"`
+	LimiterAttackMs    *int32   `protobuf:"varint,33,opt,name=limiter_attack_ms,json=limiterAttackMs,proto3,oneof" json:"limiter_attack_ms,omitempty"`
+	LimiterReleaseMs   *int32   `protobuf:"varint,34,opt,name=limiter_release_ms,json=limiterReleaseMs,proto3,oneof" json:"limiter_release_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{1}
 }
 
@@ -309,6 +977,209 @@ func (x *JoinRoomRequest) GetTargetIdentity() string {
 	return ""
 }
 
+func (x *JoinRoomRequest) GetNoiseSuppression() bool {
+	if x != nil {
+		return x.NoiseSuppression
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetTrackPriorities() map[string]int32 {
+	if x != nil {
+		return x.TrackPriorities
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetPriorityPolicy() JoinRoomRequest_PriorityPolicy {
+	if x != nil {
+		return x.PriorityPolicy
+	}
+	return JoinRoomRequest_POLICY_DEFAULT
+}
+
+func (x *JoinRoomRequest) GetMaxTracks() int32 {
+	if x != nil {
+		return x.MaxTracks
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetBandwidthLimitKbps() int32 {
+	if x != nil {
+		return x.BandwidthLimitKbps
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetIncomingBufferFrames() int32 {
+	if x != nil {
+		return x.IncomingBufferFrames
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetIncomingOverflowPolicy() JoinRoomRequest_OverflowPolicy {
+	if x != nil {
+		return x.IncomingOverflowPolicy
+	}
+	return JoinRoomRequest_OVERFLOW_DEFAULT
+}
+
+func (x *JoinRoomRequest) GetAsr() bool {
+	if x != nil {
+		return x.Asr
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetAsrLanguage() string {
+	if x != nil {
+		return x.AsrLanguage
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetWakeWords() []string {
+	if x != nil {
+		return x.WakeWords
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetWakeWordSensitivity() float32 {
+	if x != nil {
+		return x.WakeWordSensitivity
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetEndpointing() bool {
+	if x != nil {
+		return x.Endpointing
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetEndpointingAudio() bool {
+	if x != nil {
+		return x.EndpointingAudio
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetEndpointingSilenceMs() int32 {
+	if x != nil {
+		return x.EndpointingSilenceMs
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetSoundEvents() []string {
+	if x != nil {
+		return x.SoundEvents
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetSoundEventNames() []string {
+	if x != nil {
+		return x.SoundEventNames
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetDiarization() bool {
+	if x != nil {
+		return x.Diarization
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetCleanMicTrack() string {
+	if x != nil {
+		return x.CleanMicTrack
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetSharedRoom() bool {
+	if x != nil {
+		return x.SharedRoom
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetMixdown() bool {
+	if x != nil {
+		return x.Mixdown
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetMixdownGains() map[string]float32 {
+	if x != nil {
+		return x.MixdownGains
+	}
+	return nil
+}
+
+func (x *JoinRoomRequest) GetMixTracks() bool {
+	if x != nil {
+		return x.MixTracks
+	}
+	return false
+}
+
+func (x *JoinRoomRequest) GetMixTrackName() string {
+	if x != nil {
+		return x.MixTrackName
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetCatchupRate() float32 {
+	if x != nil {
+		return x.CatchupRate
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetLimiterThresholdDb() float32 {
+	if x != nil && x.LimiterThresholdDb != nil {
+		return *x.LimiterThresholdDb
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetLimiterRatio() float32 {
+	if x != nil && x.LimiterRatio != nil {
+		return *x.LimiterRatio
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetLimiterAttackMs() int32 {
+	if x != nil && x.LimiterAttackMs != nil {
+		return *x.LimiterAttackMs
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetLimiterReleaseMs() int32 {
+	if x != nil && x.LimiterReleaseMs != nil {
+		return *x.LimiterReleaseMs
+	}
+	return 0
+}
+
 // Join room response
 type JoinRoomResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -508,7 +1379,13 @@ type PlayAudioRequest struct {
 	// Unique request ID (for tracking events)
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// URL to audio file (HTTP/HTTPS)
-	// Supports: MP3 (audio/mpeg), WAV (audio/wav, audio/x-wav)
+	// Supports: MP3 (audio/mpeg), WAV (audio/wav, audio/x-wav),
+	// AAC (audio/aac ADTS, audio/mp4 and audio/x-m4a; decoded with ffmpeg),
+	// AMR-NB/WB (audio/amr, audio/amr-wb; decoded with ffmpeg),
+	// FLAC (audio/flac; decoded with ffmpeg),
+	// Ogg/Opus (audio/ogg, audio/opus), Sun AU (audio/basic with a header),
+	// G.711 (audio/PCMU, audio/PCMA, audio/basic; 8kHz unless ;rate= says)
+	// WAV may be 8/16/24/32-bit integer or 32/64-bit float PCM at any rate
 	AudioUrl string `protobuf:"bytes,2,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
 	// Volume level (0.0 = mute, 1.0 = full volume, >1.0 = boost)
 	Volume float32 `protobuf:"fixed32,3,opt,name=volume,proto3" json:"volume,omitempty"`
@@ -517,7 +1394,55 @@ type PlayAudioRequest struct {
 	// User ID (for routing to correct room session)
 	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	// Track ID (optional, defaults to 0 = "speaker")
-	TrackId       int32 `protobuf:"varint,6,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	TrackId int32 `protobuf:"varint,6,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// Crossfade from the interrupted audio into this one over this many
+	// milliseconds instead of cutting it off (requires stop_other, 0 = cut)
+	CrossfadeMs int32 `protobuf:"varint,7,opt,name=crossfade_ms,json=crossfadeMs,proto3" json:"crossfade_ms,omitempty"`
+	// Optional: TTS word timings, relative to the start of the audio. The
+	// bridge publishes captions on the caption data topic as each word plays.
+	WordTimings []*WordTiming `protobuf:"bytes,8,rep,name=word_timings,json=wordTimings,proto3" json:"word_timings,omitempty"`
+	// Optional: the audio file itself, instead of audio_url (PlayBytes)
+	AudioData []byte `protobuf:"bytes,9,opt,name=audio_data,json=audioData,proto3" json:"audio_data,omitempty"`
+	// Optional: MIME type of the audio (e.g. "audio/wav"); overrides the
+	// HTTP Content-Type. Sniffed from the data when neither says.
+	ContentType string `protobuf:"bytes,10,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Ogg/Opus only: publish the Opus packets as-is on an Opus track instead
+	// of decoding to PCM. Saves CPU, but volume, ducking and AGC don't apply
+	// and the track name can't also carry PCM.
+	Passthrough bool `protobuf:"varint,11,opt,name=passthrough,proto3" json:"passthrough,omitempty"`
+	// How often to send PROGRESS events, overriding the bridge's
+	// PROGRESS_INTERVAL_MS (0 = bridge default, < 0 = none)
+	ProgressIntervalMs int32 `protobuf:"varint,12,opt,name=progress_interval_ms,json=progressIntervalMs,proto3" json:"progress_interval_ms,omitempty"`
+	// Optional: when the first sample should be heard, as Unix milliseconds
+	// on the bridge's clock (hosts synced with NTP share it). The audio is
+	// fetched and decoded straight away and held until then; a time in the
+	// past plays immediately. STARTED is still sent when the request is
+	// accepted.
+	StartAtMs int64 `protobuf:"varint,13,opt,name=start_at_ms,json=startAtMs,proto3" json:"start_at_ms,omitempty"`
+	// Loop the clip (ambient beds, ringtones): it plays from the start to
+	// loop_end_ms, then repeats the loop_start_ms..loop_end_ms region from
+	// memory until it has played loop_count times in all (0 = until
+	// stopped). The region defaults to the whole clip and may be at most
+	// two minutes long. Not supported with passthrough.
+	Loop        bool  `protobuf:"varint,14,opt,name=loop,proto3" json:"loop,omitempty"`
+	LoopCount   int32 `protobuf:"varint,15,opt,name=loop_count,json=loopCount,proto3" json:"loop_count,omitempty"`
+	LoopStartMs int64 `protobuf:"varint,16,opt,name=loop_start_ms,json=loopStartMs,proto3" json:"loop_start_ms,omitempty"`
+	LoopEndMs   int64 `protobuf:"varint,17,opt,name=loop_end_ms,json=loopEndMs,proto3" json:"loop_end_ms,omitempty"`
+	// Track name, overriding track_id (optional). Apps can name their tracks
+	// under a prefix such as "appX:music" so StopAudio can stop them together
+	// with track_pattern.
+	TrackName string `protobuf:"bytes,18,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// App the request is made for (optional). The track is named under the
+	// app ("appX:" + track name) and stop_other only stops that app's tracks;
+	// a track_name under another app's prefix is refused.
+	AppId string `protobuf:"bytes,19,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Optional: play a tone generated by the bridge (DTMF digits, sine
+	// sweeps, earcons) instead of audio_url or audio_data
+	Tone *Tone `protobuf:"bytes,20,opt,name=tone,proto3" json:"tone,omitempty"`
+	// Optional: the audio is raw samples in this format, with no header,
+	// instead of a file whose format is detected. Unsupported combinations
+	// are refused. Not supported with passthrough or tone.
+	Format        *AudioFormat `protobuf:"bytes,21,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -594,40 +1519,138 @@ func (x *PlayAudioRequest) GetTrackId() int32 {
 	return 0
 }
 
-// Play audio event (streaming response)
-//
-// Emitted during audio playback lifecycle.
-type PlayAudioEvent struct {
-	state protoimpl.MessageState   `protogen:"open.v1"`
-	Type  PlayAudioEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=mentra.livekit.bridge.PlayAudioEvent_EventType" json:"type,omitempty"`
-	// Request ID (matches PlayAudioRequest.request_id)
-	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Total duration in milliseconds (if known)
-	DurationMs int64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
-	// Current playback position in milliseconds
-	PositionMs int64 `protobuf:"varint,4,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
-	// Error message (if type = FAILED)
-	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	// Additional metadata
-	Metadata      map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *PlayAudioRequest) GetCrossfadeMs() int32 {
+	if x != nil {
+		return x.CrossfadeMs
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetWordTimings() []*WordTiming {
+	if x != nil {
+		return x.WordTimings
+	}
+	return nil
+}
+
+func (x *PlayAudioRequest) GetAudioData() []byte {
+	if x != nil {
+		return x.AudioData
+	}
+	return nil
+}
+
+func (x *PlayAudioRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PlayAudioRequest) GetPassthrough() bool {
+	if x != nil {
+		return x.Passthrough
+	}
+	return false
+}
+
+func (x *PlayAudioRequest) GetProgressIntervalMs() int32 {
+	if x != nil {
+		return x.ProgressIntervalMs
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetStartAtMs() int64 {
+	if x != nil {
+		return x.StartAtMs
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetLoop() bool {
+	if x != nil {
+		return x.Loop
+	}
+	return false
+}
+
+func (x *PlayAudioRequest) GetLoopCount() int32 {
+	if x != nil {
+		return x.LoopCount
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetLoopStartMs() int64 {
+	if x != nil {
+		return x.LoopStartMs
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetLoopEndMs() int64 {
+	if x != nil {
+		return x.LoopEndMs
+	}
+	return 0
+}
+
+func (x *PlayAudioRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *PlayAudioRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *PlayAudioRequest) GetTone() *Tone {
+	if x != nil {
+		return x.Tone
+	}
+	return nil
+}
+
+func (x *PlayAudioRequest) GetFormat() *AudioFormat {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+// Describes raw, headerless audio. Zero values mean signed little-endian
+// 16-bit mono at 16kHz.
+type AudioFormat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Encoding      AudioFormat_Encoding   `protobuf:"varint,1,opt,name=encoding,proto3,enum=mentra.livekit.bridge.AudioFormat_Encoding" json:"encoding,omitempty"`
+	Endianness    AudioFormat_Endianness `protobuf:"varint,2,opt,name=endianness,proto3,enum=mentra.livekit.bridge.AudioFormat_Endianness" json:"endianness,omitempty"`
+	SampleRate    int32                  `protobuf:"varint,3,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"` // 8000-192000 (0 = 16000)
+	Channels      int32                  `protobuf:"varint,4,opt,name=channels,proto3" json:"channels,omitempty"`                       // 1 or 2 (0 = 1)
+	BitDepth      int32                  `protobuf:"varint,5,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty"`       // 0 = 16, or 32 for FLOAT and 8 for MULAW/ALAW
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayAudioEvent) Reset() {
-	*x = PlayAudioEvent{}
+func (x *AudioFormat) Reset() {
+	*x = AudioFormat{}
 	mi := &file_proto_livekit_bridge_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayAudioEvent) String() string {
+func (x *AudioFormat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayAudioEvent) ProtoMessage() {}
+func (*AudioFormat) ProtoMessage() {}
 
-func (x *PlayAudioEvent) ProtoReflect() protoreflect.Message {
+func (x *AudioFormat) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_livekit_bridge_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -639,82 +1662,87 @@ func (x *PlayAudioEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayAudioEvent.ProtoReflect.Descriptor instead.
-func (*PlayAudioEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use AudioFormat.ProtoReflect.Descriptor instead.
+func (*AudioFormat) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PlayAudioEvent) GetType() PlayAudioEvent_EventType {
+func (x *AudioFormat) GetEncoding() AudioFormat_Encoding {
 	if x != nil {
-		return x.Type
+		return x.Encoding
 	}
-	return PlayAudioEvent_STARTED
+	return AudioFormat_SIGNED
 }
 
-func (x *PlayAudioEvent) GetRequestId() string {
+func (x *AudioFormat) GetEndianness() AudioFormat_Endianness {
 	if x != nil {
-		return x.RequestId
+		return x.Endianness
 	}
-	return ""
+	return AudioFormat_LITTLE
 }
 
-func (x *PlayAudioEvent) GetDurationMs() int64 {
+func (x *AudioFormat) GetSampleRate() int32 {
 	if x != nil {
-		return x.DurationMs
+		return x.SampleRate
 	}
 	return 0
 }
 
-func (x *PlayAudioEvent) GetPositionMs() int64 {
+func (x *AudioFormat) GetChannels() int32 {
 	if x != nil {
-		return x.PositionMs
+		return x.Channels
 	}
 	return 0
 }
 
-func (x *PlayAudioEvent) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *PlayAudioEvent) GetMetadata() map[string]string {
+func (x *AudioFormat) GetBitDepth() int32 {
 	if x != nil {
-		return x.Metadata
+		return x.BitDepth
 	}
-	return nil
+	return 0
 }
 
-// Stop audio playback request
-type StopAudioRequest struct {
+// A tone the bridge generates: exactly one of dtmf, segments, beeps, chime
+// or earcon
+type Tone struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (for routing)
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Optional: Specific request ID to stop (if not set, stops current playback)
-	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Reason for stopping (for debugging/logging)
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	// Track ID to stop (optional, defaults to 0 = "speaker")
-	TrackId       int32 `protobuf:"varint,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// DTMF digits (0-9, *, #, A-D; "," pauses), each tone_ms long (default
+	// 100) with gap_ms of silence between them (default 60)
+	Dtmf   string `protobuf:"bytes,1,opt,name=dtmf,proto3" json:"dtmf,omitempty"`
+	ToneMs int32  `protobuf:"varint,2,opt,name=tone_ms,json=toneMs,proto3" json:"tone_ms,omitempty"`
+	GapMs  int32  `protobuf:"varint,3,opt,name=gap_ms,json=gapMs,proto3" json:"gap_ms,omitempty"`
+	// Sines played one after another
+	Segments []*ToneSegment `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	// A built-in cue: "success", "error", "notify", "alert", "start" or
+	// "stop"
+	Earcon string `protobuf:"bytes,5,opt,name=earcon,proto3" json:"earcon,omitempty"`
+	// Level in dBFS (negative; default -12)
+	LevelDb float32 `protobuf:"fixed32,6,opt,name=level_db,json=levelDb,proto3" json:"level_db,omitempty"`
+	// A beep pattern
+	Beeps *BeepPattern `protobuf:"bytes,7,opt,name=beeps,proto3" json:"beeps,omitempty"`
+	// A notification chime
+	Chime *Chime `protobuf:"bytes,8,opt,name=chime,proto3" json:"chime,omitempty"`
+	// Shape of each sine segment, beep or chime note (optional; default a
+	// 5ms fade in and out, or for chimes an exponential ring-out)
+	Envelope      *ToneEnvelope `protobuf:"bytes,9,opt,name=envelope,proto3" json:"envelope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopAudioRequest) Reset() {
-	*x = StopAudioRequest{}
+func (x *Tone) Reset() {
+	*x = Tone{}
 	mi := &file_proto_livekit_bridge_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopAudioRequest) String() string {
+func (x *Tone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopAudioRequest) ProtoMessage() {}
+func (*Tone) ProtoMessage() {}
 
-func (x *StopAudioRequest) ProtoReflect() protoreflect.Message {
+func (x *Tone) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_livekit_bridge_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -726,64 +1754,101 @@ func (x *StopAudioRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopAudioRequest.ProtoReflect.Descriptor instead.
-func (*StopAudioRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tone.ProtoReflect.Descriptor instead.
+func (*Tone) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *StopAudioRequest) GetUserId() string {
+func (x *Tone) GetDtmf() string {
 	if x != nil {
-		return x.UserId
+		return x.Dtmf
 	}
 	return ""
 }
 
-func (x *StopAudioRequest) GetRequestId() string {
+func (x *Tone) GetToneMs() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.ToneMs
 	}
-	return ""
+	return 0
 }
 
-func (x *StopAudioRequest) GetReason() string {
+func (x *Tone) GetGapMs() int32 {
 	if x != nil {
-		return x.Reason
+		return x.GapMs
+	}
+	return 0
+}
+
+func (x *Tone) GetSegments() []*ToneSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *Tone) GetEarcon() string {
+	if x != nil {
+		return x.Earcon
 	}
 	return ""
 }
 
-func (x *StopAudioRequest) GetTrackId() int32 {
+func (x *Tone) GetLevelDb() float32 {
 	if x != nil {
-		return x.TrackId
+		return x.LevelDb
 	}
 	return 0
 }
 
-// Stop audio response
-type StopAudioResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	// Request ID that was stopped (if any)
-	StoppedRequestId string `protobuf:"bytes,3,opt,name=stopped_request_id,json=stoppedRequestId,proto3" json:"stopped_request_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Tone) GetBeeps() *BeepPattern {
+	if x != nil {
+		return x.Beeps
+	}
+	return nil
 }
 
-func (x *StopAudioResponse) Reset() {
-	*x = StopAudioResponse{}
+func (x *Tone) GetChime() *Chime {
+	if x != nil {
+		return x.Chime
+	}
+	return nil
+}
+
+func (x *Tone) GetEnvelope() *ToneEnvelope {
+	if x != nil {
+		return x.Envelope
+	}
+	return nil
+}
+
+// Beeps at one pitch: count of them, or a pattern of "." (a beep), "-" (a
+// beep three times as long) and " " (a pause)
+type BeepPattern struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hz            float32                `protobuf:"fixed32,1,opt,name=hz,proto3" json:"hz,omitempty"`                      // default 1000
+	BeepMs        int32                  `protobuf:"varint,2,opt,name=beep_ms,json=beepMs,proto3" json:"beep_ms,omitempty"` // default 100
+	GapMs         int32                  `protobuf:"varint,3,opt,name=gap_ms,json=gapMs,proto3" json:"gap_ms,omitempty"`    // silence between symbols (default beep_ms)
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                 // default 1
+	Pattern       string                 `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`              // overrides count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeepPattern) Reset() {
+	*x = BeepPattern{}
 	mi := &file_proto_livekit_bridge_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopAudioResponse) String() string {
+func (x *BeepPattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopAudioResponse) ProtoMessage() {}
+func (*BeepPattern) ProtoMessage() {}
 
-func (x *StopAudioResponse) ProtoReflect() protoreflect.Message {
+func (x *BeepPattern) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_livekit_bridge_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,55 +1860,70 @@ func (x *StopAudioResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopAudioResponse.ProtoReflect.Descriptor instead.
-func (*StopAudioResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeepPattern.ProtoReflect.Descriptor instead.
+func (*BeepPattern) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StopAudioResponse) GetSuccess() bool {
+func (x *BeepPattern) GetHz() float32 {
 	if x != nil {
-		return x.Success
+		return x.Hz
 	}
-	return false
+	return 0
 }
 
-func (x *StopAudioResponse) GetError() string {
+func (x *BeepPattern) GetBeepMs() int32 {
 	if x != nil {
-		return x.Error
+		return x.BeepMs
 	}
-	return ""
+	return 0
 }
 
-func (x *StopAudioResponse) GetStoppedRequestId() string {
+func (x *BeepPattern) GetGapMs() int32 {
 	if x != nil {
-		return x.StoppedRequestId
+		return x.GapMs
+	}
+	return 0
+}
+
+func (x *BeepPattern) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BeepPattern) GetPattern() string {
+	if x != nil {
+		return x.Pattern
 	}
 	return ""
 }
 
-// Health check request
-type HealthCheckRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional service name to check (empty = check all)
-	Service       string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+// Bell-like notes struck note_ms apart, each ringing for ring_ms
+type Chime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotesHz       []float32              `protobuf:"fixed32,1,rep,packed,name=notes_hz,json=notesHz,proto3" json:"notes_hz,omitempty"` // default 1047, 1319 (a rising major third)
+	NoteMs        int32                  `protobuf:"varint,2,opt,name=note_ms,json=noteMs,proto3" json:"note_ms,omitempty"`            // default 150
+	RingMs        int32                  `protobuf:"varint,3,opt,name=ring_ms,json=ringMs,proto3" json:"ring_ms,omitempty"`            // default 600
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
+func (x *Chime) Reset() {
+	*x = Chime{}
 	mi := &file_proto_livekit_bridge_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *Chime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*Chime) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *Chime) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_livekit_bridge_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,48 +1935,59 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Chime.ProtoReflect.Descriptor instead.
+func (*Chime) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *HealthCheckRequest) GetService() string {
+func (x *Chime) GetNotesHz() []float32 {
 	if x != nil {
-		return x.Service
+		return x.NotesHz
 	}
-	return ""
+	return nil
 }
 
-// Health check response
-type HealthCheckResponse struct {
-	state  protoimpl.MessageState            `protogen:"open.v1"`
-	Status HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=mentra.livekit.bridge.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
-	// Total active sessions
-	ActiveSessions int32 `protobuf:"varint,2,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
-	// Total active streams
-	ActiveStreams int32 `protobuf:"varint,3,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
-	// Uptime in seconds
-	UptimeSeconds int64 `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	// Additional diagnostics
-	Metadata      map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *Chime) GetNoteMs() int32 {
+	if x != nil {
+		return x.NoteMs
+	}
+	return 0
+}
+
+func (x *Chime) GetRingMs() int32 {
+	if x != nil {
+		return x.RingMs
+	}
+	return 0
+}
+
+// ADSR envelope: the level rises over attack_ms, falls over decay_ms to
+// sustain (0-1), and fades out over the last release_ms. Attack and release
+// are at least 5ms.
+type ToneEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttackMs      int32                  `protobuf:"varint,1,opt,name=attack_ms,json=attackMs,proto3" json:"attack_ms,omitempty"`
+	DecayMs       int32                  `protobuf:"varint,2,opt,name=decay_ms,json=decayMs,proto3" json:"decay_ms,omitempty"`
+	Sustain       float32                `protobuf:"fixed32,3,opt,name=sustain,proto3" json:"sustain,omitempty"`
+	ReleaseMs     int32                  `protobuf:"varint,4,opt,name=release_ms,json=releaseMs,proto3" json:"release_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *ToneEnvelope) Reset() {
+	*x = ToneEnvelope{}
 	mi := &file_proto_livekit_bridge_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *ToneEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*ToneEnvelope) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *ToneEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_livekit_bridge_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -908,70 +1999,311 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToneEnvelope.ProtoReflect.Descriptor instead.
+func (*ToneEnvelope) Descriptor() ([]byte, []int) {
 	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
+func (x *ToneEnvelope) GetAttackMs() int32 {
 	if x != nil {
-		return x.Status
+		return x.AttackMs
 	}
-	return HealthCheckResponse_UNKNOWN
+	return 0
 }
 
-func (x *HealthCheckResponse) GetActiveSessions() int32 {
+func (x *ToneEnvelope) GetDecayMs() int32 {
 	if x != nil {
-		return x.ActiveSessions
+		return x.DecayMs
 	}
 	return 0
 }
 
-func (x *HealthCheckResponse) GetActiveStreams() int32 {
+func (x *ToneEnvelope) GetSustain() float32 {
 	if x != nil {
-		return x.ActiveStreams
+		return x.Sustain
 	}
 	return 0
 }
 
-func (x *HealthCheckResponse) GetUptimeSeconds() int64 {
+func (x *ToneEnvelope) GetReleaseMs() int32 {
 	if x != nil {
-		return x.UptimeSeconds
+		return x.ReleaseMs
 	}
 	return 0
 }
 
-func (x *HealthCheckResponse) GetMetadata() map[string]string {
+// One stretch of a tone
+type ToneSegment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A sine at start_hz, sweeping (logarithmically) to end_hz when that is
+	// set and differs; both 0 = silence. 20-20000 Hz.
+	StartHz       float32 `protobuf:"fixed32,1,opt,name=start_hz,json=startHz,proto3" json:"start_hz,omitempty"`
+	EndHz         float32 `protobuf:"fixed32,2,opt,name=end_hz,json=endHz,proto3" json:"end_hz,omitempty"`
+	DurationMs    int32   `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToneSegment) Reset() {
+	*x = ToneSegment{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToneSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToneSegment) ProtoMessage() {}
+
+func (x *ToneSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToneSegment.ProtoReflect.Descriptor instead.
+func (*ToneSegment) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ToneSegment) GetStartHz() float32 {
+	if x != nil {
+		return x.StartHz
+	}
+	return 0
+}
+
+func (x *ToneSegment) GetEndHz() float32 {
+	if x != nil {
+		return x.EndHz
+	}
+	return 0
+}
+
+func (x *ToneSegment) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// When a word is spoken within an audio clip
+type WordTiming struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	StartMs       int64                  `protobuf:"varint,2,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs         int64                  `protobuf:"varint,3,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordTiming) Reset() {
+	*x = WordTiming{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordTiming) ProtoMessage() {}
+
+func (x *WordTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordTiming.ProtoReflect.Descriptor instead.
+func (*WordTiming) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WordTiming) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WordTiming) GetStartMs() int64 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *WordTiming) GetEndMs() int64 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+// Play audio event (streaming response)
+//
+// Emitted during audio playback lifecycle.
+type PlayAudioEvent struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Type  PlayAudioEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=mentra.livekit.bridge.PlayAudioEvent_EventType" json:"type,omitempty"`
+	// Request ID (matches PlayAudioRequest.request_id)
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Total duration in milliseconds (if known)
+	DurationMs int64 `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// Current playback position in milliseconds
+	PositionMs int64 `protobuf:"varint,4,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	// Error message (if type = FAILED or INTERRUPTED)
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Additional metadata
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// How much of the request's queued audio was played to the track, and how
+	// much an interruption dropped before it could play (COMPLETED, FAILED
+	// and INTERRUPTED only)
+	DeliveredMs   int64 `protobuf:"varint,7,opt,name=delivered_ms,json=deliveredMs,proto3" json:"delivered_ms,omitempty"`
+	DroppedMs     int64 `protobuf:"varint,8,opt,name=dropped_ms,json=droppedMs,proto3" json:"dropped_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayAudioEvent) Reset() {
+	*x = PlayAudioEvent{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayAudioEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayAudioEvent) ProtoMessage() {}
+
+func (x *PlayAudioEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayAudioEvent.ProtoReflect.Descriptor instead.
+func (*PlayAudioEvent) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayAudioEvent) GetType() PlayAudioEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return PlayAudioEvent_STARTED
+}
+
+func (x *PlayAudioEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *PlayAudioEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *PlayAudioEvent) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *PlayAudioEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PlayAudioEvent) GetMetadata() map[string]string {
 	if x != nil {
 		return x.Metadata
 	}
 	return nil
 }
 
-// Bridge status request/response messages
-type BridgeStatusRequest struct {
+func (x *PlayAudioEvent) GetDeliveredMs() int64 {
+	if x != nil {
+		return x.DeliveredMs
+	}
+	return 0
+}
+
+func (x *PlayAudioEvent) GetDroppedMs() int64 {
+	if x != nil {
+		return x.DroppedMs
+	}
+	return 0
+}
+
+// Stop audio playback request
+type StopAudioRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (for routing to correct room session)
-	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User ID (for routing)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional: Specific request ID to stop (if not set, stops current playback)
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Reason for stopping (for debugging/logging)
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Track ID to stop (optional, defaults to 0 = "speaker")
+	TrackId int32                     `protobuf:"varint,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Mode    StopAudioRequest_StopMode `protobuf:"varint,5,opt,name=mode,proto3,enum=mentra.livekit.bridge.StopAudioRequest_StopMode" json:"mode,omitempty"`
+	// Only stop tracks whose names match this glob, e.g. "appX:*" for every
+	// track named under appX (optional, empty = all tracks)
+	TrackPattern string `protobuf:"bytes,6,opt,name=track_pattern,json=trackPattern,proto3" json:"track_pattern,omitempty"`
+	// App the request is made for (optional): only that app's tracks are
+	// stopped, with track_pattern matched against the rest of their names
+	AppId         string `protobuf:"bytes,7,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BridgeStatusRequest) Reset() {
-	*x = BridgeStatusRequest{}
-	mi := &file_proto_livekit_bridge_proto_msgTypes[11]
+func (x *StopAudioRequest) Reset() {
+	*x = StopAudioRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeStatusRequest) String() string {
+func (x *StopAudioRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeStatusRequest) ProtoMessage() {}
+func (*StopAudioRequest) ProtoMessage() {}
 
-func (x *BridgeStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_livekit_bridge_proto_msgTypes[11]
+func (x *StopAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,51 +2314,6834 @@ func (x *BridgeStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeStatusRequest.ProtoReflect.Descriptor instead.
-func (*BridgeStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StopAudioRequest.ProtoReflect.Descriptor instead.
+func (*StopAudioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BridgeStatusRequest) GetUserId() string {
+func (x *StopAudioRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type BridgeStatusResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the bridge is currently connected to the LiveKit room
-	Connected bool `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
-	// Local participant ID (e.g., cloud-agent:{userId})
-	ParticipantId string `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
-	// Number of participants in room (including self)
-	ParticipantCount int32 `protobuf:"varint,3,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
-	// Last disconnect timestamp (milliseconds since epoch), 0 if never
-	LastDisconnectAt int64 `protobuf:"varint,4,opt,name=last_disconnect_at,json=lastDisconnectAt,proto3" json:"last_disconnect_at,omitempty"`
-	// Best-effort reason for last disconnect (may be empty)
-	LastDisconnectReason string `protobuf:"bytes,5,opt,name=last_disconnect_reason,json=lastDisconnectReason,proto3" json:"last_disconnect_reason,omitempty"`
-	// Optional: bridge/server version string for diagnostics
-	ServerVersion string `protobuf:"bytes,6,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+func (x *StopAudioRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StopAudioRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StopAudioRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *StopAudioRequest) GetMode() StopAudioRequest_StopMode {
+	if x != nil {
+		return x.Mode
+	}
+	return StopAudioRequest_FLUSH
+}
+
+func (x *StopAudioRequest) GetTrackPattern() string {
+	if x != nil {
+		return x.TrackPattern
+	}
+	return ""
+}
+
+func (x *StopAudioRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+// Stop audio response
+type StopAudioResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Request ID that was stopped (if any)
+	StoppedRequestId string `protobuf:"bytes,3,opt,name=stopped_request_id,json=stoppedRequestId,proto3" json:"stopped_request_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StopAudioResponse) Reset() {
+	*x = StopAudioResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopAudioResponse) ProtoMessage() {}
+
+func (x *StopAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopAudioResponse.ProtoReflect.Descriptor instead.
+func (*StopAudioResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StopAudioResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopAudioResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StopAudioResponse) GetStoppedRequestId() string {
+	if x != nil {
+		return x.StoppedRequestId
+	}
+	return ""
+}
+
+// Playback queue messages
+type AudioQueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Track ID (optional, defaults to 0 = "speaker")
+	TrackId int32 `protobuf:"varint,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// Track name, overriding track_id (optional)
+	TrackName string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// App the request is made for (optional): the track is named under it
+	AppId         string `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioQueueRequest) Reset() {
+	*x = AudioQueueRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioQueueRequest) ProtoMessage() {}
+
+func (x *AudioQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioQueueRequest.ProtoReflect.Descriptor instead.
+func (*AudioQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AudioQueueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AudioQueueRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *AudioQueueRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *AudioQueueRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type MoveQueuedAudioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Track ID (optional, defaults to 0 = "speaker")
+	TrackId int32 `protobuf:"varint,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// Queued clip to move
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// New place among the waiting clips (0 = next to play; past the end = last)
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// Track name, overriding track_id (optional)
+	TrackName string `protobuf:"bytes,5,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// App the request is made for (optional): the track is named under it
+	AppId         string `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveQueuedAudioRequest) Reset() {
+	*x = MoveQueuedAudioRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveQueuedAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveQueuedAudioRequest) ProtoMessage() {}
+
+func (x *MoveQueuedAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveQueuedAudioRequest.ProtoReflect.Descriptor instead.
+func (*MoveQueuedAudioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MoveQueuedAudioRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveQueuedAudioRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *MoveQueuedAudioRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *MoveQueuedAudioRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MoveQueuedAudioRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *MoveQueuedAudioRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type AudioQueueResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The clip being played first, then the waiting clips in play order
+	Items         []*QueuedAudio `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioQueueResponse) Reset() {
+	*x = AudioQueueResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioQueueResponse) ProtoMessage() {}
+
+func (x *AudioQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioQueueResponse.ProtoReflect.Descriptor instead.
+func (*AudioQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AudioQueueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AudioQueueResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AudioQueueResponse) GetItems() []*QueuedAudio {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type QueuedAudio struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	AudioUrl  string                 `protobuf:"bytes,2,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	// true for the clip currently being written to the track
+	Playing       bool `protobuf:"varint,3,opt,name=playing,proto3" json:"playing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedAudio) Reset() {
+	*x = QueuedAudio{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedAudio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedAudio) ProtoMessage() {}
+
+func (x *QueuedAudio) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedAudio.ProtoReflect.Descriptor instead.
+func (*QueuedAudio) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueuedAudio) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *QueuedAudio) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *QueuedAudio) GetPlaying() bool {
+	if x != nil {
+		return x.Playing
+	}
+	return false
+}
+
+type ClearAudioQueueResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Waiting clips that were removed; the playing clip is left to finish
+	// (use StopAudio to cut it off)
+	ClearedRequestIds []string `protobuf:"bytes,3,rep,name=cleared_request_ids,json=clearedRequestIds,proto3" json:"cleared_request_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClearAudioQueueResponse) Reset() {
+	*x = ClearAudioQueueResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAudioQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAudioQueueResponse) ProtoMessage() {}
+
+func (x *ClearAudioQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAudioQueueResponse.ProtoReflect.Descriptor instead.
+func (*ClearAudioQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearAudioQueueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClearAudioQueueResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ClearAudioQueueResponse) GetClearedRequestIds() []string {
+	if x != nil {
+		return x.ClearedRequestIds
+	}
+	return nil
+}
+
+// Seek messages
+type SeekTrackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Track ID (optional, defaults to 0 = "speaker")
+	TrackId int32 `protobuf:"varint,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	// Position in the clip, or the distance to move when relative is set
+	// (negative = back)
+	PositionMs int64 `protobuf:"varint,3,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Relative   bool  `protobuf:"varint,4,opt,name=relative,proto3" json:"relative,omitempty"`
+	// Track name, overriding track_id (optional)
+	TrackName string `protobuf:"bytes,5,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// App the request is made for (optional): the track is named under it
+	AppId         string `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeekTrackRequest) Reset() {
+	*x = SeekTrackRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeekTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeekTrackRequest) ProtoMessage() {}
+
+func (x *SeekTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeekTrackRequest.ProtoReflect.Descriptor instead.
+func (*SeekTrackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SeekTrackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SeekTrackRequest) GetTrackId() int32 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *SeekTrackRequest) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *SeekTrackRequest) GetRelative() bool {
+	if x != nil {
+		return x.Relative
+	}
+	return false
+}
+
+func (x *SeekTrackRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SeekTrackRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type SeekTrackResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Request that was moved, and the position it now plays from
+	RequestId     string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PositionMs    int64  `protobuf:"varint,4,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeekTrackResponse) Reset() {
+	*x = SeekTrackResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeekTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeekTrackResponse) ProtoMessage() {}
+
+func (x *SeekTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeekTrackResponse.ProtoReflect.Descriptor instead.
+func (*SeekTrackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SeekTrackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SeekTrackResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SeekTrackResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SeekTrackResponse) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+type TrackPauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName     string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackPauseRequest) Reset() {
+	*x = TrackPauseRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackPauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackPauseRequest) ProtoMessage() {}
+
+func (x *TrackPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackPauseRequest.ProtoReflect.Descriptor instead.
+func (*TrackPauseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TrackPauseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TrackPauseRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *TrackPauseRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type TrackPauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackPauseResponse) Reset() {
+	*x = TrackPauseResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackPauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackPauseResponse) ProtoMessage() {}
+
+func (x *TrackPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackPauseResponse.ProtoReflect.Descriptor instead.
+func (*TrackPauseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TrackPauseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TrackPauseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SetTrackVolumeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId     string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	// Linear gain (0 = silent, 1 = unchanged, up to 4)
+	Volume        float32 `protobuf:"fixed32,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackVolumeRequest) Reset() {
+	*x = SetTrackVolumeRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackVolumeRequest) ProtoMessage() {}
+
+func (x *SetTrackVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SetTrackVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTrackVolumeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTrackVolumeRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SetTrackVolumeRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetTrackVolumeRequest) GetVolume() float32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type SetTrackVolumeResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Gain applied, after clamping to 4
+	Volume        float32 `protobuf:"fixed32,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackVolumeResponse) Reset() {
+	*x = SetTrackVolumeResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackVolumeResponse) ProtoMessage() {}
+
+func (x *SetTrackVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackVolumeResponse.ProtoReflect.Descriptor instead.
+func (*SetTrackVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetTrackVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetTrackVolumeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetTrackVolumeResponse) GetVolume() float32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type SetTrackAGCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName     string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackAGCRequest) Reset() {
+	*x = SetTrackAGCRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackAGCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackAGCRequest) ProtoMessage() {}
+
+func (x *SetTrackAGCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackAGCRequest.ProtoReflect.Descriptor instead.
+func (*SetTrackAGCRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetTrackAGCRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTrackAGCRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SetTrackAGCRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetTrackAGCRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetTrackAGCResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackAGCResponse) Reset() {
+	*x = SetTrackAGCResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackAGCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackAGCResponse) ProtoMessage() {}
+
+func (x *SetTrackAGCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackAGCResponse.ProtoReflect.Descriptor instead.
+func (*SetTrackAGCResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetTrackAGCResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetTrackAGCResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TrackMuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName     string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackMuteRequest) Reset() {
+	*x = TrackMuteRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackMuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMuteRequest) ProtoMessage() {}
+
+func (x *TrackMuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMuteRequest.ProtoReflect.Descriptor instead.
+func (*TrackMuteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrackMuteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TrackMuteRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *TrackMuteRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type TrackMuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackMuteResponse) Reset() {
+	*x = TrackMuteResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackMuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackMuteResponse) ProtoMessage() {}
+
+func (x *TrackMuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackMuteResponse.ProtoReflect.Descriptor instead.
+func (*TrackMuteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TrackMuteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TrackMuteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Track registry messages
+type ListTracksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only list this app's tracks (optional)
+	AppId         string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTracksRequest) Reset() {
+	*x = ListTracksRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTracksRequest) ProtoMessage() {}
+
+func (x *ListTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTracksRequest.ProtoReflect.Descriptor instead.
+func (*ListTracksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTracksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTracksRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ListTracksResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Sorted by name
+	Tracks        []*TrackInfo `protobuf:"bytes,3,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTracksResponse) Reset() {
+	*x = ListTracksResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTracksResponse) ProtoMessage() {}
+
+func (x *ListTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTracksResponse.ProtoReflect.Descriptor instead.
+func (*ListTracksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTracksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListTracksResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListTracksResponse) GetTracks() []*TrackInfo {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type TrackInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty until the track has been published
+	Sid string `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	// App the track belongs to: the part of its name before ':' (e.g. "appX"
+	// for "appX:music"), empty for shared tracks
+	OwnerApp string `protobuf:"bytes,3,opt,name=owner_app,json=ownerApp,proto3" json:"owner_app,omitempty"`
+	// "pcm" (encoded by the bridge) or "opus" (passthrough)
+	Encoding string `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Rate and channel count of the audio published on the track
+	SampleRate int32                   `protobuf:"varint,5,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Channels   int32                   `protobuf:"varint,6,opt,name=channels,proto3" json:"channels,omitempty"`
+	State      TrackInfo_PlaybackState `protobuf:"varint,7,opt,name=state,proto3,enum=mentra.livekit.bridge.TrackInfo_PlaybackState" json:"state,omitempty"`
+	// Request currently playing on the track, if any
+	RequestId string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// EnqueueAudio clips waiting behind the current one
+	QueuedClips int32 `protobuf:"varint,9,opt,name=queued_clips,json=queuedClips,proto3" json:"queued_clips,omitempty"`
+	// Audio queued on the track that has not played yet
+	BufferedMs    int64 `protobuf:"varint,10,opt,name=buffered_ms,json=bufferedMs,proto3" json:"buffered_ms,omitempty"`
+	Muted         bool  `protobuf:"varint,11,opt,name=muted,proto3" json:"muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackInfo) Reset() {
+	*x = TrackInfo{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackInfo) ProtoMessage() {}
+
+func (x *TrackInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackInfo.ProtoReflect.Descriptor instead.
+func (*TrackInfo) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TrackInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetOwnerApp() string {
+	if x != nil {
+		return x.OwnerApp
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *TrackInfo) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *TrackInfo) GetState() TrackInfo_PlaybackState {
+	if x != nil {
+		return x.State
+	}
+	return TrackInfo_IDLE
+}
+
+func (x *TrackInfo) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetQueuedClips() int32 {
+	if x != nil {
+		return x.QueuedClips
+	}
+	return 0
+}
+
+func (x *TrackInfo) GetBufferedMs() int64 {
+	if x != nil {
+		return x.BufferedMs
+	}
+	return 0
+}
+
+func (x *TrackInfo) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+// Health check request
+type HealthCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional service name to check (empty = check all)
+	Service       string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HealthCheckRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+// Health check response
+type HealthCheckResponse struct {
+	state  protoimpl.MessageState            `protogen:"open.v1"`
+	Status HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=mentra.livekit.bridge.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
+	// Total active sessions
+	ActiveSessions int32 `protobuf:"varint,2,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	// Total active streams
+	ActiveStreams int32 `protobuf:"varint,3,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
+	// Uptime in seconds
+	UptimeSeconds int64 `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// Additional diagnostics
+	Metadata      map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthCheckResponse_UNKNOWN
+}
+
+func (x *HealthCheckResponse) GetActiveSessions() int32 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetActiveStreams() int32 {
+	if x != nil {
+		return x.ActiveStreams
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Bridge status request/response messages
+type BridgeStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing to correct room session)
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeStatusRequest) Reset() {
+	*x = BridgeStatusRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeStatusRequest) ProtoMessage() {}
+
+func (x *BridgeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeStatusRequest.ProtoReflect.Descriptor instead.
+func (*BridgeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BridgeStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BridgeStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the bridge is currently connected to the LiveKit room
+	Connected bool `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Local participant ID (e.g., cloud-agent:{userId})
+	ParticipantId string `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// Number of participants in room (including self)
+	ParticipantCount int32 `protobuf:"varint,3,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	// Last disconnect timestamp (milliseconds since epoch), 0 if never
+	LastDisconnectAt int64 `protobuf:"varint,4,opt,name=last_disconnect_at,json=lastDisconnectAt,proto3" json:"last_disconnect_at,omitempty"`
+	// Best-effort reason for last disconnect (may be empty)
+	LastDisconnectReason string `protobuf:"bytes,5,opt,name=last_disconnect_reason,json=lastDisconnectReason,proto3" json:"last_disconnect_reason,omitempty"`
+	// Optional: bridge/server version string for diagnostics
+	ServerVersion string `protobuf:"bytes,6,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	// Sessions currently held by the bridge
+	ActiveSessions int32 `protobuf:"varint,7,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	// Session cap (0 = unlimited)
+	MaxSessions int32 `protobuf:"varint,8,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	// JoinRoom calls waiting for a free session slot
+	QueuedJoins int32 `protobuf:"varint,9,opt,name=queued_joins,json=queuedJoins,proto3" json:"queued_joins,omitempty"`
+	// Live audio levels of published tracks and received mic audio
+	TrackLevels []*TrackLevel `protobuf:"bytes,10,rep,name=track_levels,json=trackLevels,proto3" json:"track_levels,omitempty"`
+	// WebRTC transport stats of published and subscribed tracks
+	TrackStats []*TrackRTCStats `protobuf:"bytes,11,rep,name=track_stats,json=trackStats,proto3" json:"track_stats,omitempty"`
+	// Every track the bridge has published for this session
+	PublishedTracks []*PublishedTrack `protobuf:"bytes,12,rep,name=published_tracks,json=publishedTracks,proto3" json:"published_tracks,omitempty"`
+	// Other participants in the room and their tracks
+	RemoteParticipants []*RemoteParticipant `protobuf:"bytes,13,rep,name=remote_participants,json=remoteParticipants,proto3" json:"remote_participants,omitempty"`
+	// connected, reconnecting or disconnected
+	ConnectionState string `protobuf:"bytes,14,opt,name=connection_state,json=connectionState,proto3" json:"connection_state,omitempty"`
+	// The bridge is shutting down and refuses new sessions
+	Draining bool `protobuf:"varint,15,opt,name=draining,proto3" json:"draining,omitempty"`
+	// The session's last successful ProbeLatency (unset if none)
+	LatencyProbe  *LatencyProbe `protobuf:"bytes,16,opt,name=latency_probe,json=latencyProbe,proto3" json:"latency_probe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeStatusResponse) Reset() {
+	*x = BridgeStatusResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeStatusResponse) ProtoMessage() {}
+
+func (x *BridgeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeStatusResponse.ProtoReflect.Descriptor instead.
+func (*BridgeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BridgeStatusResponse) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *BridgeStatusResponse) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *BridgeStatusResponse) GetParticipantCount() int32 {
+	if x != nil {
+		return x.ParticipantCount
+	}
+	return 0
+}
+
+func (x *BridgeStatusResponse) GetLastDisconnectAt() int64 {
+	if x != nil {
+		return x.LastDisconnectAt
+	}
+	return 0
+}
+
+func (x *BridgeStatusResponse) GetLastDisconnectReason() string {
+	if x != nil {
+		return x.LastDisconnectReason
+	}
+	return ""
+}
+
+func (x *BridgeStatusResponse) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *BridgeStatusResponse) GetActiveSessions() int32 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *BridgeStatusResponse) GetMaxSessions() int32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *BridgeStatusResponse) GetQueuedJoins() int32 {
+	if x != nil {
+		return x.QueuedJoins
+	}
+	return 0
+}
+
+func (x *BridgeStatusResponse) GetTrackLevels() []*TrackLevel {
+	if x != nil {
+		return x.TrackLevels
+	}
+	return nil
+}
+
+func (x *BridgeStatusResponse) GetTrackStats() []*TrackRTCStats {
+	if x != nil {
+		return x.TrackStats
+	}
+	return nil
+}
+
+func (x *BridgeStatusResponse) GetPublishedTracks() []*PublishedTrack {
+	if x != nil {
+		return x.PublishedTracks
+	}
+	return nil
+}
+
+func (x *BridgeStatusResponse) GetRemoteParticipants() []*RemoteParticipant {
+	if x != nil {
+		return x.RemoteParticipants
+	}
+	return nil
+}
+
+func (x *BridgeStatusResponse) GetConnectionState() string {
+	if x != nil {
+		return x.ConnectionState
+	}
+	return ""
+}
+
+func (x *BridgeStatusResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *BridgeStatusResponse) GetLatencyProbe() *LatencyProbe {
+	if x != nil {
+		return x.LatencyProbe
+	}
+	return nil
+}
+
+// A round-trip audio latency measurement
+type LatencyProbe struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Track the chirp was played on
+	TrackName string `protobuf:"bytes,1,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// From the chirp playing out to it arriving back at the bridge
+	LatencyMs int64 `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// How closely the audio heard back matched the chirp (0-1)
+	Correlation float32 `protobuf:"fixed32,3,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	// When the probe completed (milliseconds since epoch)
+	ProbedAt      int64 `protobuf:"varint,4,opt,name=probed_at,json=probedAt,proto3" json:"probed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencyProbe) Reset() {
+	*x = LatencyProbe{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencyProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyProbe) ProtoMessage() {}
+
+func (x *LatencyProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyProbe.ProtoReflect.Descriptor instead.
+func (*LatencyProbe) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LatencyProbe) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *LatencyProbe) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *LatencyProbe) GetCorrelation() float32 {
+	if x != nil {
+		return x.Correlation
+	}
+	return 0
+}
+
+func (x *LatencyProbe) GetProbedAt() int64 {
+	if x != nil {
+		return x.ProbedAt
+	}
+	return 0
+}
+
+// One push from WatchStatus
+type BridgeStatusUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What changed: "snapshot" (first message), "connection_state",
+	// "track_published", "track_unpublished", "participant_joined",
+	// "participant_left", "bridge_draining" or "session_closed" (last message)
+	Change string `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	// Track the change applies to, if any
+	TrackName string `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// Details of the change (e.g. state, track_sid, participant_identity)
+	Attributes  map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimestampMs int64             `protobuf:"varint,4,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Full status after the change
+	Status        *BridgeStatusResponse `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeStatusUpdate) Reset() {
+	*x = BridgeStatusUpdate{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeStatusUpdate) ProtoMessage() {}
+
+func (x *BridgeStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeStatusUpdate.ProtoReflect.Descriptor instead.
+func (*BridgeStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BridgeStatusUpdate) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *BridgeStatusUpdate) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *BridgeStatusUpdate) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BridgeStatusUpdate) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *BridgeStatusUpdate) GetStatus() *BridgeStatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A track published by the bridge
+type PublishedTrack struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sid   string                 `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	// MIME type, e.g. "audio/opus"
+	Codec string `protobuf:"bytes,3,opt,name=codec,proto3" json:"codec,omitempty"`
+	// "pcm" (encoded by the bridge) or "opus" (passthrough)
+	Encoding string `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Track creation time (milliseconds since epoch)
+	CreatedAt int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Frames written to WebRTC: 10ms PCM frames or Opus packets
+	FramesWritten int64 `protobuf:"varint,6,opt,name=frames_written,json=framesWritten,proto3" json:"frames_written,omitempty"`
+	// Last frame written (milliseconds since epoch), 0 if never
+	LastWriteAt   int64 `protobuf:"varint,7,opt,name=last_write_at,json=lastWriteAt,proto3" json:"last_write_at,omitempty"`
+	Muted         bool  `protobuf:"varint,8,opt,name=muted,proto3" json:"muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishedTrack) Reset() {
+	*x = PublishedTrack{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishedTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishedTrack) ProtoMessage() {}
+
+func (x *PublishedTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishedTrack.ProtoReflect.Descriptor instead.
+func (*PublishedTrack) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PublishedTrack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublishedTrack) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *PublishedTrack) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *PublishedTrack) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *PublishedTrack) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PublishedTrack) GetFramesWritten() int64 {
+	if x != nil {
+		return x.FramesWritten
+	}
+	return 0
+}
+
+func (x *PublishedTrack) GetLastWriteAt() int64 {
+	if x != nil {
+		return x.LastWriteAt
+	}
+	return 0
+}
+
+func (x *PublishedTrack) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+// A participant in the room other than the bridge
+type RemoteParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Sid           string                 `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tracks        []*RemoteTrack         `protobuf:"bytes,4,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteParticipant) Reset() {
+	*x = RemoteParticipant{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteParticipant) ProtoMessage() {}
+
+func (x *RemoteParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteParticipant.ProtoReflect.Descriptor instead.
+func (*RemoteParticipant) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoteParticipant) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *RemoteParticipant) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *RemoteParticipant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoteParticipant) GetTracks() []*RemoteTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+// A track published by a remote participant
+type RemoteTrack struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sid   string                 `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	// "audio" or "video"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// MIME type, when known
+	Codec string `protobuf:"bytes,4,opt,name=codec,proto3" json:"codec,omitempty"`
+	Muted bool   `protobuf:"varint,5,opt,name=muted,proto3" json:"muted,omitempty"`
+	// Whether the bridge is subscribed to it
+	Subscribed    bool `protobuf:"varint,6,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteTrack) Reset() {
+	*x = RemoteTrack{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteTrack) ProtoMessage() {}
+
+func (x *RemoteTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteTrack.ProtoReflect.Descriptor instead.
+func (*RemoteTrack) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoteTrack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoteTrack) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *RemoteTrack) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RemoteTrack) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *RemoteTrack) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *RemoteTrack) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+// Rolling audio level of one track, for VU meters and silent-mic detection
+type TrackLevel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Published track name (outgoing only)
+	TrackName string `protobuf:"bytes,1,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// "outgoing" (published by the bridge) or "incoming" (received from the room)
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Sending participant (incoming only)
+	ParticipantIdentity string `protobuf:"bytes,3,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// RMS and peak level over the last ~300ms, in dBFS (-120 = no audio)
+	RmsDbfs  float64 `protobuf:"fixed64,4,opt,name=rms_dbfs,json=rmsDbfs,proto3" json:"rms_dbfs,omitempty"`
+	PeakDbfs float64 `protobuf:"fixed64,5,opt,name=peak_dbfs,json=peakDbfs,proto3" json:"peak_dbfs,omitempty"`
+	// How long the track has carried nothing above -60 dBFS
+	SilentForMs int64 `protobuf:"varint,6,opt,name=silent_for_ms,json=silentForMs,proto3" json:"silent_for_ms,omitempty"`
+	// Clipping since the track was created (outgoing only): samples written
+	// at full scale, samples the publish chain pushed past full scale, and
+	// episodes of sustained near-full-scale audio (see CLIP_SUSTAIN_MS)
+	ClippedSamples       int64 `protobuf:"varint,7,opt,name=clipped_samples,json=clippedSamples,proto3" json:"clipped_samples,omitempty"`
+	OutputClippedSamples int64 `protobuf:"varint,8,opt,name=output_clipped_samples,json=outputClippedSamples,proto3" json:"output_clipped_samples,omitempty"`
+	ClipEvents           int64 `protobuf:"varint,9,opt,name=clip_events,json=clipEvents,proto3" json:"clip_events,omitempty"`
+	// Gain reduction the soft limiter is applying, in dB (0 = none or off)
+	SoftLimitDb   float64 `protobuf:"fixed64,10,opt,name=soft_limit_db,json=softLimitDb,proto3" json:"soft_limit_db,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackLevel) Reset() {
+	*x = TrackLevel{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackLevel) ProtoMessage() {}
+
+func (x *TrackLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackLevel.ProtoReflect.Descriptor instead.
+func (*TrackLevel) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TrackLevel) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *TrackLevel) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TrackLevel) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *TrackLevel) GetRmsDbfs() float64 {
+	if x != nil {
+		return x.RmsDbfs
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetPeakDbfs() float64 {
+	if x != nil {
+		return x.PeakDbfs
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetSilentForMs() int64 {
+	if x != nil {
+		return x.SilentForMs
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetClippedSamples() int64 {
+	if x != nil {
+		return x.ClippedSamples
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetOutputClippedSamples() int64 {
+	if x != nil {
+		return x.OutputClippedSamples
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetClipEvents() int64 {
+	if x != nil {
+		return x.ClipEvents
+	}
+	return 0
+}
+
+func (x *TrackLevel) GetSoftLimitDb() float64 {
+	if x != nil {
+		return x.SoftLimitDb
+	}
+	return 0
+}
+
+// RTP statistics of one track, for diagnosing choppy or robotic audio
+type TrackRTCStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TrackName string                 `protobuf:"bytes,1,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	TrackSid  string                 `protobuf:"bytes,2,opt,name=track_sid,json=trackSid,proto3" json:"track_sid,omitempty"`
+	// "outgoing" (published by the bridge) or "incoming" (subscribed)
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Publishing participant (incoming only)
+	ParticipantIdentity string `protobuf:"bytes,4,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Packets sent (outgoing) or received (incoming)
+	Packets uint64 `protobuf:"varint,5,opt,name=packets,proto3" json:"packets,omitempty"`
+	// Cumulative packets lost; for outgoing tracks as reported by the SFU
+	PacketsLost int64 `protobuf:"varint,6,opt,name=packets_lost,json=packetsLost,proto3" json:"packets_lost,omitempty"`
+	// Loss fraction 0..1 (outgoing: last receiver report; incoming: cumulative)
+	FractionLost float64 `protobuf:"fixed64,7,opt,name=fraction_lost,json=fractionLost,proto3" json:"fraction_lost,omitempty"`
+	JitterMs     float64 `protobuf:"fixed64,8,opt,name=jitter_ms,json=jitterMs,proto3" json:"jitter_ms,omitempty"`
+	// Round-trip time to the SFU (0 until measured)
+	RttMs float64 `protobuf:"fixed64,9,opt,name=rtt_ms,json=rttMs,proto3" json:"rtt_ms,omitempty"`
+	// Bitrate over roughly the last second or more
+	BitrateBps    float64 `protobuf:"fixed64,10,opt,name=bitrate_bps,json=bitrateBps,proto3" json:"bitrate_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackRTCStats) Reset() {
+	*x = TrackRTCStats{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackRTCStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackRTCStats) ProtoMessage() {}
+
+func (x *TrackRTCStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackRTCStats.ProtoReflect.Descriptor instead.
+func (*TrackRTCStats) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TrackRTCStats) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *TrackRTCStats) GetTrackSid() string {
+	if x != nil {
+		return x.TrackSid
+	}
+	return ""
+}
+
+func (x *TrackRTCStats) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TrackRTCStats) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *TrackRTCStats) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *TrackRTCStats) GetPacketsLost() int64 {
+	if x != nil {
+		return x.PacketsLost
+	}
+	return 0
+}
+
+func (x *TrackRTCStats) GetFractionLost() float64 {
+	if x != nil {
+		return x.FractionLost
+	}
+	return 0
+}
+
+func (x *TrackRTCStats) GetJitterMs() float64 {
+	if x != nil {
+		return x.JitterMs
+	}
+	return 0
+}
+
+func (x *TrackRTCStats) GetRttMs() float64 {
+	if x != nil {
+		return x.RttMs
+	}
+	return 0
+}
+
+func (x *TrackRTCStats) GetBitrateBps() float64 {
+	if x != nil {
+		return x.BitrateBps
+	}
+	return 0
+}
+
+// Per-participant audio subscription
+type SubscribeAudioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing to correct room session)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only audio sent by this participant (empty = any)
+	ParticipantIdentity string `protobuf:"bytes,2,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Only audio sent on this data packet topic (empty = any)
+	TrackName string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// Receive the 16kHz mono copy the bridge feeds its speech recognition
+	// (denoised when noise suppression is on) instead of audio at the
+	// session's sample_rate; the same as the default at 16kHz
+	AsrFeed       bool `protobuf:"varint,4,opt,name=asr_feed,json=asrFeed,proto3" json:"asr_feed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeAudioRequest) Reset() {
+	*x = SubscribeAudioRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAudioRequest) ProtoMessage() {}
+
+func (x *SubscribeAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAudioRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAudioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SubscribeAudioRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubscribeAudioRequest) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *SubscribeAudioRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SubscribeAudioRequest) GetAsrFeed() bool {
+	if x != nil {
+		return x.AsrFeed
+	}
+	return false
+}
+
+// Selective subscription messages
+type UpdateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing to correct room session)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Participant to match (empty = any)
+	ParticipantIdentity string `protobuf:"bytes,2,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Track name or data packet topic to match (empty = any)
+	TrackName string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// true adds the subscription, false removes it
+	Subscribe     bool `protobuf:"varint,4,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+type UpdateSubscriptionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Subscriptions in effect after the update
+	Subscriptions []*AudioSubscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionResponse) Reset() {
+	*x = UpdateSubscriptionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateSubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateSubscriptionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionResponse) GetSubscriptions() []*AudioSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type AudioSubscription struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantIdentity string                 `protobuf:"bytes,1,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	TrackName           string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AudioSubscription) Reset() {
+	*x = AudioSubscription{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioSubscription) ProtoMessage() {}
+
+func (x *AudioSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioSubscription.ProtoReflect.Descriptor instead.
+func (*AudioSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AudioSubscription) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *AudioSubscription) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+type SetMixdownGainsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Weight of each participant's audio in the mix, by identity (0 mutes,
+	// up to 4)
+	Gains         map[string]float32 `protobuf:"bytes,2,rep,name=gains,proto3" json:"gains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMixdownGainsRequest) Reset() {
+	*x = SetMixdownGainsRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMixdownGainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMixdownGainsRequest) ProtoMessage() {}
+
+func (x *SetMixdownGainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMixdownGainsRequest.ProtoReflect.Descriptor instead.
+func (*SetMixdownGainsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetMixdownGainsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMixdownGainsRequest) GetGains() map[string]float32 {
+	if x != nil {
+		return x.Gains
+	}
+	return nil
+}
+
+type SetMixdownGainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMixdownGainsResponse) Reset() {
+	*x = SetMixdownGainsResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMixdownGainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMixdownGainsResponse) ProtoMessage() {}
+
+func (x *SetMixdownGainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMixdownGainsResponse.ProtoReflect.Descriptor instead.
+func (*SetMixdownGainsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetMixdownGainsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetMixdownGainsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// One edge of a routing matrix
+type AudioRoute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "participant:<identity>" (mic audio received from it),
+	// "track:<name>" (audio played on the session's track) or
+	// "tone:<hz>" (a generated sine tone, 20-20000 Hz)
+	Input string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// "track:<name>" (a PCM track published by the session), "asr" (what
+	// the speech recognizer hears) or "recording" (a "routed" series in the
+	// session's recording)
+	Output string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	// 0-4 (0 = 1.0); leave the route out to mute it
+	Gain          float32 `protobuf:"fixed32,3,opt,name=gain,proto3" json:"gain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioRoute) Reset() {
+	*x = AudioRoute{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioRoute) ProtoMessage() {}
+
+func (x *AudioRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioRoute.ProtoReflect.Descriptor instead.
+func (*AudioRoute) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AudioRoute) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *AudioRoute) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *AudioRoute) GetGain() float32 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+type SetRoutesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The whole matrix; empty clears it
+	Routes        []*AudioRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoutesRequest) Reset() {
+	*x = SetRoutesRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoutesRequest) ProtoMessage() {}
+
+func (x *SetRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoutesRequest.ProtoReflect.Descriptor instead.
+func (*SetRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetRoutesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetRoutesRequest) GetRoutes() []*AudioRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type SetRoutesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Routes in effect after the call
+	Routes        []*AudioRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoutesResponse) Reset() {
+	*x = SetRoutesResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoutesResponse) ProtoMessage() {}
+
+func (x *SetRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoutesResponse.ProtoReflect.Descriptor instead.
+func (*SetRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetRoutesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetRoutesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetRoutesResponse) GetRoutes() []*AudioRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// Transcription publishing messages
+type PublishTranscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing to correct room session)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Participant whose speech was transcribed (empty = the bridge itself)
+	ParticipantIdentity string `protobuf:"bytes,2,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Track the speech came from, by SID or by name (one is required)
+	TrackSid      string               `protobuf:"bytes,3,opt,name=track_sid,json=trackSid,proto3" json:"track_sid,omitempty"`
+	TrackName     string               `protobuf:"bytes,4,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	Segments      []*TranscriptSegment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishTranscriptionRequest) Reset() {
+	*x = PublishTranscriptionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishTranscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTranscriptionRequest) ProtoMessage() {}
+
+func (x *PublishTranscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTranscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PublishTranscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PublishTranscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublishTranscriptionRequest) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *PublishTranscriptionRequest) GetTrackSid() string {
+	if x != nil {
+		return x.TrackSid
+	}
+	return ""
+}
+
+func (x *PublishTranscriptionRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *PublishTranscriptionRequest) GetSegments() []*TranscriptSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type TranscriptSegment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable segment ID; republish it with more text until final
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// BCP-47 language code (optional)
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	StartMs  int64  `protobuf:"varint,4,opt,name=start_ms,json=startMs,proto3" json:"start_ms,omitempty"`
+	EndMs    int64  `protobuf:"varint,5,opt,name=end_ms,json=endMs,proto3" json:"end_ms,omitempty"`
+	// false = interim (will be replaced), true = final
+	Final         bool `protobuf:"varint,6,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptSegment) Reset() {
+	*x = TranscriptSegment{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptSegment) ProtoMessage() {}
+
+func (x *TranscriptSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptSegment.ProtoReflect.Descriptor instead.
+func (*TranscriptSegment) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TranscriptSegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TranscriptSegment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TranscriptSegment) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *TranscriptSegment) GetStartMs() int64 {
+	if x != nil {
+		return x.StartMs
+	}
+	return 0
+}
+
+func (x *TranscriptSegment) GetEndMs() int64 {
+	if x != nil {
+		return x.EndMs
+	}
+	return 0
+}
+
+func (x *TranscriptSegment) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+type PublishTranscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishTranscriptionResponse) Reset() {
+	*x = PublishTranscriptionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishTranscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTranscriptionResponse) ProtoMessage() {}
+
+func (x *PublishTranscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTranscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PublishTranscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PublishTranscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PublishTranscriptionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Session event stream messages
+type StreamEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (for routing to correct room session)
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StreamEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SessionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event type, e.g. "barge_in"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// User ID the event belongs to
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Track the event concerns (may be empty)
+	TrackName string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// When the event happened (milliseconds since epoch)
+	TimestampMs int64 `protobuf:"varint,4,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Event-specific details
+	// barge_in: tracks (comma-separated playing tracks), action (none|duck|stop)
+	Attributes map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// utterance_end: the utterance's audio (16kHz mono PCM16, from
+	// audio_start_ms) when the session asked for it
+	Audio         []byte `protobuf:"bytes,6,opt,name=audio,proto3" json:"audio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SessionEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetAudio() []byte {
+	if x != nil {
+		return x.Audio
+	}
+	return nil
+}
+
+// Statistics message (for future monitoring/debugging)
+type SessionStats struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AudioFramesSent     int64                  `protobuf:"varint,2,opt,name=audio_frames_sent,json=audioFramesSent,proto3" json:"audio_frames_sent,omitempty"`
+	AudioFramesReceived int64                  `protobuf:"varint,3,opt,name=audio_frames_received,json=audioFramesReceived,proto3" json:"audio_frames_received,omitempty"`
+	BytesSent           int64                  `protobuf:"varint,4,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived       int64                  `protobuf:"varint,5,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	SessionDurationMs   int64                  `protobuf:"varint,6,opt,name=session_duration_ms,json=sessionDurationMs,proto3" json:"session_duration_ms,omitempty"`
+	RoomName            string                 `protobuf:"bytes,7,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	ParticipantCount    int32                  `protobuf:"varint,8,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SessionStats) Reset() {
+	*x = SessionStats{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStats) ProtoMessage() {}
+
+func (x *SessionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStats.ProtoReflect.Descriptor instead.
+func (*SessionStats) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SessionStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionStats) GetAudioFramesSent() int64 {
+	if x != nil {
+		return x.AudioFramesSent
+	}
+	return 0
+}
+
+func (x *SessionStats) GetAudioFramesReceived() int64 {
+	if x != nil {
+		return x.AudioFramesReceived
+	}
+	return 0
+}
+
+func (x *SessionStats) GetBytesSent() int64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *SessionStats) GetBytesReceived() int64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *SessionStats) GetSessionDurationMs() int64 {
+	if x != nil {
+		return x.SessionDurationMs
+	}
+	return 0
+}
+
+func (x *SessionStats) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *SessionStats) GetParticipantCount() int32 {
+	if x != nil {
+		return x.ParticipantCount
+	}
+	return 0
+}
+
+// Log level messages
+type SetLogLevelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// debug, info, warn or error
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Level in effect after the call
+	Level         string `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetLogLevelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetLogLevelResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// Session resource diagnostics
+type SessionResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only this user's sessions (optional; default all)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only closed sessions that still hold resources
+	LeakedOnly    bool `protobuf:"varint,2,opt,name=leaked_only,json=leakedOnly,proto3" json:"leaked_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionResourcesRequest) Reset() {
+	*x = SessionResourcesRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResourcesRequest) ProtoMessage() {}
+
+func (x *SessionResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResourcesRequest.ProtoReflect.Descriptor instead.
+func (*SessionResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SessionResourcesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionResourcesRequest) GetLeakedOnly() bool {
+	if x != nil {
+		return x.LeakedOnly
+	}
+	return false
+}
+
+type SessionResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionResources    `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionResourcesResponse) Reset() {
+	*x = SessionResourcesResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResourcesResponse) ProtoMessage() {}
+
+func (x *SessionResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResourcesResponse.ProtoReflect.Descriptor instead.
+func (*SessionResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SessionResourcesResponse) GetSessions() []*SessionResources {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionResources struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// When the session closed (milliseconds since epoch; 0 = still open)
+	ClosedAtMs int64 `protobuf:"varint,2,opt,name=closed_at_ms,json=closedAtMs,proto3" json:"closed_at_ms,omitempty"`
+	// Closed for longer than the leak grace period yet still holding resources
+	Leaked bool `protobuf:"varint,3,opt,name=leaked,proto3" json:"leaked,omitempty"`
+	// Running goroutines the session started, by what they do
+	Goroutines map[string]int32 `protobuf:"bytes,4,rep,name=goroutines,proto3" json:"goroutines,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Tracks (PCM and Opus) and publications still held
+	Tracks       int32 `protobuf:"varint,5,opt,name=tracks,proto3" json:"tracks,omitempty"`
+	Publications int32 `protobuf:"varint,6,opt,name=publications,proto3" json:"publications,omitempty"`
+	// Remote tracks still being decoded
+	RemoteTracks int32 `protobuf:"varint,7,opt,name=remote_tracks,json=remoteTracks,proto3" json:"remote_tracks,omitempty"`
+	// PlayAudio/EnqueueAudio calls still writing
+	RunningPlaybacks int32 `protobuf:"varint,8,opt,name=running_playbacks,json=runningPlaybacks,proto3" json:"running_playbacks,omitempty"`
+	// StreamEvents and SubscribeAudio channels still open
+	EventSubscribers int32 `protobuf:"varint,9,opt,name=event_subscribers,json=eventSubscribers,proto3" json:"event_subscribers,omitempty"`
+	AudioSubscribers int32 `protobuf:"varint,10,opt,name=audio_subscribers,json=audioSubscribers,proto3" json:"audio_subscribers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SessionResources) Reset() {
+	*x = SessionResources{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResources) ProtoMessage() {}
+
+func (x *SessionResources) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResources.ProtoReflect.Descriptor instead.
+func (*SessionResources) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SessionResources) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionResources) GetClosedAtMs() int64 {
+	if x != nil {
+		return x.ClosedAtMs
+	}
+	return 0
+}
+
+func (x *SessionResources) GetLeaked() bool {
+	if x != nil {
+		return x.Leaked
+	}
+	return false
+}
+
+func (x *SessionResources) GetGoroutines() map[string]int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return nil
+}
+
+func (x *SessionResources) GetTracks() int32 {
+	if x != nil {
+		return x.Tracks
+	}
+	return 0
+}
+
+func (x *SessionResources) GetPublications() int32 {
+	if x != nil {
+		return x.Publications
+	}
+	return 0
+}
+
+func (x *SessionResources) GetRemoteTracks() int32 {
+	if x != nil {
+		return x.RemoteTracks
+	}
+	return 0
+}
+
+func (x *SessionResources) GetRunningPlaybacks() int32 {
+	if x != nil {
+		return x.RunningPlaybacks
+	}
+	return 0
+}
+
+func (x *SessionResources) GetEventSubscribers() int32 {
+	if x != nil {
+		return x.EventSubscribers
+	}
+	return 0
+}
+
+func (x *SessionResources) GetAudioSubscribers() int32 {
+	if x != nil {
+		return x.AudioSubscribers
+	}
+	return 0
+}
+
+// Session history messages
+type QuerySessionEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only this user's events (optional; default all users)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only these event types (optional; default all)
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Time range (milliseconds since epoch; 0 = unbounded)
+	SinceMs int64 `protobuf:"varint,3,opt,name=since_ms,json=sinceMs,proto3" json:"since_ms,omitempty"`
+	UntilMs int64 `protobuf:"varint,4,opt,name=until_ms,json=untilMs,proto3" json:"until_ms,omitempty"`
+	// Most events to return, newest first (default and max 1000)
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySessionEventsRequest) Reset() {
+	*x = QuerySessionEventsRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySessionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySessionEventsRequest) ProtoMessage() {}
+
+func (x *QuerySessionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySessionEventsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySessionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QuerySessionEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuerySessionEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *QuerySessionEventsRequest) GetSinceMs() int64 {
+	if x != nil {
+		return x.SinceMs
+	}
+	return 0
+}
+
+func (x *QuerySessionEventsRequest) GetUntilMs() int64 {
+	if x != nil {
+		return x.UntilMs
+	}
+	return 0
+}
+
+func (x *QuerySessionEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QuerySessionEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Events        []*SessionEvent        `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuerySessionEventsResponse) Reset() {
+	*x = QuerySessionEventsResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuerySessionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySessionEventsResponse) ProtoMessage() {}
+
+func (x *QuerySessionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySessionEventsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySessionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QuerySessionEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QuerySessionEventsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QuerySessionEventsResponse) GetEvents() []*SessionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Session registry messages
+type LocateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateSessionRequest) Reset() {
+	*x = LocateSessionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateSessionRequest) ProtoMessage() {}
+
+func (x *LocateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateSessionRequest.ProtoReflect.Descriptor instead.
+func (*LocateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LocateSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LocateSessionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether any live replica owns the session
+	Found bool `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	// Owning replica and the address to dial it on
+	InstanceId string `protobuf:"bytes,4,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Address    string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the owning replica is the one that answered
+	Local           bool   `protobuf:"varint,6,opt,name=local,proto3" json:"local,omitempty"`
+	RoomName        string `protobuf:"bytes,7,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	ConnectionState string `protobuf:"bytes,8,opt,name=connection_state,json=connectionState,proto3" json:"connection_state,omitempty"`
+	// When the entry last changed (milliseconds since epoch)
+	UpdatedAtMs   int64 `protobuf:"varint,9,opt,name=updated_at_ms,json=updatedAtMs,proto3" json:"updated_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateSessionResponse) Reset() {
+	*x = LocateSessionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateSessionResponse) ProtoMessage() {}
+
+func (x *LocateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateSessionResponse.ProtoReflect.Descriptor instead.
+func (*LocateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LocateSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LocateSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LocateSessionResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *LocateSessionResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *LocateSessionResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LocateSessionResponse) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *LocateSessionResponse) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *LocateSessionResponse) GetConnectionState() string {
+	if x != nil {
+		return x.ConnectionState
+	}
+	return ""
+}
+
+func (x *LocateSessionResponse) GetUpdatedAtMs() int64 {
+	if x != nil {
+		return x.UpdatedAtMs
+	}
+	return 0
+}
+
+// Session handoff messages
+type HandoffSessionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Replica to move the session to (optional; default the least loaded
+	// replica that isn't draining)
+	TargetInstance string `protobuf:"bytes,2,opt,name=target_instance,json=targetInstance,proto3" json:"target_instance,omitempty"`
+	// Fresh LiveKit token for the target to join with (optional; default the
+	// token the session joined with)
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandoffSessionRequest) Reset() {
+	*x = HandoffSessionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoffSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffSessionRequest) ProtoMessage() {}
+
+func (x *HandoffSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffSessionRequest.ProtoReflect.Descriptor instead.
+func (*HandoffSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *HandoffSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HandoffSessionRequest) GetTargetInstance() string {
+	if x != nil {
+		return x.TargetInstance
+	}
+	return ""
+}
+
+func (x *HandoffSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type HandoffSessionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Replica that now owns the session, and where to dial it
+	InstanceId string `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Address    string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Clips resumed on the target
+	ResumedPlayback int32 `protobuf:"varint,5,opt,name=resumed_playback,json=resumedPlayback,proto3" json:"resumed_playback,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HandoffSessionResponse) Reset() {
+	*x = HandoffSessionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoffSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffSessionResponse) ProtoMessage() {}
+
+func (x *HandoffSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffSessionResponse.ProtoReflect.Descriptor instead.
+func (*HandoffSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *HandoffSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandoffSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HandoffSessionResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *HandoffSessionResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HandoffSessionResponse) GetResumedPlayback() int32 {
+	if x != nil {
+		return x.ResumedPlayback
+	}
+	return 0
+}
+
+// What a replica needs to take a session over
+type SessionSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The request the session joined with
+	Join *JoinRoomRequest `protobuf:"bytes,1,opt,name=join,proto3" json:"join,omitempty"`
+	// Playback to resume: per track, the clip playing first, then the clips
+	// queued behind it in order
+	Playback []*QueuedPlayback `protobuf:"bytes,2,rep,name=playback,proto3" json:"playback,omitempty"`
+	// When the snapshot was taken (milliseconds since epoch)
+	TakenAtMs int64 `protobuf:"varint,3,opt,name=taken_at_ms,json=takenAtMs,proto3" json:"taken_at_ms,omitempty"`
+	// Audio subscriptions set with UpdateSubscription
+	Subscriptions []*AudioSubscription `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Wake words the session listens for (no keywords = off)
+	WakeWords     *WakeWordConfig `protobuf:"bytes,5,opt,name=wake_words,json=wakeWords,proto3" json:"wake_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionSnapshot) Reset() {
+	*x = SessionSnapshot{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSnapshot) ProtoMessage() {}
+
+func (x *SessionSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSnapshot.ProtoReflect.Descriptor instead.
+func (*SessionSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SessionSnapshot) GetJoin() *JoinRoomRequest {
+	if x != nil {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *SessionSnapshot) GetPlayback() []*QueuedPlayback {
+	if x != nil {
+		return x.Playback
+	}
+	return nil
+}
+
+func (x *SessionSnapshot) GetTakenAtMs() int64 {
+	if x != nil {
+		return x.TakenAtMs
+	}
+	return 0
+}
+
+func (x *SessionSnapshot) GetSubscriptions() []*AudioSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SessionSnapshot) GetWakeWords() *WakeWordConfig {
+	if x != nil {
+		return x.WakeWords
+	}
+	return nil
+}
+
+type WakeWordConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      []string               `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Sensitivity   float32                `protobuf:"fixed32,2,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WakeWordConfig) Reset() {
+	*x = WakeWordConfig{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WakeWordConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WakeWordConfig) ProtoMessage() {}
+
+func (x *WakeWordConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WakeWordConfig.ProtoReflect.Descriptor instead.
+func (*WakeWordConfig) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WakeWordConfig) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *WakeWordConfig) GetSensitivity() float32 {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return 0
+}
+
+type QueuedPlayback struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TrackName string                 `protobuf:"bytes,1,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	Request   *PlayAudioRequest      `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// How far into the clip had been heard (0 = not started)
+	PositionMs    int64 `protobuf:"varint,3,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedPlayback) Reset() {
+	*x = QueuedPlayback{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedPlayback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedPlayback) ProtoMessage() {}
+
+func (x *QueuedPlayback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedPlayback.ProtoReflect.Descriptor instead.
+func (*QueuedPlayback) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *QueuedPlayback) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *QueuedPlayback) GetRequest() *PlayAudioRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *QueuedPlayback) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+type AcceptSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *SessionSnapshot       `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSessionRequest) Reset() {
+	*x = AcceptSessionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSessionRequest) ProtoMessage() {}
+
+func (x *AcceptSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSessionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AcceptSessionRequest) GetSnapshot() *SessionSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type AcceptSessionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error           string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ParticipantId   string                 `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ResumedPlayback int32                  `protobuf:"varint,4,opt,name=resumed_playback,json=resumedPlayback,proto3" json:"resumed_playback,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AcceptSessionResponse) Reset() {
+	*x = AcceptSessionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSessionResponse) ProtoMessage() {}
+
+func (x *AcceptSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSessionResponse.ProtoReflect.Descriptor instead.
+func (*AcceptSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AcceptSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AcceptSessionResponse) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *AcceptSessionResponse) GetResumedPlayback() int32 {
+	if x != nil {
+		return x.ResumedPlayback
+	}
+	return 0
+}
+
+// Session snapshot messages
+type SerializeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerializeSessionRequest) Reset() {
+	*x = SerializeSessionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerializeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializeSessionRequest) ProtoMessage() {}
+
+func (x *SerializeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializeSessionRequest.ProtoReflect.Descriptor instead.
+func (*SerializeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SerializeSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SerializeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Snapshot      *SessionSnapshot       `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerializeSessionResponse) Reset() {
+	*x = SerializeSessionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerializeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializeSessionResponse) ProtoMessage() {}
+
+func (x *SerializeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializeSessionResponse.ProtoReflect.Descriptor instead.
+func (*SerializeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SerializeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SerializeSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SerializeSessionResponse) GetSnapshot() *SessionSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type RestoreSessionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Snapshot to restore (optional; default the last one saved in the
+	// registry for user_id)
+	Snapshot *SessionSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Fresh LiveKit token to join with (optional; default the snapshot's)
+	Token         string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSessionRequest) Reset() {
+	*x = RestoreSessionRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSessionRequest) ProtoMessage() {}
+
+func (x *RestoreSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSessionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RestoreSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreSessionRequest) GetSnapshot() *SessionSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *RestoreSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RestoreSessionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error           string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ParticipantId   string                 `protobuf:"bytes,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ResumedPlayback int32                  `protobuf:"varint,4,opt,name=resumed_playback,json=resumedPlayback,proto3" json:"resumed_playback,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RestoreSessionResponse) Reset() {
+	*x = RestoreSessionResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSessionResponse) ProtoMessage() {}
+
+func (x *RestoreSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSessionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RestoreSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RestoreSessionResponse) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RestoreSessionResponse) GetResumedPlayback() int32 {
+	if x != nil {
+		return x.ResumedPlayback
+	}
+	return 0
+}
+
+// Usage accounting messages
+type GetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only this user's usage (optional; default all users)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only this app's usage: the namespace its tracks are named under, e.g.
+	// "appX" for "appX:music" (optional; default all)
+	AppId string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Time range (milliseconds since epoch; 0 = unbounded), rounded down to
+	// the hour usage is stored by
+	SinceMs       int64 `protobuf:"varint,3,opt,name=since_ms,json=sinceMs,proto3" json:"since_ms,omitempty"`
+	UntilMs       int64 `protobuf:"varint,4,opt,name=until_ms,json=untilMs,proto3" json:"until_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetSinceMs() int64 {
+	if x != nil {
+		return x.SinceMs
+	}
+	return 0
+}
+
+func (x *GetUsageRequest) GetUntilMs() int64 {
+	if x != nil {
+		return x.UntilMs
+	}
+	return 0
+}
+
+type AppUsage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Empty for tracks not named under an app, and for received mic audio
+	AppId            string  `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	PublishedSeconds float64 `protobuf:"fixed64,3,opt,name=published_seconds,json=publishedSeconds,proto3" json:"published_seconds,omitempty"`
+	ReceivedSeconds  float64 `protobuf:"fixed64,4,opt,name=received_seconds,json=receivedSeconds,proto3" json:"received_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AppUsage) Reset() {
+	*x = AppUsage{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppUsage) ProtoMessage() {}
+
+func (x *AppUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppUsage.ProtoReflect.Descriptor instead.
+func (*AppUsage) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AppUsage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppUsage) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppUsage) GetPublishedSeconds() float64 {
+	if x != nil {
+		return x.PublishedSeconds
+	}
+	return 0
+}
+
+func (x *AppUsage) GetReceivedSeconds() float64 {
+	if x != nil {
+		return x.ReceivedSeconds
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Usage         []*AppUsage            `protobuf:"bytes,3,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetUsageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetUsageResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetUsage() []*AppUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Recording messages
+type StartRecordingRequest struct {
+	state  protoimpl.MessageState       `protogen:"open.v1"`
+	UserId string                       `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Format StartRecordingRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=mentra.livekit.bridge.StartRecordingRequest_Format" json:"format,omitempty"`
+	// Also record what the bridge publishes, one file series per PCM track
+	// (default only incoming audio, one file series per participant)
+	IncludePublished bool `protobuf:"varint,3,opt,name=include_published,json=includePublished,proto3" json:"include_published,omitempty"`
+	// Start a new file once one reaches this size or length (0 = the
+	// bridge's RECORDING_MAX_FILE_BYTES and RECORDING_MAX_FILE_MS)
+	MaxFileBytes      int64 `protobuf:"varint,4,opt,name=max_file_bytes,json=maxFileBytes,proto3" json:"max_file_bytes,omitempty"`
+	MaxFileDurationMs int64 `protobuf:"varint,5,opt,name=max_file_duration_ms,json=maxFileDurationMs,proto3" json:"max_file_duration_ms,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartRecordingRequest) Reset() {
+	*x = StartRecordingRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingRequest) ProtoMessage() {}
+
+func (x *StartRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StartRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StartRecordingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartRecordingRequest) GetFormat() StartRecordingRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return StartRecordingRequest_WAV
+}
+
+func (x *StartRecordingRequest) GetIncludePublished() bool {
+	if x != nil {
+		return x.IncludePublished
+	}
+	return false
+}
+
+func (x *StartRecordingRequest) GetMaxFileBytes() int64 {
+	if x != nil {
+		return x.MaxFileBytes
+	}
+	return 0
+}
+
+func (x *StartRecordingRequest) GetMaxFileDurationMs() int64 {
+	if x != nil {
+		return x.MaxFileDurationMs
+	}
+	return 0
+}
+
+type StartRecordingResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Success     bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error       string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RecordingId string                 `protobuf:"bytes,3,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	// Directory the recording's files are written to
+	Directory     string `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartRecordingResponse) Reset() {
+	*x = StartRecordingResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingResponse) ProtoMessage() {}
+
+func (x *StartRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StartRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StartRecordingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StartRecordingResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StartRecordingResponse) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *StartRecordingResponse) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+type StopRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRecordingRequest) Reset() {
+	*x = StopRecordingRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRecordingRequest) ProtoMessage() {}
+
+func (x *StopRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StopRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *StopRecordingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StopRecordingResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Success     bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error       string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RecordingId string                 `protobuf:"bytes,3,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	// Every file written, in the order they were started
+	Files         []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	DurationMs    int64    `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRecordingResponse) Reset() {
+	*x = StopRecordingResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRecordingResponse) ProtoMessage() {}
+
+func (x *StopRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRecordingResponse.ProtoReflect.Descriptor instead.
+func (*StopRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StopRecordingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopRecordingResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StopRecordingResponse) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *StopRecordingResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *StopRecordingResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// Diagnostic audio dump messages
+type DumpAudioRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Why the dump was taken, for the logs (optional)
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpAudioRequest) Reset() {
+	*x = DumpAudioRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpAudioRequest) ProtoMessage() {}
+
+func (x *DumpAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpAudioRequest.ProtoReflect.Descriptor instead.
+func (*DumpAudioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DumpAudioRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DumpAudioRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DumpAudioResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Directory the dump was written to, and its files (one per source)
+	Directory     string   `protobuf:"bytes,3,opt,name=directory,proto3" json:"directory,omitempty"`
+	Files         []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DumpAudioResponse) Reset() {
+	*x = DumpAudioResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DumpAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpAudioResponse) ProtoMessage() {}
+
+func (x *DumpAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpAudioResponse.ProtoReflect.Descriptor instead.
+func (*DumpAudioResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DumpAudioResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DumpAudioResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DumpAudioResponse) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *DumpAudioResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// Streaming TTS ingestion messages
+type IngestAudioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Where and how to play; read from the first message only
+	UserId    string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName string  `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId     string  `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // plays on the app's own "<app_id>:<track_name>" track
+	RequestId string  `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // default generated
+	StopOther bool    `protobuf:"varint,5,opt,name=stop_other,json=stopOther,proto3" json:"stop_other,omitempty"`
+	Volume    float32 `protobuf:"fixed32,6,opt,name=volume,proto3" json:"volume,omitempty"` // 0 = unchanged
+	// "audio/mpeg", "audio/wav", "audio/ogg", "audio/aac", "audio/pcm" for
+	// raw little-endian PCM16 at sample_rate and channels (default 16000 mono),
+	// or "audio/PCMU" / "audio/PCMA" for G.711 (default 8000 mono);
+	// empty = detected from the first bytes
+	ContentType string `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SampleRate  int32  `protobuf:"varint,8,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Channels    int32  `protobuf:"varint,9,opt,name=channels,proto3" json:"channels,omitempty"`
+	// Audio bytes, in order, in any message
+	Data []byte `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	// Raw audio in this format, overriding content_type, sample_rate and
+	// channels; read from the first message only
+	Format *AudioFormat `protobuf:"bytes,11,opt,name=format,proto3" json:"format,omitempty"`
+	// Optional: per-stream message counter starting at 1. Data is decoded in
+	// this order, with repeats dropped; a missing message is waited for up to
+	// CHUNK_REORDER_TIMEOUT_MS (0 = in arrival order).
+	Sequence      uint64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestAudioRequest) Reset() {
+	*x = IngestAudioRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestAudioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestAudioRequest) ProtoMessage() {}
+
+func (x *IngestAudioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestAudioRequest.ProtoReflect.Descriptor instead.
+func (*IngestAudioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *IngestAudioRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IngestAudioRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *IngestAudioRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *IngestAudioRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *IngestAudioRequest) GetStopOther() bool {
+	if x != nil {
+		return x.StopOther
+	}
+	return false
+}
+
+func (x *IngestAudioRequest) GetVolume() float32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *IngestAudioRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *IngestAudioRequest) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *IngestAudioRequest) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *IngestAudioRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *IngestAudioRequest) GetFormat() *AudioFormat {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+func (x *IngestAudioRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type IngestAudioResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Success   bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error     string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RequestId string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// How long the audio took to play
+	DurationMs    int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestAudioResponse) Reset() {
+	*x = IngestAudioResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestAudioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestAudioResponse) ProtoMessage() {}
+
+func (x *IngestAudioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestAudioResponse.ProtoReflect.Descriptor instead.
+func (*IngestAudioResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *IngestAudioResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IngestAudioResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IngestAudioResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *IngestAudioResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// Wake word messages
+type SetWakeWordsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keywords []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// 0-1: higher catches more wake words but misfires more (0 = WAKE_WORD_SENSITIVITY)
+	Sensitivity   float32 `protobuf:"fixed32,3,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWakeWordsRequest) Reset() {
+	*x = SetWakeWordsRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWakeWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWakeWordsRequest) ProtoMessage() {}
+
+func (x *SetWakeWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWakeWordsRequest.ProtoReflect.Descriptor instead.
+func (*SetWakeWordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetWakeWordsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetWakeWordsRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *SetWakeWordsRequest) GetSensitivity() float32 {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return 0
+}
+
+type SetWakeWordsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The keywords now being listened for
+	Keywords      []string `protobuf:"bytes,3,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWakeWordsResponse) Reset() {
+	*x = SetWakeWordsResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWakeWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWakeWordsResponse) ProtoMessage() {}
+
+func (x *SetWakeWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWakeWordsResponse.ProtoReflect.Descriptor instead.
+func (*SetWakeWordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetWakeWordsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetWakeWordsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetWakeWordsResponse) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+// Loopback messages
+type SetLoopbackRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// true starts (or reconfigures) the echo, false stops it
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Track to echo on (optional, defaults to "loopback")
+	TrackName string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// Hold audio back this long before echoing it, so it can be heard apart
+	// from talking (0-5000)
+	DelayMs       int32 `protobuf:"varint,4,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLoopbackRequest) Reset() {
+	*x = SetLoopbackRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLoopbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLoopbackRequest) ProtoMessage() {}
+
+func (x *SetLoopbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLoopbackRequest.ProtoReflect.Descriptor instead.
+func (*SetLoopbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SetLoopbackRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetLoopbackRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetLoopbackRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SetLoopbackRequest) GetDelayMs() int32 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+type SetLoopbackResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The track audio is echoed on
+	TrackName     string `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLoopbackResponse) Reset() {
+	*x = SetLoopbackResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLoopbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLoopbackResponse) ProtoMessage() {}
+
+func (x *SetLoopbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLoopbackResponse.ProtoReflect.Descriptor instead.
+func (*SetLoopbackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetLoopbackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetLoopbackResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetLoopbackResponse) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+// Latency probe messages
+type ProbeLatencyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Track to play the chirp on (optional, defaults to "speaker")
+	TrackName string `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	// Only listen for the chirp from this participant (empty = the first
+	// one heard)
+	ParticipantIdentity string `protobuf:"bytes,3,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	// Use an audible 1-4kHz chirp even when the session's rate carries the
+	// near-ultrasonic one (for devices that filter it out)
+	Audible bool `protobuf:"varint,4,opt,name=audible,proto3" json:"audible,omitempty"`
+	// How long to listen for the chirp (default 3000, at most 10000)
+	TimeoutMs     int32 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeLatencyRequest) Reset() {
+	*x = ProbeLatencyRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeLatencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeLatencyRequest) ProtoMessage() {}
+
+func (x *ProbeLatencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeLatencyRequest.ProtoReflect.Descriptor instead.
+func (*ProbeLatencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ProbeLatencyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ProbeLatencyRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *ProbeLatencyRequest) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *ProbeLatencyRequest) GetAudible() bool {
+	if x != nil {
+		return x.Audible
+	}
+	return false
+}
+
+func (x *ProbeLatencyRequest) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ProbeLatencyResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// From the chirp playing out to it arriving back at the bridge
+	LatencyMs int64 `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// How closely the audio heard back matched the chirp (0-1)
+	Correlation   float32 `protobuf:"fixed32,4,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeLatencyResponse) Reset() {
+	*x = ProbeLatencyResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeLatencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeLatencyResponse) ProtoMessage() {}
+
+func (x *ProbeLatencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeLatencyResponse.ProtoReflect.Descriptor instead.
+func (*ProbeLatencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ProbeLatencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProbeLatencyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProbeLatencyResponse) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ProbeLatencyResponse) GetCorrelation() float32 {
+	if x != nil {
+		return x.Correlation
+	}
+	return 0
+}
+
+// Clock mapping messages
+type GetClockMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClockMappingRequest) Reset() {
+	*x = GetClockMappingRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClockMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClockMappingRequest) ProtoMessage() {}
+
+func (x *GetClockMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClockMappingRequest.ProtoReflect.Descriptor instead.
+func (*GetClockMappingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetClockMappingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetClockMappingResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The bridge's clock when the mappings were read (milliseconds since epoch)
+	BridgeTime    int64           `protobuf:"varint,3,opt,name=bridge_time,json=bridgeTime,proto3" json:"bridge_time,omitempty"`
+	Mappings      []*ClockMapping `protobuf:"bytes,4,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClockMappingResponse) Reset() {
+	*x = GetClockMappingResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClockMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClockMappingResponse) ProtoMessage() {}
+
+func (x *GetClockMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClockMappingResponse.ProtoReflect.Descriptor instead.
+func (*GetClockMappingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetClockMappingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetClockMappingResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetClockMappingResponse) GetBridgeTime() int64 {
+	if x != nil {
+		return x.BridgeTime
+	}
+	return 0
+}
+
+func (x *GetClockMappingResponse) GetMappings() []*ClockMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// How one received track's clocks relate to the bridge's. All times are
+// milliseconds since epoch; *_received_at and last_received_at are on the
+// bridge's clock, sr_sender_time on the sender's.
+type ClockMapping struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantIdentity string                 `protobuf:"bytes,1,opt,name=participant_identity,json=participantIdentity,proto3" json:"participant_identity,omitempty"`
+	TrackName           string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"`
+	TrackSid            string                 `protobuf:"bytes,3,opt,name=track_sid,json=trackSid,proto3" json:"track_sid,omitempty"`
+	Ssrc                uint32                 `protobuf:"varint,4,opt,name=ssrc,proto3" json:"ssrc,omitempty"`
+	// RTP clock rate in Hz (48000 for Opus)
+	ClockRate uint32 `protobuf:"varint,5,opt,name=clock_rate,json=clockRate,proto3" json:"clock_rate,omitempty"`
+	// The latest RTP packet received
+	LastRtpTimestamp uint32 `protobuf:"varint,6,opt,name=last_rtp_timestamp,json=lastRtpTimestamp,proto3" json:"last_rtp_timestamp,omitempty"`
+	LastReceivedAt   int64  `protobuf:"varint,7,opt,name=last_received_at,json=lastReceivedAt,proto3" json:"last_received_at,omitempty"`
+	// The latest sender report: RTP timestamp sr_rtp_timestamp was captured at
+	// sr_sender_time (unset until the first report arrives)
+	SrRtpTimestamp uint32 `protobuf:"varint,8,opt,name=sr_rtp_timestamp,json=srRtpTimestamp,proto3" json:"sr_rtp_timestamp,omitempty"`
+	SrSenderTime   int64  `protobuf:"varint,9,opt,name=sr_sender_time,json=srSenderTime,proto3" json:"sr_sender_time,omitempty"`
+	SrReceivedAt   int64  `protobuf:"varint,10,opt,name=sr_received_at,json=srReceivedAt,proto3" json:"sr_received_at,omitempty"`
+	// sr_received_at - sr_sender_time: add to a sender time to get bridge
+	// time (clock skew plus one-way network delay)
+	OffsetMs      int64 `protobuf:"varint,11,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClockMapping) Reset() {
+	*x = ClockMapping{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClockMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockMapping) ProtoMessage() {}
+
+func (x *ClockMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockMapping.ProtoReflect.Descriptor instead.
+func (*ClockMapping) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ClockMapping) GetParticipantIdentity() string {
+	if x != nil {
+		return x.ParticipantIdentity
+	}
+	return ""
+}
+
+func (x *ClockMapping) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *ClockMapping) GetTrackSid() string {
+	if x != nil {
+		return x.TrackSid
+	}
+	return ""
+}
+
+func (x *ClockMapping) GetSsrc() uint32 {
+	if x != nil {
+		return x.Ssrc
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetClockRate() uint32 {
+	if x != nil {
+		return x.ClockRate
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetLastRtpTimestamp() uint32 {
+	if x != nil {
+		return x.LastRtpTimestamp
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetLastReceivedAt() int64 {
+	if x != nil {
+		return x.LastReceivedAt
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetSrRtpTimestamp() uint32 {
+	if x != nil {
+		return x.SrRtpTimestamp
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetSrSenderTime() int64 {
+	if x != nil {
+		return x.SrSenderTime
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetSrReceivedAt() int64 {
+	if x != nil {
+		return x.SrReceivedAt
+	}
+	return 0
+}
+
+func (x *ClockMapping) GetOffsetMs() int64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+type BroadcastRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sessions to play in; each must be on this bridge
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// Where and how to play, as on PlayAudio
+	RequestId     string       `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // default generated
+	TrackName     string       `protobuf:"bytes,3,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId         string       `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // plays on the app's own "<app_id>:<track_name>" track
+	AudioUrl      string       `protobuf:"bytes,5,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	AudioData     []byte       `protobuf:"bytes,6,opt,name=audio_data,json=audioData,proto3" json:"audio_data,omitempty"` // instead of audio_url
+	ContentType   string       `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Volume        float32      `protobuf:"fixed32,8,opt,name=volume,proto3" json:"volume,omitempty"` // 0 = unchanged
+	StopOther     bool         `protobuf:"varint,9,opt,name=stop_other,json=stopOther,proto3" json:"stop_other,omitempty"`
+	Format        *AudioFormat `protobuf:"bytes,10,opt,name=format,proto3" json:"format,omitempty"` // raw audio, as on PlayAudio
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastRequest) Reset() {
+	*x = BroadcastRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRequest) ProtoMessage() {}
+
+func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BroadcastRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BroadcastRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BroadcastRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *BroadcastRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *BroadcastRequest) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *BroadcastRequest) GetAudioData() []byte {
+	if x != nil {
+		return x.AudioData
+	}
+	return nil
+}
+
+func (x *BroadcastRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *BroadcastRequest) GetVolume() float32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *BroadcastRequest) GetStopOther() bool {
+	if x != nil {
+		return x.StopOther
+	}
+	return false
+}
+
+func (x *BroadcastRequest) GetFormat() *AudioFormat {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+type BroadcastResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when at least one room played the clip
+	Success    bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RequestId  string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	DurationMs int64  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// One per user_id, in order
+	Results       []*BroadcastResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastResponse) Reset() {
+	*x = BroadcastResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastResponse) ProtoMessage() {}
+
+func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BroadcastResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BroadcastResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BroadcastResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BroadcastResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *BroadcastResponse) GetResults() []*BroadcastResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BroadcastResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Audio the room couldn't keep up with and skipped
+	DroppedMs     int64 `protobuf:"varint,4,opt,name=dropped_ms,json=droppedMs,proto3" json:"dropped_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastResult) Reset() {
+	*x = BroadcastResult{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastResult) ProtoMessage() {}
+
+func (x *BroadcastResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastResult.ProtoReflect.Descriptor instead.
+func (*BroadcastResult) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BroadcastResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BroadcastResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BroadcastResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BroadcastResult) GetDroppedMs() int64 {
+	if x != nil {
+		return x.DroppedMs
+	}
+	return 0
+}
+
+type SetMirrorRoomRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Where to mirror to: the LiveKit URL (default the session's) and a token
+	// for the room to publish in (required when enabling)
+	LivekitUrl    string `protobuf:"bytes,3,opt,name=livekit_url,json=livekitUrl,proto3" json:"livekit_url,omitempty"`
+	Token         string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMirrorRoomRequest) Reset() {
+	*x = SetMirrorRoomRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMirrorRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMirrorRoomRequest) ProtoMessage() {}
+
+func (x *SetMirrorRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMirrorRoomRequest.ProtoReflect.Descriptor instead.
+func (*SetMirrorRoomRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetMirrorRoomRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMirrorRoomRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMirrorRoomRequest) GetLivekitUrl() string {
+	if x != nil {
+		return x.LivekitUrl
+	}
+	return ""
+}
+
+func (x *SetMirrorRoomRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SetMirrorRoomResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The mirror room joined, and the bridge's identity there
+	RoomName      string `protobuf:"bytes,3,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	ParticipantId string `protobuf:"bytes,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMirrorRoomResponse) Reset() {
+	*x = SetMirrorRoomResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMirrorRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMirrorRoomResponse) ProtoMessage() {}
+
+func (x *SetMirrorRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMirrorRoomResponse.ProtoReflect.Descriptor instead.
+func (*SetMirrorRoomResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetMirrorRoomResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetMirrorRoomResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetMirrorRoomResponse) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *SetMirrorRoomResponse) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type SetTrackPanRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId     string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	// -1 (left) .. 0 (center) .. 1 (right)
+	Pan float32 `protobuf:"fixed32,4,opt,name=pan,proto3" json:"pan,omitempty"`
+	// Render as a source at the pan's azimuth (±1 = 90°), with the far ear
+	// hearing it later, quieter and muffled, instead of a plain pan
+	Spatial bool `protobuf:"varint,5,opt,name=spatial,proto3" json:"spatial,omitempty"`
+	// Stop panning the track
+	Clear         bool `protobuf:"varint,6,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackPanRequest) Reset() {
+	*x = SetTrackPanRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackPanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackPanRequest) ProtoMessage() {}
+
+func (x *SetTrackPanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackPanRequest.ProtoReflect.Descriptor instead.
+func (*SetTrackPanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SetTrackPanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTrackPanRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SetTrackPanRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetTrackPanRequest) GetPan() float32 {
+	if x != nil {
+		return x.Pan
+	}
+	return 0
+}
+
+func (x *SetTrackPanRequest) GetSpatial() bool {
+	if x != nil {
+		return x.Spatial
+	}
+	return false
+}
+
+func (x *SetTrackPanRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type SetTrackPanResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The track is playing in stereo, so the pan applies now; otherwise it
+	// does from when the track is next created
+	Stereo        bool `protobuf:"varint,3,opt,name=stereo,proto3" json:"stereo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTrackPanResponse) Reset() {
+	*x = SetTrackPanResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTrackPanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrackPanResponse) ProtoMessage() {}
+
+func (x *SetTrackPanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrackPanResponse.ProtoReflect.Descriptor instead.
+func (*SetTrackPanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SetTrackPanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetTrackPanResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SetTrackPanResponse) GetStereo() bool {
+	if x != nil {
+		return x.Stereo
+	}
+	return false
+}
+
+type SetVoiceEffectRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId     string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	// Transform received mic audio instead of a track
+	Incoming bool `protobuf:"varint,4,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// "anonymize", "deep", "chipmunk" or "robot" (optional)
+	Preset string `protobuf:"bytes,5,opt,name=preset,proto3" json:"preset,omitempty"`
+	// Pitch shift, -12..12; overrides the preset's
+	Semitones float32 `protobuf:"fixed32,6,opt,name=semitones,proto3" json:"semitones,omitempty"`
+	// Stop transforming the voice
+	Clear         bool `protobuf:"varint,7,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVoiceEffectRequest) Reset() {
+	*x = SetVoiceEffectRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVoiceEffectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVoiceEffectRequest) ProtoMessage() {}
+
+func (x *SetVoiceEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVoiceEffectRequest.ProtoReflect.Descriptor instead.
+func (*SetVoiceEffectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SetVoiceEffectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetVoiceEffectRequest) GetTrackName() string {
+	if x != nil {
+		return x.TrackName
+	}
+	return ""
+}
+
+func (x *SetVoiceEffectRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetVoiceEffectRequest) GetIncoming() bool {
+	if x != nil {
+		return x.Incoming
+	}
+	return false
+}
+
+func (x *SetVoiceEffectRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *SetVoiceEffectRequest) GetSemitones() float32 {
+	if x != nil {
+		return x.Semitones
+	}
+	return 0
+}
+
+func (x *SetVoiceEffectRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type SetVoiceEffectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BridgeStatusResponse) Reset() {
-	*x = BridgeStatusResponse{}
-	mi := &file_proto_livekit_bridge_proto_msgTypes[12]
+func (x *SetVoiceEffectResponse) Reset() {
+	*x = SetVoiceEffectResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BridgeStatusResponse) String() string {
+func (x *SetVoiceEffectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeStatusResponse) ProtoMessage() {}
+func (*SetVoiceEffectResponse) ProtoMessage() {}
 
-func (x *BridgeStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_livekit_bridge_proto_msgTypes[12]
+func (x *SetVoiceEffectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,83 +9152,51 @@ func (x *BridgeStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeStatusResponse.ProtoReflect.Descriptor instead.
-func (*BridgeStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetVoiceEffectResponse.ProtoReflect.Descriptor instead.
+func (*SetVoiceEffectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *BridgeStatusResponse) GetConnected() bool {
+func (x *SetVoiceEffectResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Connected
+		return x.Success
 	}
 	return false
 }
 
-func (x *BridgeStatusResponse) GetParticipantId() string {
-	if x != nil {
-		return x.ParticipantId
-	}
-	return ""
-}
-
-func (x *BridgeStatusResponse) GetParticipantCount() int32 {
-	if x != nil {
-		return x.ParticipantCount
-	}
-	return 0
-}
-
-func (x *BridgeStatusResponse) GetLastDisconnectAt() int64 {
-	if x != nil {
-		return x.LastDisconnectAt
-	}
-	return 0
-}
-
-func (x *BridgeStatusResponse) GetLastDisconnectReason() string {
-	if x != nil {
-		return x.LastDisconnectReason
-	}
-	return ""
-}
-
-func (x *BridgeStatusResponse) GetServerVersion() string {
+func (x *SetVoiceEffectResponse) GetError() string {
 	if x != nil {
-		return x.ServerVersion
+		return x.Error
 	}
 	return ""
 }
 
-// Statistics message (for future monitoring/debugging)
-type SessionStats struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AudioFramesSent     int64                  `protobuf:"varint,2,opt,name=audio_frames_sent,json=audioFramesSent,proto3" json:"audio_frames_sent,omitempty"`
-	AudioFramesReceived int64                  `protobuf:"varint,3,opt,name=audio_frames_received,json=audioFramesReceived,proto3" json:"audio_frames_received,omitempty"`
-	BytesSent           int64                  `protobuf:"varint,4,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
-	BytesReceived       int64                  `protobuf:"varint,5,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
-	SessionDurationMs   int64                  `protobuf:"varint,6,opt,name=session_duration_ms,json=sessionDurationMs,proto3" json:"session_duration_ms,omitempty"`
-	RoomName            string                 `protobuf:"bytes,7,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
-	ParticipantCount    int32                  `protobuf:"varint,8,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type SetPlaybackRateRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackName string                 `protobuf:"bytes,2,opt,name=track_name,json=trackName,proto3" json:"track_name,omitempty"` // default "speaker"
+	AppId     string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`             // optional: address the app's own track
+	// 0.5-2.0 (0 or 1 = as written)
+	Rate          float32 `protobuf:"fixed32,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionStats) Reset() {
-	*x = SessionStats{}
-	mi := &file_proto_livekit_bridge_proto_msgTypes[13]
+func (x *SetPlaybackRateRequest) Reset() {
+	*x = SetPlaybackRateRequest{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionStats) String() string {
+func (x *SetPlaybackRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionStats) ProtoMessage() {}
+func (*SetPlaybackRateRequest) ProtoMessage() {}
 
-func (x *SessionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_livekit_bridge_proto_msgTypes[13]
+func (x *SetPlaybackRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,72 +9207,96 @@ func (x *SessionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionStats.ProtoReflect.Descriptor instead.
-func (*SessionStats) Descriptor() ([]byte, []int) {
-	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetPlaybackRateRequest.ProtoReflect.Descriptor instead.
+func (*SetPlaybackRateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *SessionStats) GetUserId() string {
+func (x *SetPlaybackRateRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SessionStats) GetAudioFramesSent() int64 {
+func (x *SetPlaybackRateRequest) GetTrackName() string {
 	if x != nil {
-		return x.AudioFramesSent
+		return x.TrackName
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionStats) GetAudioFramesReceived() int64 {
+func (x *SetPlaybackRateRequest) GetAppId() string {
 	if x != nil {
-		return x.AudioFramesReceived
+		return x.AppId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionStats) GetBytesSent() int64 {
+func (x *SetPlaybackRateRequest) GetRate() float32 {
 	if x != nil {
-		return x.BytesSent
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *SessionStats) GetBytesReceived() int64 {
-	if x != nil {
-		return x.BytesReceived
-	}
-	return 0
+type SetPlaybackRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionStats) GetSessionDurationMs() int64 {
+func (x *SetPlaybackRateResponse) Reset() {
+	*x = SetPlaybackRateResponse{}
+	mi := &file_proto_livekit_bridge_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlaybackRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlaybackRateResponse) ProtoMessage() {}
+
+func (x *SetPlaybackRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_livekit_bridge_proto_msgTypes[110]
 	if x != nil {
-		return x.SessionDurationMs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionStats) GetRoomName() string {
+// Deprecated: Use SetPlaybackRateResponse.ProtoReflect.Descriptor instead.
+func (*SetPlaybackRateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_livekit_bridge_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SetPlaybackRateResponse) GetSuccess() bool {
 	if x != nil {
-		return x.RoomName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *SessionStats) GetParticipantCount() int32 {
+func (x *SetPlaybackRateResponse) GetError() string {
 	if x != nil {
-		return x.ParticipantCount
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
 var File_proto_livekit_bridge_proto protoreflect.FileDescriptor
 
 const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\n" +
-	"\x1aproto/livekit_bridge.proto\x12\x15mentra.livekit.bridge\"\xbb\x01\n" +
+	"\x1aproto/livekit_bridge.proto\x12\x15mentra.livekit.bridge\"\x9e\x06\n" +
 	"\n" +
 	"AudioChunk\x12\x19\n" +
 	"\bpcm_data\x18\x01 \x01(\fR\apcmData\x12\x1f\n" +
@@ -1198,14 +9305,91 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\bchannels\x18\x03 \x01(\x05R\bchannels\x12!\n" +
 	"\ftimestamp_ms\x18\x04 \x01(\x03R\vtimestampMs\x12\x17\n" +
 	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x19\n" +
-	"\btrack_id\x18\x06 \x01(\x05R\atrackId\"\xa7\x01\n" +
+	"\btrack_id\x18\x06 \x01(\x05R\atrackId\x12@\n" +
+	"\bencoding\x18\a \x01(\x0e2$.mentra.livekit.bridge.AudioEncodingR\bencoding\x12*\n" +
+	"\x11frame_duration_ms\x18\b \x01(\x05R\x0fframeDurationMs\x12!\n" +
+	"\fcrossfade_ms\x18\t \x01(\x05R\vcrossfadeMs\x121\n" +
+	"\x14participant_identity\x18\n" +
+	" \x01(\tR\x13participantIdentity\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\v \x01(\tR\ttrackName\x12\x1a\n" +
+	"\bsequence\x18\f \x01(\x04R\bsequence\x12\x1d\n" +
+	"\n" +
+	"speaker_id\x18\x0e \x01(\x05R\tspeakerId\x12#\n" +
+	"\rrtp_timestamp\x18\x0f \x01(\rR\frtpTimestamp\x12&\n" +
+	"\x0fcapture_time_ms\x18\x10 \x01(\x03R\rcaptureTimeMs\x12\x14\n" +
+	"\x05mixed\x18\x12 \x01(\bR\x05mixed\x12\x15\n" +
+	"\x06app_id\x18\r \x01(\tR\x05appId\x120\n" +
+	"\x14presentation_time_ms\x18\x11 \x01(\x03R\x12presentationTimeMs\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x13 \x01(\tR\trequestId\x12$\n" +
+	"\x0eend_of_request\x18\x14 \x01(\bR\fendOfRequest\x12\x10\n" +
+	"\x03ack\x18\x15 \x01(\bR\x03ack\x12!\n" +
+	"\fdelivered_ms\x18\x16 \x01(\x03R\vdeliveredMs\x12\x1d\n" +
+	"\n" +
+	"dropped_ms\x18\x17 \x01(\x03R\tdroppedMs\"\xca\x0f\n" +
 	"\x0fJoinRoomRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\troom_name\x18\x02 \x01(\tR\broomName\x12\x14\n" +
 	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1f\n" +
 	"\vlivekit_url\x18\x04 \x01(\tR\n" +
 	"livekitUrl\x12'\n" +
-	"\x0ftarget_identity\x18\x05 \x01(\tR\x0etargetIdentity\"\xa6\x02\n" +
+	"\x0ftarget_identity\x18\x05 \x01(\tR\x0etargetIdentity\x12+\n" +
+	"\x11noise_suppression\x18\x06 \x01(\bR\x10noiseSuppression\x12f\n" +
+	"\x10track_priorities\x18\a \x03(\v2;.mentra.livekit.bridge.JoinRoomRequest.TrackPrioritiesEntryR\x0ftrackPriorities\x12^\n" +
+	"\x0fpriority_policy\x18\b \x01(\x0e25.mentra.livekit.bridge.JoinRoomRequest.PriorityPolicyR\x0epriorityPolicy\x12\x1d\n" +
+	"\n" +
+	"max_tracks\x18\t \x01(\x05R\tmaxTracks\x120\n" +
+	"\x14bandwidth_limit_kbps\x18\n" +
+	" \x01(\x05R\x12bandwidthLimitKbps\x124\n" +
+	"\x16incoming_buffer_frames\x18\v \x01(\x05R\x14incomingBufferFrames\x12o\n" +
+	"\x18incoming_overflow_policy\x18\f \x01(\x0e25.mentra.livekit.bridge.JoinRoomRequest.OverflowPolicyR\x16incomingOverflowPolicy\x12\x10\n" +
+	"\x03asr\x18\r \x01(\bR\x03asr\x12!\n" +
+	"\fasr_language\x18\x0e \x01(\tR\vasrLanguage\x12\x1d\n" +
+	"\n" +
+	"wake_words\x18\x0f \x03(\tR\twakeWords\x122\n" +
+	"\x15wake_word_sensitivity\x18\x10 \x01(\x02R\x13wakeWordSensitivity\x12 \n" +
+	"\vendpointing\x18\x11 \x01(\bR\vendpointing\x12+\n" +
+	"\x11endpointing_audio\x18\x12 \x01(\bR\x10endpointingAudio\x124\n" +
+	"\x16endpointing_silence_ms\x18\x13 \x01(\x05R\x14endpointingSilenceMs\x12!\n" +
+	"\fsound_events\x18\x14 \x03(\tR\vsoundEvents\x12*\n" +
+	"\x11sound_event_names\x18\x15 \x03(\tR\x0fsoundEventNames\x12 \n" +
+	"\vdiarization\x18\x16 \x01(\bR\vdiarization\x12\x1f\n" +
+	"\vsample_rate\x18\x17 \x01(\x05R\n" +
+	"sampleRate\x12&\n" +
+	"\x0fclean_mic_track\x18\x18 \x01(\tR\rcleanMicTrack\x12\x1f\n" +
+	"\vshared_room\x18\x19 \x01(\bR\n" +
+	"sharedRoom\x12\x18\n" +
+	"\amixdown\x18\x1a \x01(\bR\amixdown\x12]\n" +
+	"\rmixdown_gains\x18\x1b \x03(\v28.mentra.livekit.bridge.JoinRoomRequest.MixdownGainsEntryR\fmixdownGains\x12\x1d\n" +
+	"\n" +
+	"mix_tracks\x18\x1c \x01(\bR\tmixTracks\x12$\n" +
+	"\x0emix_track_name\x18\x1d \x01(\tR\fmixTrackName\x12!\n" +
+	"\fcatchup_rate\x18\x1e \x01(\x02R\vcatchupRate\x125\n" +
+	"\x14limiter_threshold_db\x18\x1f \x01(\x02H\x00R\x12limiterThresholdDb\x88\x01\x01\x12(\n" +
+	"\rlimiter_ratio\x18  \x01(\x02H\x01R\flimiterRatio\x88\x01\x01\x12/\n" +
+	"\x11limiter_attack_ms\x18! \x01(\x05H\x02R\x0flimiterAttackMs\x88\x01\x01\x121\n" +
+	"\x12limiter_release_ms\x18\" \x01(\x05H\x03R\x10limiterReleaseMs\x88\x01\x01\x1aB\n" +
+	"\x14TrackPrioritiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
+	"\x11MixdownGainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"Z\n" +
+	"\x0ePriorityPolicy\x12\x12\n" +
+	"\x0ePOLICY_DEFAULT\x10\x00\x12\x0f\n" +
+	"\vPOLICY_NONE\x10\x01\x12\x0f\n" +
+	"\vPOLICY_DUCK\x10\x02\x12\x12\n" +
+	"\x0ePOLICY_PREEMPT\x10\x03\"m\n" +
+	"\x0eOverflowPolicy\x12\x14\n" +
+	"\x10OVERFLOW_DEFAULT\x10\x00\x12\x18\n" +
+	"\x14OVERFLOW_DROP_NEWEST\x10\x01\x12\x18\n" +
+	"\x14OVERFLOW_DROP_OLDEST\x10\x02\x12\x11\n" +
+	"\rOVERFLOW_GROW\x10\x03B\x17\n" +
+	"\x15_limiter_threshold_dbB\x10\n" +
+	"\x0e_limiter_ratioB\x14\n" +
+	"\x12_limiter_attack_msB\x15\n" +
+	"\x13_limiter_release_ms\"\xa6\x02\n" +
 	"\x10JoinRoomResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12%\n" +
@@ -1220,7 +9404,7 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"C\n" +
 	"\x11LeaveRoomResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xb9\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xf2\x05\n" +
 	"\x10PlayAudioRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
@@ -1229,7 +9413,83 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\n" +
 	"stop_other\x18\x04 \x01(\bR\tstopOther\x12\x17\n" +
 	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x19\n" +
-	"\btrack_id\x18\x06 \x01(\x05R\atrackId\"\x9d\x03\n" +
+	"\btrack_id\x18\x06 \x01(\x05R\atrackId\x12!\n" +
+	"\fcrossfade_ms\x18\a \x01(\x05R\vcrossfadeMs\x12D\n" +
+	"\fword_timings\x18\b \x03(\v2!.mentra.livekit.bridge.WordTimingR\vwordTimings\x12\x1d\n" +
+	"\n" +
+	"audio_data\x18\t \x01(\fR\taudioData\x12!\n" +
+	"\fcontent_type\x18\n" +
+	" \x01(\tR\vcontentType\x12 \n" +
+	"\vpassthrough\x18\v \x01(\bR\vpassthrough\x120\n" +
+	"\x14progress_interval_ms\x18\f \x01(\x05R\x12progressIntervalMs\x12\x1e\n" +
+	"\vstart_at_ms\x18\r \x01(\x03R\tstartAtMs\x12\x12\n" +
+	"\x04loop\x18\x0e \x01(\bR\x04loop\x12\x1d\n" +
+	"\n" +
+	"loop_count\x18\x0f \x01(\x05R\tloopCount\x12\"\n" +
+	"\rloop_start_ms\x18\x10 \x01(\x03R\vloopStartMs\x12\x1e\n" +
+	"\vloop_end_ms\x18\x11 \x01(\x03R\tloopEndMs\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x12 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x13 \x01(\tR\x05appId\x12/\n" +
+	"\x04tone\x18\x14 \x01(\v2\x1b.mentra.livekit.bridge.ToneR\x04tone\x12:\n" +
+	"\x06format\x18\x15 \x01(\v2\".mentra.livekit.bridge.AudioFormatR\x06format\"\xe8\x02\n" +
+	"\vAudioFormat\x12G\n" +
+	"\bencoding\x18\x01 \x01(\x0e2+.mentra.livekit.bridge.AudioFormat.EncodingR\bencoding\x12M\n" +
+	"\n" +
+	"endianness\x18\x02 \x01(\x0e2-.mentra.livekit.bridge.AudioFormat.EndiannessR\n" +
+	"endianness\x12\x1f\n" +
+	"\vsample_rate\x18\x03 \x01(\x05R\n" +
+	"sampleRate\x12\x1a\n" +
+	"\bchannels\x18\x04 \x01(\x05R\bchannels\x12\x1b\n" +
+	"\tbit_depth\x18\x05 \x01(\x05R\bbitDepth\"D\n" +
+	"\bEncoding\x12\n" +
+	"\n" +
+	"\x06SIGNED\x10\x00\x12\f\n" +
+	"\bUNSIGNED\x10\x01\x12\t\n" +
+	"\x05FLOAT\x10\x02\x12\t\n" +
+	"\x05MULAW\x10\x03\x12\b\n" +
+	"\x04ALAW\x10\x04\"!\n" +
+	"\n" +
+	"Endianness\x12\n" +
+	"\n" +
+	"\x06LITTLE\x10\x00\x12\a\n" +
+	"\x03BIG\x10\x01\"\xec\x02\n" +
+	"\x04Tone\x12\x12\n" +
+	"\x04dtmf\x18\x01 \x01(\tR\x04dtmf\x12\x17\n" +
+	"\atone_ms\x18\x02 \x01(\x05R\x06toneMs\x12\x15\n" +
+	"\x06gap_ms\x18\x03 \x01(\x05R\x05gapMs\x12>\n" +
+	"\bsegments\x18\x04 \x03(\v2\".mentra.livekit.bridge.ToneSegmentR\bsegments\x12\x16\n" +
+	"\x06earcon\x18\x05 \x01(\tR\x06earcon\x12\x19\n" +
+	"\blevel_db\x18\x06 \x01(\x02R\alevelDb\x128\n" +
+	"\x05beeps\x18\a \x01(\v2\".mentra.livekit.bridge.BeepPatternR\x05beeps\x122\n" +
+	"\x05chime\x18\b \x01(\v2\x1c.mentra.livekit.bridge.ChimeR\x05chime\x12?\n" +
+	"\benvelope\x18\t \x01(\v2#.mentra.livekit.bridge.ToneEnvelopeR\benvelope\"}\n" +
+	"\vBeepPattern\x12\x0e\n" +
+	"\x02hz\x18\x01 \x01(\x02R\x02hz\x12\x17\n" +
+	"\abeep_ms\x18\x02 \x01(\x05R\x06beepMs\x12\x15\n" +
+	"\x06gap_ms\x18\x03 \x01(\x05R\x05gapMs\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x18\n" +
+	"\apattern\x18\x05 \x01(\tR\apattern\"T\n" +
+	"\x05Chime\x12\x19\n" +
+	"\bnotes_hz\x18\x01 \x03(\x02R\anotesHz\x12\x17\n" +
+	"\anote_ms\x18\x02 \x01(\x05R\x06noteMs\x12\x17\n" +
+	"\aring_ms\x18\x03 \x01(\x05R\x06ringMs\"\x7f\n" +
+	"\fToneEnvelope\x12\x1b\n" +
+	"\tattack_ms\x18\x01 \x01(\x05R\battackMs\x12\x19\n" +
+	"\bdecay_ms\x18\x02 \x01(\x05R\adecayMs\x12\x18\n" +
+	"\asustain\x18\x03 \x01(\x02R\asustain\x12\x1d\n" +
+	"\n" +
+	"release_ms\x18\x04 \x01(\x05R\treleaseMs\"`\n" +
+	"\vToneSegment\x12\x19\n" +
+	"\bstart_hz\x18\x01 \x01(\x02R\astartHz\x12\x15\n" +
+	"\x06end_hz\x18\x02 \x01(\x02R\x05endHz\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x05R\n" +
+	"durationMs\"R\n" +
+	"\n" +
+	"WordTiming\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x19\n" +
+	"\bstart_ms\x18\x02 \x01(\x03R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x03 \x01(\x03R\x05endMs\"\xf0\x03\n" +
 	"\x0ePlayAudioEvent\x12C\n" +
 	"\x04type\x18\x01 \x01(\x0e2/.mentra.livekit.bridge.PlayAudioEvent.EventTypeR\x04type\x12\x1d\n" +
 	"\n" +
@@ -1239,26 +9499,144 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\vposition_ms\x18\x04 \x01(\x03R\n" +
 	"positionMs\x12\x14\n" +
 	"\x05error\x18\x05 \x01(\tR\x05error\x12O\n" +
-	"\bmetadata\x18\x06 \x03(\v23.mentra.livekit.bridge.PlayAudioEvent.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x06 \x03(\v23.mentra.livekit.bridge.PlayAudioEvent.MetadataEntryR\bmetadata\x12!\n" +
+	"\fdelivered_ms\x18\a \x01(\x03R\vdeliveredMs\x12\x1d\n" +
+	"\n" +
+	"dropped_ms\x18\b \x01(\x03R\tdroppedMs\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
 	"\tEventType\x12\v\n" +
 	"\aSTARTED\x10\x00\x12\f\n" +
 	"\bPROGRESS\x10\x01\x12\r\n" +
 	"\tCOMPLETED\x10\x02\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x03\"}\n" +
+	"\x06FAILED\x10\x03\x12\x0f\n" +
+	"\vINTERRUPTED\x10\x04\"\xb9\x02\n" +
 	"\x10StopAudioRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\tR\trequestId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
-	"\btrack_id\x18\x04 \x01(\x05R\atrackId\"q\n" +
+	"\btrack_id\x18\x04 \x01(\x05R\atrackId\x12D\n" +
+	"\x04mode\x18\x05 \x01(\x0e20.mentra.livekit.bridge.StopAudioRequest.StopModeR\x04mode\x12#\n" +
+	"\rtrack_pattern\x18\x06 \x01(\tR\ftrackPattern\x12\x15\n" +
+	"\x06app_id\x18\a \x01(\tR\x05appId\"8\n" +
+	"\bStopMode\x12\t\n" +
+	"\x05FLUSH\x10\x00\x12\r\n" +
+	"\tIMMEDIATE\x10\x01\x12\x12\n" +
+	"\x0eFINISH_CURRENT\x10\x02\"q\n" +
 	"\x11StopAudioResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12,\n" +
-	"\x12stopped_request_id\x18\x03 \x01(\tR\x10stoppedRequestId\".\n" +
+	"\x12stopped_request_id\x18\x03 \x01(\tR\x10stoppedRequestId\"}\n" +
+	"\x11AudioQueueRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\x05R\atrackId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x04 \x01(\tR\x05appId\"\xbd\x01\n" +
+	"\x16MoveQueuedAudioRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\x05R\atrackId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x05 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x06 \x01(\tR\x05appId\"~\n" +
+	"\x12AudioQueueResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x128\n" +
+	"\x05items\x18\x03 \x03(\v2\".mentra.livekit.bridge.QueuedAudioR\x05items\"c\n" +
+	"\vQueuedAudio\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
+	"\taudio_url\x18\x02 \x01(\tR\baudioUrl\x12\x18\n" +
+	"\aplaying\x18\x03 \x01(\bR\aplaying\"y\n" +
+	"\x17ClearAudioQueueResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12.\n" +
+	"\x13cleared_request_ids\x18\x03 \x03(\tR\x11clearedRequestIds\"\xb9\x01\n" +
+	"\x10SeekTrackRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btrack_id\x18\x02 \x01(\x05R\atrackId\x12\x1f\n" +
+	"\vposition_ms\x18\x03 \x01(\x03R\n" +
+	"positionMs\x12\x1a\n" +
+	"\brelative\x18\x04 \x01(\bR\brelative\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x05 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x06 \x01(\tR\x05appId\"\x83\x01\n" +
+	"\x11SeekTrackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vposition_ms\x18\x04 \x01(\x03R\n" +
+	"positionMs\"b\n" +
+	"\x11TrackPauseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\"D\n" +
+	"\x12TrackPauseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"~\n" +
+	"\x15SetTrackVolumeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\x02R\x06volume\"`\n" +
+	"\x16SetTrackVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x02R\x06volume\"}\n" +
+	"\x12SetTrackAGCRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\"E\n" +
+	"\x13SetTrackAGCResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"a\n" +
+	"\x10TrackMuteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\"C\n" +
+	"\x11TrackMuteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"C\n" +
+	"\x11ListTracksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\"~\n" +
+	"\x12ListTracksResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x128\n" +
+	"\x06tracks\x18\x03 \x03(\v2 .mentra.livekit.bridge.TrackInfoR\x06tracks\"\x9a\x03\n" +
+	"\tTrackInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\tR\x03sid\x12\x1b\n" +
+	"\towner_app\x18\x03 \x01(\tR\bownerApp\x12\x1a\n" +
+	"\bencoding\x18\x04 \x01(\tR\bencoding\x12\x1f\n" +
+	"\vsample_rate\x18\x05 \x01(\x05R\n" +
+	"sampleRate\x12\x1a\n" +
+	"\bchannels\x18\x06 \x01(\x05R\bchannels\x12D\n" +
+	"\x05state\x18\a \x01(\x0e2..mentra.livekit.bridge.TrackInfo.PlaybackStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12!\n" +
+	"\fqueued_clips\x18\t \x01(\x05R\vqueuedClips\x12\x1f\n" +
+	"\vbuffered_ms\x18\n" +
+	" \x01(\x03R\n" +
+	"bufferedMs\x12\x14\n" +
+	"\x05muted\x18\v \x01(\bR\x05muted\"2\n" +
+	"\rPlaybackState\x12\b\n" +
+	"\x04IDLE\x10\x00\x12\v\n" +
+	"\aPLAYING\x10\x01\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x02\".\n" +
 	"\x12HealthCheckRequest\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\"\xc2\x03\n" +
 	"\x13HealthCheckResponse\x12P\n" +
@@ -1276,14 +9654,172 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\vNOT_SERVING\x10\x02\x12\x13\n" +
 	"\x0fSERVICE_UNKNOWN\x10\x03\".\n" +
 	"\x13BridgeStatusRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x93\x02\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xcd\x06\n" +
 	"\x14BridgeStatusResponse\x12\x1c\n" +
 	"\tconnected\x18\x01 \x01(\bR\tconnected\x12%\n" +
 	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12+\n" +
 	"\x11participant_count\x18\x03 \x01(\x05R\x10participantCount\x12,\n" +
 	"\x12last_disconnect_at\x18\x04 \x01(\x03R\x10lastDisconnectAt\x124\n" +
 	"\x16last_disconnect_reason\x18\x05 \x01(\tR\x14lastDisconnectReason\x12%\n" +
-	"\x0eserver_version\x18\x06 \x01(\tR\rserverVersion\"\xc7\x02\n" +
+	"\x0eserver_version\x18\x06 \x01(\tR\rserverVersion\x12'\n" +
+	"\x0factive_sessions\x18\a \x01(\x05R\x0eactiveSessions\x12!\n" +
+	"\fmax_sessions\x18\b \x01(\x05R\vmaxSessions\x12!\n" +
+	"\fqueued_joins\x18\t \x01(\x05R\vqueuedJoins\x12D\n" +
+	"\ftrack_levels\x18\n" +
+	" \x03(\v2!.mentra.livekit.bridge.TrackLevelR\vtrackLevels\x12E\n" +
+	"\vtrack_stats\x18\v \x03(\v2$.mentra.livekit.bridge.TrackRTCStatsR\n" +
+	"trackStats\x12P\n" +
+	"\x10published_tracks\x18\f \x03(\v2%.mentra.livekit.bridge.PublishedTrackR\x0fpublishedTracks\x12Y\n" +
+	"\x13remote_participants\x18\r \x03(\v2(.mentra.livekit.bridge.RemoteParticipantR\x12remoteParticipants\x12)\n" +
+	"\x10connection_state\x18\x0e \x01(\tR\x0fconnectionState\x12\x1a\n" +
+	"\bdraining\x18\x0f \x01(\bR\bdraining\x12H\n" +
+	"\rlatency_probe\x18\x10 \x01(\v2#.mentra.livekit.bridge.LatencyProbeR\flatencyProbe\"\x8b\x01\n" +
+	"\fLatencyProbe\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x01 \x01(\tR\ttrackName\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x02 \x01(\x03R\tlatencyMs\x12 \n" +
+	"\vcorrelation\x18\x03 \x01(\x02R\vcorrelation\x12\x1b\n" +
+	"\tprobed_at\x18\x04 \x01(\x03R\bprobedAt\"\xcd\x02\n" +
+	"\x12BridgeStatusUpdate\x12\x16\n" +
+	"\x06change\x18\x01 \x01(\tR\x06change\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12Y\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v29.mentra.livekit.bridge.BridgeStatusUpdate.AttributesEntryR\n" +
+	"attributes\x12!\n" +
+	"\ftimestamp_ms\x18\x04 \x01(\x03R\vtimestampMs\x12C\n" +
+	"\x06status\x18\x05 \x01(\v2+.mentra.livekit.bridge.BridgeStatusResponseR\x06status\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x01\n" +
+	"\x0ePublishedTrack\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\tR\x03sid\x12\x14\n" +
+	"\x05codec\x18\x03 \x01(\tR\x05codec\x12\x1a\n" +
+	"\bencoding\x18\x04 \x01(\tR\bencoding\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12%\n" +
+	"\x0eframes_written\x18\x06 \x01(\x03R\rframesWritten\x12\"\n" +
+	"\rlast_write_at\x18\a \x01(\x03R\vlastWriteAt\x12\x14\n" +
+	"\x05muted\x18\b \x01(\bR\x05muted\"\x91\x01\n" +
+	"\x11RemoteParticipant\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\tR\x03sid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12:\n" +
+	"\x06tracks\x18\x04 \x03(\v2\".mentra.livekit.bridge.RemoteTrackR\x06tracks\"\x93\x01\n" +
+	"\vRemoteTrack\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\tR\x03sid\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05codec\x18\x04 \x01(\tR\x05codec\x12\x14\n" +
+	"\x05muted\x18\x05 \x01(\bR\x05muted\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x06 \x01(\bR\n" +
+	"subscribed\"\xfc\x02\n" +
+	"\n" +
+	"TrackLevel\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x01 \x01(\tR\ttrackName\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x121\n" +
+	"\x14participant_identity\x18\x03 \x01(\tR\x13participantIdentity\x12\x19\n" +
+	"\brms_dbfs\x18\x04 \x01(\x01R\armsDbfs\x12\x1b\n" +
+	"\tpeak_dbfs\x18\x05 \x01(\x01R\bpeakDbfs\x12\"\n" +
+	"\rsilent_for_ms\x18\x06 \x01(\x03R\vsilentForMs\x12'\n" +
+	"\x0fclipped_samples\x18\a \x01(\x03R\x0eclippedSamples\x124\n" +
+	"\x16output_clipped_samples\x18\b \x01(\x03R\x14outputClippedSamples\x12\x1f\n" +
+	"\vclip_events\x18\t \x01(\x03R\n" +
+	"clipEvents\x12\"\n" +
+	"\rsoft_limit_db\x18\n" +
+	" \x01(\x01R\vsoftLimitDb\"\xd3\x02\n" +
+	"\rTrackRTCStats\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x01 \x01(\tR\ttrackName\x12\x1b\n" +
+	"\ttrack_sid\x18\x02 \x01(\tR\btrackSid\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x121\n" +
+	"\x14participant_identity\x18\x04 \x01(\tR\x13participantIdentity\x12\x18\n" +
+	"\apackets\x18\x05 \x01(\x04R\apackets\x12!\n" +
+	"\fpackets_lost\x18\x06 \x01(\x03R\vpacketsLost\x12#\n" +
+	"\rfraction_lost\x18\a \x01(\x01R\ffractionLost\x12\x1b\n" +
+	"\tjitter_ms\x18\b \x01(\x01R\bjitterMs\x12\x15\n" +
+	"\x06rtt_ms\x18\t \x01(\x01R\x05rttMs\x12\x1f\n" +
+	"\vbitrate_bps\x18\n" +
+	" \x01(\x01R\n" +
+	"bitrateBps\"\x9d\x01\n" +
+	"\x15SubscribeAudioRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x121\n" +
+	"\x14participant_identity\x18\x02 \x01(\tR\x13participantIdentity\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12\x19\n" +
+	"\basr_feed\x18\x04 \x01(\bR\aasrFeed\"\xa4\x01\n" +
+	"\x19UpdateSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x121\n" +
+	"\x14participant_identity\x18\x02 \x01(\tR\x13participantIdentity\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12\x1c\n" +
+	"\tsubscribe\x18\x04 \x01(\bR\tsubscribe\"\x9c\x01\n" +
+	"\x1aUpdateSubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12N\n" +
+	"\rsubscriptions\x18\x03 \x03(\v2(.mentra.livekit.bridge.AudioSubscriptionR\rsubscriptions\"e\n" +
+	"\x11AudioSubscription\x121\n" +
+	"\x14participant_identity\x18\x01 \x01(\tR\x13participantIdentity\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\"\xbb\x01\n" +
+	"\x16SetMixdownGainsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12N\n" +
+	"\x05gains\x18\x02 \x03(\v28.mentra.livekit.bridge.SetMixdownGainsRequest.GainsEntryR\x05gains\x1a8\n" +
+	"\n" +
+	"GainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"I\n" +
+	"\x17SetMixdownGainsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"N\n" +
+	"\n" +
+	"AudioRoute\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\x12\x12\n" +
+	"\x04gain\x18\x03 \x01(\x02R\x04gain\"f\n" +
+	"\x10SetRoutesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\x06routes\x18\x02 \x03(\v2!.mentra.livekit.bridge.AudioRouteR\x06routes\"~\n" +
+	"\x11SetRoutesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x129\n" +
+	"\x06routes\x18\x03 \x03(\v2!.mentra.livekit.bridge.AudioRouteR\x06routes\"\xeb\x01\n" +
+	"\x1bPublishTranscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x121\n" +
+	"\x14participant_identity\x18\x02 \x01(\tR\x13participantIdentity\x12\x1b\n" +
+	"\ttrack_sid\x18\x03 \x01(\tR\btrackSid\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x04 \x01(\tR\ttrackName\x12D\n" +
+	"\bsegments\x18\x05 \x03(\v2(.mentra.livekit.bridge.TranscriptSegmentR\bsegments\"\x9b\x01\n" +
+	"\x11TranscriptSegment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x19\n" +
+	"\bstart_ms\x18\x04 \x01(\x03R\astartMs\x12\x15\n" +
+	"\x06end_ms\x18\x05 \x01(\x03R\x05endMs\x12\x14\n" +
+	"\x05final\x18\x06 \x01(\bR\x05final\"N\n" +
+	"\x1cPublishTranscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\".\n" +
+	"\x13StreamEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa7\x02\n" +
+	"\fSessionEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12!\n" +
+	"\ftimestamp_ms\x18\x04 \x01(\x03R\vtimestampMs\x12S\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v23.mentra.livekit.bridge.SessionEvent.AttributesEntryR\n" +
+	"attributes\x12\x14\n" +
+	"\x05audio\x18\x06 \x01(\fR\x05audio\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
 	"\fSessionStats\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
 	"\x11audio_frames_sent\x18\x02 \x01(\x03R\x0faudioFramesSent\x122\n" +
@@ -1293,15 +9829,363 @@ const file_proto_livekit_bridge_proto_rawDesc = "" +
 	"\x0ebytes_received\x18\x05 \x01(\x03R\rbytesReceived\x12.\n" +
 	"\x13session_duration_ms\x18\x06 \x01(\x03R\x11sessionDurationMs\x12\x1b\n" +
 	"\troom_name\x18\a \x01(\tR\broomName\x12+\n" +
-	"\x11participant_count\x18\b \x01(\x05R\x10participantCount2\xb0\x05\n" +
+	"\x11participant_count\x18\b \x01(\x05R\x10participantCount\"*\n" +
+	"\x12SetLogLevelRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"[\n" +
+	"\x13SetLogLevelResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\"S\n" +
+	"\x17SessionResourcesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vleaked_only\x18\x02 \x01(\bR\n" +
+	"leakedOnly\"_\n" +
+	"\x18SessionResourcesResponse\x12C\n" +
+	"\bsessions\x18\x01 \x03(\v2'.mentra.livekit.bridge.SessionResourcesR\bsessions\"\xe5\x03\n" +
+	"\x10SessionResources\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\fclosed_at_ms\x18\x02 \x01(\x03R\n" +
+	"closedAtMs\x12\x16\n" +
+	"\x06leaked\x18\x03 \x01(\bR\x06leaked\x12W\n" +
+	"\n" +
+	"goroutines\x18\x04 \x03(\v27.mentra.livekit.bridge.SessionResources.GoroutinesEntryR\n" +
+	"goroutines\x12\x16\n" +
+	"\x06tracks\x18\x05 \x01(\x05R\x06tracks\x12\"\n" +
+	"\fpublications\x18\x06 \x01(\x05R\fpublications\x12#\n" +
+	"\rremote_tracks\x18\a \x01(\x05R\fremoteTracks\x12+\n" +
+	"\x11running_playbacks\x18\b \x01(\x05R\x10runningPlaybacks\x12+\n" +
+	"\x11event_subscribers\x18\t \x01(\x05R\x10eventSubscribers\x12+\n" +
+	"\x11audio_subscribers\x18\n" +
+	" \x01(\x05R\x10audioSubscribers\x1a=\n" +
+	"\x0fGoroutinesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x96\x01\n" +
+	"\x19QuerySessionEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12\x19\n" +
+	"\bsince_ms\x18\x03 \x01(\x03R\asinceMs\x12\x19\n" +
+	"\buntil_ms\x18\x04 \x01(\x03R\auntilMs\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x89\x01\n" +
+	"\x1aQuerySessionEventsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12;\n" +
+	"\x06events\x18\x03 \x03(\v2#.mentra.livekit.bridge.SessionEventR\x06events\"/\n" +
+	"\x14LocateSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9a\x02\n" +
+	"\x15LocateSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\x12\x1f\n" +
+	"\vinstance_id\x18\x04 \x01(\tR\n" +
+	"instanceId\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05local\x18\x06 \x01(\bR\x05local\x12\x1b\n" +
+	"\troom_name\x18\a \x01(\tR\broomName\x12)\n" +
+	"\x10connection_state\x18\b \x01(\tR\x0fconnectionState\x12\"\n" +
+	"\rupdated_at_ms\x18\t \x01(\x03R\vupdatedAtMs\"o\n" +
+	"\x15HandoffSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0ftarget_instance\x18\x02 \x01(\tR\x0etargetInstance\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\xae\x01\n" +
+	"\x16HandoffSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1f\n" +
+	"\vinstance_id\x18\x03 \x01(\tR\n" +
+	"instanceId\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12)\n" +
+	"\x10resumed_playback\x18\x05 \x01(\x05R\x0fresumedPlayback\"\xc6\x02\n" +
+	"\x0fSessionSnapshot\x12:\n" +
+	"\x04join\x18\x01 \x01(\v2&.mentra.livekit.bridge.JoinRoomRequestR\x04join\x12A\n" +
+	"\bplayback\x18\x02 \x03(\v2%.mentra.livekit.bridge.QueuedPlaybackR\bplayback\x12\x1e\n" +
+	"\vtaken_at_ms\x18\x03 \x01(\x03R\ttakenAtMs\x12N\n" +
+	"\rsubscriptions\x18\x04 \x03(\v2(.mentra.livekit.bridge.AudioSubscriptionR\rsubscriptions\x12D\n" +
+	"\n" +
+	"wake_words\x18\x05 \x01(\v2%.mentra.livekit.bridge.WakeWordConfigR\twakeWords\"N\n" +
+	"\x0eWakeWordConfig\x12\x1a\n" +
+	"\bkeywords\x18\x01 \x03(\tR\bkeywords\x12 \n" +
+	"\vsensitivity\x18\x02 \x01(\x02R\vsensitivity\"\x93\x01\n" +
+	"\x0eQueuedPlayback\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x01 \x01(\tR\ttrackName\x12A\n" +
+	"\arequest\x18\x02 \x01(\v2'.mentra.livekit.bridge.PlayAudioRequestR\arequest\x12\x1f\n" +
+	"\vposition_ms\x18\x03 \x01(\x03R\n" +
+	"positionMs\"Z\n" +
+	"\x14AcceptSessionRequest\x12B\n" +
+	"\bsnapshot\x18\x01 \x01(\v2&.mentra.livekit.bridge.SessionSnapshotR\bsnapshot\"\x99\x01\n" +
+	"\x15AcceptSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12%\n" +
+	"\x0eparticipant_id\x18\x03 \x01(\tR\rparticipantId\x12)\n" +
+	"\x10resumed_playback\x18\x04 \x01(\x05R\x0fresumedPlayback\"2\n" +
+	"\x17SerializeSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8e\x01\n" +
+	"\x18SerializeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12B\n" +
+	"\bsnapshot\x18\x03 \x01(\v2&.mentra.livekit.bridge.SessionSnapshotR\bsnapshot\"\x8a\x01\n" +
+	"\x15RestoreSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\bsnapshot\x18\x02 \x01(\v2&.mentra.livekit.bridge.SessionSnapshotR\bsnapshot\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"\x9a\x01\n" +
+	"\x16RestoreSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12%\n" +
+	"\x0eparticipant_id\x18\x03 \x01(\tR\rparticipantId\x12)\n" +
+	"\x10resumed_playback\x18\x04 \x01(\x05R\x0fresumedPlayback\"w\n" +
+	"\x0fGetUsageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x19\n" +
+	"\bsince_ms\x18\x03 \x01(\x03R\asinceMs\x12\x19\n" +
+	"\buntil_ms\x18\x04 \x01(\x03R\auntilMs\"\x92\x01\n" +
+	"\bAppUsage\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12+\n" +
+	"\x11published_seconds\x18\x03 \x01(\x01R\x10publishedSeconds\x12)\n" +
+	"\x10received_seconds\x18\x04 \x01(\x01R\x0freceivedSeconds\"y\n" +
+	"\x10GetUsageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x125\n" +
+	"\x05usage\x18\x03 \x03(\v2\x1f.mentra.livekit.bridge.AppUsageR\x05usage\"\xa2\x02\n" +
+	"\x15StartRecordingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12K\n" +
+	"\x06format\x18\x02 \x01(\x0e23.mentra.livekit.bridge.StartRecordingRequest.FormatR\x06format\x12+\n" +
+	"\x11include_published\x18\x03 \x01(\bR\x10includePublished\x12$\n" +
+	"\x0emax_file_bytes\x18\x04 \x01(\x03R\fmaxFileBytes\x12/\n" +
+	"\x14max_file_duration_ms\x18\x05 \x01(\x03R\x11maxFileDurationMs\"\x1f\n" +
+	"\x06Format\x12\a\n" +
+	"\x03WAV\x10\x00\x12\f\n" +
+	"\bOGG_OPUS\x10\x01\"\x89\x01\n" +
+	"\x16StartRecordingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12!\n" +
+	"\frecording_id\x18\x03 \x01(\tR\vrecordingId\x12\x1c\n" +
+	"\tdirectory\x18\x04 \x01(\tR\tdirectory\"/\n" +
+	"\x14StopRecordingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa1\x01\n" +
+	"\x15StopRecordingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12!\n" +
+	"\frecording_id\x18\x03 \x01(\tR\vrecordingId\x12\x14\n" +
+	"\x05files\x18\x04 \x03(\tR\x05files\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"C\n" +
+	"\x10DumpAudioRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"w\n" +
+	"\x11DumpAudioResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1c\n" +
+	"\tdirectory\x18\x03 \x01(\tR\tdirectory\x12\x14\n" +
+	"\x05files\x18\x04 \x03(\tR\x05files\"\x85\x03\n" +
+	"\x12IngestAudioRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"stop_other\x18\x05 \x01(\bR\tstopOther\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x02R\x06volume\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x1f\n" +
+	"\vsample_rate\x18\b \x01(\x05R\n" +
+	"sampleRate\x12\x1a\n" +
+	"\bchannels\x18\t \x01(\x05R\bchannels\x12\x12\n" +
+	"\x04data\x18\n" +
+	" \x01(\fR\x04data\x12:\n" +
+	"\x06format\x18\v \x01(\v2\".mentra.livekit.bridge.AudioFormatR\x06format\x12\x1a\n" +
+	"\bsequence\x18\f \x01(\x04R\bsequence\"\x85\x01\n" +
+	"\x13IngestAudioResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\"l\n" +
+	"\x13SetWakeWordsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bkeywords\x18\x02 \x03(\tR\bkeywords\x12 \n" +
+	"\vsensitivity\x18\x03 \x01(\x02R\vsensitivity\"b\n" +
+	"\x14SetWakeWordsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1a\n" +
+	"\bkeywords\x18\x03 \x03(\tR\bkeywords\"\x81\x01\n" +
+	"\x12SetLoopbackRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12\x19\n" +
+	"\bdelay_ms\x18\x04 \x01(\x05R\adelayMs\"d\n" +
+	"\x13SetLoopbackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\"\xb9\x01\n" +
+	"\x13ProbeLatencyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x121\n" +
+	"\x14participant_identity\x18\x03 \x01(\tR\x13participantIdentity\x12\x18\n" +
+	"\aaudible\x18\x04 \x01(\bR\aaudible\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\x05R\ttimeoutMs\"\x87\x01\n" +
+	"\x14ProbeLatencyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\x12 \n" +
+	"\vcorrelation\x18\x04 \x01(\x02R\vcorrelation\"1\n" +
+	"\x16GetClockMappingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xab\x01\n" +
+	"\x17GetClockMappingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1f\n" +
+	"\vbridge_time\x18\x03 \x01(\x03R\n" +
+	"bridgeTime\x12?\n" +
+	"\bmappings\x18\x04 \x03(\v2#.mentra.livekit.bridge.ClockMappingR\bmappings\"\x9b\x03\n" +
+	"\fClockMapping\x121\n" +
+	"\x14participant_identity\x18\x01 \x01(\tR\x13participantIdentity\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x1b\n" +
+	"\ttrack_sid\x18\x03 \x01(\tR\btrackSid\x12\x12\n" +
+	"\x04ssrc\x18\x04 \x01(\rR\x04ssrc\x12\x1d\n" +
+	"\n" +
+	"clock_rate\x18\x05 \x01(\rR\tclockRate\x12,\n" +
+	"\x12last_rtp_timestamp\x18\x06 \x01(\rR\x10lastRtpTimestamp\x12(\n" +
+	"\x10last_received_at\x18\a \x01(\x03R\x0elastReceivedAt\x12(\n" +
+	"\x10sr_rtp_timestamp\x18\b \x01(\rR\x0esrRtpTimestamp\x12$\n" +
+	"\x0esr_sender_time\x18\t \x01(\x03R\fsrSenderTime\x12$\n" +
+	"\x0esr_received_at\x18\n" +
+	" \x01(\x03R\fsrReceivedAt\x12\x1b\n" +
+	"\toffset_ms\x18\v \x01(\x03R\boffsetMs\"\xd4\x02\n" +
+	"\x10BroadcastRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x03 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x04 \x01(\tR\x05appId\x12\x1b\n" +
+	"\taudio_url\x18\x05 \x01(\tR\baudioUrl\x12\x1d\n" +
+	"\n" +
+	"audio_data\x18\x06 \x01(\fR\taudioData\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06volume\x18\b \x01(\x02R\x06volume\x12\x1d\n" +
+	"\n" +
+	"stop_other\x18\t \x01(\bR\tstopOther\x12:\n" +
+	"\x06format\x18\n" +
+	" \x01(\v2\".mentra.livekit.bridge.AudioFormatR\x06format\"\xc5\x01\n" +
+	"\x11BroadcastResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12@\n" +
+	"\aresults\x18\x05 \x03(\v2&.mentra.livekit.bridge.BroadcastResultR\aresults\"y\n" +
+	"\x0fBroadcastResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"dropped_ms\x18\x04 \x01(\x03R\tdroppedMs\"\x80\x01\n" +
+	"\x14SetMirrorRoomRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vlivekit_url\x18\x03 \x01(\tR\n" +
+	"livekitUrl\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"\x8b\x01\n" +
+	"\x15SetMirrorRoomResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1b\n" +
+	"\troom_name\x18\x03 \x01(\tR\broomName\x12%\n" +
+	"\x0eparticipant_id\x18\x04 \x01(\tR\rparticipantId\"\xa5\x01\n" +
+	"\x12SetTrackPanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x10\n" +
+	"\x03pan\x18\x04 \x01(\x02R\x03pan\x12\x18\n" +
+	"\aspatial\x18\x05 \x01(\bR\aspatial\x12\x14\n" +
+	"\x05clear\x18\x06 \x01(\bR\x05clear\"]\n" +
+	"\x13SetTrackPanResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06stereo\x18\x03 \x01(\bR\x06stereo\"\xce\x01\n" +
+	"\x15SetVoiceEffectRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x1a\n" +
+	"\bincoming\x18\x04 \x01(\bR\bincoming\x12\x16\n" +
+	"\x06preset\x18\x05 \x01(\tR\x06preset\x12\x1c\n" +
+	"\tsemitones\x18\x06 \x01(\x02R\tsemitones\x12\x14\n" +
+	"\x05clear\x18\a \x01(\bR\x05clear\"H\n" +
+	"\x16SetVoiceEffectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"{\n" +
+	"\x16SetPlaybackRateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"track_name\x18\x02 \x01(\tR\ttrackName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x02R\x04rate\"I\n" +
+	"\x17SetPlaybackRateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error*$\n" +
+	"\rAudioEncoding\x12\t\n" +
+	"\x05PCM16\x10\x00\x12\b\n" +
+	"\x04OPUS\x10\x012\xb2'\n" +
 	"\rLiveKitBridge\x12W\n" +
 	"\vStreamAudio\x12!.mentra.livekit.bridge.AudioChunk\x1a!.mentra.livekit.bridge.AudioChunk(\x010\x01\x12[\n" +
 	"\bJoinRoom\x12&.mentra.livekit.bridge.JoinRoomRequest\x1a'.mentra.livekit.bridge.JoinRoomResponse\x12^\n" +
 	"\tLeaveRoom\x12'.mentra.livekit.bridge.LeaveRoomRequest\x1a(.mentra.livekit.bridge.LeaveRoomResponse\x12]\n" +
 	"\tPlayAudio\x12'.mentra.livekit.bridge.PlayAudioRequest\x1a%.mentra.livekit.bridge.PlayAudioEvent0\x01\x12^\n" +
-	"\tStopAudio\x12'.mentra.livekit.bridge.StopAudioRequest\x1a(.mentra.livekit.bridge.StopAudioResponse\x12d\n" +
+	"\tStopAudio\x12'.mentra.livekit.bridge.StopAudioRequest\x1a(.mentra.livekit.bridge.StopAudioResponse\x12`\n" +
+	"\fEnqueueAudio\x12'.mentra.livekit.bridge.PlayAudioRequest\x1a%.mentra.livekit.bridge.PlayAudioEvent0\x01\x12d\n" +
+	"\rGetAudioQueue\x12(.mentra.livekit.bridge.AudioQueueRequest\x1a).mentra.livekit.bridge.AudioQueueResponse\x12k\n" +
+	"\x0fMoveQueuedAudio\x12-.mentra.livekit.bridge.MoveQueuedAudioRequest\x1a).mentra.livekit.bridge.AudioQueueResponse\x12k\n" +
+	"\x0fClearAudioQueue\x12(.mentra.livekit.bridge.AudioQueueRequest\x1a..mentra.livekit.bridge.ClearAudioQueueResponse\x12^\n" +
+	"\tSeekTrack\x12'.mentra.livekit.bridge.SeekTrackRequest\x1a(.mentra.livekit.bridge.SeekTrackResponse\x12a\n" +
+	"\n" +
+	"PauseTrack\x12(.mentra.livekit.bridge.TrackPauseRequest\x1a).mentra.livekit.bridge.TrackPauseResponse\x12b\n" +
+	"\vResumeTrack\x12(.mentra.livekit.bridge.TrackPauseRequest\x1a).mentra.livekit.bridge.TrackPauseResponse\x12m\n" +
+	"\x0eSetTrackVolume\x12,.mentra.livekit.bridge.SetTrackVolumeRequest\x1a-.mentra.livekit.bridge.SetTrackVolumeResponse\x12d\n" +
+	"\vSetTrackAGC\x12).mentra.livekit.bridge.SetTrackAGCRequest\x1a*.mentra.livekit.bridge.SetTrackAGCResponse\x12^\n" +
+	"\tMuteTrack\x12'.mentra.livekit.bridge.TrackMuteRequest\x1a(.mentra.livekit.bridge.TrackMuteResponse\x12`\n" +
+	"\vUnmuteTrack\x12'.mentra.livekit.bridge.TrackMuteRequest\x1a(.mentra.livekit.bridge.TrackMuteResponse\x12a\n" +
+	"\n" +
+	"ListTracks\x12(.mentra.livekit.bridge.ListTracksRequest\x1a).mentra.livekit.bridge.ListTracksResponse\x12d\n" +
 	"\vHealthCheck\x12).mentra.livekit.bridge.HealthCheckRequest\x1a*.mentra.livekit.bridge.HealthCheckResponse\x12d\n" +
-	"\tGetStatus\x12*.mentra.livekit.bridge.BridgeStatusRequest\x1a+.mentra.livekit.bridge.BridgeStatusResponseB(Z&github.com/mentra/livekit-bridge/protob\x06proto3"
+	"\tGetStatus\x12*.mentra.livekit.bridge.BridgeStatusRequest\x1a+.mentra.livekit.bridge.BridgeStatusResponse\x12f\n" +
+	"\vWatchStatus\x12*.mentra.livekit.bridge.BridgeStatusRequest\x1a).mentra.livekit.bridge.BridgeStatusUpdate0\x01\x12a\n" +
+	"\fStreamEvents\x12*.mentra.livekit.bridge.StreamEventsRequest\x1a#.mentra.livekit.bridge.SessionEvent0\x01\x12c\n" +
+	"\x0eSubscribeAudio\x12,.mentra.livekit.bridge.SubscribeAudioRequest\x1a!.mentra.livekit.bridge.AudioChunk0\x01\x12y\n" +
+	"\x12UpdateSubscription\x120.mentra.livekit.bridge.UpdateSubscriptionRequest\x1a1.mentra.livekit.bridge.UpdateSubscriptionResponse\x12p\n" +
+	"\x0fSetMixdownGains\x12-.mentra.livekit.bridge.SetMixdownGainsRequest\x1a..mentra.livekit.bridge.SetMixdownGainsResponse\x12^\n" +
+	"\tSetRoutes\x12'.mentra.livekit.bridge.SetRoutesRequest\x1a(.mentra.livekit.bridge.SetRoutesResponse\x12\x7f\n" +
+	"\x14PublishTranscription\x122.mentra.livekit.bridge.PublishTranscriptionRequest\x1a3.mentra.livekit.bridge.PublishTranscriptionResponse\x12d\n" +
+	"\vSetLogLevel\x12).mentra.livekit.bridge.SetLogLevelRequest\x1a*.mentra.livekit.bridge.SetLogLevelResponse\x12v\n" +
+	"\x13GetSessionResources\x12..mentra.livekit.bridge.SessionResourcesRequest\x1a/.mentra.livekit.bridge.SessionResourcesResponse\x12y\n" +
+	"\x12QuerySessionEvents\x120.mentra.livekit.bridge.QuerySessionEventsRequest\x1a1.mentra.livekit.bridge.QuerySessionEventsResponse\x12j\n" +
+	"\rLocateSession\x12+.mentra.livekit.bridge.LocateSessionRequest\x1a,.mentra.livekit.bridge.LocateSessionResponse\x12m\n" +
+	"\x0eHandoffSession\x12,.mentra.livekit.bridge.HandoffSessionRequest\x1a-.mentra.livekit.bridge.HandoffSessionResponse\x12j\n" +
+	"\rAcceptSession\x12+.mentra.livekit.bridge.AcceptSessionRequest\x1a,.mentra.livekit.bridge.AcceptSessionResponse\x12s\n" +
+	"\x10SerializeSession\x12..mentra.livekit.bridge.SerializeSessionRequest\x1a/.mentra.livekit.bridge.SerializeSessionResponse\x12m\n" +
+	"\x0eRestoreSession\x12,.mentra.livekit.bridge.RestoreSessionRequest\x1a-.mentra.livekit.bridge.RestoreSessionResponse\x12[\n" +
+	"\bGetUsage\x12&.mentra.livekit.bridge.GetUsageRequest\x1a'.mentra.livekit.bridge.GetUsageResponse\x12m\n" +
+	"\x0eStartRecording\x12,.mentra.livekit.bridge.StartRecordingRequest\x1a-.mentra.livekit.bridge.StartRecordingResponse\x12j\n" +
+	"\rStopRecording\x12+.mentra.livekit.bridge.StopRecordingRequest\x1a,.mentra.livekit.bridge.StopRecordingResponse\x12^\n" +
+	"\tDumpAudio\x12'.mentra.livekit.bridge.DumpAudioRequest\x1a(.mentra.livekit.bridge.DumpAudioResponse\x12f\n" +
+	"\vIngestAudio\x12).mentra.livekit.bridge.IngestAudioRequest\x1a*.mentra.livekit.bridge.IngestAudioResponse(\x01\x12g\n" +
+	"\fSetWakeWords\x12*.mentra.livekit.bridge.SetWakeWordsRequest\x1a+.mentra.livekit.bridge.SetWakeWordsResponse\x12d\n" +
+	"\vSetLoopback\x12).mentra.livekit.bridge.SetLoopbackRequest\x1a*.mentra.livekit.bridge.SetLoopbackResponse\x12g\n" +
+	"\fProbeLatency\x12*.mentra.livekit.bridge.ProbeLatencyRequest\x1a+.mentra.livekit.bridge.ProbeLatencyResponse\x12p\n" +
+	"\x0fGetClockMapping\x12-.mentra.livekit.bridge.GetClockMappingRequest\x1a..mentra.livekit.bridge.GetClockMappingResponse\x12^\n" +
+	"\tBroadcast\x12'.mentra.livekit.bridge.BroadcastRequest\x1a(.mentra.livekit.bridge.BroadcastResponse\x12j\n" +
+	"\rSetMirrorRoom\x12+.mentra.livekit.bridge.SetMirrorRoomRequest\x1a,.mentra.livekit.bridge.SetMirrorRoomResponse\x12d\n" +
+	"\vSetTrackPan\x12).mentra.livekit.bridge.SetTrackPanRequest\x1a*.mentra.livekit.bridge.SetTrackPanResponse\x12m\n" +
+	"\x0eSetVoiceEffect\x12,.mentra.livekit.bridge.SetVoiceEffectRequest\x1a-.mentra.livekit.bridge.SetVoiceEffectResponse\x12p\n" +
+	"\x0fSetPlaybackRate\x12-.mentra.livekit.bridge.SetPlaybackRateRequest\x1a..mentra.livekit.bridge.SetPlaybackRateResponseB(Z&github.com/mentra/livekit-bridge/protob\x06proto3"
 
 var (
 	file_proto_livekit_bridge_proto_rawDescOnce sync.Once
@@ -1315,54 +10199,296 @@ func file_proto_livekit_bridge_proto_rawDescGZIP() []byte {
 	return file_proto_livekit_bridge_proto_rawDescData
 }
 
-var file_proto_livekit_bridge_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_livekit_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_livekit_bridge_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_livekit_bridge_proto_msgTypes = make([]protoimpl.MessageInfo, 120)
 var file_proto_livekit_bridge_proto_goTypes = []any{
-	(PlayAudioEvent_EventType)(0),          // 0: mentra.livekit.bridge.PlayAudioEvent.EventType
-	(HealthCheckResponse_ServingStatus)(0), // 1: mentra.livekit.bridge.HealthCheckResponse.ServingStatus
-	(*AudioChunk)(nil),                     // 2: mentra.livekit.bridge.AudioChunk
-	(*JoinRoomRequest)(nil),                // 3: mentra.livekit.bridge.JoinRoomRequest
-	(*JoinRoomResponse)(nil),               // 4: mentra.livekit.bridge.JoinRoomResponse
-	(*LeaveRoomRequest)(nil),               // 5: mentra.livekit.bridge.LeaveRoomRequest
-	(*LeaveRoomResponse)(nil),              // 6: mentra.livekit.bridge.LeaveRoomResponse
-	(*PlayAudioRequest)(nil),               // 7: mentra.livekit.bridge.PlayAudioRequest
-	(*PlayAudioEvent)(nil),                 // 8: mentra.livekit.bridge.PlayAudioEvent
-	(*StopAudioRequest)(nil),               // 9: mentra.livekit.bridge.StopAudioRequest
-	(*StopAudioResponse)(nil),              // 10: mentra.livekit.bridge.StopAudioResponse
-	(*HealthCheckRequest)(nil),             // 11: mentra.livekit.bridge.HealthCheckRequest
-	(*HealthCheckResponse)(nil),            // 12: mentra.livekit.bridge.HealthCheckResponse
-	(*BridgeStatusRequest)(nil),            // 13: mentra.livekit.bridge.BridgeStatusRequest
-	(*BridgeStatusResponse)(nil),           // 14: mentra.livekit.bridge.BridgeStatusResponse
-	(*SessionStats)(nil),                   // 15: mentra.livekit.bridge.SessionStats
-	nil,                                    // 16: mentra.livekit.bridge.JoinRoomResponse.MetadataEntry
-	nil,                                    // 17: mentra.livekit.bridge.PlayAudioEvent.MetadataEntry
-	nil,                                    // 18: mentra.livekit.bridge.HealthCheckResponse.MetadataEntry
+	(AudioEncoding)(0),                     // 0: mentra.livekit.bridge.AudioEncoding
+	(JoinRoomRequest_PriorityPolicy)(0),    // 1: mentra.livekit.bridge.JoinRoomRequest.PriorityPolicy
+	(JoinRoomRequest_OverflowPolicy)(0),    // 2: mentra.livekit.bridge.JoinRoomRequest.OverflowPolicy
+	(AudioFormat_Encoding)(0),              // 3: mentra.livekit.bridge.AudioFormat.Encoding
+	(AudioFormat_Endianness)(0),            // 4: mentra.livekit.bridge.AudioFormat.Endianness
+	(PlayAudioEvent_EventType)(0),          // 5: mentra.livekit.bridge.PlayAudioEvent.EventType
+	(StopAudioRequest_StopMode)(0),         // 6: mentra.livekit.bridge.StopAudioRequest.StopMode
+	(TrackInfo_PlaybackState)(0),           // 7: mentra.livekit.bridge.TrackInfo.PlaybackState
+	(HealthCheckResponse_ServingStatus)(0), // 8: mentra.livekit.bridge.HealthCheckResponse.ServingStatus
+	(StartRecordingRequest_Format)(0),      // 9: mentra.livekit.bridge.StartRecordingRequest.Format
+	(*AudioChunk)(nil),                     // 10: mentra.livekit.bridge.AudioChunk
+	(*JoinRoomRequest)(nil),                // 11: mentra.livekit.bridge.JoinRoomRequest
+	(*JoinRoomResponse)(nil),               // 12: mentra.livekit.bridge.JoinRoomResponse
+	(*LeaveRoomRequest)(nil),               // 13: mentra.livekit.bridge.LeaveRoomRequest
+	(*LeaveRoomResponse)(nil),              // 14: mentra.livekit.bridge.LeaveRoomResponse
+	(*PlayAudioRequest)(nil),               // 15: mentra.livekit.bridge.PlayAudioRequest
+	(*AudioFormat)(nil),                    // 16: mentra.livekit.bridge.AudioFormat
+	(*Tone)(nil),                           // 17: mentra.livekit.bridge.Tone
+	(*BeepPattern)(nil),                    // 18: mentra.livekit.bridge.BeepPattern
+	(*Chime)(nil),                          // 19: mentra.livekit.bridge.Chime
+	(*ToneEnvelope)(nil),                   // 20: mentra.livekit.bridge.ToneEnvelope
+	(*ToneSegment)(nil),                    // 21: mentra.livekit.bridge.ToneSegment
+	(*WordTiming)(nil),                     // 22: mentra.livekit.bridge.WordTiming
+	(*PlayAudioEvent)(nil),                 // 23: mentra.livekit.bridge.PlayAudioEvent
+	(*StopAudioRequest)(nil),               // 24: mentra.livekit.bridge.StopAudioRequest
+	(*StopAudioResponse)(nil),              // 25: mentra.livekit.bridge.StopAudioResponse
+	(*AudioQueueRequest)(nil),              // 26: mentra.livekit.bridge.AudioQueueRequest
+	(*MoveQueuedAudioRequest)(nil),         // 27: mentra.livekit.bridge.MoveQueuedAudioRequest
+	(*AudioQueueResponse)(nil),             // 28: mentra.livekit.bridge.AudioQueueResponse
+	(*QueuedAudio)(nil),                    // 29: mentra.livekit.bridge.QueuedAudio
+	(*ClearAudioQueueResponse)(nil),        // 30: mentra.livekit.bridge.ClearAudioQueueResponse
+	(*SeekTrackRequest)(nil),               // 31: mentra.livekit.bridge.SeekTrackRequest
+	(*SeekTrackResponse)(nil),              // 32: mentra.livekit.bridge.SeekTrackResponse
+	(*TrackPauseRequest)(nil),              // 33: mentra.livekit.bridge.TrackPauseRequest
+	(*TrackPauseResponse)(nil),             // 34: mentra.livekit.bridge.TrackPauseResponse
+	(*SetTrackVolumeRequest)(nil),          // 35: mentra.livekit.bridge.SetTrackVolumeRequest
+	(*SetTrackVolumeResponse)(nil),         // 36: mentra.livekit.bridge.SetTrackVolumeResponse
+	(*SetTrackAGCRequest)(nil),             // 37: mentra.livekit.bridge.SetTrackAGCRequest
+	(*SetTrackAGCResponse)(nil),            // 38: mentra.livekit.bridge.SetTrackAGCResponse
+	(*TrackMuteRequest)(nil),               // 39: mentra.livekit.bridge.TrackMuteRequest
+	(*TrackMuteResponse)(nil),              // 40: mentra.livekit.bridge.TrackMuteResponse
+	(*ListTracksRequest)(nil),              // 41: mentra.livekit.bridge.ListTracksRequest
+	(*ListTracksResponse)(nil),             // 42: mentra.livekit.bridge.ListTracksResponse
+	(*TrackInfo)(nil),                      // 43: mentra.livekit.bridge.TrackInfo
+	(*HealthCheckRequest)(nil),             // 44: mentra.livekit.bridge.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 45: mentra.livekit.bridge.HealthCheckResponse
+	(*BridgeStatusRequest)(nil),            // 46: mentra.livekit.bridge.BridgeStatusRequest
+	(*BridgeStatusResponse)(nil),           // 47: mentra.livekit.bridge.BridgeStatusResponse
+	(*LatencyProbe)(nil),                   // 48: mentra.livekit.bridge.LatencyProbe
+	(*BridgeStatusUpdate)(nil),             // 49: mentra.livekit.bridge.BridgeStatusUpdate
+	(*PublishedTrack)(nil),                 // 50: mentra.livekit.bridge.PublishedTrack
+	(*RemoteParticipant)(nil),              // 51: mentra.livekit.bridge.RemoteParticipant
+	(*RemoteTrack)(nil),                    // 52: mentra.livekit.bridge.RemoteTrack
+	(*TrackLevel)(nil),                     // 53: mentra.livekit.bridge.TrackLevel
+	(*TrackRTCStats)(nil),                  // 54: mentra.livekit.bridge.TrackRTCStats
+	(*SubscribeAudioRequest)(nil),          // 55: mentra.livekit.bridge.SubscribeAudioRequest
+	(*UpdateSubscriptionRequest)(nil),      // 56: mentra.livekit.bridge.UpdateSubscriptionRequest
+	(*UpdateSubscriptionResponse)(nil),     // 57: mentra.livekit.bridge.UpdateSubscriptionResponse
+	(*AudioSubscription)(nil),              // 58: mentra.livekit.bridge.AudioSubscription
+	(*SetMixdownGainsRequest)(nil),         // 59: mentra.livekit.bridge.SetMixdownGainsRequest
+	(*SetMixdownGainsResponse)(nil),        // 60: mentra.livekit.bridge.SetMixdownGainsResponse
+	(*AudioRoute)(nil),                     // 61: mentra.livekit.bridge.AudioRoute
+	(*SetRoutesRequest)(nil),               // 62: mentra.livekit.bridge.SetRoutesRequest
+	(*SetRoutesResponse)(nil),              // 63: mentra.livekit.bridge.SetRoutesResponse
+	(*PublishTranscriptionRequest)(nil),    // 64: mentra.livekit.bridge.PublishTranscriptionRequest
+	(*TranscriptSegment)(nil),              // 65: mentra.livekit.bridge.TranscriptSegment
+	(*PublishTranscriptionResponse)(nil),   // 66: mentra.livekit.bridge.PublishTranscriptionResponse
+	(*StreamEventsRequest)(nil),            // 67: mentra.livekit.bridge.StreamEventsRequest
+	(*SessionEvent)(nil),                   // 68: mentra.livekit.bridge.SessionEvent
+	(*SessionStats)(nil),                   // 69: mentra.livekit.bridge.SessionStats
+	(*SetLogLevelRequest)(nil),             // 70: mentra.livekit.bridge.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),            // 71: mentra.livekit.bridge.SetLogLevelResponse
+	(*SessionResourcesRequest)(nil),        // 72: mentra.livekit.bridge.SessionResourcesRequest
+	(*SessionResourcesResponse)(nil),       // 73: mentra.livekit.bridge.SessionResourcesResponse
+	(*SessionResources)(nil),               // 74: mentra.livekit.bridge.SessionResources
+	(*QuerySessionEventsRequest)(nil),      // 75: mentra.livekit.bridge.QuerySessionEventsRequest
+	(*QuerySessionEventsResponse)(nil),     // 76: mentra.livekit.bridge.QuerySessionEventsResponse
+	(*LocateSessionRequest)(nil),           // 77: mentra.livekit.bridge.LocateSessionRequest
+	(*LocateSessionResponse)(nil),          // 78: mentra.livekit.bridge.LocateSessionResponse
+	(*HandoffSessionRequest)(nil),          // 79: mentra.livekit.bridge.HandoffSessionRequest
+	(*HandoffSessionResponse)(nil),         // 80: mentra.livekit.bridge.HandoffSessionResponse
+	(*SessionSnapshot)(nil),                // 81: mentra.livekit.bridge.SessionSnapshot
+	(*WakeWordConfig)(nil),                 // 82: mentra.livekit.bridge.WakeWordConfig
+	(*QueuedPlayback)(nil),                 // 83: mentra.livekit.bridge.QueuedPlayback
+	(*AcceptSessionRequest)(nil),           // 84: mentra.livekit.bridge.AcceptSessionRequest
+	(*AcceptSessionResponse)(nil),          // 85: mentra.livekit.bridge.AcceptSessionResponse
+	(*SerializeSessionRequest)(nil),        // 86: mentra.livekit.bridge.SerializeSessionRequest
+	(*SerializeSessionResponse)(nil),       // 87: mentra.livekit.bridge.SerializeSessionResponse
+	(*RestoreSessionRequest)(nil),          // 88: mentra.livekit.bridge.RestoreSessionRequest
+	(*RestoreSessionResponse)(nil),         // 89: mentra.livekit.bridge.RestoreSessionResponse
+	(*GetUsageRequest)(nil),                // 90: mentra.livekit.bridge.GetUsageRequest
+	(*AppUsage)(nil),                       // 91: mentra.livekit.bridge.AppUsage
+	(*GetUsageResponse)(nil),               // 92: mentra.livekit.bridge.GetUsageResponse
+	(*StartRecordingRequest)(nil),          // 93: mentra.livekit.bridge.StartRecordingRequest
+	(*StartRecordingResponse)(nil),         // 94: mentra.livekit.bridge.StartRecordingResponse
+	(*StopRecordingRequest)(nil),           // 95: mentra.livekit.bridge.StopRecordingRequest
+	(*StopRecordingResponse)(nil),          // 96: mentra.livekit.bridge.StopRecordingResponse
+	(*DumpAudioRequest)(nil),               // 97: mentra.livekit.bridge.DumpAudioRequest
+	(*DumpAudioResponse)(nil),              // 98: mentra.livekit.bridge.DumpAudioResponse
+	(*IngestAudioRequest)(nil),             // 99: mentra.livekit.bridge.IngestAudioRequest
+	(*IngestAudioResponse)(nil),            // 100: mentra.livekit.bridge.IngestAudioResponse
+	(*SetWakeWordsRequest)(nil),            // 101: mentra.livekit.bridge.SetWakeWordsRequest
+	(*SetWakeWordsResponse)(nil),           // 102: mentra.livekit.bridge.SetWakeWordsResponse
+	(*SetLoopbackRequest)(nil),             // 103: mentra.livekit.bridge.SetLoopbackRequest
+	(*SetLoopbackResponse)(nil),            // 104: mentra.livekit.bridge.SetLoopbackResponse
+	(*ProbeLatencyRequest)(nil),            // 105: mentra.livekit.bridge.ProbeLatencyRequest
+	(*ProbeLatencyResponse)(nil),           // 106: mentra.livekit.bridge.ProbeLatencyResponse
+	(*GetClockMappingRequest)(nil),         // 107: mentra.livekit.bridge.GetClockMappingRequest
+	(*GetClockMappingResponse)(nil),        // 108: mentra.livekit.bridge.GetClockMappingResponse
+	(*ClockMapping)(nil),                   // 109: mentra.livekit.bridge.ClockMapping
+	(*BroadcastRequest)(nil),               // 110: mentra.livekit.bridge.BroadcastRequest
+	(*BroadcastResponse)(nil),              // 111: mentra.livekit.bridge.BroadcastResponse
+	(*BroadcastResult)(nil),                // 112: mentra.livekit.bridge.BroadcastResult
+	(*SetMirrorRoomRequest)(nil),           // 113: mentra.livekit.bridge.SetMirrorRoomRequest
+	(*SetMirrorRoomResponse)(nil),          // 114: mentra.livekit.bridge.SetMirrorRoomResponse
+	(*SetTrackPanRequest)(nil),             // 115: mentra.livekit.bridge.SetTrackPanRequest
+	(*SetTrackPanResponse)(nil),            // 116: mentra.livekit.bridge.SetTrackPanResponse
+	(*SetVoiceEffectRequest)(nil),          // 117: mentra.livekit.bridge.SetVoiceEffectRequest
+	(*SetVoiceEffectResponse)(nil),         // 118: mentra.livekit.bridge.SetVoiceEffectResponse
+	(*SetPlaybackRateRequest)(nil),         // 119: mentra.livekit.bridge.SetPlaybackRateRequest
+	(*SetPlaybackRateResponse)(nil),        // 120: mentra.livekit.bridge.SetPlaybackRateResponse
+	nil,                                    // 121: mentra.livekit.bridge.JoinRoomRequest.TrackPrioritiesEntry
+	nil,                                    // 122: mentra.livekit.bridge.JoinRoomRequest.MixdownGainsEntry
+	nil,                                    // 123: mentra.livekit.bridge.JoinRoomResponse.MetadataEntry
+	nil,                                    // 124: mentra.livekit.bridge.PlayAudioEvent.MetadataEntry
+	nil,                                    // 125: mentra.livekit.bridge.HealthCheckResponse.MetadataEntry
+	nil,                                    // 126: mentra.livekit.bridge.BridgeStatusUpdate.AttributesEntry
+	nil,                                    // 127: mentra.livekit.bridge.SetMixdownGainsRequest.GainsEntry
+	nil,                                    // 128: mentra.livekit.bridge.SessionEvent.AttributesEntry
+	nil,                                    // 129: mentra.livekit.bridge.SessionResources.GoroutinesEntry
 }
 var file_proto_livekit_bridge_proto_depIdxs = []int32{
-	16, // 0: mentra.livekit.bridge.JoinRoomResponse.metadata:type_name -> mentra.livekit.bridge.JoinRoomResponse.MetadataEntry
-	0,  // 1: mentra.livekit.bridge.PlayAudioEvent.type:type_name -> mentra.livekit.bridge.PlayAudioEvent.EventType
-	17, // 2: mentra.livekit.bridge.PlayAudioEvent.metadata:type_name -> mentra.livekit.bridge.PlayAudioEvent.MetadataEntry
-	1,  // 3: mentra.livekit.bridge.HealthCheckResponse.status:type_name -> mentra.livekit.bridge.HealthCheckResponse.ServingStatus
-	18, // 4: mentra.livekit.bridge.HealthCheckResponse.metadata:type_name -> mentra.livekit.bridge.HealthCheckResponse.MetadataEntry
-	2,  // 5: mentra.livekit.bridge.LiveKitBridge.StreamAudio:input_type -> mentra.livekit.bridge.AudioChunk
-	3,  // 6: mentra.livekit.bridge.LiveKitBridge.JoinRoom:input_type -> mentra.livekit.bridge.JoinRoomRequest
-	5,  // 7: mentra.livekit.bridge.LiveKitBridge.LeaveRoom:input_type -> mentra.livekit.bridge.LeaveRoomRequest
-	7,  // 8: mentra.livekit.bridge.LiveKitBridge.PlayAudio:input_type -> mentra.livekit.bridge.PlayAudioRequest
-	9,  // 9: mentra.livekit.bridge.LiveKitBridge.StopAudio:input_type -> mentra.livekit.bridge.StopAudioRequest
-	11, // 10: mentra.livekit.bridge.LiveKitBridge.HealthCheck:input_type -> mentra.livekit.bridge.HealthCheckRequest
-	13, // 11: mentra.livekit.bridge.LiveKitBridge.GetStatus:input_type -> mentra.livekit.bridge.BridgeStatusRequest
-	2,  // 12: mentra.livekit.bridge.LiveKitBridge.StreamAudio:output_type -> mentra.livekit.bridge.AudioChunk
-	4,  // 13: mentra.livekit.bridge.LiveKitBridge.JoinRoom:output_type -> mentra.livekit.bridge.JoinRoomResponse
-	6,  // 14: mentra.livekit.bridge.LiveKitBridge.LeaveRoom:output_type -> mentra.livekit.bridge.LeaveRoomResponse
-	8,  // 15: mentra.livekit.bridge.LiveKitBridge.PlayAudio:output_type -> mentra.livekit.bridge.PlayAudioEvent
-	10, // 16: mentra.livekit.bridge.LiveKitBridge.StopAudio:output_type -> mentra.livekit.bridge.StopAudioResponse
-	12, // 17: mentra.livekit.bridge.LiveKitBridge.HealthCheck:output_type -> mentra.livekit.bridge.HealthCheckResponse
-	14, // 18: mentra.livekit.bridge.LiveKitBridge.GetStatus:output_type -> mentra.livekit.bridge.BridgeStatusResponse
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	0,   // 0: mentra.livekit.bridge.AudioChunk.encoding:type_name -> mentra.livekit.bridge.AudioEncoding
+	121, // 1: mentra.livekit.bridge.JoinRoomRequest.track_priorities:type_name -> mentra.livekit.bridge.JoinRoomRequest.TrackPrioritiesEntry
+	1,   // 2: mentra.livekit.bridge.JoinRoomRequest.priority_policy:type_name -> mentra.livekit.bridge.JoinRoomRequest.PriorityPolicy
+	2,   // 3: mentra.livekit.bridge.JoinRoomRequest.incoming_overflow_policy:type_name -> mentra.livekit.bridge.JoinRoomRequest.OverflowPolicy
+	122, // 4: mentra.livekit.bridge.JoinRoomRequest.mixdown_gains:type_name -> mentra.livekit.bridge.JoinRoomRequest.MixdownGainsEntry
+	123, // 5: mentra.livekit.bridge.JoinRoomResponse.metadata:type_name -> mentra.livekit.bridge.JoinRoomResponse.MetadataEntry
+	22,  // 6: mentra.livekit.bridge.PlayAudioRequest.word_timings:type_name -> mentra.livekit.bridge.WordTiming
+	17,  // 7: mentra.livekit.bridge.PlayAudioRequest.tone:type_name -> mentra.livekit.bridge.Tone
+	16,  // 8: mentra.livekit.bridge.PlayAudioRequest.format:type_name -> mentra.livekit.bridge.AudioFormat
+	3,   // 9: mentra.livekit.bridge.AudioFormat.encoding:type_name -> mentra.livekit.bridge.AudioFormat.Encoding
+	4,   // 10: mentra.livekit.bridge.AudioFormat.endianness:type_name -> mentra.livekit.bridge.AudioFormat.Endianness
+	21,  // 11: mentra.livekit.bridge.Tone.segments:type_name -> mentra.livekit.bridge.ToneSegment
+	18,  // 12: mentra.livekit.bridge.Tone.beeps:type_name -> mentra.livekit.bridge.BeepPattern
+	19,  // 13: mentra.livekit.bridge.Tone.chime:type_name -> mentra.livekit.bridge.Chime
+	20,  // 14: mentra.livekit.bridge.Tone.envelope:type_name -> mentra.livekit.bridge.ToneEnvelope
+	5,   // 15: mentra.livekit.bridge.PlayAudioEvent.type:type_name -> mentra.livekit.bridge.PlayAudioEvent.EventType
+	124, // 16: mentra.livekit.bridge.PlayAudioEvent.metadata:type_name -> mentra.livekit.bridge.PlayAudioEvent.MetadataEntry
+	6,   // 17: mentra.livekit.bridge.StopAudioRequest.mode:type_name -> mentra.livekit.bridge.StopAudioRequest.StopMode
+	29,  // 18: mentra.livekit.bridge.AudioQueueResponse.items:type_name -> mentra.livekit.bridge.QueuedAudio
+	43,  // 19: mentra.livekit.bridge.ListTracksResponse.tracks:type_name -> mentra.livekit.bridge.TrackInfo
+	7,   // 20: mentra.livekit.bridge.TrackInfo.state:type_name -> mentra.livekit.bridge.TrackInfo.PlaybackState
+	8,   // 21: mentra.livekit.bridge.HealthCheckResponse.status:type_name -> mentra.livekit.bridge.HealthCheckResponse.ServingStatus
+	125, // 22: mentra.livekit.bridge.HealthCheckResponse.metadata:type_name -> mentra.livekit.bridge.HealthCheckResponse.MetadataEntry
+	53,  // 23: mentra.livekit.bridge.BridgeStatusResponse.track_levels:type_name -> mentra.livekit.bridge.TrackLevel
+	54,  // 24: mentra.livekit.bridge.BridgeStatusResponse.track_stats:type_name -> mentra.livekit.bridge.TrackRTCStats
+	50,  // 25: mentra.livekit.bridge.BridgeStatusResponse.published_tracks:type_name -> mentra.livekit.bridge.PublishedTrack
+	51,  // 26: mentra.livekit.bridge.BridgeStatusResponse.remote_participants:type_name -> mentra.livekit.bridge.RemoteParticipant
+	48,  // 27: mentra.livekit.bridge.BridgeStatusResponse.latency_probe:type_name -> mentra.livekit.bridge.LatencyProbe
+	126, // 28: mentra.livekit.bridge.BridgeStatusUpdate.attributes:type_name -> mentra.livekit.bridge.BridgeStatusUpdate.AttributesEntry
+	47,  // 29: mentra.livekit.bridge.BridgeStatusUpdate.status:type_name -> mentra.livekit.bridge.BridgeStatusResponse
+	52,  // 30: mentra.livekit.bridge.RemoteParticipant.tracks:type_name -> mentra.livekit.bridge.RemoteTrack
+	58,  // 31: mentra.livekit.bridge.UpdateSubscriptionResponse.subscriptions:type_name -> mentra.livekit.bridge.AudioSubscription
+	127, // 32: mentra.livekit.bridge.SetMixdownGainsRequest.gains:type_name -> mentra.livekit.bridge.SetMixdownGainsRequest.GainsEntry
+	61,  // 33: mentra.livekit.bridge.SetRoutesRequest.routes:type_name -> mentra.livekit.bridge.AudioRoute
+	61,  // 34: mentra.livekit.bridge.SetRoutesResponse.routes:type_name -> mentra.livekit.bridge.AudioRoute
+	65,  // 35: mentra.livekit.bridge.PublishTranscriptionRequest.segments:type_name -> mentra.livekit.bridge.TranscriptSegment
+	128, // 36: mentra.livekit.bridge.SessionEvent.attributes:type_name -> mentra.livekit.bridge.SessionEvent.AttributesEntry
+	74,  // 37: mentra.livekit.bridge.SessionResourcesResponse.sessions:type_name -> mentra.livekit.bridge.SessionResources
+	129, // 38: mentra.livekit.bridge.SessionResources.goroutines:type_name -> mentra.livekit.bridge.SessionResources.GoroutinesEntry
+	68,  // 39: mentra.livekit.bridge.QuerySessionEventsResponse.events:type_name -> mentra.livekit.bridge.SessionEvent
+	11,  // 40: mentra.livekit.bridge.SessionSnapshot.join:type_name -> mentra.livekit.bridge.JoinRoomRequest
+	83,  // 41: mentra.livekit.bridge.SessionSnapshot.playback:type_name -> mentra.livekit.bridge.QueuedPlayback
+	58,  // 42: mentra.livekit.bridge.SessionSnapshot.subscriptions:type_name -> mentra.livekit.bridge.AudioSubscription
+	82,  // 43: mentra.livekit.bridge.SessionSnapshot.wake_words:type_name -> mentra.livekit.bridge.WakeWordConfig
+	15,  // 44: mentra.livekit.bridge.QueuedPlayback.request:type_name -> mentra.livekit.bridge.PlayAudioRequest
+	81,  // 45: mentra.livekit.bridge.AcceptSessionRequest.snapshot:type_name -> mentra.livekit.bridge.SessionSnapshot
+	81,  // 46: mentra.livekit.bridge.SerializeSessionResponse.snapshot:type_name -> mentra.livekit.bridge.SessionSnapshot
+	81,  // 47: mentra.livekit.bridge.RestoreSessionRequest.snapshot:type_name -> mentra.livekit.bridge.SessionSnapshot
+	91,  // 48: mentra.livekit.bridge.GetUsageResponse.usage:type_name -> mentra.livekit.bridge.AppUsage
+	9,   // 49: mentra.livekit.bridge.StartRecordingRequest.format:type_name -> mentra.livekit.bridge.StartRecordingRequest.Format
+	16,  // 50: mentra.livekit.bridge.IngestAudioRequest.format:type_name -> mentra.livekit.bridge.AudioFormat
+	109, // 51: mentra.livekit.bridge.GetClockMappingResponse.mappings:type_name -> mentra.livekit.bridge.ClockMapping
+	16,  // 52: mentra.livekit.bridge.BroadcastRequest.format:type_name -> mentra.livekit.bridge.AudioFormat
+	112, // 53: mentra.livekit.bridge.BroadcastResponse.results:type_name -> mentra.livekit.bridge.BroadcastResult
+	10,  // 54: mentra.livekit.bridge.LiveKitBridge.StreamAudio:input_type -> mentra.livekit.bridge.AudioChunk
+	11,  // 55: mentra.livekit.bridge.LiveKitBridge.JoinRoom:input_type -> mentra.livekit.bridge.JoinRoomRequest
+	13,  // 56: mentra.livekit.bridge.LiveKitBridge.LeaveRoom:input_type -> mentra.livekit.bridge.LeaveRoomRequest
+	15,  // 57: mentra.livekit.bridge.LiveKitBridge.PlayAudio:input_type -> mentra.livekit.bridge.PlayAudioRequest
+	24,  // 58: mentra.livekit.bridge.LiveKitBridge.StopAudio:input_type -> mentra.livekit.bridge.StopAudioRequest
+	15,  // 59: mentra.livekit.bridge.LiveKitBridge.EnqueueAudio:input_type -> mentra.livekit.bridge.PlayAudioRequest
+	26,  // 60: mentra.livekit.bridge.LiveKitBridge.GetAudioQueue:input_type -> mentra.livekit.bridge.AudioQueueRequest
+	27,  // 61: mentra.livekit.bridge.LiveKitBridge.MoveQueuedAudio:input_type -> mentra.livekit.bridge.MoveQueuedAudioRequest
+	26,  // 62: mentra.livekit.bridge.LiveKitBridge.ClearAudioQueue:input_type -> mentra.livekit.bridge.AudioQueueRequest
+	31,  // 63: mentra.livekit.bridge.LiveKitBridge.SeekTrack:input_type -> mentra.livekit.bridge.SeekTrackRequest
+	33,  // 64: mentra.livekit.bridge.LiveKitBridge.PauseTrack:input_type -> mentra.livekit.bridge.TrackPauseRequest
+	33,  // 65: mentra.livekit.bridge.LiveKitBridge.ResumeTrack:input_type -> mentra.livekit.bridge.TrackPauseRequest
+	35,  // 66: mentra.livekit.bridge.LiveKitBridge.SetTrackVolume:input_type -> mentra.livekit.bridge.SetTrackVolumeRequest
+	37,  // 67: mentra.livekit.bridge.LiveKitBridge.SetTrackAGC:input_type -> mentra.livekit.bridge.SetTrackAGCRequest
+	39,  // 68: mentra.livekit.bridge.LiveKitBridge.MuteTrack:input_type -> mentra.livekit.bridge.TrackMuteRequest
+	39,  // 69: mentra.livekit.bridge.LiveKitBridge.UnmuteTrack:input_type -> mentra.livekit.bridge.TrackMuteRequest
+	41,  // 70: mentra.livekit.bridge.LiveKitBridge.ListTracks:input_type -> mentra.livekit.bridge.ListTracksRequest
+	44,  // 71: mentra.livekit.bridge.LiveKitBridge.HealthCheck:input_type -> mentra.livekit.bridge.HealthCheckRequest
+	46,  // 72: mentra.livekit.bridge.LiveKitBridge.GetStatus:input_type -> mentra.livekit.bridge.BridgeStatusRequest
+	46,  // 73: mentra.livekit.bridge.LiveKitBridge.WatchStatus:input_type -> mentra.livekit.bridge.BridgeStatusRequest
+	67,  // 74: mentra.livekit.bridge.LiveKitBridge.StreamEvents:input_type -> mentra.livekit.bridge.StreamEventsRequest
+	55,  // 75: mentra.livekit.bridge.LiveKitBridge.SubscribeAudio:input_type -> mentra.livekit.bridge.SubscribeAudioRequest
+	56,  // 76: mentra.livekit.bridge.LiveKitBridge.UpdateSubscription:input_type -> mentra.livekit.bridge.UpdateSubscriptionRequest
+	59,  // 77: mentra.livekit.bridge.LiveKitBridge.SetMixdownGains:input_type -> mentra.livekit.bridge.SetMixdownGainsRequest
+	62,  // 78: mentra.livekit.bridge.LiveKitBridge.SetRoutes:input_type -> mentra.livekit.bridge.SetRoutesRequest
+	64,  // 79: mentra.livekit.bridge.LiveKitBridge.PublishTranscription:input_type -> mentra.livekit.bridge.PublishTranscriptionRequest
+	70,  // 80: mentra.livekit.bridge.LiveKitBridge.SetLogLevel:input_type -> mentra.livekit.bridge.SetLogLevelRequest
+	72,  // 81: mentra.livekit.bridge.LiveKitBridge.GetSessionResources:input_type -> mentra.livekit.bridge.SessionResourcesRequest
+	75,  // 82: mentra.livekit.bridge.LiveKitBridge.QuerySessionEvents:input_type -> mentra.livekit.bridge.QuerySessionEventsRequest
+	77,  // 83: mentra.livekit.bridge.LiveKitBridge.LocateSession:input_type -> mentra.livekit.bridge.LocateSessionRequest
+	79,  // 84: mentra.livekit.bridge.LiveKitBridge.HandoffSession:input_type -> mentra.livekit.bridge.HandoffSessionRequest
+	84,  // 85: mentra.livekit.bridge.LiveKitBridge.AcceptSession:input_type -> mentra.livekit.bridge.AcceptSessionRequest
+	86,  // 86: mentra.livekit.bridge.LiveKitBridge.SerializeSession:input_type -> mentra.livekit.bridge.SerializeSessionRequest
+	88,  // 87: mentra.livekit.bridge.LiveKitBridge.RestoreSession:input_type -> mentra.livekit.bridge.RestoreSessionRequest
+	90,  // 88: mentra.livekit.bridge.LiveKitBridge.GetUsage:input_type -> mentra.livekit.bridge.GetUsageRequest
+	93,  // 89: mentra.livekit.bridge.LiveKitBridge.StartRecording:input_type -> mentra.livekit.bridge.StartRecordingRequest
+	95,  // 90: mentra.livekit.bridge.LiveKitBridge.StopRecording:input_type -> mentra.livekit.bridge.StopRecordingRequest
+	97,  // 91: mentra.livekit.bridge.LiveKitBridge.DumpAudio:input_type -> mentra.livekit.bridge.DumpAudioRequest
+	99,  // 92: mentra.livekit.bridge.LiveKitBridge.IngestAudio:input_type -> mentra.livekit.bridge.IngestAudioRequest
+	101, // 93: mentra.livekit.bridge.LiveKitBridge.SetWakeWords:input_type -> mentra.livekit.bridge.SetWakeWordsRequest
+	103, // 94: mentra.livekit.bridge.LiveKitBridge.SetLoopback:input_type -> mentra.livekit.bridge.SetLoopbackRequest
+	105, // 95: mentra.livekit.bridge.LiveKitBridge.ProbeLatency:input_type -> mentra.livekit.bridge.ProbeLatencyRequest
+	107, // 96: mentra.livekit.bridge.LiveKitBridge.GetClockMapping:input_type -> mentra.livekit.bridge.GetClockMappingRequest
+	110, // 97: mentra.livekit.bridge.LiveKitBridge.Broadcast:input_type -> mentra.livekit.bridge.BroadcastRequest
+	113, // 98: mentra.livekit.bridge.LiveKitBridge.SetMirrorRoom:input_type -> mentra.livekit.bridge.SetMirrorRoomRequest
+	115, // 99: mentra.livekit.bridge.LiveKitBridge.SetTrackPan:input_type -> mentra.livekit.bridge.SetTrackPanRequest
+	117, // 100: mentra.livekit.bridge.LiveKitBridge.SetVoiceEffect:input_type -> mentra.livekit.bridge.SetVoiceEffectRequest
+	119, // 101: mentra.livekit.bridge.LiveKitBridge.SetPlaybackRate:input_type -> mentra.livekit.bridge.SetPlaybackRateRequest
+	10,  // 102: mentra.livekit.bridge.LiveKitBridge.StreamAudio:output_type -> mentra.livekit.bridge.AudioChunk
+	12,  // 103: mentra.livekit.bridge.LiveKitBridge.JoinRoom:output_type -> mentra.livekit.bridge.JoinRoomResponse
+	14,  // 104: mentra.livekit.bridge.LiveKitBridge.LeaveRoom:output_type -> mentra.livekit.bridge.LeaveRoomResponse
+	23,  // 105: mentra.livekit.bridge.LiveKitBridge.PlayAudio:output_type -> mentra.livekit.bridge.PlayAudioEvent
+	25,  // 106: mentra.livekit.bridge.LiveKitBridge.StopAudio:output_type -> mentra.livekit.bridge.StopAudioResponse
+	23,  // 107: mentra.livekit.bridge.LiveKitBridge.EnqueueAudio:output_type -> mentra.livekit.bridge.PlayAudioEvent
+	28,  // 108: mentra.livekit.bridge.LiveKitBridge.GetAudioQueue:output_type -> mentra.livekit.bridge.AudioQueueResponse
+	28,  // 109: mentra.livekit.bridge.LiveKitBridge.MoveQueuedAudio:output_type -> mentra.livekit.bridge.AudioQueueResponse
+	30,  // 110: mentra.livekit.bridge.LiveKitBridge.ClearAudioQueue:output_type -> mentra.livekit.bridge.ClearAudioQueueResponse
+	32,  // 111: mentra.livekit.bridge.LiveKitBridge.SeekTrack:output_type -> mentra.livekit.bridge.SeekTrackResponse
+	34,  // 112: mentra.livekit.bridge.LiveKitBridge.PauseTrack:output_type -> mentra.livekit.bridge.TrackPauseResponse
+	34,  // 113: mentra.livekit.bridge.LiveKitBridge.ResumeTrack:output_type -> mentra.livekit.bridge.TrackPauseResponse
+	36,  // 114: mentra.livekit.bridge.LiveKitBridge.SetTrackVolume:output_type -> mentra.livekit.bridge.SetTrackVolumeResponse
+	38,  // 115: mentra.livekit.bridge.LiveKitBridge.SetTrackAGC:output_type -> mentra.livekit.bridge.SetTrackAGCResponse
+	40,  // 116: mentra.livekit.bridge.LiveKitBridge.MuteTrack:output_type -> mentra.livekit.bridge.TrackMuteResponse
+	40,  // 117: mentra.livekit.bridge.LiveKitBridge.UnmuteTrack:output_type -> mentra.livekit.bridge.TrackMuteResponse
+	42,  // 118: mentra.livekit.bridge.LiveKitBridge.ListTracks:output_type -> mentra.livekit.bridge.ListTracksResponse
+	45,  // 119: mentra.livekit.bridge.LiveKitBridge.HealthCheck:output_type -> mentra.livekit.bridge.HealthCheckResponse
+	47,  // 120: mentra.livekit.bridge.LiveKitBridge.GetStatus:output_type -> mentra.livekit.bridge.BridgeStatusResponse
+	49,  // 121: mentra.livekit.bridge.LiveKitBridge.WatchStatus:output_type -> mentra.livekit.bridge.BridgeStatusUpdate
+	68,  // 122: mentra.livekit.bridge.LiveKitBridge.StreamEvents:output_type -> mentra.livekit.bridge.SessionEvent
+	10,  // 123: mentra.livekit.bridge.LiveKitBridge.SubscribeAudio:output_type -> mentra.livekit.bridge.AudioChunk
+	57,  // 124: mentra.livekit.bridge.LiveKitBridge.UpdateSubscription:output_type -> mentra.livekit.bridge.UpdateSubscriptionResponse
+	60,  // 125: mentra.livekit.bridge.LiveKitBridge.SetMixdownGains:output_type -> mentra.livekit.bridge.SetMixdownGainsResponse
+	63,  // 126: mentra.livekit.bridge.LiveKitBridge.SetRoutes:output_type -> mentra.livekit.bridge.SetRoutesResponse
+	66,  // 127: mentra.livekit.bridge.LiveKitBridge.PublishTranscription:output_type -> mentra.livekit.bridge.PublishTranscriptionResponse
+	71,  // 128: mentra.livekit.bridge.LiveKitBridge.SetLogLevel:output_type -> mentra.livekit.bridge.SetLogLevelResponse
+	73,  // 129: mentra.livekit.bridge.LiveKitBridge.GetSessionResources:output_type -> mentra.livekit.bridge.SessionResourcesResponse
+	76,  // 130: mentra.livekit.bridge.LiveKitBridge.QuerySessionEvents:output_type -> mentra.livekit.bridge.QuerySessionEventsResponse
+	78,  // 131: mentra.livekit.bridge.LiveKitBridge.LocateSession:output_type -> mentra.livekit.bridge.LocateSessionResponse
+	80,  // 132: mentra.livekit.bridge.LiveKitBridge.HandoffSession:output_type -> mentra.livekit.bridge.HandoffSessionResponse
+	85,  // 133: mentra.livekit.bridge.LiveKitBridge.AcceptSession:output_type -> mentra.livekit.bridge.AcceptSessionResponse
+	87,  // 134: mentra.livekit.bridge.LiveKitBridge.SerializeSession:output_type -> mentra.livekit.bridge.SerializeSessionResponse
+	89,  // 135: mentra.livekit.bridge.LiveKitBridge.RestoreSession:output_type -> mentra.livekit.bridge.RestoreSessionResponse
+	92,  // 136: mentra.livekit.bridge.LiveKitBridge.GetUsage:output_type -> mentra.livekit.bridge.GetUsageResponse
+	94,  // 137: mentra.livekit.bridge.LiveKitBridge.StartRecording:output_type -> mentra.livekit.bridge.StartRecordingResponse
+	96,  // 138: mentra.livekit.bridge.LiveKitBridge.StopRecording:output_type -> mentra.livekit.bridge.StopRecordingResponse
+	98,  // 139: mentra.livekit.bridge.LiveKitBridge.DumpAudio:output_type -> mentra.livekit.bridge.DumpAudioResponse
+	100, // 140: mentra.livekit.bridge.LiveKitBridge.IngestAudio:output_type -> mentra.livekit.bridge.IngestAudioResponse
+	102, // 141: mentra.livekit.bridge.LiveKitBridge.SetWakeWords:output_type -> mentra.livekit.bridge.SetWakeWordsResponse
+	104, // 142: mentra.livekit.bridge.LiveKitBridge.SetLoopback:output_type -> mentra.livekit.bridge.SetLoopbackResponse
+	106, // 143: mentra.livekit.bridge.LiveKitBridge.ProbeLatency:output_type -> mentra.livekit.bridge.ProbeLatencyResponse
+	108, // 144: mentra.livekit.bridge.LiveKitBridge.GetClockMapping:output_type -> mentra.livekit.bridge.GetClockMappingResponse
+	111, // 145: mentra.livekit.bridge.LiveKitBridge.Broadcast:output_type -> mentra.livekit.bridge.BroadcastResponse
+	114, // 146: mentra.livekit.bridge.LiveKitBridge.SetMirrorRoom:output_type -> mentra.livekit.bridge.SetMirrorRoomResponse
+	116, // 147: mentra.livekit.bridge.LiveKitBridge.SetTrackPan:output_type -> mentra.livekit.bridge.SetTrackPanResponse
+	118, // 148: mentra.livekit.bridge.LiveKitBridge.SetVoiceEffect:output_type -> mentra.livekit.bridge.SetVoiceEffectResponse
+	120, // 149: mentra.livekit.bridge.LiveKitBridge.SetPlaybackRate:output_type -> mentra.livekit.bridge.SetPlaybackRateResponse
+	102, // [102:150] is the sub-list for method output_type
+	54,  // [54:102] is the sub-list for method input_type
+	54,  // [54:54] is the sub-list for extension type_name
+	54,  // [54:54] is the sub-list for extension extendee
+	0,   // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_proto_livekit_bridge_proto_init() }
@@ -1370,13 +10496,14 @@ func file_proto_livekit_bridge_proto_init() {
 	if File_proto_livekit_bridge_proto != nil {
 		return
 	}
+	file_proto_livekit_bridge_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_livekit_bridge_proto_rawDesc), len(file_proto_livekit_bridge_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   17,
+			NumEnums:      10,
+			NumMessages:   120,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LiveKitBridge_StreamAudio_FullMethodName = "/mentra.livekit.bridge.LiveKitBridge/StreamAudio"
-	LiveKitBridge_JoinRoom_FullMethodName    = "/mentra.livekit.bridge.LiveKitBridge/JoinRoom"
-	LiveKitBridge_LeaveRoom_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/LeaveRoom"
-	LiveKitBridge_PlayAudio_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/PlayAudio"
-	LiveKitBridge_StopAudio_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/StopAudio"
-	LiveKitBridge_HealthCheck_FullMethodName = "/mentra.livekit.bridge.LiveKitBridge/HealthCheck"
-	LiveKitBridge_GetStatus_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/GetStatus"
+	LiveKitBridge_StreamAudio_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/StreamAudio"
+	LiveKitBridge_JoinRoom_FullMethodName             = "/mentra.livekit.bridge.LiveKitBridge/JoinRoom"
+	LiveKitBridge_LeaveRoom_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/LeaveRoom"
+	LiveKitBridge_PlayAudio_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/PlayAudio"
+	LiveKitBridge_StopAudio_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/StopAudio"
+	LiveKitBridge_EnqueueAudio_FullMethodName         = "/mentra.livekit.bridge.LiveKitBridge/EnqueueAudio"
+	LiveKitBridge_GetAudioQueue_FullMethodName        = "/mentra.livekit.bridge.LiveKitBridge/GetAudioQueue"
+	LiveKitBridge_MoveQueuedAudio_FullMethodName      = "/mentra.livekit.bridge.LiveKitBridge/MoveQueuedAudio"
+	LiveKitBridge_ClearAudioQueue_FullMethodName      = "/mentra.livekit.bridge.LiveKitBridge/ClearAudioQueue"
+	LiveKitBridge_SeekTrack_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/SeekTrack"
+	LiveKitBridge_PauseTrack_FullMethodName           = "/mentra.livekit.bridge.LiveKitBridge/PauseTrack"
+	LiveKitBridge_ResumeTrack_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/ResumeTrack"
+	LiveKitBridge_SetTrackVolume_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/SetTrackVolume"
+	LiveKitBridge_SetTrackAGC_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/SetTrackAGC"
+	LiveKitBridge_MuteTrack_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/MuteTrack"
+	LiveKitBridge_UnmuteTrack_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/UnmuteTrack"
+	LiveKitBridge_ListTracks_FullMethodName           = "/mentra.livekit.bridge.LiveKitBridge/ListTracks"
+	LiveKitBridge_HealthCheck_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/HealthCheck"
+	LiveKitBridge_GetStatus_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/GetStatus"
+	LiveKitBridge_WatchStatus_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/WatchStatus"
+	LiveKitBridge_StreamEvents_FullMethodName         = "/mentra.livekit.bridge.LiveKitBridge/StreamEvents"
+	LiveKitBridge_SubscribeAudio_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/SubscribeAudio"
+	LiveKitBridge_UpdateSubscription_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/UpdateSubscription"
+	LiveKitBridge_SetMixdownGains_FullMethodName      = "/mentra.livekit.bridge.LiveKitBridge/SetMixdownGains"
+	LiveKitBridge_SetRoutes_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/SetRoutes"
+	LiveKitBridge_PublishTranscription_FullMethodName = "/mentra.livekit.bridge.LiveKitBridge/PublishTranscription"
+	LiveKitBridge_SetLogLevel_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/SetLogLevel"
+	LiveKitBridge_GetSessionResources_FullMethodName  = "/mentra.livekit.bridge.LiveKitBridge/GetSessionResources"
+	LiveKitBridge_QuerySessionEvents_FullMethodName   = "/mentra.livekit.bridge.LiveKitBridge/QuerySessionEvents"
+	LiveKitBridge_LocateSession_FullMethodName        = "/mentra.livekit.bridge.LiveKitBridge/LocateSession"
+	LiveKitBridge_HandoffSession_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/HandoffSession"
+	LiveKitBridge_AcceptSession_FullMethodName        = "/mentra.livekit.bridge.LiveKitBridge/AcceptSession"
+	LiveKitBridge_SerializeSession_FullMethodName     = "/mentra.livekit.bridge.LiveKitBridge/SerializeSession"
+	LiveKitBridge_RestoreSession_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/RestoreSession"
+	LiveKitBridge_GetUsage_FullMethodName             = "/mentra.livekit.bridge.LiveKitBridge/GetUsage"
+	LiveKitBridge_StartRecording_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/StartRecording"
+	LiveKitBridge_StopRecording_FullMethodName        = "/mentra.livekit.bridge.LiveKitBridge/StopRecording"
+	LiveKitBridge_DumpAudio_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/DumpAudio"
+	LiveKitBridge_IngestAudio_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/IngestAudio"
+	LiveKitBridge_SetWakeWords_FullMethodName         = "/mentra.livekit.bridge.LiveKitBridge/SetWakeWords"
+	LiveKitBridge_SetLoopback_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/SetLoopback"
+	LiveKitBridge_ProbeLatency_FullMethodName         = "/mentra.livekit.bridge.LiveKitBridge/ProbeLatency"
+	LiveKitBridge_GetClockMapping_FullMethodName      = "/mentra.livekit.bridge.LiveKitBridge/GetClockMapping"
+	LiveKitBridge_Broadcast_FullMethodName            = "/mentra.livekit.bridge.LiveKitBridge/Broadcast"
+	LiveKitBridge_SetMirrorRoom_FullMethodName        = "/mentra.livekit.bridge.LiveKitBridge/SetMirrorRoom"
+	LiveKitBridge_SetTrackPan_FullMethodName          = "/mentra.livekit.bridge.LiveKitBridge/SetTrackPan"
+	LiveKitBridge_SetVoiceEffect_FullMethodName       = "/mentra.livekit.bridge.LiveKitBridge/SetVoiceEffect"
+	LiveKitBridge_SetPlaybackRate_FullMethodName      = "/mentra.livekit.bridge.LiveKitBridge/SetPlaybackRate"
 )
 
 // LiveKitBridgeClient is the client API for LiveKitBridge service.
@@ -52,10 +93,148 @@ type LiveKitBridgeClient interface {
 	// Used by session.audio.playAudio() and session.audio.speak()
 	PlayAudio(ctx context.Context, in *PlayAudioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlayAudioEvent], error)
 	StopAudio(ctx context.Context, in *StopAudioRequest, opts ...grpc.CallOption) (*StopAudioResponse, error)
+	// Queue audio on a track behind whatever it is playing or has queued
+	//
+	// Clips on a queue play back-to-back with no gap between them (e.g.
+	// sentence-by-sentence TTS). Events match PlayAudio's: STARTED when the
+	// clip's turn comes, COMPLETED once it has played out. Cancelling the
+	// call removes the clip from the queue.
+	EnqueueAudio(ctx context.Context, in *PlayAudioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlayAudioEvent], error)
+	// Inspect, reorder and clear a track's playback queue
+	GetAudioQueue(ctx context.Context, in *AudioQueueRequest, opts ...grpc.CallOption) (*AudioQueueResponse, error)
+	MoveQueuedAudio(ctx context.Context, in *MoveQueuedAudioRequest, opts ...grpc.CallOption) (*AudioQueueResponse, error)
+	ClearAudioQueue(ctx context.Context, in *AudioQueueRequest, opts ...grpc.CallOption) (*ClearAudioQueueResponse, error)
+	// Move file/URL playback on a track to another position (skip forward or
+	// back) without ending its PlayAudio stream. The source is decoded again
+	// from the start up to the new position; captions aren't re-timed.
+	SeekTrack(ctx context.Context, in *SeekTrackRequest, opts ...grpc.CallOption) (*SeekTrackResponse, error)
+	// Hold a track's playback where it is, keeping what it has queued, and
+	// continue it later from the same place
+	PauseTrack(ctx context.Context, in *TrackPauseRequest, opts ...grpc.CallOption) (*TrackPauseResponse, error)
+	ResumeTrack(ctx context.Context, in *TrackPauseRequest, opts ...grpc.CallOption) (*TrackPauseResponse, error)
+	// Set a track's volume, including audio it already has queued; the
+	// setting survives the track being recreated
+	SetTrackVolume(ctx context.Context, in *SetTrackVolumeRequest, opts ...grpc.CallOption) (*SetTrackVolumeResponse, error)
+	// Turn automatic gain control on or off for a track, overriding
+	// AGC_ENABLED; the setting survives the track being recreated
+	SetTrackAGC(ctx context.Context, in *SetTrackAGCRequest, opts ...grpc.CallOption) (*SetTrackAGCResponse, error)
+	// Silence a published track through LiveKit's track mute, keeping it
+	// published (same SID, no renegotiation) while playback continues
+	// underneath, or make it audible again
+	MuteTrack(ctx context.Context, in *TrackMuteRequest, opts ...grpc.CallOption) (*TrackMuteResponse, error)
+	UnmuteTrack(ctx context.Context, in *TrackMuteRequest, opts ...grpc.CallOption) (*TrackMuteResponse, error)
+	// Tracks the session is publishing, with what each is playing
+	ListTracks(ctx context.Context, in *ListTracksRequest, opts ...grpc.CallOption) (*ListTracksResponse, error)
 	// Health check (for monitoring/load balancing)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	// Bridge status (room connectivity for a specific user session)
 	GetStatus(ctx context.Context, in *BridgeStatusRequest, opts ...grpc.CallOption) (*BridgeStatusResponse, error)
+	// Bridge status pushed on every connection, track or participant change
+	//
+	// Sends the current status first. The stream ends when the session closes.
+	WatchStatus(ctx context.Context, in *BridgeStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BridgeStatusUpdate], error)
+	// Session events (barge-in, ...) pushed as they happen
+	//
+	// The stream ends when the session closes.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error)
+	// Incoming audio from a single remote participant and/or data track
+	//
+	// Runs alongside StreamAudio, which keeps receiving the merged audio.
+	// Open one stream per participant for per-speaker transcription. The
+	// stream ends when the session closes.
+	SubscribeAudio(ctx context.Context, in *SubscribeAudioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AudioChunk], error)
+	// Choose which remote participants or tracks the bridge takes audio from
+	//
+	// With no subscriptions the bridge hears data packet audio from everyone
+	// and subscribes to no media tracks. Once any exist, only matching audio
+	// is accepted, and matching remote audio tracks are subscribed.
+	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*UpdateSubscriptionResponse, error)
+	// Replace the per-participant weights of a session's mixdown (JoinRoom
+	// mixdown); participants left out are mixed at 1.0
+	SetMixdownGains(ctx context.Context, in *SetMixdownGainsRequest, opts ...grpc.CallOption) (*SetMixdownGainsResponse, error)
+	// Replace a session's routing matrix: which inputs (remote participants,
+	// audio played on tracks, generated tones) are mixed into which outputs
+	// (published tracks, the ASR feed, the recording), each at its own gain
+	SetRoutes(ctx context.Context, in *SetRoutesRequest, opts ...grpc.CallOption) (*SetRoutesResponse, error)
+	// Publish interim/final transcripts with LiveKit's transcription
+	// protocol, so standard LiveKit clients show them as captions
+	PublishTranscription(ctx context.Context, in *PublishTranscriptionRequest, opts ...grpc.CallOption) (*PublishTranscriptionResponse, error)
+	// Change the bridge's log level without a restart
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// Goroutines, tracks and channels each session owns, including sessions
+	// that have closed but still hold some (leaks)
+	GetSessionResources(ctx context.Context, in *SessionResourcesRequest, opts ...grpc.CallOption) (*SessionResourcesResponse, error)
+	// Query a user's stored session history (needs MONGODB_URI)
+	QuerySessionEvents(ctx context.Context, in *QuerySessionEventsRequest, opts ...grpc.CallOption) (*QuerySessionEventsResponse, error)
+	// Find which bridge replica owns a user's session (needs REDIS_URL)
+	LocateSession(ctx context.Context, in *LocateSessionRequest, opts ...grpc.CallOption) (*LocateSessionResponse, error)
+	// Move a live session to another bridge replica (needs REDIS_URL)
+	//
+	// The session's join request and playback (the clip playing, from where
+	// it was heard, and everything queued behind it) are sent to the target,
+	// which joins the room under the same identity and resumes playback; this
+	// replica then closes its session. Streams open on this replica end, and
+	// the cloud reopens them on the replica returned.
+	HandoffSession(ctx context.Context, in *HandoffSessionRequest, opts ...grpc.CallOption) (*HandoffSessionResponse, error)
+	// Take over a session handed off by another replica (bridge to bridge)
+	AcceptSession(ctx context.Context, in *AcceptSessionRequest, opts ...grpc.CallOption) (*AcceptSessionResponse, error)
+	// Capture a session's state (join request, subscriptions, playback) as a
+	// snapshot that RestoreSession can rebuild it from
+	SerializeSession(ctx context.Context, in *SerializeSessionRequest, opts ...grpc.CallOption) (*SerializeSessionResponse, error)
+	// Rebuild a session from a snapshot: the one given, or the last one this
+	// or another replica saved in the registry (needs REDIS_URL)
+	RestoreSession(ctx context.Context, in *RestoreSessionRequest, opts ...grpc.CallOption) (*RestoreSessionResponse, error)
+	// Seconds of audio published and received per user and app, for billing
+	// and quotas (needs MONGODB_URI)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// Record a session's incoming audio (and optionally its published tracks)
+	// to rotating WAV or Ogg/Opus files under RECORDING_DIR, for debugging or
+	// consented data collection
+	StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingResponse, error)
+	StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingResponse, error)
+	// Write the last AUDIO_RING_BUFFER_MS of a session's incoming and
+	// published audio to WAV files under AUDIO_DUMP_DIR, e.g. when a user
+	// reports garbled speech after the fact
+	DumpAudio(ctx context.Context, in *DumpAudioRequest, opts ...grpc.CallOption) (*DumpAudioResponse, error)
+	// Play a TTS stream as it is produced: the caller forwards the provider's
+	// chunks (MP3, WAV, Ogg/Opus, AAC or raw PCM) and they are decoded and
+	// played as they arrive, without a URL to fetch. Also served over HTTP on
+	// TTS_INGEST_PORT.
+	IngestAudio(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestAudioRequest, IngestAudioResponse], error)
+	// Listen for keywords on a session's mic audio, on the bridge, and emit a
+	// "wake_word" event when one is heard; an empty list turns it off. A
+	// keyword is a "<name>.ppn" model in WAKE_WORD_DIR or one of Porcupine's
+	// built-in keywords ("computer", "jarvis", "hey google", ...).
+	SetWakeWords(ctx context.Context, in *SetWakeWordsRequest, opts ...grpc.CallOption) (*SetWakeWordsResponse, error)
+	// Echo test: play a session's incoming audio straight back into the room
+	// on a "loopback" track, reporting its time through the bridge in
+	// "loopback_latency" events
+	SetLoopback(ctx context.Context, in *SetLoopbackRequest, opts ...grpc.CallOption) (*SetLoopbackResponse, error)
+	// Measure round-trip audio latency: play a short chirp (near-ultrasonic
+	// at 44.1kHz and up) on a track and time its return in the session's
+	// incoming audio, which needs the client to loop its output back (e.g.
+	// its own loopback mode). The result is also kept for GetStatus.
+	ProbeLatency(ctx context.Context, in *ProbeLatencyRequest, opts ...grpc.CallOption) (*ProbeLatencyResponse, error)
+	// How the RTP and wall clocks of a session's received tracks line up with
+	// the bridge's clock, per the latest RTCP sender reports, for aligning
+	// transcripts, captions and video events on one timeline
+	GetClockMapping(ctx context.Context, in *GetClockMappingRequest, opts ...grpc.CallOption) (*GetClockMappingResponse, error)
+	// Play one clip on a track in many users' rooms at once (announcements,
+	// group experiences): it is fetched and decoded once and each room paces
+	// its own copy. Returns once every room has played it out.
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	// Also publish a session's PCM tracks into a second room (monitoring,
+	// recording), joined with its own token, or stop
+	SetMirrorRoom(ctx context.Context, in *SetMirrorRoomRequest, opts ...grpc.CallOption) (*SetMirrorRoomResponse, error)
+	// Place a track between the left and right ear (e.g. navigation cues
+	// "from the left"), with a plain pan or simple spatialization
+	SetTrackPan(ctx context.Context, in *SetTrackPanRequest, opts ...grpc.CallOption) (*SetTrackPanResponse, error)
+	// Shift the pitch of, anonymize or otherwise transform the voice on a
+	// track or in received mic audio (privacy features, voice effects)
+	SetVoiceEffect(ctx context.Context, in *SetVoiceEffectRequest, opts ...grpc.CallOption) (*SetVoiceEffectResponse, error)
+	// Play a track faster or slower (0.5x-2x) without changing its pitch,
+	// e.g. for podcasts and audiobooks
+	SetPlaybackRate(ctx context.Context, in *SetPlaybackRateRequest, opts ...grpc.CallOption) (*SetPlaybackRateResponse, error)
 }
 
 type liveKitBridgeClient struct {
@@ -128,212 +307,1621 @@ func (c *liveKitBridgeClient) StopAudio(ctx context.Context, in *StopAudioReques
 	return out, nil
 }
 
-func (c *liveKitBridgeClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+func (c *liveKitBridgeClient) EnqueueAudio(ctx context.Context, in *PlayAudioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlayAudioEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, LiveKitBridge_HealthCheck_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &LiveKitBridge_ServiceDesc.Streams[2], LiveKitBridge_EnqueueAudio_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[PlayAudioRequest, PlayAudioEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *liveKitBridgeClient) GetStatus(ctx context.Context, in *BridgeStatusRequest, opts ...grpc.CallOption) (*BridgeStatusResponse, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_EnqueueAudioClient = grpc.ServerStreamingClient[PlayAudioEvent]
+
+func (c *liveKitBridgeClient) GetAudioQueue(ctx context.Context, in *AudioQueueRequest, opts ...grpc.CallOption) (*AudioQueueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BridgeStatusResponse)
-	err := c.cc.Invoke(ctx, LiveKitBridge_GetStatus_FullMethodName, in, out, cOpts...)
+	out := new(AudioQueueResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_GetAudioQueue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LiveKitBridgeServer is the server API for LiveKitBridge service.
-// All implementations must embed UnimplementedLiveKitBridgeServer
-// for forward compatibility.
-//
-// # LiveKit Bridge Service
-//
-// Provides gRPC interface between TypeScript cloud and Go LiveKit bridge.
-// Handles bidirectional audio streaming and room management.
-type LiveKitBridgeServer interface {
-	// Bidirectional audio streaming
-	//
-	// Client can send audio TO LiveKit room and receive audio FROM room
-	// in a single multiplexed stream. Audio arrives bursty from LiveKit
-	// (network/buffering), TypeScript handles jitter buffering for Soniox.
-	StreamAudio(grpc.BidiStreamingServer[AudioChunk, AudioChunk]) error
-	// Room lifecycle management
-	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error)
-	LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error)
-	// Server-side audio playback (MP3/WAV → LiveKit track)
-	//
-	// Returns streaming events for progress tracking.
-	// Used by session.audio.playAudio() and session.audio.speak()
-	PlayAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error
-	StopAudio(context.Context, *StopAudioRequest) (*StopAudioResponse, error)
-	// Health check (for monitoring/load balancing)
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	// Bridge status (room connectivity for a specific user session)
-	GetStatus(context.Context, *BridgeStatusRequest) (*BridgeStatusResponse, error)
-	mustEmbedUnimplementedLiveKitBridgeServer()
+func (c *liveKitBridgeClient) MoveQueuedAudio(ctx context.Context, in *MoveQueuedAudioRequest, opts ...grpc.CallOption) (*AudioQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudioQueueResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_MoveQueuedAudio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedLiveKitBridgeServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLiveKitBridgeServer struct{}
-
-func (UnimplementedLiveKitBridgeServer) StreamAudio(grpc.BidiStreamingServer[AudioChunk, AudioChunk]) error {
-	return status.Errorf(codes.Unimplemented, "method StreamAudio not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) PlayAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error {
-	return status.Errorf(codes.Unimplemented, "method PlayAudio not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) StopAudio(context.Context, *StopAudioRequest) (*StopAudioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopAudio not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
-}
-func (UnimplementedLiveKitBridgeServer) GetStatus(context.Context, *BridgeStatusRequest) (*BridgeStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+func (c *liveKitBridgeClient) ClearAudioQueue(ctx context.Context, in *AudioQueueRequest, opts ...grpc.CallOption) (*ClearAudioQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAudioQueueResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_ClearAudioQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedLiveKitBridgeServer) mustEmbedUnimplementedLiveKitBridgeServer() {}
-func (UnimplementedLiveKitBridgeServer) testEmbeddedByValue()                       {}
 
-// UnsafeLiveKitBridgeServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LiveKitBridgeServer will
-// result in compilation errors.
-type UnsafeLiveKitBridgeServer interface {
-	mustEmbedUnimplementedLiveKitBridgeServer()
+func (c *liveKitBridgeClient) SeekTrack(ctx context.Context, in *SeekTrackRequest, opts ...grpc.CallOption) (*SeekTrackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeekTrackResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SeekTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterLiveKitBridgeServer(s grpc.ServiceRegistrar, srv LiveKitBridgeServer) {
-	// If the following call pancis, it indicates UnimplementedLiveKitBridgeServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *liveKitBridgeClient) PauseTrack(ctx context.Context, in *TrackPauseRequest, opts ...grpc.CallOption) (*TrackPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackPauseResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_PauseTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&LiveKitBridge_ServiceDesc, srv)
+	return out, nil
 }
 
-func _LiveKitBridge_StreamAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LiveKitBridgeServer).StreamAudio(&grpc.GenericServerStream[AudioChunk, AudioChunk]{ServerStream: stream})
+func (c *liveKitBridgeClient) ResumeTrack(ctx context.Context, in *TrackPauseRequest, opts ...grpc.CallOption) (*TrackPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackPauseResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_ResumeTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type LiveKitBridge_StreamAudioServer = grpc.BidiStreamingServer[AudioChunk, AudioChunk]
-
-func _LiveKitBridge_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRoomRequest)
-	if err := dec(in); err != nil {
+func (c *liveKitBridgeClient) SetTrackVolume(ctx context.Context, in *SetTrackVolumeRequest, opts ...grpc.CallOption) (*SetTrackVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTrackVolumeResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetTrackVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LiveKitBridgeServer).JoinRoom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LiveKitBridge_JoinRoom_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LiveKitBridgeServer).JoinRoom(ctx, req.(*JoinRoomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LiveKitBridge_LeaveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveRoomRequest)
-	if err := dec(in); err != nil {
+func (c *liveKitBridgeClient) SetTrackAGC(ctx context.Context, in *SetTrackAGCRequest, opts ...grpc.CallOption) (*SetTrackAGCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTrackAGCResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetTrackAGC_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LiveKitBridgeServer).LeaveRoom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LiveKitBridge_LeaveRoom_FullMethodName,
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) MuteTrack(ctx context.Context, in *TrackMuteRequest, opts ...grpc.CallOption) (*TrackMuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackMuteResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_MuteTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LiveKitBridgeServer).LeaveRoom(ctx, req.(*LeaveRoomRequest))
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) UnmuteTrack(ctx context.Context, in *TrackMuteRequest, opts ...grpc.CallOption) (*TrackMuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackMuteResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_UnmuteTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LiveKitBridge_PlayAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PlayAudioRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *liveKitBridgeClient) ListTracks(ctx context.Context, in *ListTracksRequest, opts ...grpc.CallOption) (*ListTracksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTracksResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_ListTracks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(LiveKitBridgeServer).PlayAudio(m, &grpc.GenericServerStream[PlayAudioRequest, PlayAudioEvent]{ServerStream: stream})
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type LiveKitBridge_PlayAudioServer = grpc.ServerStreamingServer[PlayAudioEvent]
+func (c *liveKitBridgeClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func _LiveKitBridge_StopAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopAudioRequest)
-	if err := dec(in); err != nil {
+func (c *liveKitBridgeClient) GetStatus(ctx context.Context, in *BridgeStatusRequest, opts ...grpc.CallOption) (*BridgeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BridgeStatusResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LiveKitBridgeServer).StopAudio(ctx, in)
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) WatchStatus(ctx context.Context, in *BridgeStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BridgeStatusUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LiveKitBridge_ServiceDesc.Streams[3], LiveKitBridge_WatchStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LiveKitBridge_StopAudio_FullMethodName,
+	x := &grpc.GenericClientStream[BridgeStatusRequest, BridgeStatusUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LiveKitBridgeServer).StopAudio(ctx, req.(*StopAudioRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _LiveKitBridge_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_WatchStatusClient = grpc.ServerStreamingClient[BridgeStatusUpdate]
+
+func (c *liveKitBridgeClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LiveKitBridge_ServiceDesc.Streams[4], LiveKitBridge_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LiveKitBridgeServer).HealthCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LiveKitBridge_HealthCheck_FullMethodName,
+	x := &grpc.GenericClientStream[StreamEventsRequest, SessionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LiveKitBridgeServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _LiveKitBridge_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BridgeStatusRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_StreamEventsClient = grpc.ServerStreamingClient[SessionEvent]
+
+func (c *liveKitBridgeClient) SubscribeAudio(ctx context.Context, in *SubscribeAudioRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AudioChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LiveKitBridge_ServiceDesc.Streams[5], LiveKitBridge_SubscribeAudio_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LiveKitBridgeServer).GetStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LiveKitBridge_GetStatus_FullMethodName,
+	x := &grpc.GenericClientStream[SubscribeAudioRequest, AudioChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LiveKitBridgeServer).GetStatus(ctx, req.(*BridgeStatusRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_SubscribeAudioClient = grpc.ServerStreamingClient[AudioChunk]
+
+func (c *liveKitBridgeClient) UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*UpdateSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_UpdateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetMixdownGains(ctx context.Context, in *SetMixdownGainsRequest, opts ...grpc.CallOption) (*SetMixdownGainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMixdownGainsResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetMixdownGains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetRoutes(ctx context.Context, in *SetRoutesRequest, opts ...grpc.CallOption) (*SetRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRoutesResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) PublishTranscription(ctx context.Context, in *PublishTranscriptionRequest, opts ...grpc.CallOption) (*PublishTranscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishTranscriptionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_PublishTranscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) GetSessionResources(ctx context.Context, in *SessionResourcesRequest, opts ...grpc.CallOption) (*SessionResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionResourcesResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_GetSessionResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) QuerySessionEvents(ctx context.Context, in *QuerySessionEventsRequest, opts ...grpc.CallOption) (*QuerySessionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySessionEventsResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_QuerySessionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) LocateSession(ctx context.Context, in *LocateSessionRequest, opts ...grpc.CallOption) (*LocateSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocateSessionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_LocateSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) HandoffSession(ctx context.Context, in *HandoffSessionRequest, opts ...grpc.CallOption) (*HandoffSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandoffSessionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_HandoffSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) AcceptSession(ctx context.Context, in *AcceptSessionRequest, opts ...grpc.CallOption) (*AcceptSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptSessionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_AcceptSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SerializeSession(ctx context.Context, in *SerializeSessionRequest, opts ...grpc.CallOption) (*SerializeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SerializeSessionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SerializeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) RestoreSession(ctx context.Context, in *RestoreSessionRequest, opts ...grpc.CallOption) (*RestoreSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSessionResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_RestoreSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartRecordingResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_StartRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopRecordingResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_StopRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) DumpAudio(ctx context.Context, in *DumpAudioRequest, opts ...grpc.CallOption) (*DumpAudioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DumpAudioResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_DumpAudio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) IngestAudio(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IngestAudioRequest, IngestAudioResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LiveKitBridge_ServiceDesc.Streams[6], LiveKitBridge_IngestAudio_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IngestAudioRequest, IngestAudioResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_IngestAudioClient = grpc.ClientStreamingClient[IngestAudioRequest, IngestAudioResponse]
+
+func (c *liveKitBridgeClient) SetWakeWords(ctx context.Context, in *SetWakeWordsRequest, opts ...grpc.CallOption) (*SetWakeWordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWakeWordsResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetWakeWords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetLoopback(ctx context.Context, in *SetLoopbackRequest, opts ...grpc.CallOption) (*SetLoopbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLoopbackResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetLoopback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) ProbeLatency(ctx context.Context, in *ProbeLatencyRequest, opts ...grpc.CallOption) (*ProbeLatencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProbeLatencyResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_ProbeLatency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) GetClockMapping(ctx context.Context, in *GetClockMappingRequest, opts ...grpc.CallOption) (*GetClockMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClockMappingResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_GetClockMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_Broadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetMirrorRoom(ctx context.Context, in *SetMirrorRoomRequest, opts ...grpc.CallOption) (*SetMirrorRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMirrorRoomResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetMirrorRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetTrackPan(ctx context.Context, in *SetTrackPanRequest, opts ...grpc.CallOption) (*SetTrackPanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTrackPanResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetTrackPan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetVoiceEffect(ctx context.Context, in *SetVoiceEffectRequest, opts ...grpc.CallOption) (*SetVoiceEffectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVoiceEffectResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetVoiceEffect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *liveKitBridgeClient) SetPlaybackRate(ctx context.Context, in *SetPlaybackRateRequest, opts ...grpc.CallOption) (*SetPlaybackRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPlaybackRateResponse)
+	err := c.cc.Invoke(ctx, LiveKitBridge_SetPlaybackRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LiveKitBridgeServer is the server API for LiveKitBridge service.
+// All implementations must embed UnimplementedLiveKitBridgeServer
+// for forward compatibility.
+//
+// # LiveKit Bridge Service
+//
+// Provides gRPC interface between TypeScript cloud and Go LiveKit bridge.
+// Handles bidirectional audio streaming and room management.
+type LiveKitBridgeServer interface {
+	// Bidirectional audio streaming
+	//
+	// Client can send audio TO LiveKit room and receive audio FROM room
+	// in a single multiplexed stream. Audio arrives bursty from LiveKit
+	// (network/buffering), TypeScript handles jitter buffering for Soniox.
+	StreamAudio(grpc.BidiStreamingServer[AudioChunk, AudioChunk]) error
+	// Room lifecycle management
+	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error)
+	LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error)
+	// Server-side audio playback (MP3/WAV → LiveKit track)
+	//
+	// Returns streaming events for progress tracking.
+	// Used by session.audio.playAudio() and session.audio.speak()
+	PlayAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error
+	StopAudio(context.Context, *StopAudioRequest) (*StopAudioResponse, error)
+	// Queue audio on a track behind whatever it is playing or has queued
+	//
+	// Clips on a queue play back-to-back with no gap between them (e.g.
+	// sentence-by-sentence TTS). Events match PlayAudio's: STARTED when the
+	// clip's turn comes, COMPLETED once it has played out. Cancelling the
+	// call removes the clip from the queue.
+	EnqueueAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error
+	// Inspect, reorder and clear a track's playback queue
+	GetAudioQueue(context.Context, *AudioQueueRequest) (*AudioQueueResponse, error)
+	MoveQueuedAudio(context.Context, *MoveQueuedAudioRequest) (*AudioQueueResponse, error)
+	ClearAudioQueue(context.Context, *AudioQueueRequest) (*ClearAudioQueueResponse, error)
+	// Move file/URL playback on a track to another position (skip forward or
+	// back) without ending its PlayAudio stream. The source is decoded again
+	// from the start up to the new position; captions aren't re-timed.
+	SeekTrack(context.Context, *SeekTrackRequest) (*SeekTrackResponse, error)
+	// Hold a track's playback where it is, keeping what it has queued, and
+	// continue it later from the same place
+	PauseTrack(context.Context, *TrackPauseRequest) (*TrackPauseResponse, error)
+	ResumeTrack(context.Context, *TrackPauseRequest) (*TrackPauseResponse, error)
+	// Set a track's volume, including audio it already has queued; the
+	// setting survives the track being recreated
+	SetTrackVolume(context.Context, *SetTrackVolumeRequest) (*SetTrackVolumeResponse, error)
+	// Turn automatic gain control on or off for a track, overriding
+	// AGC_ENABLED; the setting survives the track being recreated
+	SetTrackAGC(context.Context, *SetTrackAGCRequest) (*SetTrackAGCResponse, error)
+	// Silence a published track through LiveKit's track mute, keeping it
+	// published (same SID, no renegotiation) while playback continues
+	// underneath, or make it audible again
+	MuteTrack(context.Context, *TrackMuteRequest) (*TrackMuteResponse, error)
+	UnmuteTrack(context.Context, *TrackMuteRequest) (*TrackMuteResponse, error)
+	// Tracks the session is publishing, with what each is playing
+	ListTracks(context.Context, *ListTracksRequest) (*ListTracksResponse, error)
+	// Health check (for monitoring/load balancing)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	// Bridge status (room connectivity for a specific user session)
+	GetStatus(context.Context, *BridgeStatusRequest) (*BridgeStatusResponse, error)
+	// Bridge status pushed on every connection, track or participant change
+	//
+	// Sends the current status first. The stream ends when the session closes.
+	WatchStatus(*BridgeStatusRequest, grpc.ServerStreamingServer[BridgeStatusUpdate]) error
+	// Session events (barge-in, ...) pushed as they happen
+	//
+	// The stream ends when the session closes.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[SessionEvent]) error
+	// Incoming audio from a single remote participant and/or data track
+	//
+	// Runs alongside StreamAudio, which keeps receiving the merged audio.
+	// Open one stream per participant for per-speaker transcription. The
+	// stream ends when the session closes.
+	SubscribeAudio(*SubscribeAudioRequest, grpc.ServerStreamingServer[AudioChunk]) error
+	// Choose which remote participants or tracks the bridge takes audio from
+	//
+	// With no subscriptions the bridge hears data packet audio from everyone
+	// and subscribes to no media tracks. Once any exist, only matching audio
+	// is accepted, and matching remote audio tracks are subscribed.
+	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*UpdateSubscriptionResponse, error)
+	// Replace the per-participant weights of a session's mixdown (JoinRoom
+	// mixdown); participants left out are mixed at 1.0
+	SetMixdownGains(context.Context, *SetMixdownGainsRequest) (*SetMixdownGainsResponse, error)
+	// Replace a session's routing matrix: which inputs (remote participants,
+	// audio played on tracks, generated tones) are mixed into which outputs
+	// (published tracks, the ASR feed, the recording), each at its own gain
+	SetRoutes(context.Context, *SetRoutesRequest) (*SetRoutesResponse, error)
+	// Publish interim/final transcripts with LiveKit's transcription
+	// protocol, so standard LiveKit clients show them as captions
+	PublishTranscription(context.Context, *PublishTranscriptionRequest) (*PublishTranscriptionResponse, error)
+	// Change the bridge's log level without a restart
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// Goroutines, tracks and channels each session owns, including sessions
+	// that have closed but still hold some (leaks)
+	GetSessionResources(context.Context, *SessionResourcesRequest) (*SessionResourcesResponse, error)
+	// Query a user's stored session history (needs MONGODB_URI)
+	QuerySessionEvents(context.Context, *QuerySessionEventsRequest) (*QuerySessionEventsResponse, error)
+	// Find which bridge replica owns a user's session (needs REDIS_URL)
+	LocateSession(context.Context, *LocateSessionRequest) (*LocateSessionResponse, error)
+	// Move a live session to another bridge replica (needs REDIS_URL)
+	//
+	// The session's join request and playback (the clip playing, from where
+	// it was heard, and everything queued behind it) are sent to the target,
+	// which joins the room under the same identity and resumes playback; this
+	// replica then closes its session. Streams open on this replica end, and
+	// the cloud reopens them on the replica returned.
+	HandoffSession(context.Context, *HandoffSessionRequest) (*HandoffSessionResponse, error)
+	// Take over a session handed off by another replica (bridge to bridge)
+	AcceptSession(context.Context, *AcceptSessionRequest) (*AcceptSessionResponse, error)
+	// Capture a session's state (join request, subscriptions, playback) as a
+	// snapshot that RestoreSession can rebuild it from
+	SerializeSession(context.Context, *SerializeSessionRequest) (*SerializeSessionResponse, error)
+	// Rebuild a session from a snapshot: the one given, or the last one this
+	// or another replica saved in the registry (needs REDIS_URL)
+	RestoreSession(context.Context, *RestoreSessionRequest) (*RestoreSessionResponse, error)
+	// Seconds of audio published and received per user and app, for billing
+	// and quotas (needs MONGODB_URI)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// Record a session's incoming audio (and optionally its published tracks)
+	// to rotating WAV or Ogg/Opus files under RECORDING_DIR, for debugging or
+	// consented data collection
+	StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingResponse, error)
+	StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingResponse, error)
+	// Write the last AUDIO_RING_BUFFER_MS of a session's incoming and
+	// published audio to WAV files under AUDIO_DUMP_DIR, e.g. when a user
+	// reports garbled speech after the fact
+	DumpAudio(context.Context, *DumpAudioRequest) (*DumpAudioResponse, error)
+	// Play a TTS stream as it is produced: the caller forwards the provider's
+	// chunks (MP3, WAV, Ogg/Opus, AAC or raw PCM) and they are decoded and
+	// played as they arrive, without a URL to fetch. Also served over HTTP on
+	// TTS_INGEST_PORT.
+	IngestAudio(grpc.ClientStreamingServer[IngestAudioRequest, IngestAudioResponse]) error
+	// Listen for keywords on a session's mic audio, on the bridge, and emit a
+	// "wake_word" event when one is heard; an empty list turns it off. A
+	// keyword is a "<name>.ppn" model in WAKE_WORD_DIR or one of Porcupine's
+	// built-in keywords ("computer", "jarvis", "hey google", ...).
+	SetWakeWords(context.Context, *SetWakeWordsRequest) (*SetWakeWordsResponse, error)
+	// Echo test: play a session's incoming audio straight back into the room
+	// on a "loopback" track, reporting its time through the bridge in
+	// "loopback_latency" events
+	SetLoopback(context.Context, *SetLoopbackRequest) (*SetLoopbackResponse, error)
+	// Measure round-trip audio latency: play a short chirp (near-ultrasonic
+	// at 44.1kHz and up) on a track and time its return in the session's
+	// incoming audio, which needs the client to loop its output back (e.g.
+	// its own loopback mode). The result is also kept for GetStatus.
+	ProbeLatency(context.Context, *ProbeLatencyRequest) (*ProbeLatencyResponse, error)
+	// How the RTP and wall clocks of a session's received tracks line up with
+	// the bridge's clock, per the latest RTCP sender reports, for aligning
+	// transcripts, captions and video events on one timeline
+	GetClockMapping(context.Context, *GetClockMappingRequest) (*GetClockMappingResponse, error)
+	// Play one clip on a track in many users' rooms at once (announcements,
+	// group experiences): it is fetched and decoded once and each room paces
+	// its own copy. Returns once every room has played it out.
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	// Also publish a session's PCM tracks into a second room (monitoring,
+	// recording), joined with its own token, or stop
+	SetMirrorRoom(context.Context, *SetMirrorRoomRequest) (*SetMirrorRoomResponse, error)
+	// Place a track between the left and right ear (e.g. navigation cues
+	// "from the left"), with a plain pan or simple spatialization
+	SetTrackPan(context.Context, *SetTrackPanRequest) (*SetTrackPanResponse, error)
+	// Shift the pitch of, anonymize or otherwise transform the voice on a
+	// track or in received mic audio (privacy features, voice effects)
+	SetVoiceEffect(context.Context, *SetVoiceEffectRequest) (*SetVoiceEffectResponse, error)
+	// Play a track faster or slower (0.5x-2x) without changing its pitch,
+	// e.g. for podcasts and audiobooks
+	SetPlaybackRate(context.Context, *SetPlaybackRateRequest) (*SetPlaybackRateResponse, error)
+	mustEmbedUnimplementedLiveKitBridgeServer()
+}
+
+// UnimplementedLiveKitBridgeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLiveKitBridgeServer struct{}
+
+func (UnimplementedLiveKitBridgeServer) StreamAudio(grpc.BidiStreamingServer[AudioChunk, AudioChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) LeaveRoom(context.Context, *LeaveRoomRequest) (*LeaveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) PlayAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method PlayAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) StopAudio(context.Context, *StopAudioRequest) (*StopAudioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) EnqueueAudio(*PlayAudioRequest, grpc.ServerStreamingServer[PlayAudioEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method EnqueueAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) GetAudioQueue(context.Context, *AudioQueueRequest) (*AudioQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudioQueue not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) MoveQueuedAudio(context.Context, *MoveQueuedAudioRequest) (*AudioQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveQueuedAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) ClearAudioQueue(context.Context, *AudioQueueRequest) (*ClearAudioQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAudioQueue not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SeekTrack(context.Context, *SeekTrackRequest) (*SeekTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeekTrack not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) PauseTrack(context.Context, *TrackPauseRequest) (*TrackPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTrack not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) ResumeTrack(context.Context, *TrackPauseRequest) (*TrackPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTrack not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetTrackVolume(context.Context, *SetTrackVolumeRequest) (*SetTrackVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrackVolume not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetTrackAGC(context.Context, *SetTrackAGCRequest) (*SetTrackAGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrackAGC not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) MuteTrack(context.Context, *TrackMuteRequest) (*TrackMuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteTrack not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) UnmuteTrack(context.Context, *TrackMuteRequest) (*TrackMuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteTrack not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) ListTracks(context.Context, *ListTracksRequest) (*ListTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTracks not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) GetStatus(context.Context, *BridgeStatusRequest) (*BridgeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) WatchStatus(*BridgeStatusRequest, grpc.ServerStreamingServer[BridgeStatusUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStatus not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[SessionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SubscribeAudio(*SubscribeAudioRequest, grpc.ServerStreamingServer[AudioChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*UpdateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetMixdownGains(context.Context, *SetMixdownGainsRequest) (*SetMixdownGainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMixdownGains not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetRoutes(context.Context, *SetRoutesRequest) (*SetRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoutes not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) PublishTranscription(context.Context, *PublishTranscriptionRequest) (*PublishTranscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTranscription not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) GetSessionResources(context.Context, *SessionResourcesRequest) (*SessionResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionResources not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) QuerySessionEvents(context.Context, *QuerySessionEventsRequest) (*QuerySessionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySessionEvents not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) LocateSession(context.Context, *LocateSessionRequest) (*LocateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateSession not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) HandoffSession(context.Context, *HandoffSessionRequest) (*HandoffSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffSession not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) AcceptSession(context.Context, *AcceptSessionRequest) (*AcceptSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSession not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SerializeSession(context.Context, *SerializeSessionRequest) (*SerializeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SerializeSession not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) RestoreSession(context.Context, *RestoreSessionRequest) (*RestoreSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSession not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) DumpAudio(context.Context, *DumpAudioRequest) (*DumpAudioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) IngestAudio(grpc.ClientStreamingServer[IngestAudioRequest, IngestAudioResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method IngestAudio not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetWakeWords(context.Context, *SetWakeWordsRequest) (*SetWakeWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWakeWords not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetLoopback(context.Context, *SetLoopbackRequest) (*SetLoopbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoopback not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) ProbeLatency(context.Context, *ProbeLatencyRequest) (*ProbeLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeLatency not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) GetClockMapping(context.Context, *GetClockMappingRequest) (*GetClockMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClockMapping not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetMirrorRoom(context.Context, *SetMirrorRoomRequest) (*SetMirrorRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMirrorRoom not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetTrackPan(context.Context, *SetTrackPanRequest) (*SetTrackPanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrackPan not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetVoiceEffect(context.Context, *SetVoiceEffectRequest) (*SetVoiceEffectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVoiceEffect not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) SetPlaybackRate(context.Context, *SetPlaybackRateRequest) (*SetPlaybackRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlaybackRate not implemented")
+}
+func (UnimplementedLiveKitBridgeServer) mustEmbedUnimplementedLiveKitBridgeServer() {}
+func (UnimplementedLiveKitBridgeServer) testEmbeddedByValue()                       {}
+
+// UnsafeLiveKitBridgeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LiveKitBridgeServer will
+// result in compilation errors.
+type UnsafeLiveKitBridgeServer interface {
+	mustEmbedUnimplementedLiveKitBridgeServer()
+}
+
+func RegisterLiveKitBridgeServer(s grpc.ServiceRegistrar, srv LiveKitBridgeServer) {
+	// If the following call pancis, it indicates UnimplementedLiveKitBridgeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LiveKitBridge_ServiceDesc, srv)
+}
+
+func _LiveKitBridge_StreamAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LiveKitBridgeServer).StreamAudio(&grpc.GenericServerStream[AudioChunk, AudioChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_StreamAudioServer = grpc.BidiStreamingServer[AudioChunk, AudioChunk]
+
+func _LiveKitBridge_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_JoinRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_LeaveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).LeaveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_LeaveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).LeaveRoom(ctx, req.(*LeaveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_PlayAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlayAudioRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveKitBridgeServer).PlayAudio(m, &grpc.GenericServerStream[PlayAudioRequest, PlayAudioEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_PlayAudioServer = grpc.ServerStreamingServer[PlayAudioEvent]
+
+func _LiveKitBridge_StopAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopAudioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).StopAudio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_StopAudio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).StopAudio(ctx, req.(*StopAudioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_EnqueueAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlayAudioRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveKitBridgeServer).EnqueueAudio(m, &grpc.GenericServerStream[PlayAudioRequest, PlayAudioEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_EnqueueAudioServer = grpc.ServerStreamingServer[PlayAudioEvent]
+
+func _LiveKitBridge_GetAudioQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudioQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).GetAudioQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_GetAudioQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).GetAudioQueue(ctx, req.(*AudioQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_MoveQueuedAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveQueuedAudioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).MoveQueuedAudio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_MoveQueuedAudio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).MoveQueuedAudio(ctx, req.(*MoveQueuedAudioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_ClearAudioQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudioQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).ClearAudioQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_ClearAudioQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).ClearAudioQueue(ctx, req.(*AudioQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SeekTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeekTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SeekTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SeekTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SeekTrack(ctx, req.(*SeekTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_PauseTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).PauseTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_PauseTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).PauseTrack(ctx, req.(*TrackPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_ResumeTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).ResumeTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_ResumeTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).ResumeTrack(ctx, req.(*TrackPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetTrackVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrackVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetTrackVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetTrackVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetTrackVolume(ctx, req.(*SetTrackVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetTrackAGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrackAGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetTrackAGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetTrackAGC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetTrackAGC(ctx, req.(*SetTrackAGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_MuteTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).MuteTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_MuteTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).MuteTrack(ctx, req.(*TrackMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_UnmuteTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).UnmuteTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_UnmuteTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).UnmuteTrack(ctx, req.(*TrackMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_ListTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).ListTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_ListTracks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).ListTracks(ctx, req.(*ListTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).GetStatus(ctx, req.(*BridgeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_WatchStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BridgeStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveKitBridgeServer).WatchStatus(m, &grpc.GenericServerStream[BridgeStatusRequest, BridgeStatusUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_WatchStatusServer = grpc.ServerStreamingServer[BridgeStatusUpdate]
+
+func _LiveKitBridge_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveKitBridgeServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, SessionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_StreamEventsServer = grpc.ServerStreamingServer[SessionEvent]
+
+func _LiveKitBridge_SubscribeAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAudioRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LiveKitBridgeServer).SubscribeAudio(m, &grpc.GenericServerStream[SubscribeAudioRequest, AudioChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_SubscribeAudioServer = grpc.ServerStreamingServer[AudioChunk]
+
+func _LiveKitBridge_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_UpdateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetMixdownGains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMixdownGainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetMixdownGains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetMixdownGains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetMixdownGains(ctx, req.(*SetMixdownGainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetRoutes(ctx, req.(*SetRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_PublishTranscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTranscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).PublishTranscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_PublishTranscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).PublishTranscription(ctx, req.(*PublishTranscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_GetSessionResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).GetSessionResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_GetSessionResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).GetSessionResources(ctx, req.(*SessionResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_QuerySessionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).QuerySessionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_QuerySessionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).QuerySessionEvents(ctx, req.(*QuerySessionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_LocateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).LocateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_LocateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).LocateSession(ctx, req.(*LocateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_HandoffSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).HandoffSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_HandoffSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).HandoffSession(ctx, req.(*HandoffSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_AcceptSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).AcceptSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_AcceptSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).AcceptSession(ctx, req.(*AcceptSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SerializeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SerializeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SerializeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SerializeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SerializeSession(ctx, req.(*SerializeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_RestoreSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).RestoreSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_RestoreSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).RestoreSession(ctx, req.(*RestoreSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_StartRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).StartRecording(ctx, req.(*StartRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_StopRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).StopRecording(ctx, req.(*StopRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_DumpAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpAudioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).DumpAudio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_DumpAudio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).DumpAudio(ctx, req.(*DumpAudioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_IngestAudio_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LiveKitBridgeServer).IngestAudio(&grpc.GenericServerStream[IngestAudioRequest, IngestAudioResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LiveKitBridge_IngestAudioServer = grpc.ClientStreamingServer[IngestAudioRequest, IngestAudioResponse]
+
+func _LiveKitBridge_SetWakeWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWakeWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetWakeWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetWakeWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetWakeWords(ctx, req.(*SetWakeWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetLoopback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoopbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetLoopback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetLoopback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetLoopback(ctx, req.(*SetLoopbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_ProbeLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).ProbeLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_ProbeLatency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).ProbeLatency(ctx, req.(*ProbeLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_GetClockMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClockMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).GetClockMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_GetClockMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).GetClockMapping(ctx, req.(*GetClockMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_Broadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).Broadcast(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetMirrorRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMirrorRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetMirrorRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetMirrorRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetMirrorRoom(ctx, req.(*SetMirrorRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetTrackPan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrackPanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetTrackPan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetTrackPan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetTrackPan(ctx, req.(*SetTrackPanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetVoiceEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVoiceEffectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetVoiceEffect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetVoiceEffect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetVoiceEffect(ctx, req.(*SetVoiceEffectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LiveKitBridge_SetPlaybackRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlaybackRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LiveKitBridgeServer).SetPlaybackRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LiveKitBridge_SetPlaybackRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LiveKitBridgeServer).SetPlaybackRate(ctx, req.(*SetPlaybackRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // LiveKitBridge_ServiceDesc is the grpc.ServiceDesc for LiveKitBridge service.
@@ -355,6 +1943,50 @@ var LiveKitBridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopAudio",
 			Handler:    _LiveKitBridge_StopAudio_Handler,
 		},
+		{
+			MethodName: "GetAudioQueue",
+			Handler:    _LiveKitBridge_GetAudioQueue_Handler,
+		},
+		{
+			MethodName: "MoveQueuedAudio",
+			Handler:    _LiveKitBridge_MoveQueuedAudio_Handler,
+		},
+		{
+			MethodName: "ClearAudioQueue",
+			Handler:    _LiveKitBridge_ClearAudioQueue_Handler,
+		},
+		{
+			MethodName: "SeekTrack",
+			Handler:    _LiveKitBridge_SeekTrack_Handler,
+		},
+		{
+			MethodName: "PauseTrack",
+			Handler:    _LiveKitBridge_PauseTrack_Handler,
+		},
+		{
+			MethodName: "ResumeTrack",
+			Handler:    _LiveKitBridge_ResumeTrack_Handler,
+		},
+		{
+			MethodName: "SetTrackVolume",
+			Handler:    _LiveKitBridge_SetTrackVolume_Handler,
+		},
+		{
+			MethodName: "SetTrackAGC",
+			Handler:    _LiveKitBridge_SetTrackAGC_Handler,
+		},
+		{
+			MethodName: "MuteTrack",
+			Handler:    _LiveKitBridge_MuteTrack_Handler,
+		},
+		{
+			MethodName: "UnmuteTrack",
+			Handler:    _LiveKitBridge_UnmuteTrack_Handler,
+		},
+		{
+			MethodName: "ListTracks",
+			Handler:    _LiveKitBridge_ListTracks_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _LiveKitBridge_HealthCheck_Handler,
@@ -363,6 +1995,106 @@ var LiveKitBridge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _LiveKitBridge_GetStatus_Handler,
 		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _LiveKitBridge_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "SetMixdownGains",
+			Handler:    _LiveKitBridge_SetMixdownGains_Handler,
+		},
+		{
+			MethodName: "SetRoutes",
+			Handler:    _LiveKitBridge_SetRoutes_Handler,
+		},
+		{
+			MethodName: "PublishTranscription",
+			Handler:    _LiveKitBridge_PublishTranscription_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _LiveKitBridge_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetSessionResources",
+			Handler:    _LiveKitBridge_GetSessionResources_Handler,
+		},
+		{
+			MethodName: "QuerySessionEvents",
+			Handler:    _LiveKitBridge_QuerySessionEvents_Handler,
+		},
+		{
+			MethodName: "LocateSession",
+			Handler:    _LiveKitBridge_LocateSession_Handler,
+		},
+		{
+			MethodName: "HandoffSession",
+			Handler:    _LiveKitBridge_HandoffSession_Handler,
+		},
+		{
+			MethodName: "AcceptSession",
+			Handler:    _LiveKitBridge_AcceptSession_Handler,
+		},
+		{
+			MethodName: "SerializeSession",
+			Handler:    _LiveKitBridge_SerializeSession_Handler,
+		},
+		{
+			MethodName: "RestoreSession",
+			Handler:    _LiveKitBridge_RestoreSession_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _LiveKitBridge_GetUsage_Handler,
+		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _LiveKitBridge_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _LiveKitBridge_StopRecording_Handler,
+		},
+		{
+			MethodName: "DumpAudio",
+			Handler:    _LiveKitBridge_DumpAudio_Handler,
+		},
+		{
+			MethodName: "SetWakeWords",
+			Handler:    _LiveKitBridge_SetWakeWords_Handler,
+		},
+		{
+			MethodName: "SetLoopback",
+			Handler:    _LiveKitBridge_SetLoopback_Handler,
+		},
+		{
+			MethodName: "ProbeLatency",
+			Handler:    _LiveKitBridge_ProbeLatency_Handler,
+		},
+		{
+			MethodName: "GetClockMapping",
+			Handler:    _LiveKitBridge_GetClockMapping_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _LiveKitBridge_Broadcast_Handler,
+		},
+		{
+			MethodName: "SetMirrorRoom",
+			Handler:    _LiveKitBridge_SetMirrorRoom_Handler,
+		},
+		{
+			MethodName: "SetTrackPan",
+			Handler:    _LiveKitBridge_SetTrackPan_Handler,
+		},
+		{
+			MethodName: "SetVoiceEffect",
+			Handler:    _LiveKitBridge_SetVoiceEffect_Handler,
+		},
+		{
+			MethodName: "SetPlaybackRate",
+			Handler:    _LiveKitBridge_SetPlaybackRate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -376,6 +2108,31 @@ var LiveKitBridge_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _LiveKitBridge_PlayAudio_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "EnqueueAudio",
+			Handler:       _LiveKitBridge_EnqueueAudio_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchStatus",
+			Handler:       _LiveKitBridge_WatchStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _LiveKitBridge_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAudio",
+			Handler:       _LiveKitBridge_SubscribeAudio_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "IngestAudio",
+			Handler:       _LiveKitBridge_IngestAudio_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/livekit_bridge.proto",
 }
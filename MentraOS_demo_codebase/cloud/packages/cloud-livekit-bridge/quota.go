@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Per-session quotas a QuotaError can report
+const (
+	QuotaTracks    = "tracks"    // simultaneous tracks
+	QuotaBandwidth = "bandwidth" // audio written per second
+)
+
+// quotaBurst is how far ahead of the bandwidth limit a session may write,
+// so a clip filling its playback queue at decode speed isn't refused
+const quotaBurst = 2 * time.Second
+
+// QuotaError is returned when a session goes over one of its limits, or a
+// user or app has used up its daily audio (see dailyQuotas)
+type QuotaError struct {
+	Quota    string // QuotaTracks, QuotaBandwidth, QuotaDailyUser or QuotaDailyApp
+	Limit    int    // tracks, kbps or minutes
+	AppID    string // app over its daily quota (QuotaDailyApp)
+	Degraded bool   // only higher-priority tracks may play (daily quotas in degrade mode)
+}
+
+// Error describes the quota that was exceeded
+func (e *QuotaError) Error() string {
+	switch e.Quota {
+	case QuotaTracks:
+		return fmt.Sprintf("session track limit reached (%d tracks)", e.Limit)
+	case QuotaDailyUser, QuotaDailyApp:
+		msg := fmt.Sprintf("daily audio quota exceeded (%d minutes)", e.Limit)
+		if e.AppID != "" {
+			msg = fmt.Sprintf("daily audio quota exceeded for app %s (%d minutes)", e.AppID, e.Limit)
+		}
+		if e.Degraded {
+			msg += "; only high-priority tracks may play"
+		}
+		return msg
+	}
+	return fmt.Sprintf("session bandwidth limit exceeded (%d kbps)", e.Limit)
+}
+
+// GRPCStatus reports quota errors to clients as RESOURCE_EXHAUSTED
+func (e *QuotaError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+// sessionQuota caps how many tracks a session may have and how fast it may
+// write audio to them (a token bucket over bytes written, before resampling)
+type sessionQuota struct {
+	mu        sync.Mutex
+	maxTracks int // 0 = unlimited
+	kbps      int // 0 = unlimited
+	tokens    float64
+	refilled  time.Time // zero until the first write
+}
+
+// newSessionQuota builds a session's quota from the bridge defaults
+func newSessionQuota(config *Config) *sessionQuota {
+	return &sessionQuota{maxTracks: config.MaxSessionTracks, kbps: config.SessionBandwidthKbps}
+}
+
+// configure overrides the bridge defaults with any limits that are set
+func (q *sessionQuota) configure(maxTracks, kbps int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if maxTracks > 0 {
+		q.maxTracks = maxTracks
+	}
+	if kbps > 0 {
+		q.kbps = kbps
+		q.refilled = time.Time{}
+	}
+}
+
+// checkTracks returns a QuotaError when a session that has count tracks
+// may not create another
+func (q *sessionQuota) checkTracks(count int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.maxTracks > 0 && count >= q.maxTracks {
+		return &QuotaError{Quota: QuotaTracks, Limit: q.maxTracks}
+	}
+	return nil
+}
+
+// spend takes n bytes of audio from the session's allowance, returning a
+// QuotaError when the session is writing too fast. A write may overdraw the
+// allowance (a loop pass is written in one go and then paced by playout);
+// later writes are refused until the overdraft is paid back.
+func (q *sessionQuota) spend(n int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.kbps <= 0 {
+		return nil
+	}
+
+	rate := float64(q.kbps) * 1000 / 8 // bytes per second
+	burst := rate * quotaBurst.Seconds()
+	now := time.Now()
+	if q.refilled.IsZero() {
+		q.tokens = burst
+	} else {
+		q.tokens = min(burst, q.tokens+now.Sub(q.refilled).Seconds()*rate)
+	}
+	q.refilled = now
+
+	if q.tokens <= 0 {
+		return &QuotaError{Quota: QuotaBandwidth, Limit: q.kbps}
+	}
+	q.tokens -= float64(n)
+	return nil
+}
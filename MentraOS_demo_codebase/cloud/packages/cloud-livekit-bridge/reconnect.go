@@ -0,0 +1,266 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+)
+
+// ConnectionState is a session's view of its LiveKit connection
+type ConnectionState string
+
+const (
+	StateConnected    ConnectionState = "connected"
+	StateReconnecting ConnectionState = "reconnecting"
+	StateDisconnected ConnectionState = "disconnected"
+)
+
+// reconnectBackoffFactor is how much the delay grows after each failed attempt
+const reconnectBackoffFactor = 2.0
+
+// ReconnectSettings configures how a session re-joins its room after the
+// LiveKit connection drops for good (the SDK's own ICE resume gave up)
+type ReconnectSettings struct {
+	Enabled      bool
+	InitialDelay time.Duration // wait before the first attempt
+	MaxDelay     time.Duration // cap on the exponential backoff
+	MaxAttempts  int           // attempts before giving up (0 = unlimited)
+	Jitter       float64       // fraction of each delay randomised, +/-
+	Buffer       time.Duration // outgoing audio queued per track while reconnecting
+}
+
+// delay returns how long to wait before the given (1-based) attempt
+func (r ReconnectSettings) delay(attempt int) time.Duration {
+	d := float64(r.InitialDelay) * math.Pow(reconnectBackoffFactor, float64(attempt-1))
+	if r.MaxDelay > 0 && d > float64(r.MaxDelay) {
+		d = float64(r.MaxDelay)
+	}
+	if r.Jitter > 0 {
+		d *= 1 + r.Jitter*(2*rand.Float64()-1)
+	}
+	if d < 0 {
+		d = 0
+	}
+	return time.Duration(d)
+}
+
+// shouldReconnect reports whether a disconnect is worth retrying. A closed
+// room, removal by the server or another client taking our identity are final.
+func shouldReconnect(reason lksdk.DisconnectionReason) bool {
+	switch reason {
+	case lksdk.LeaveRequested, lksdk.RoomClosed, lksdk.ParticipantRemoved, lksdk.DuplicateIdentity:
+		return false
+	}
+	return true
+}
+
+// setConnectionState records the connection state and pushes it as an event
+func (s *RoomSession) setConnectionState(state ConnectionState, attributes map[string]string) {
+	s.connMu.Lock()
+	s.connState = state
+	s.connected = state == StateConnected
+	s.connMu.Unlock()
+
+	if s.ctx.Err() == nil {
+		// A closed session's entry may already belong to its replacement
+		s.registry.SetState(s.userId, string(state))
+	}
+
+	if attributes == nil {
+		attributes = make(map[string]string)
+	}
+	attributes["state"] = string(state)
+	s.emitEvent(EventConnectionState, "", attributes)
+}
+
+// handleDisconnect runs when the SDK reports the room is gone. Unless the
+// disconnect is final, players hold their queues and the session re-joins
+// in the background; the SDK closes the old tracks right after this returns.
+func (s *RoomSession) handleDisconnect(reason lksdk.DisconnectionReason) {
+	if s.ctx.Err() != nil {
+		return // closed on purpose
+	}
+
+	s.mu.Lock()
+	s.connMu.Lock()
+	s.room = nil
+	s.lastDisconnectAt = time.Now()
+	s.lastDisconnectReason = string(reason)
+	s.connMu.Unlock()
+	s.closeRemoteTracksLocked()
+	retry := s.reconnect.Enabled && s.dial != nil && shouldReconnect(reason) && !s.reconnecting
+	if retry {
+		s.reconnecting = true
+		for _, state := range s.trackStates {
+			if state.player != nil {
+				state.player.suspendOutput(s.reconnect.Buffer)
+			}
+		}
+		// Pre-encoded Opus can't be re-timed onto a new track; the next
+		// write recreates it
+		for name := range s.opusTracks {
+			s.closeOpusTrackLocked(name)
+		}
+		s.publications = make(map[string]*lksdk.LocalTrackPublication)
+	}
+	s.mu.Unlock()
+
+	if !retry {
+		s.setConnectionState(StateDisconnected, map[string]string{"reason": string(reason)})
+		return
+	}
+	s.spawn("reconnect", func() { s.reconnectLoop(reason) })
+}
+
+// reconnectLoop re-joins the room with exponential backoff and jitter until
+// it succeeds, the session closes or the attempts run out
+func (s *RoomSession) reconnectLoop(reason lksdk.DisconnectionReason) {
+	defer func() {
+		s.mu.Lock()
+		s.reconnecting = false
+		s.mu.Unlock()
+	}()
+
+	for attempt := 1; s.reconnect.MaxAttempts == 0 || attempt <= s.reconnect.MaxAttempts; attempt++ {
+		delay := s.reconnect.delay(attempt)
+		s.setConnectionState(StateReconnecting, map[string]string{
+			"reason":   string(reason),
+			"attempt":  strconv.Itoa(attempt),
+			"delay_ms": strconv.FormatInt(delay.Milliseconds(), 10),
+		})
+		s.log().Info("Reconnecting to room", "attempt", attempt, "delay", delay, "reason", string(reason))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		}
+
+		room, err := s.dial()
+		if err != nil {
+			reconnects.WithLabelValues("failure").Inc()
+			s.log().Warn("Reconnect attempt failed", "attempt", attempt, "error", err)
+			continue
+		}
+		if err := s.restoreRoom(room); err != nil {
+			s.disconnectRoom(room)
+			return
+		}
+
+		reconnects.WithLabelValues("success").Inc()
+		s.log().Info("Reconnected to room", "attempts", attempt)
+		s.setConnectionState(StateConnected, map[string]string{"attempt": strconv.Itoa(attempt)})
+		return
+	}
+
+	reconnects.WithLabelValues("abandoned").Inc()
+	s.log().Error("Giving up reconnecting", "attempts", s.reconnect.MaxAttempts)
+	s.abandonTracks()
+	s.setConnectionState(StateDisconnected, map[string]string{
+		"reason":   string(reason),
+		"attempts": strconv.Itoa(s.reconnect.MaxAttempts),
+	})
+}
+
+// restoreRoom installs a freshly joined room and republishes every PCM track
+// under its old name. Each player keeps its queue and resumes on the new
+// track once it is negotiated, so interrupted playback carries on.
+func (s *RoomSession) restoreRoom(room *lksdk.Room) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx.Err() != nil {
+		return fmt.Errorf("session closed")
+	}
+
+	s.connMu.Lock()
+	s.room = room
+	s.participantID = string(room.LocalParticipant.Identity())
+	s.participantCount = len(room.GetRemoteParticipants()) + 1
+	s.connMu.Unlock()
+	s.annotateLogs(room)
+
+	if s.bus != nil {
+		if err := s.publishBusLocked(); err != nil {
+			s.log().Error("Failed to republish mixed track", "track_name", s.bus.name, "error", err)
+		}
+	}
+	for name, state := range s.trackStates {
+		if err := s.republishTrackLocked(name, state); err != nil {
+			s.log().Error("Failed to republish track", "track_name", name, "error", err)
+			if track, exists := s.tracks[name]; exists {
+				track.Close()
+				delete(s.tracks, name)
+			}
+			s.releaseTrackStateLocked(name)
+		}
+	}
+	return nil
+}
+
+// republishTrackLocked publishes a new PCM track for an existing track state
+// and hands it to the state's player, replacing the dead (or, for tracks
+// created while reconnecting, never published) one; caller must hold s.mu
+func (s *RoomSession) republishTrackLocked(trackName string, state *trackState) error {
+	if s.bus != nil {
+		// Mixed tracks aren't published; they resume with the bus
+		if state.player != nil {
+			state.player.replaceTrack(s.tracks[trackName], s.bus.readyChan())
+		}
+		return nil
+	}
+
+	track, err := lkmedia.NewPCMLocalTrack(s.sampleRate, state.channels, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create PCM track: %w", err)
+	}
+
+	onBind, ready := s.negotiationGate(trackName)
+	publication, err := s.room.LocalParticipant.PublishTrack(&negotiatedTrack{PCMLocalTrack: track, onBind: onBind}, &lksdk.TrackPublicationOptions{
+		Name:   s.publishName(trackName),
+		Stereo: state.channels == 2,
+	})
+	if err != nil {
+		track.Close()
+		return fmt.Errorf("failed to publish track: %w", err)
+	}
+
+	if previous, exists := s.tracks[trackName]; exists {
+		previous.Close()
+	}
+	s.tracks[trackName] = track
+	s.publications[trackName] = publication
+	if state.player != nil {
+		if state.player.isMuted() {
+			publication.SetMuted(true)
+		}
+		state.player.replaceTrack(track, ready)
+	}
+
+	s.log().Info("Republished PCM track", "track_name", trackName, "track_sid", publication.SID(), "channels", state.channels)
+	s.emitEvent(EventTrackPublished, trackName, map[string]string{"track_sid": publication.SID(), "encoding": "pcm", "republished": "true"})
+	return nil
+}
+
+// abandonTracks drops every track after reconnecting failed, releasing
+// writers blocked on a suspended player
+func (s *RoomSession) abandonTracks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name := range s.trackStates {
+		s.releaseTrackStateLocked(name)
+	}
+	for _, track := range s.tracks {
+		track.Close()
+	}
+	s.tracks = make(map[string]*lkmedia.PCMLocalTrack)
+	s.publications = make(map[string]*lksdk.LocalTrackPublication)
+}
@@ -0,0 +1,528 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v4/pkg/media/oggwriter"
+	"gopkg.in/hraban/opus.v2"
+)
+
+// recordingOpusFrame is how much audio goes into each recorded Opus packet
+const recordingOpusFrame = 20 * time.Millisecond
+
+// recordingOpusBitrate is the Opus bitrate recordings are encoded at, per channel
+const recordingOpusBitrate = 32000
+
+// recordingGapThreshold is how far a source may fall behind the recording's
+// clock before the gap is filled with silence
+const recordingGapThreshold = 100 * time.Millisecond
+
+// errNotRecording is returned by StopRecording for a session not recording
+var errNotRecording = errors.New("session is not recording")
+
+// recordingFile is one file of a recording being written
+type recordingFile interface {
+	write(samples []int16) error
+	size() int64
+	close() error
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write passes p on and counts it
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// wavFile writes PCM16 to a WAV file, filling in the header's sizes on close
+type wavFile struct {
+	file *os.File
+	buf  *bufio.Writer
+	data int64 // bytes of audio written
+}
+
+// wavHeaderSize is the length of the canonical 44-byte WAV header
+const wavHeaderSize = 44
+
+// newWAVFile creates a WAV file with a header to be completed by close
+func newWAVFile(path string, sampleRate, channels int) (*wavFile, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := file.Write(wavHeader(sampleRate, channels, 0)); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &wavFile{file: file, buf: bufio.NewWriter(file)}, nil
+}
+
+// wavHeader returns a canonical header for 16-bit PCM with dataBytes of
+// audio (0 when not yet known)
+func wavHeader(sampleRate, channels, dataBytes int) []byte {
+	header := make([]byte, wavHeaderSize)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(wavHeaderSize-8+dataBytes))
+	copy(header[8:], "WAVEfmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16)
+	binary.LittleEndian.PutUint16(header[20:], wavFormatPCM)
+	binary.LittleEndian.PutUint16(header[22:], uint16(channels))
+	binary.LittleEndian.PutUint32(header[24:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(header[28:], uint32(sampleRate*channels*2))
+	binary.LittleEndian.PutUint16(header[32:], uint16(channels*2))
+	binary.LittleEndian.PutUint16(header[34:], 16)
+	copy(header[36:], "data")
+	binary.LittleEndian.PutUint32(header[40:], uint32(dataBytes))
+	return header
+}
+
+// write appends samples
+func (w *wavFile) write(samples []int16) error {
+	if err := binary.Write(w.buf, binary.LittleEndian, samples); err != nil {
+		return err
+	}
+	w.data += int64(len(samples) * 2)
+	return nil
+}
+
+// size returns the file's length so far
+func (w *wavFile) size() int64 {
+	return wavHeaderSize + w.data
+}
+
+// close flushes the audio and writes the RIFF and data chunk sizes
+func (w *wavFile) close() error {
+	err := w.buf.Flush()
+	sizes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sizes, uint32(wavHeaderSize-8+w.data))
+	if _, seekErr := w.file.WriteAt(sizes, 4); err == nil {
+		err = seekErr
+	}
+	binary.LittleEndian.PutUint32(sizes, uint32(w.data))
+	if _, seekErr := w.file.WriteAt(sizes, 40); err == nil {
+		err = seekErr
+	}
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// oggOpusFile encodes PCM16 to Opus in an Ogg file, 20ms per packet
+type oggOpusFile struct {
+	file      *os.File
+	buf       *bufio.Writer
+	out       *countingWriter
+	ogg       *oggwriter.OggWriter
+	encoder   *opus.Encoder
+	frame     int     // samples per packet, all channels
+	pending   []int16 // audio short of a full packet
+	packet    []byte
+	timestamp uint32 // in 48kHz ticks, as Opus RTP timestamps are
+	ticks     uint32 // per packet
+}
+
+// newOggOpusFile creates an Ogg/Opus file; sampleRate must be one Opus
+// supports (8, 12, 16, 24 or 48kHz)
+func newOggOpusFile(path string, sampleRate, channels int) (*oggOpusFile, error) {
+	encoder, err := opus.NewEncoder(sampleRate, channels, opus.AppVoIP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opus encoder: %w", err)
+	}
+	encoder.SetBitrate(recordingOpusBitrate * channels)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(file)
+	out := &countingWriter{w: buf}
+	ogg, err := oggwriter.NewWith(out, uint32(sampleRate), uint16(channels))
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &oggOpusFile{
+		file:    file,
+		buf:     buf,
+		out:     out,
+		ogg:     ogg,
+		encoder: encoder,
+		frame:   sampleRate * channels * int(recordingOpusFrame/time.Millisecond) / 1000,
+		packet:  make([]byte, 4000),
+		ticks:   uint32(opusDecodeRate * recordingOpusFrame / time.Second),
+	}, nil
+}
+
+// write encodes every complete packet's worth of samples, keeping the rest
+func (o *oggOpusFile) write(samples []int16) error {
+	o.pending = append(o.pending, samples...)
+	for len(o.pending) >= o.frame {
+		n, err := o.encoder.Encode(o.pending[:o.frame], o.packet)
+		if err != nil {
+			return fmt.Errorf("failed to encode Opus: %w", err)
+		}
+		packet := &rtp.Packet{Header: rtp.Header{Timestamp: o.timestamp}, Payload: o.packet[:n]}
+		if err := o.ogg.WriteRTP(packet); err != nil {
+			return err
+		}
+		o.timestamp += o.ticks
+		o.pending = o.pending[o.frame:]
+	}
+	o.pending = append(o.pending[:0:0], o.pending...)
+	return nil
+}
+
+// size returns the file's length so far (some may still be buffered)
+func (o *oggOpusFile) size() int64 {
+	return o.out.n
+}
+
+// close flushes the file; audio short of a packet is dropped
+func (o *oggOpusFile) close() error {
+	err := o.ogg.Close()
+	if flushErr := o.buf.Flush(); err == nil {
+		err = flushErr
+	}
+	if closeErr := o.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// recordingSource is one series of files in a recording: a participant's
+// incoming audio or a published track
+type recordingSource struct {
+	mu         sync.Mutex
+	name       string
+	sampleRate int
+	channels   int
+	file       recordingFile
+	started    time.Duration // audio written before the current file
+	frames     int64         // audio written in total, per channel
+	sequence   int
+	failed     bool // stop writing after an error, which is logged once
+	closed     bool // the recording stopped
+}
+
+// sessionRecording writes a session's audio to files under dir, one series
+// per source, starting a new file in a series once the current one reaches
+// maxBytes or maxDuration
+type sessionRecording struct {
+	id               string
+	dir              string
+	format           pb.StartRecordingRequest_Format
+	includePublished bool
+	maxBytes         int64
+	maxDuration      time.Duration
+	started          time.Time
+
+	mu      sync.Mutex
+	sources map[string]*recordingSource
+	files   []string
+	stopped bool
+}
+
+// recordingName makes a participant identity or track name safe for a file name
+func recordingName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// source returns the series for name, creating it on first use; nil once
+// the recording has stopped
+func (r *sessionRecording) source(name string, sampleRate, channels int) *recordingSource {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return nil
+	}
+	source, ok := r.sources[name]
+	if !ok {
+		source = &recordingSource{name: recordingName(name), sampleRate: sampleRate, channels: channels}
+		r.sources[name] = source
+	}
+	return source
+}
+
+// write records samples for a source, starting a new file when the current
+// one is full. Where the source sent nothing (before its first audio, or
+// between clips) silence is written, so every source's files follow the
+// recording's clock and can be replayed with their original timing. A
+// write that fails stops that source, leaving the others.
+func (r *sessionRecording) write(log *slog.Logger, name string, samples []int16, sampleRate, channels int) {
+	source := r.source(name, sampleRate, channels)
+	if source == nil || len(samples) == 0 {
+		return
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.closed || source.failed || source.channels != channels || source.sampleRate != sampleRate {
+		return
+	}
+
+	// Incoming audio is written as it arrives, so it ends now; published
+	// audio is written ahead of playout and never leaves a gap this way
+	frames := int64(len(samples) / channels)
+	gap := int64(time.Since(r.started).Seconds()*float64(sampleRate)) - source.frames - frames
+	if gap > int64(sampleRate)*int64(recordingGapThreshold)/int64(time.Second) {
+		silence := make([]int16, sampleRate*channels)
+		for gap > 0 && !source.failed {
+			chunk := min(gap, int64(sampleRate))
+			r.writeLocked(log, name, source, silence[:chunk*int64(channels)])
+			gap -= chunk
+		}
+	}
+	r.writeLocked(log, name, source, samples)
+}
+
+// writeLocked writes samples to a source's current file, rotating it first
+// if full; source.mu must be held
+func (r *sessionRecording) writeLocked(log *slog.Logger, name string, source *recordingSource, samples []int16) {
+	if source.failed {
+		return
+	}
+	if source.file != nil && (source.file.size() >= r.maxBytes || source.written()-source.started >= r.maxDuration) {
+		if err := source.file.close(); err != nil {
+			log.Warn("Failed to finish recording file", "source", name, "error", err)
+		}
+		source.file = nil
+	}
+	if source.file == nil {
+		if err := r.open(source); err != nil {
+			log.Warn("Failed to start recording file, not recording this source", "source", name, "error", err)
+			source.failed = true
+			return
+		}
+	}
+
+	if err := source.file.write(samples); err != nil {
+		log.Warn("Failed to write recording, not recording this source", "source", name, "error", err)
+		source.file.close()
+		source.file = nil
+		source.failed = true
+		return
+	}
+	source.frames += int64(len(samples) / source.channels)
+}
+
+// written returns how much audio (and silence) the source has recorded
+func (rs *recordingSource) written() time.Duration {
+	return time.Duration(rs.frames) * time.Second / time.Duration(rs.sampleRate)
+}
+
+// open starts a source's next file; source.mu must be held
+func (r *sessionRecording) open(source *recordingSource) error {
+	source.sequence++
+	ext := "wav"
+	if r.format == pb.StartRecordingRequest_OGG_OPUS {
+		ext = "ogg"
+	}
+	path := filepath.Join(r.dir, fmt.Sprintf("%s-%03d.%s", source.name, source.sequence, ext))
+
+	var (
+		file recordingFile
+		err  error
+	)
+	if r.format == pb.StartRecordingRequest_OGG_OPUS {
+		file, err = newOggOpusFile(path, source.sampleRate, source.channels)
+	} else {
+		file, err = newWAVFile(path, source.sampleRate, source.channels)
+	}
+	if err != nil {
+		return err
+	}
+	source.file = file
+	source.started = source.written()
+
+	r.mu.Lock()
+	r.files = append(r.files, path)
+	r.mu.Unlock()
+	return nil
+}
+
+// stop closes every file and returns them all in the order they were started
+func (r *sessionRecording) stop(log *slog.Logger) []string {
+	r.mu.Lock()
+	r.stopped = true
+	sources := r.sources
+	r.mu.Unlock()
+
+	for name, source := range sources {
+		source.mu.Lock()
+		source.closed = true
+		if source.file != nil {
+			if err := source.file.close(); err != nil {
+				log.Warn("Failed to finish recording file", "source", name, "error", err)
+			}
+			source.file = nil
+		}
+		source.mu.Unlock()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.files...)
+}
+
+// recordIncoming keeps mic audio received from a participant in the
+// diagnostic ring buffer and, if the session is recording, the recording
+func (s *RoomSession) recordIncoming(identity string, pcmData []byte) {
+	recording := s.recording.Load()
+	if s.audioRings.duration <= 0 && recording == nil {
+		return
+	}
+	source := "incoming-" + identity
+	samples := pooledSamples(pcmData)
+	s.audioRings.write(source, *samples, s.sampleRate, 1)
+	if recording != nil {
+		recording.write(s.log(), source, *samples, s.sampleRate, 1)
+	}
+	putSamples(samples)
+}
+
+// recordPublished keeps audio written to a PCM track in the diagnostic ring
+// buffer and, if the session is recording published audio, the recording
+func (s *RoomSession) recordPublished(trackName string, samples []int16, channels int) {
+	source := "track-" + trackName
+	s.audioRings.write(source, samples, s.sampleRate, channels)
+	if recording := s.recording.Load(); recording != nil && recording.includePublished {
+		recording.write(s.log(), source, samples, s.sampleRate, channels)
+	}
+}
+
+// startRecording starts recording the session under dir
+func (s *RoomSession) startRecording(req *pb.StartRecordingRequest, dir string, maxBytes int64, maxDuration time.Duration) (*sessionRecording, error) {
+	if req.MaxFileBytes > 0 {
+		maxBytes = req.MaxFileBytes
+	}
+	if req.MaxFileDurationMs > 0 {
+		maxDuration = time.Duration(req.MaxFileDurationMs) * time.Millisecond
+	}
+
+	started := time.Now()
+	id := started.UTC().Format("20060102T150405.000Z")
+	recording := &sessionRecording{
+		id:               id,
+		dir:              filepath.Join(dir, recordingName(s.userId), id),
+		format:           req.Format,
+		includePublished: req.IncludePublished,
+		maxBytes:         maxBytes,
+		maxDuration:      maxDuration,
+		started:          started,
+		sources:          make(map[string]*recordingSource),
+	}
+	if err := os.MkdirAll(recording.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+
+	if !s.recording.CompareAndSwap(nil, recording) {
+		os.Remove(recording.dir)
+		return nil, fmt.Errorf("session is already recording")
+	}
+	s.log().Info("Recording started", "recording_id", id, "directory", recording.dir,
+		"format", req.Format.String(), "include_published", req.IncludePublished)
+	return recording, nil
+}
+
+// stopRecording stops the session's recording and returns it with its files
+func (s *RoomSession) stopRecording() (*sessionRecording, []string, error) {
+	recording := s.recording.Swap(nil)
+	if recording == nil {
+		return nil, nil, errNotRecording
+	}
+	files := recording.stop(s.log())
+	s.log().Info("Recording stopped", "recording_id", recording.id, "files", len(files),
+		"duration", time.Since(recording.started).Round(time.Second))
+	return recording, files, nil
+}
+
+// StartRecording starts recording a session's audio to files
+func (s *LiveKitBridgeService) StartRecording(
+	ctx context.Context,
+	req *pb.StartRecordingRequest,
+) (*pb.StartRecordingResponse, error) {
+	slog.Info("StartRecording request", "user_id", req.UserId, "format", req.Format.String(),
+		"include_published", req.IncludePublished)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.StartRecordingResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	recording, err := session.startRecording(req, s.config.RecordingDir, s.config.RecordingMaxFileBytes, s.config.RecordingMaxFileDuration)
+	if err != nil {
+		return &pb.StartRecordingResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.StartRecordingResponse{
+		Success:     true,
+		RecordingId: recording.id,
+		Directory:   recording.dir,
+	}, nil
+}
+
+// StopRecording stops a session's recording and lists the files written
+func (s *LiveKitBridgeService) StopRecording(
+	ctx context.Context,
+	req *pb.StopRecordingRequest,
+) (*pb.StopRecordingResponse, error) {
+	slog.Info("StopRecording request", "user_id", req.UserId)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.StopRecordingResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	recording, files, err := session.stopRecording()
+	if err != nil {
+		return &pb.StopRecordingResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.StopRecordingResponse{
+		Success:     true,
+		RecordingId: recording.id,
+		Files:       files,
+		DurationMs:  time.Since(recording.started).Milliseconds(),
+	}, nil
+}
@@ -0,0 +1,524 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrNotFound is returned by Lookup when no live bridge owns the user's session
+var ErrNotFound = errors.New("session not registered")
+
+// Entry is one session as registered in Redis
+type Entry struct {
+	UserID    string
+	Instance  string // bridge instance that owns the session
+	Address   string // where that instance accepts gRPC
+	RoomName  string
+	State     string // connection state, e.g. "connected"
+	UpdatedAt time.Time
+}
+
+// Instance is a live bridge replica
+type Instance struct {
+	ID       string
+	Address  string
+	Sessions int
+	Draining bool // shutting down: not taking sessions
+}
+
+// Config for Registry
+type Config struct {
+	URL               string // Redis URL, e.g. redis://redis:6379/0
+	Prefix            string // key prefix shared by all replicas
+	Instance          string // this replica's ID (default hostname)
+	Address           string // this replica's gRPC address, as the cloud should dial it
+	TTL               time.Duration
+	HeartbeatInterval time.Duration
+	SnapshotTTL       time.Duration // how long a session's last snapshot outlives it
+	Enabled           bool
+}
+
+// Registry records which bridge instance owns each user's session, so the
+// cloud can route a user's RPCs to the right replica. Entries carry a TTL
+// that the heartbeat keeps refreshing; a replica that dies drops out once
+// its entries expire. A disabled (or nil) registry does nothing.
+type Registry struct {
+	cfg      Config
+	client   *redis.Client
+	entries  map[string]Entry // sessions owned here, by user
+	draining bool
+	mu       sync.Mutex
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+// releaseScript deletes a session or snapshot key only while this instance
+// still owns it, so a late cleanup can't drop one another replica took over
+var releaseScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "instance") == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// refreshScript rewrites a session entry and its TTL unless another
+// instance has claimed it, returning 0 in that case
+var refreshScript = redis.NewScript(`
+local owner = redis.call("HGET", KEYS[1], "instance")
+if owner and owner ~= ARGV[1] then
+	return 0
+end
+redis.call("HSET", KEYS[1], "instance", ARGV[1], "address", ARGV[2], "room_name", ARGV[3],
+	"state", ARGV[4], "updated_at_ms", ARGV[5])
+redis.call("PEXPIRE", KEYS[1], ARGV[6])
+return 1
+`)
+
+// New connects to Redis and starts the heartbeat. A bad URL or an
+// unreachable Redis is logged and leaves the registry disabled, so the
+// bridge still serves as a single instance.
+func New(cfg Config) *Registry {
+	if cfg.Prefix == "" {
+		cfg.Prefix = "livekit-bridge"
+	}
+	if cfg.Instance == "" {
+		cfg.Instance, _ = os.Hostname()
+	}
+	if cfg.TTL == 0 {
+		cfg.TTL = 15 * time.Second
+	}
+	if cfg.HeartbeatInterval == 0 {
+		cfg.HeartbeatInterval = cfg.TTL / 3
+	}
+	if cfg.SnapshotTTL == 0 {
+		cfg.SnapshotTTL = 10 * time.Minute
+	}
+
+	r := &Registry{cfg: cfg, entries: make(map[string]Entry), stopCh: make(chan struct{})}
+	if !cfg.Enabled {
+		return r
+	}
+
+	opts, err := redis.ParseURL(cfg.URL)
+	if err != nil {
+		slog.Error("Session registry disabled: invalid REDIS_URL", "error", err)
+		r.cfg.Enabled = false
+		return r
+	}
+	r.client = redis.NewClient(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.client.Ping(ctx).Err(); err != nil {
+		slog.Error("Session registry disabled: failed to reach Redis", "error", err)
+		r.client.Close()
+		r.cfg.Enabled = false
+		return r
+	}
+
+	r.wg.Add(1)
+	go r.heartbeatLoop()
+	slog.Info("Session registry enabled", "instance", cfg.Instance, "address", cfg.Address)
+	return r
+}
+
+// NewFromEnv creates a registry from REDIS_URL and friends; the registry is
+// off unless REDIS_URL is set
+func NewFromEnv() *Registry {
+	url := os.Getenv("REDIS_URL")
+	ttlMs, _ := strconv.Atoi(os.Getenv("SESSION_REGISTRY_TTL_MS"))
+	snapshotTTLMs, _ := strconv.Atoi(os.Getenv("SESSION_SNAPSHOT_TTL_MS"))
+	return New(Config{
+		URL:         url,
+		Prefix:      os.Getenv("SESSION_REGISTRY_PREFIX"),
+		Instance:    os.Getenv("BRIDGE_INSTANCE_ID"),
+		Address:     os.Getenv("BRIDGE_ADVERTISE_ADDR"),
+		TTL:         time.Duration(ttlMs) * time.Millisecond,
+		SnapshotTTL: time.Duration(snapshotTTLMs) * time.Millisecond,
+		Enabled:     url != "",
+	})
+}
+
+// Enabled reports whether sessions are being registered
+func (r *Registry) Enabled() bool {
+	return r != nil && r.cfg.Enabled
+}
+
+// InstanceID returns this replica's ID
+func (r *Registry) InstanceID() string {
+	if r == nil {
+		return ""
+	}
+	return r.cfg.Instance
+}
+
+// sessionKey is the Redis hash for a user's session
+func (r *Registry) sessionKey(userId string) string {
+	return r.cfg.Prefix + ":session:" + userId
+}
+
+// instanceKey is the Redis hash for a bridge replica
+func (r *Registry) instanceKey(instance string) string {
+	return r.cfg.Prefix + ":instance:" + instance
+}
+
+// snapshotKey is the Redis hash holding a user's last session snapshot
+func (r *Registry) snapshotKey(userId string) string {
+	return r.cfg.Prefix + ":snapshot:" + userId
+}
+
+// restoringKey marks a user's snapshot as being restored by one replica
+func (r *Registry) restoringKey(userId string) string {
+	return r.cfg.Prefix + ":restoring:" + userId
+}
+
+// instancesKey is the set of replica IDs that have registered
+func (r *Registry) instancesKey() string {
+	return r.cfg.Prefix + ":instances"
+}
+
+// Register claims a user's session for this instance, replacing whichever
+// instance held it before
+func (r *Registry) Register(userId, roomName, state string) {
+	if !r.Enabled() {
+		return
+	}
+	entry := Entry{
+		UserID:    userId,
+		Instance:  r.cfg.Instance,
+		Address:   r.cfg.Address,
+		RoomName:  roomName,
+		State:     state,
+		UpdatedAt: time.Now(),
+	}
+
+	r.mu.Lock()
+	r.entries[userId] = entry
+	r.mu.Unlock()
+
+	r.claim(entry)
+}
+
+// SetState updates the connection state of a session owned here
+func (r *Registry) SetState(userId, state string) {
+	if !r.Enabled() {
+		return
+	}
+
+	r.mu.Lock()
+	entry, ok := r.entries[userId]
+	if ok {
+		entry.State = state
+		entry.UpdatedAt = time.Now()
+		r.entries[userId] = entry
+	}
+	r.mu.Unlock()
+
+	if ok {
+		r.refresh([]Entry{entry})
+	}
+}
+
+// Unregister releases a user's session, unless another instance has
+// claimed it since
+func (r *Registry) Unregister(userId string) {
+	if !r.Enabled() {
+		return
+	}
+
+	r.mu.Lock()
+	delete(r.entries, userId)
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := releaseScript.Run(ctx, r.client, []string{r.sessionKey(userId)}, r.cfg.Instance).Err(); err != nil {
+		slog.Warn("Failed to unregister session", "user_id", userId, "error", err)
+	}
+}
+
+// claim stores an entry with a fresh TTL, whoever owned it before
+func (r *Registry) claim(entry Entry) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	key := r.sessionKey(entry.UserID)
+	pipe := r.client.Pipeline()
+	pipe.HSet(ctx, key,
+		"instance", entry.Instance,
+		"address", entry.Address,
+		"room_name", entry.RoomName,
+		"state", entry.State,
+		"updated_at_ms", entry.UpdatedAt.UnixMilli(),
+	)
+	pipe.PExpire(ctx, key, r.cfg.TTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("Failed to register session", "user_id", entry.UserID, "error", err)
+	}
+}
+
+// refresh rewrites entries with a fresh TTL. Entries another instance has
+// claimed since (the user re-joined there, or the session was handed off)
+// are left alone and forgotten here.
+func (r *Registry) refresh(entries []Entry) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.Cmd, len(entries))
+	for i, entry := range entries {
+		cmds[i] = refreshScript.Eval(ctx, pipe, []string{r.sessionKey(entry.UserID)},
+			entry.Instance, entry.Address, entry.RoomName, entry.State,
+			entry.UpdatedAt.UnixMilli(), r.cfg.TTL.Milliseconds())
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("Failed to refresh sessions", "sessions", len(entries), "error", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, cmd := range cmds {
+		if owned, _ := cmd.Int(); owned == 0 && r.entries[entries[i].UserID] == entries[i] {
+			delete(r.entries, entries[i].UserID)
+		}
+	}
+}
+
+// heartbeatLoop refreshes this instance and every session it owns until
+// Close, so their TTLs never lapse while the instance is alive
+func (r *Registry) heartbeatLoop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	r.heartbeat()
+	for {
+		select {
+		case <-ticker.C:
+			r.heartbeat()
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
+// SetDraining marks this instance as shutting down, so handoffs pick
+// another replica
+func (r *Registry) SetDraining(draining bool) {
+	if !r.Enabled() {
+		return
+	}
+
+	r.mu.Lock()
+	r.draining = draining
+	r.mu.Unlock()
+
+	r.heartbeat()
+}
+
+// heartbeat refreshes this instance's record and its sessions' TTLs
+func (r *Registry) heartbeat() {
+	r.mu.Lock()
+	entries := make([]Entry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+	draining := r.draining
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	key := r.instanceKey(r.cfg.Instance)
+	pipe := r.client.Pipeline()
+	pipe.HSet(ctx, key, "address", r.cfg.Address, "sessions", len(entries), "draining", draining)
+	pipe.PExpire(ctx, key, r.cfg.TTL)
+	pipe.SAdd(ctx, r.instancesKey(), r.cfg.Instance)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("Session registry heartbeat failed", "error", err)
+		return
+	}
+	if len(entries) > 0 {
+		r.refresh(entries)
+	}
+}
+
+// Lookup returns the entry for a user's session, whichever instance owns it
+func (r *Registry) Lookup(ctx context.Context, userId string) (Entry, error) {
+	if !r.Enabled() {
+		return Entry{}, fmt.Errorf("session registry is not configured")
+	}
+
+	fields, err := r.client.HGetAll(ctx, r.sessionKey(userId)).Result()
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to look up session: %w", err)
+	}
+	if len(fields) == 0 {
+		return Entry{}, ErrNotFound
+	}
+	updatedAtMs, _ := strconv.ParseInt(fields["updated_at_ms"], 10, 64)
+	return Entry{
+		UserID:    userId,
+		Instance:  fields["instance"],
+		Address:   fields["address"],
+		RoomName:  fields["room_name"],
+		State:     fields["state"],
+		UpdatedAt: time.UnixMilli(updatedAtMs),
+	}, nil
+}
+
+// Instances returns the bridge replicas whose heartbeat is current; ones
+// that have expired are pruned from the set
+func (r *Registry) Instances(ctx context.Context) ([]Instance, error) {
+	if !r.Enabled() {
+		return nil, fmt.Errorf("session registry is not configured")
+	}
+
+	ids, err := r.client.SMembers(ctx, r.instancesKey()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	var instances []Instance
+	for _, id := range ids {
+		fields, err := r.client.HGetAll(ctx, r.instanceKey(id)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read instance %s: %w", id, err)
+		}
+		if len(fields) == 0 {
+			r.client.SRem(ctx, r.instancesKey(), id)
+			continue
+		}
+		sessions, _ := strconv.Atoi(fields["sessions"])
+		draining, _ := strconv.ParseBool(fields["draining"])
+		instances = append(instances, Instance{
+			ID:       id,
+			Address:  fields["address"],
+			Sessions: sessions,
+			Draining: draining,
+		})
+	}
+	return instances, nil
+}
+
+// SaveSnapshot stores the latest snapshot of a session owned here,
+// replacing the previous one
+func (r *Registry) SaveSnapshot(userId string, data []byte) {
+	if !r.Enabled() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	key := r.snapshotKey(userId)
+	pipe := r.client.Pipeline()
+	pipe.HSet(ctx, key, "instance", r.cfg.Instance, "data", data)
+	pipe.PExpire(ctx, key, r.cfg.SnapshotTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		slog.Warn("Failed to save session snapshot", "user_id", userId, "error", err)
+	}
+}
+
+// LoadSnapshot returns the last snapshot saved for a user
+func (r *Registry) LoadSnapshot(ctx context.Context, userId string) ([]byte, error) {
+	if !r.Enabled() {
+		return nil, fmt.Errorf("session registry is not configured")
+	}
+
+	data, err := r.client.HGet(ctx, r.snapshotKey(userId), "data").Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// DeleteSnapshot drops a user's snapshot once the session has ended for
+// good, unless another instance saved it since
+func (r *Registry) DeleteSnapshot(userId string) {
+	if !r.Enabled() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := releaseScript.Run(ctx, r.client, []string{r.snapshotKey(userId)}, r.cfg.Instance).Err(); err != nil {
+		slog.Warn("Failed to delete session snapshot", "user_id", userId, "error", err)
+	}
+}
+
+// Orphans returns the users whose snapshot was left by this instance (it
+// restarted) or by an instance that is no longer alive, and whose session
+// isn't running on another replica
+func (r *Registry) Orphans(ctx context.Context) ([]string, error) {
+	if !r.Enabled() {
+		return nil, fmt.Errorf("session registry is not configured")
+	}
+
+	prefix := r.snapshotKey("")
+	var orphans []string
+	iter := r.client.Scan(ctx, 0, prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		userId := strings.TrimPrefix(iter.Val(), prefix)
+
+		owner, err := r.client.HGet(ctx, iter.Val(), "instance").Result()
+		if err != nil {
+			continue // expired since the scan
+		}
+		if owner != r.cfg.Instance {
+			if alive, _ := r.client.Exists(ctx, r.instanceKey(owner)).Result(); alive > 0 {
+				continue
+			}
+		}
+		running, err := r.client.HGet(ctx, r.sessionKey(userId), "instance").Result()
+		if err == nil && running != r.cfg.Instance {
+			continue
+		}
+		orphans = append(orphans, userId)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan session snapshots: %w", err)
+	}
+	return orphans, nil
+}
+
+// ClaimRestore reserves restoring a user's snapshot for this instance for
+// ttl, so replicas starting together don't restore the same session twice
+func (r *Registry) ClaimRestore(ctx context.Context, userId string, ttl time.Duration) bool {
+	if !r.Enabled() {
+		return false
+	}
+
+	claimed, err := r.client.SetNX(ctx, r.restoringKey(userId), r.cfg.Instance, ttl).Result()
+	return err == nil && claimed
+}
+
+// Close stops the heartbeat and removes this instance's record. Sessions
+// still registered expire with their TTL unless another instance claims them.
+func (r *Registry) Close() {
+	if !r.Enabled() {
+		return
+	}
+	close(r.stopCh)
+	r.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	r.client.Del(ctx, r.instanceKey(r.cfg.Instance))
+	r.client.SRem(ctx, r.instancesKey(), r.cfg.Instance)
+	r.client.Close()
+}
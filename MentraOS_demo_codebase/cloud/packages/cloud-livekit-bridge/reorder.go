@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// ReorderSettings configure how sequenced chunks from the cloud are put back
+// in order: up to Window chunks are held behind a missing one, for at most
+// Timeout, before the gap is skipped (Window 0 = play in arrival order)
+type ReorderSettings struct {
+	Window  int
+	Timeout time.Duration
+}
+
+// chunkReorderer puts chunks numbered from 1 back in sequence order and
+// drops repeats, as retransmission upstream can deliver a burst out of
+// order or twice. Chunks numbered 0 aren't sequenced and pass straight
+// through. Not safe for concurrent use.
+type chunkReorderer[T any] struct {
+	window    int
+	next      uint64 // sequence due next
+	held      map[uint64]T
+	heldSince time.Time // when the oldest held chunk started waiting on a gap
+
+	reordered, duplicates, stale, skipped int64
+}
+
+// newChunkReorderer creates a reorderer holding at most window chunks
+func newChunkReorderer[T any](window int) *chunkReorderer[T] {
+	return &chunkReorderer[T]{window: window, next: 1, held: make(map[uint64]T)}
+}
+
+// add takes a chunk and returns those now ready to play, in order
+func (r *chunkReorderer[T]) add(seq uint64, chunk T, now time.Time) []T {
+	switch {
+	case seq == 0:
+		return []T{chunk}
+	case seq < r.next:
+		// Played already, or its gap was skipped
+		r.stale++
+		return nil
+	case seq > r.next:
+		if _, held := r.held[seq]; held {
+			r.duplicates++
+			return nil
+		}
+		if len(r.held) == 0 {
+			r.heldSince = now
+		}
+		r.held[seq] = chunk
+		r.reordered++
+		if len(r.held) > r.window {
+			return r.skip(now)
+		}
+		return nil
+	}
+
+	ready := append([]T{chunk}, r.release()...)
+	if len(r.held) > 0 {
+		// Still waiting on a later gap; its wait starts now
+		r.heldSince = now
+	}
+	return ready
+}
+
+// release returns the held chunks that follow on from next, advancing it
+func (r *chunkReorderer[T]) release() []T {
+	var ready []T
+	for {
+		r.next++
+		chunk, held := r.held[r.next]
+		if !held {
+			return ready
+		}
+		delete(r.held, r.next)
+		ready = append(ready, chunk)
+	}
+}
+
+// waiting reports whether chunks are held behind a gap, and since when
+func (r *chunkReorderer[T]) waiting() (time.Time, bool) {
+	return r.heldSince, len(r.held) > 0
+}
+
+// skip gives up on the chunks missing before the first held one and
+// returns what can play from there
+func (r *chunkReorderer[T]) skip(now time.Time) []T {
+	if len(r.held) == 0 {
+		return nil
+	}
+	first := r.sortedHeld()[0]
+	r.skipped += int64(first - r.next)
+	r.next = first
+
+	ready := []T{r.held[first]}
+	delete(r.held, first)
+	ready = append(ready, r.release()...)
+	r.heldSince = now
+	return ready
+}
+
+// drain returns every held chunk in order, skipping the gaps between them,
+// for when no more chunks will come
+func (r *chunkReorderer[T]) drain() []T {
+	var ready []T
+	for _, seq := range r.sortedHeld() {
+		r.skipped += int64(seq - r.next)
+		ready = append(ready, r.held[seq])
+		delete(r.held, seq)
+		r.next = seq + 1
+	}
+	return ready
+}
+
+// sortedHeld returns the held sequence numbers in ascending order
+func (r *chunkReorderer[T]) sortedHeld() []uint64 {
+	seqs := make([]uint64, 0, len(r.held))
+	for seq := range r.held {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs
+}
+
+// recvInOrder receives a client stream's messages on a goroutine of its own
+// and hands them to handle in sequence order, skipping a gap once the
+// chunks held behind it fill the window or have waited settings.Timeout.
+// It returns nil at the end of the stream, after handling what was held,
+// or the first receive or handle error.
+func recvInOrder[T any](
+	ctx context.Context,
+	logger *slog.Logger,
+	settings ReorderSettings,
+	recv func() (T, error),
+	sequence func(T) uint64,
+	handle func(T) error,
+) error {
+	type received struct {
+		msg T
+		err error
+	}
+	in := make(chan received)
+	go func() {
+		for {
+			msg, err := recv()
+			select {
+			case in <- received{msg, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	reorder := newChunkReorderer[T](settings.Window)
+	defer func() {
+		reportReordering(logger, reorder.reordered, reorder.duplicates, reorder.stale, reorder.skipped)
+	}()
+
+	handleAll := func(msgs []T) error {
+		for _, msg := range msgs {
+			if err := handle(msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		var gapTimeout <-chan time.Time
+		if since, waiting := reorder.waiting(); waiting {
+			gapTimeout = time.After(settings.Timeout - time.Since(since))
+		}
+
+		select {
+		case r := <-in:
+			if r.err != nil {
+				if err := handleAll(reorder.drain()); err != nil {
+					return err
+				}
+				if errors.Is(r.err, io.EOF) {
+					return nil
+				}
+				return r.err
+			}
+			seq := sequence(r.msg)
+			if settings.Window <= 0 {
+				seq = 0
+			}
+			if err := handleAll(reorder.add(seq, r.msg, time.Now())); err != nil {
+				return err
+			}
+		case <-gapTimeout:
+			if err := handleAll(reorder.skip(time.Now())); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// reportReordering counts and logs what a stream's reorderer had to fix
+func reportReordering(logger *slog.Logger, reordered, duplicates, stale, skipped int64) {
+	chunksReordered.WithLabelValues("reordered").Add(float64(reordered))
+	chunksReordered.WithLabelValues("duplicate").Add(float64(duplicates))
+	chunksReordered.WithLabelValues("stale").Add(float64(stale))
+	chunksReordered.WithLabelValues("skipped").Add(float64(skipped))
+	if reordered+duplicates+stale+skipped > 0 {
+		logger.Info("Reordered audio chunks", "reordered", reordered, "duplicates", duplicates,
+			"stale", stale, "skipped", skipped)
+	}
+}
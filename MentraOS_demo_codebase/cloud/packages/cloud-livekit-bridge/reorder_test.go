@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// reorderStep is one call on a chunkReorderer and the chunks it must return
+type reorderStep struct {
+	op   string // "add", "skip" (gap timeout) or "drain" (end of stream)
+	seq  uint64
+	at   time.Duration
+	want []uint64
+}
+
+func TestChunkReorderer(t *testing.T) {
+	tests := []struct {
+		name   string
+		window int
+		steps  []reorderStep
+		// counters once every step has run
+		reordered, duplicates, stale, skipped int64
+	}{
+		{
+			name:   "in order",
+			window: 4,
+			steps: []reorderStep{
+				{op: "add", seq: 1, want: []uint64{1}},
+				{op: "add", seq: 2, want: []uint64{2}},
+				{op: "add", seq: 3, want: []uint64{3}},
+			},
+		},
+		{
+			name:   "out of order",
+			window: 4,
+			steps: []reorderStep{
+				{op: "add", seq: 3},
+				{op: "add", seq: 2},
+				{op: "add", seq: 1, want: []uint64{1, 2, 3}},
+				{op: "add", seq: 5},
+				{op: "add", seq: 4, want: []uint64{4, 5}},
+			},
+			reordered: 3,
+		},
+		{
+			name:   "duplicate of a held chunk",
+			window: 4,
+			steps: []reorderStep{
+				{op: "add", seq: 2},
+				{op: "add", seq: 2},
+				{op: "add", seq: 1, want: []uint64{1, 2}},
+			},
+			reordered:  1,
+			duplicates: 1,
+		},
+		{
+			name:   "duplicate of a played chunk",
+			window: 4,
+			steps: []reorderStep{
+				{op: "add", seq: 1, want: []uint64{1}},
+				{op: "add", seq: 2, want: []uint64{2}},
+				{op: "add", seq: 1},
+				{op: "add", seq: 2},
+			},
+			stale: 2,
+		},
+		{
+			name:   "unsequenced chunks pass through",
+			window: 4,
+			steps: []reorderStep{
+				{op: "add", seq: 2},
+				{op: "add", seq: 0, want: []uint64{0}},
+				{op: "add", seq: 1, want: []uint64{1, 2}},
+			},
+			reordered: 1,
+		},
+		{
+			name:   "full window skips the gap",
+			window: 2,
+			steps: []reorderStep{
+				{op: "add", seq: 3},
+				{op: "add", seq: 4},
+				{op: "add", seq: 5, want: []uint64{3, 4, 5}},
+				{op: "add", seq: 1}, // too late
+				{op: "add", seq: 6, want: []uint64{6}},
+			},
+			reordered: 3,
+			stale:     1,
+			skipped:   2,
+		},
+		{
+			name:   "gap timeout",
+			window: 8,
+			steps: []reorderStep{
+				{op: "add", seq: 2, at: 0},
+				{op: "add", seq: 4, at: 10 * time.Millisecond},
+				{op: "skip", at: 100 * time.Millisecond, want: []uint64{2}},
+				{op: "skip", at: 200 * time.Millisecond, want: []uint64{4}},
+				{op: "skip", at: 300 * time.Millisecond}, // nothing held
+				{op: "add", seq: 3, at: 300 * time.Millisecond},
+				{op: "add", seq: 5, at: 300 * time.Millisecond, want: []uint64{5}},
+			},
+			reordered: 2,
+			stale:     1,
+			skipped:   2,
+		},
+		{
+			name:   "EOF flushes what is held",
+			window: 8,
+			steps: []reorderStep{
+				{op: "add", seq: 5},
+				{op: "add", seq: 2},
+				{op: "add", seq: 4},
+				{op: "drain", want: []uint64{2, 4, 5}},
+				{op: "drain"},
+			},
+			reordered: 3,
+			skipped:   2,
+		},
+	}
+
+	start := time.Unix(0, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newChunkReorderer[uint64](tt.window)
+			for i, step := range tt.steps {
+				now := start.Add(step.at)
+				var got []uint64
+				switch step.op {
+				case "add":
+					got = r.add(step.seq, step.seq, now)
+				case "skip":
+					got = r.skip(now)
+				case "drain":
+					got = r.drain()
+				}
+				if len(got) != 0 || len(step.want) != 0 {
+					if !reflect.DeepEqual(got, step.want) {
+						t.Fatalf("step %d (%s %d): got %v, want %v", i, step.op, step.seq, got, step.want)
+					}
+				}
+			}
+			if r.reordered != tt.reordered || r.duplicates != tt.duplicates || r.stale != tt.stale || r.skipped != tt.skipped {
+				t.Errorf("counters reordered=%d duplicates=%d stale=%d skipped=%d, want %d %d %d %d",
+					r.reordered, r.duplicates, r.stale, r.skipped,
+					tt.reordered, tt.duplicates, tt.stale, tt.skipped)
+			}
+		})
+	}
+}
+
+// A gap's wait restarts when the chunks before it are released
+func TestChunkReordererWaiting(t *testing.T) {
+	start := time.Unix(0, 0)
+	r := newChunkReorderer[uint64](8)
+
+	if _, waiting := r.waiting(); waiting {
+		t.Fatal("waiting before anything is held")
+	}
+	r.add(3, 3, start)
+	if since, waiting := r.waiting(); !waiting || !since.Equal(start) {
+		t.Fatalf("waiting() = %v, %v; want %v, true", since, waiting, start)
+	}
+	later := start.Add(50 * time.Millisecond)
+	r.add(1, 1, later)
+	if since, waiting := r.waiting(); !waiting || !since.Equal(later) {
+		t.Fatalf("after releasing 1: waiting() = %v, %v; want %v, true", since, waiting, later)
+	}
+	r.add(2, 2, later)
+	if _, waiting := r.waiting(); waiting {
+		t.Fatal("still waiting once the gap is filled")
+	}
+}
+
+func TestRecvInOrder(t *testing.T) {
+	type msg struct {
+		seq   uint64
+		delay time.Duration // before it is received
+	}
+	tests := []struct {
+		name     string
+		settings ReorderSettings
+		msgs     []msg
+		end      error
+		want     []uint64
+		wantErr  error
+	}{
+		{
+			name:     "out of order",
+			settings: ReorderSettings{Window: 4, Timeout: time.Second},
+			msgs:     []msg{{seq: 2}, {seq: 3}, {seq: 1}, {seq: 4}},
+			end:      io.EOF,
+			want:     []uint64{1, 2, 3, 4},
+		},
+		{
+			name:     "duplicates dropped",
+			settings: ReorderSettings{Window: 4, Timeout: time.Second},
+			msgs:     []msg{{seq: 1}, {seq: 3}, {seq: 3}, {seq: 1}, {seq: 2}},
+			end:      io.EOF,
+			want:     []uint64{1, 2, 3},
+		},
+		{
+			name:     "gap timeout",
+			settings: ReorderSettings{Window: 4, Timeout: 20 * time.Millisecond},
+			msgs:     []msg{{seq: 2}, {seq: 3}, {seq: 1, delay: 100 * time.Millisecond}, {seq: 4}},
+			end:      io.EOF,
+			want:     []uint64{2, 3, 4},
+		},
+		{
+			name:     "EOF flushes held chunks",
+			settings: ReorderSettings{Window: 4, Timeout: time.Hour},
+			msgs:     []msg{{seq: 3}, {seq: 5}},
+			end:      io.EOF,
+			want:     []uint64{3, 5},
+		},
+		{
+			name:     "receive error flushes and returns it",
+			settings: ReorderSettings{Window: 4, Timeout: time.Hour},
+			msgs:     []msg{{seq: 2}},
+			end:      errors.New("stream reset"),
+			want:     []uint64{2},
+			wantErr:  errors.New("stream reset"),
+		},
+		{
+			name:     "no window keeps arrival order",
+			settings: ReorderSettings{Window: 0},
+			msgs:     []msg{{seq: 2}, {seq: 1}, {seq: 1}},
+			end:      io.EOF,
+			want:     []uint64{2, 1, 1},
+		},
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := 0
+			recv := func() (msg, error) {
+				if next == len(tt.msgs) {
+					return msg{}, tt.end
+				}
+				m := tt.msgs[next]
+				next++
+				time.Sleep(m.delay)
+				return m, nil
+			}
+			var got []uint64
+			err := recvInOrder(context.Background(), logger, tt.settings, recv,
+				func(m msg) uint64 { return m.seq },
+				func(m msg) error {
+					got = append(got, m.seq)
+					return nil
+				})
+
+			if (err == nil) != (tt.wantErr == nil) || err != nil && err.Error() != tt.wantErr.Error() {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("handled %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+	"gopkg.in/hraban/opus.v2"
+)
+
+// replayFlags are the options of the replay command
+type replayFlags struct {
+	dir       string
+	url       string
+	apiKey    string
+	apiSecret string
+	room      string
+	prefix    string
+	topic     string
+	chunk     time.Duration
+	asTrack   bool
+}
+
+// runReplay is the replay command: it plays a recording's incoming audio
+// (see StartRecording) back into a room in real time, each participant's
+// from its own identity, so a bridge session in that room receives it as
+// it originally did. Recordings keep silence where nothing was received, so
+// timing between utterances and participants is reproduced too.
+//
+//	livekit-bridge replay -room <room> [flags] <recording directory>
+func runReplay(args []string, config *Config) error {
+	f := replayFlags{}
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.StringVar(&f.url, "url", config.LiveKitURL, "LiveKit server URL")
+	fs.StringVar(&f.apiKey, "api-key", config.LiveKitAPIKey, "LiveKit API key")
+	fs.StringVar(&f.apiSecret, "api-secret", config.LiveKitAPISecret, "LiveKit API secret")
+	fs.StringVar(&f.room, "room", "", "room to replay into (required)")
+	fs.StringVar(&f.prefix, "identity-prefix", "", "prefix for the replaying participants' identities (default: the recorded identities)")
+	fs.StringVar(&f.topic, "topic", "", "data packet topic to send audio on")
+	fs.DurationVar(&f.chunk, "chunk", 20*time.Millisecond, "audio per data packet")
+	fs.BoolVar(&f.asTrack, "track", false, "publish each participant's audio as a microphone track instead of data packets")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || f.room == "" {
+		fs.Usage()
+		return fmt.Errorf("a -room and one recording directory are required")
+	}
+	f.dir = fs.Arg(0)
+
+	sources, err := replaySources(f.dir)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no incoming audio found in %s", f.dir)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Connect everyone first, then start all sources on the same clock
+	var participants []*replayParticipant
+	defer func() {
+		for _, p := range participants {
+			p.room.Disconnect()
+		}
+	}()
+	for identity, files := range sources {
+		p, err := connectReplayParticipant(f, f.prefix+identity, files)
+		if err != nil {
+			return err
+		}
+		participants = append(participants, p)
+	}
+
+	start := time.Now().Add(time.Second)
+	var wg sync.WaitGroup
+	errs := make(chan error, len(participants))
+	for _, p := range participants {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := p.play(ctx, start, f); err != nil && !errors.Is(err, context.Canceled) {
+				errs <- fmt.Errorf("%s: %w", p.identity, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	slog.Info("Replay finished", "participants", len(participants))
+	return nil
+}
+
+// replaySources finds each participant's incoming audio files in a
+// recording directory, in the order they were written
+func replaySources(dir string) (map[string][]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string][]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		ext := filepath.Ext(name)
+		if entry.IsDir() || !strings.HasPrefix(name, "incoming-") || (ext != ".wav" && ext != ".ogg") {
+			continue
+		}
+		// incoming-<identity>-<sequence>.<ext>
+		base := strings.TrimSuffix(strings.TrimPrefix(name, "incoming-"), ext)
+		dash := strings.LastIndex(base, "-")
+		if dash <= 0 {
+			continue
+		}
+		identity := base[:dash]
+		sources[identity] = append(sources[identity], filepath.Join(dir, name))
+	}
+	for _, files := range sources {
+		sort.Strings(files)
+	}
+	return sources, nil
+}
+
+// replayParticipant is one recorded participant connected to the room
+type replayParticipant struct {
+	identity string
+	files    []string
+	room     *lksdk.Room
+}
+
+// connectReplayParticipant joins the room under identity
+func connectReplayParticipant(f replayFlags, identity string, files []string) (*replayParticipant, error) {
+	room, err := lksdk.ConnectToRoom(f.url, lksdk.ConnectInfo{
+		APIKey:              f.apiKey,
+		APISecret:           f.apiSecret,
+		RoomName:            f.room,
+		ParticipantIdentity: identity,
+	}, &lksdk.RoomCallback{}, lksdk.WithAutoSubscribe(false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join %s as %s: %w", f.room, identity, err)
+	}
+	slog.Info("Replay participant joined", "room", f.room, "identity", identity, "files", len(files))
+	return &replayParticipant{identity: identity, files: files, room: room}, nil
+}
+
+// play sends the participant's files back to back, each chunk at its
+// original offset from start
+func (p *replayParticipant) play(ctx context.Context, start time.Time, f replayFlags) error {
+	var track *lkmedia.PCMLocalTrack
+	if f.asTrack {
+		var err error
+		if track, err = lkmedia.NewPCMLocalTrack(incomingSampleRate, 1, nil); err != nil {
+			return fmt.Errorf("failed to create track: %w", err)
+		}
+		defer track.Close()
+		if _, err := p.room.LocalParticipant.PublishTrack(track, &lksdk.TrackPublicationOptions{Name: "replay"}); err != nil {
+			return fmt.Errorf("failed to publish track: %w", err)
+		}
+	}
+
+	chunkSamples := int(f.chunk.Seconds() * incomingSampleRate)
+	var sent int64 // samples
+	for _, path := range p.files {
+		samples, err := readReplayFile(path)
+		if err != nil {
+			return err
+		}
+		for offset := 0; offset < len(samples); offset += chunkSamples {
+			chunk := samples[offset:min(offset+chunkSamples, len(samples))]
+
+			due := start.Add(time.Duration(sent) * time.Second / incomingSampleRate)
+			select {
+			case <-time.After(time.Until(due)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			if track != nil {
+				err = track.WriteSample(chunk)
+			} else {
+				err = p.room.LocalParticipant.PublishDataPacket(lksdk.UserData(int16ToBytes(chunk)), lksdk.WithDataPublishTopic(f.topic))
+			}
+			if err != nil {
+				return fmt.Errorf("failed to send audio: %w", err)
+			}
+			sent += int64(len(chunk))
+		}
+		slog.Info("Replayed file", "identity", p.identity, "file", filepath.Base(path))
+	}
+
+	// Let a track's buffered audio play out before leaving
+	if track != nil {
+		time.Sleep(time.Until(start.Add(time.Duration(sent) * time.Second / incomingSampleRate)))
+	}
+	return nil
+}
+
+// readReplayFile reads a recorded file as 16kHz mono PCM16
+func readReplayFile(path string) ([]int16, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	br := bufio.NewReader(file)
+
+	if filepath.Ext(path) == ".ogg" {
+		return readReplayOpus(br, path)
+	}
+
+	format, err := readWAVHeader(br)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if format.audioFormat != wavFormatPCM || format.bitsPerSample != 16 || format.channels != 1 || format.sampleRate != incomingSampleRate {
+		return nil, fmt.Errorf("%s: not a recording of incoming audio (16kHz mono PCM16)", path)
+	}
+	var reader io.Reader = br
+	if format.dataBytes >= 0 {
+		reader = io.LimitReader(br, format.dataBytes)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	samples := make([]int16, len(data)/2)
+	for i := range samples {
+		samples[i] = int16(binary.LittleEndian.Uint16(data[i*2:]))
+	}
+	return samples, nil
+}
+
+// readReplayOpus decodes a recorded Ogg/Opus file to 16kHz mono PCM16
+func readReplayOpus(r io.Reader, path string) ([]int16, error) {
+	packets := &oggPacketReader{r: r}
+	head, err := readOpusHeaders(packets)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if head.channels != 1 {
+		return nil, fmt.Errorf("%s: not a recording of incoming audio (mono)", path)
+	}
+	decoder, err := opus.NewDecoder(incomingSampleRate, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opus decoder: %w", err)
+	}
+
+	var samples []int16
+	pcm := make([]int16, opusMaxFrameSamples)
+	for {
+		packet, err := packets.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		n, err := decoder.Decode(packet, pcm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		samples = append(samples, pcm[:n]...)
+	}
+
+	// Pre-skip is counted at 48kHz
+	skip := min(head.preSkip*incomingSampleRate/opusDecodeRate, len(samples))
+	return samples[skip:], nil
+}
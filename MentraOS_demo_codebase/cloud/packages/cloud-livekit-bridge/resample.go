@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/binary"
 	"math"
+	"strings"
 )
 
 // AudioResampler handles conversion between 16kHz and 48kHz
@@ -103,3 +104,243 @@ func NormalizePCM16(input []byte) []byte {
 	}
 	return output
 }
+
+// ResampleMode selects the interpolation used by the resampling subsystem
+type ResampleMode string
+
+const (
+	// ResampleLinear uses two-point linear interpolation (cheap, some aliasing)
+	ResampleLinear ResampleMode = "linear"
+	// ResampleSinc uses a Blackman-windowed sinc filter (higher quality, more CPU)
+	ResampleSinc ResampleMode = "sinc"
+)
+
+// sincHalfTaps is the number of input samples used on each side of the
+// interpolation point by the windowed-sinc resampler
+const sincHalfTaps = 16
+
+// Resampler converts a continuous stream of PCM16 samples from one sample
+// rate to another. Implementations keep state between calls so that audio
+// split across arbitrary chunk boundaries resamples without discontinuities.
+type Resampler interface {
+	// Process consumes input samples and returns any output samples that can
+	// be produced so far
+	Process(in []int16) []int16
+	// SourceRate returns the input sample rate in Hz
+	SourceRate() int
+}
+
+// parseResampleMode converts a config string into a ResampleMode, defaulting to linear
+func parseResampleMode(mode string) ResampleMode {
+	switch ResampleMode(strings.ToLower(mode)) {
+	case ResampleSinc:
+		return ResampleSinc
+	default:
+		return ResampleLinear
+	}
+}
+
+// NewResampler creates a streaming resampler for the given mode, rates and
+// interleaved channel count. Returns nil when no conversion is needed
+// (srcRate == dstRate).
+func NewResampler(mode ResampleMode, srcRate, dstRate, channels int) Resampler {
+	if srcRate <= 0 || dstRate <= 0 || srcRate == dstRate {
+		return nil
+	}
+	if channels > 1 {
+		r := &interleavedResampler{channels: channels}
+		for c := 0; c < channels; c++ {
+			r.perChannel = append(r.perChannel, NewResampler(mode, srcRate, dstRate, 1))
+		}
+		return r
+	}
+	step := float64(srcRate) / float64(dstRate)
+	if mode == ResampleSinc {
+		return newSincResampler(srcRate, step)
+	}
+	return &resampleState{step: step, srcRate: srcRate}
+}
+
+// interleavedResampler resamples multi-channel interleaved audio by running
+// an independent mono resampler per channel
+type interleavedResampler struct {
+	channels   int
+	perChannel []Resampler
+}
+
+// SourceRate returns the input sample rate in Hz
+func (r *interleavedResampler) SourceRate() int { return r.perChannel[0].SourceRate() }
+
+// Process deinterleaves, resamples each channel and re-interleaves the output
+func (r *interleavedResampler) Process(in []int16) []int16 {
+	frames := len(in) / r.channels
+	outs := make([][]int16, r.channels)
+	plane := make([]int16, frames)
+	for c := 0; c < r.channels; c++ {
+		for i := 0; i < frames; i++ {
+			plane[i] = in[i*r.channels+c]
+		}
+		outs[c] = r.perChannel[c].Process(plane)
+	}
+
+	n := len(outs[0])
+	for _, o := range outs[1:] {
+		if len(o) < n {
+			n = len(o)
+		}
+	}
+
+	out := make([]int16, n*r.channels)
+	for i := 0; i < n; i++ {
+		for c := 0; c < r.channels; c++ {
+			out[i*r.channels+c] = outs[c][i]
+		}
+	}
+	return out
+}
+
+// resampleState holds state for linear-interpolation resampling
+type resampleState struct {
+	buf     []int16
+	pos     float64
+	step    float64
+	srcRate int
+}
+
+// SourceRate returns the input sample rate in Hz
+func (r *resampleState) SourceRate() int { return r.srcRate }
+
+// Process adds samples to the resampler and returns resampled output
+func (r *resampleState) Process(in []int16) []int16 {
+	return r.push(in)
+}
+
+// push adds samples to the resampler and returns resampled output
+func (r *resampleState) push(in []int16) []int16 {
+	r.buf = append(r.buf, in...)
+	if len(r.buf) < 2 {
+		return nil
+	}
+
+	var out []int16
+	for {
+		i := int(r.pos)
+		if i+1 >= len(r.buf) {
+			break
+		}
+
+		// Linear interpolation
+		frac := r.pos - float64(i)
+		s0 := float64(r.buf[i])
+		s1 := float64(r.buf[i+1])
+		v := s0 + (s1-s0)*frac
+
+		out = append(out, clampInt16(v))
+		r.pos += r.step
+	}
+
+	// Keep unconsumed samples
+	drop := int(r.pos)
+	if drop > 0 {
+		if drop >= len(r.buf) {
+			r.buf = r.buf[:0]
+			r.pos = 0
+		} else {
+			r.buf = r.buf[drop:]
+			r.pos -= float64(drop)
+		}
+	}
+
+	return out
+}
+
+// sincResampler implements band-limited resampling with a Blackman-windowed
+// sinc kernel. When downsampling the cutoff is lowered to the destination
+// Nyquist frequency to suppress aliasing.
+type sincResampler struct {
+	buf     []float64
+	pos     float64
+	step    float64
+	cutoff  float64
+	srcRate int
+}
+
+// newSincResampler creates a windowed-sinc resampler with zeroed history
+func newSincResampler(srcRate int, step float64) *sincResampler {
+	cutoff := 1.0
+	if step > 1 {
+		cutoff = 1 / step
+	}
+	return &sincResampler{
+		// Prime with silence so the first output sample has full left context
+		buf:     make([]float64, sincHalfTaps),
+		pos:     sincHalfTaps,
+		step:    step,
+		cutoff:  cutoff * 0.95,
+		srcRate: srcRate,
+	}
+}
+
+// SourceRate returns the input sample rate in Hz
+func (r *sincResampler) SourceRate() int { return r.srcRate }
+
+// Process adds samples to the resampler and returns resampled output
+func (r *sincResampler) Process(in []int16) []int16 {
+	for _, v := range in {
+		r.buf = append(r.buf, float64(v))
+	}
+
+	var out []int16
+	for {
+		center := int(r.pos)
+		if center+sincHalfTaps >= len(r.buf) {
+			break
+		}
+
+		var acc float64
+		for k := center - sincHalfTaps + 1; k <= center+sincHalfTaps; k++ {
+			d := r.pos - float64(k)
+			acc += r.buf[k] * r.cutoff * sinc(r.cutoff*d) * blackman(d/sincHalfTaps)
+		}
+
+		out = append(out, clampInt16(acc))
+		r.pos += r.step
+	}
+
+	// Keep sincHalfTaps samples of history before the next interpolation point
+	drop := int(r.pos) - sincHalfTaps + 1
+	if drop > 0 {
+		r.buf = append(r.buf[:0], r.buf[drop:]...)
+		r.pos -= float64(drop)
+	}
+
+	return out
+}
+
+// sinc returns the normalized sinc function sin(πx)/(πx)
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	px := math.Pi * x
+	return math.Sin(px) / px
+}
+
+// blackman evaluates a Blackman window over x in [-1, 1]
+func blackman(x float64) float64 {
+	if x <= -1 || x >= 1 {
+		return 0
+	}
+	t := (x + 1) / 2
+	return 0.42 - 0.5*math.Cos(2*math.Pi*t) + 0.08*math.Cos(4*math.Pi*t)
+}
+
+// clampInt16 rounds and clamps a float sample into int16 range
+func clampInt16(v float64) int16 {
+	if v > 32767 {
+		return 32767
+	} else if v < -32768 {
+		return -32768
+	}
+	return int16(math.Round(v))
+}
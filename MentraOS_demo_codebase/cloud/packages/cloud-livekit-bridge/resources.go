@@ -0,0 +1,95 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// sessionResources counts the goroutines a session has started that have
+// not finished yet, by what they do, so ones that outlive Close show up
+type sessionResources struct {
+	mu         sync.Mutex
+	goroutines map[string]int
+}
+
+// newSessionResources creates an empty goroutine count
+func newSessionResources() *sessionResources {
+	return &sessionResources{goroutines: make(map[string]int)}
+}
+
+// started counts a goroutine in
+func (r *sessionResources) started(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.goroutines[name]++
+}
+
+// finished counts a goroutine out
+func (r *sessionResources) finished(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.goroutines[name]--; r.goroutines[name] <= 0 {
+		delete(r.goroutines, name)
+	}
+}
+
+// running returns the goroutines still running, by name
+func (r *sessionResources) running() map[string]int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	running := make(map[string]int32, len(r.goroutines))
+	for name, count := range r.goroutines {
+		running[name] = int32(count)
+	}
+	return running
+}
+
+// spawn runs f on a goroutine counted against the session
+func (s *RoomSession) spawn(name string, f func()) {
+	s.resources.started(name)
+	go func() {
+		defer s.resources.finished(name)
+		f()
+	}()
+}
+
+// watch counts a goroutine the session doesn't start itself (e.g. a track's
+// pacing loop) until stopped closes
+func (s *RoomSession) watch(name string, stopped <-chan struct{}) {
+	s.spawn(name, func() { <-stopped })
+}
+
+// resourceUsage snapshots the goroutines, tracks and channels the session
+// holds; once it has closed, anything left is a leak
+func (s *RoomSession) resourceUsage() *pb.SessionResources {
+	usage := &pb.SessionResources{
+		UserId:           s.userId,
+		Goroutines:       s.resources.running(),
+		EventSubscribers: int32(s.events.subscriberCount()),
+		AudioSubscribers: int32(s.audioSubs.subscriberCount()),
+	}
+	if closedAt := s.closedAt.Load(); closedAt != 0 {
+		usage.ClosedAtMs = time.Unix(0, closedAt).UnixMilli()
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	usage.Tracks = int32(len(s.tracks) + len(s.opusTracks))
+	usage.Publications = int32(len(s.publications))
+	usage.RemoteTracks = int32(len(s.remoteTracks))
+	usage.RunningPlaybacks = int32(len(s.running))
+	return usage
+}
+
+// holdsResources reports whether a usage snapshot has anything left in it
+func holdsResources(usage *pb.SessionResources) bool {
+	return len(usage.Goroutines) > 0 || usage.Tracks > 0 || usage.Publications > 0 ||
+		usage.RemoteTracks > 0 || usage.RunningPlaybacks > 0 ||
+		usage.EventSubscribers > 0 || usage.AudioSubscribers > 0
+}
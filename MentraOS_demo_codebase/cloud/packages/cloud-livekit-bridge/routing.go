@@ -0,0 +1,339 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// maxRoutes caps the edges in a session's routing matrix
+const maxRoutes = 64
+
+// routeToneLevel is the amplitude of a generated tone at unity gain (-12dBFS)
+const routeToneLevel = 0.25 * 32767
+
+// Ends of a route: "participant:<identity>", "track:<name>" (a published
+// track, as input the audio the cloud plays on it) and "tone:<hz>" are
+// inputs; "track:<name>", "asr" and "recording" are outputs
+const (
+	routeParticipant = "participant:"
+	routeTrack       = "track:"
+	routeTone        = "tone:"
+	routeASR         = "asr"
+	routeRecording   = "recording"
+)
+
+// routedAudioKey marks writes made by the router, whose audio is not routed
+// again
+type routedAudioKey struct{}
+
+// audioRoute is one edge of the routing matrix
+type audioRoute struct {
+	input  string
+	output string
+	gain   float64
+}
+
+// audioRouter is a session's routing matrix: each output mixes the inputs
+// routed to it, each at its edge's gain, in 10ms frames on a goroutine of
+// its own, so a track output that blocks on playout holds up no other.
+// Inputs are tapped where the session already handles their audio.
+type audioRouter struct {
+	mu      sync.Mutex
+	routes  []audioRoute
+	outputs map[string]*routeOutput
+}
+
+// routeOutput is one output and its mix
+type routeOutput struct {
+	name       string
+	mix        *mixdown
+	resamplers map[string]Resampler // by input, when it arrives at another rate
+	tones      map[string]*routedTone
+	done       chan struct{}
+}
+
+// routedTone generates a sine tone into an output's mix
+type routedTone struct {
+	hz    float64
+	phase float64
+}
+
+// newAudioRouter creates an empty routing matrix
+func newAudioRouter() *audioRouter {
+	return &audioRouter{outputs: make(map[string]*routeOutput)}
+}
+
+// parseRoute validates an edge of the routing matrix
+func parseRoute(route *pb.AudioRoute) (audioRoute, error) {
+	input, output := route.Input, route.Output
+	switch {
+	case strings.HasPrefix(input, routeParticipant) && len(input) > len(routeParticipant):
+	case strings.HasPrefix(input, routeTrack) && len(input) > len(routeTrack):
+	case strings.HasPrefix(input, routeTone):
+		hz, err := strconv.ParseFloat(strings.TrimPrefix(input, routeTone), 64)
+		if err != nil || hz < 20 || hz > 20000 {
+			return audioRoute{}, fmt.Errorf("invalid tone %q: frequency must be 20-20000 Hz", input)
+		}
+	default:
+		return audioRoute{}, fmt.Errorf("invalid route input %q", input)
+	}
+	switch {
+	case output == routeASR, output == routeRecording:
+	case strings.HasPrefix(output, routeTrack) && len(output) > len(routeTrack):
+		if output == input {
+			return audioRoute{}, fmt.Errorf("track %q can't be routed to itself", input)
+		}
+	default:
+		return audioRoute{}, fmt.Errorf("invalid route output %q", output)
+	}
+	if route.Gain < 0 {
+		return audioRoute{}, fmt.Errorf("gain must not be negative")
+	}
+
+	gain := float64(route.Gain)
+	if gain == 0 {
+		gain = 1
+	}
+	return audioRoute{input: input, output: output, gain: min(gain, maxTrackGain)}, nil
+}
+
+// outputRate returns the rate an output is mixed at: the ASR feed's, or
+// the session's
+func (s *RoomSession) outputRate(output string) int {
+	if output == routeASR {
+		return incomingSampleRate
+	}
+	return s.sampleRate
+}
+
+// setRoutes replaces the session's routing matrix, starting outputs that
+// are new and stopping those no longer routed to
+func (s *RoomSession) setRoutes(routes []audioRoute) {
+	r := s.routing
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	gains := make(map[string]map[string]float32)
+	for _, route := range routes {
+		if gains[route.output] == nil {
+			gains[route.output] = make(map[string]float32)
+		}
+		gains[route.output][route.input] = float32(route.gain)
+	}
+
+	for name, output := range r.outputs {
+		if gains[name] == nil {
+			close(output.done)
+			delete(r.outputs, name)
+		}
+	}
+	for name, inputs := range gains {
+		output, exists := r.outputs[name]
+		if !exists {
+			output = &routeOutput{
+				name:       name,
+				mix:        newMixdown(s.outputRate(name), "routing"),
+				resamplers: make(map[string]Resampler),
+				tones:      make(map[string]*routedTone),
+				done:       make(chan struct{}),
+			}
+			r.outputs[name] = output
+			s.spawn("routing_output", func() { s.runRouteOutput(output) })
+		}
+		output.mix.setGains(inputs)
+		for input := range output.tones {
+			if _, routed := inputs[input]; !routed {
+				delete(output.tones, input)
+			}
+		}
+		for input := range inputs {
+			if strings.HasPrefix(input, routeTone) && output.tones[input] == nil {
+				hz, _ := strconv.ParseFloat(strings.TrimPrefix(input, routeTone), 64)
+				output.tones[input] = &routedTone{hz: hz}
+			}
+		}
+	}
+	r.routes = routes
+}
+
+// routesFor returns the session's routing matrix
+func (s *RoomSession) routesFor() []*pb.AudioRoute {
+	s.routing.mu.Lock()
+	defer s.routing.mu.Unlock()
+
+	routes := make([]*pb.AudioRoute, len(s.routing.routes))
+	for i, route := range s.routing.routes {
+		routes[i] = &pb.AudioRoute{Input: route.input, Output: route.output, Gain: float32(route.gain)}
+	}
+	return routes
+}
+
+// feedsASR reports whether the routing matrix decides what the speech
+// recognizer hears, instead of all received mic audio
+func (r *audioRouter) feedsASR() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.outputs[routeASR] != nil
+}
+
+// routeInput taps an input's audio into every output it is routed to,
+// downmixed to mono; never blocks
+func (s *RoomSession) routeInput(ctx context.Context, input string, samples []int16, sampleRate, channels int) {
+	r := s.routing
+	if len(samples) == 0 || ctx.Value(routedAudioKey{}) != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var mono []int16
+	for _, route := range r.routes {
+		if route.input != input {
+			continue
+		}
+		if mono == nil {
+			mono = convertChannels(samples, channels, 1)
+		}
+		output := r.outputs[route.output]
+		in := mono
+		if output.mix.sampleRate != sampleRate {
+			resampler, exists := output.resamplers[input]
+			if !exists || resampler.SourceRate() != sampleRate {
+				resampler = NewResampler(s.resampleMode, sampleRate, output.mix.sampleRate, 1)
+				output.resamplers[input] = resampler
+			}
+			in = resampler.Process(mono)
+		}
+		output.mix.add(input, input, in)
+	}
+}
+
+// generateTones adds the next frame of every tone routed to an output to
+// its mix
+func (r *audioRouter) generateTones(output *routeOutput) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := output.mix.samples(mixdownFrame)
+	for input, tone := range output.tones {
+		samples := make([]int16, n)
+		step := 2 * math.Pi * tone.hz / float64(output.mix.sampleRate)
+		for i := range samples {
+			samples[i] = int16(routeToneLevel * math.Sin(tone.phase))
+			tone.phase = math.Mod(tone.phase+step, 2*math.Pi)
+		}
+		output.mix.add(input, input, samples)
+	}
+}
+
+// runRouteOutput mixes an output's inputs every 10ms and hands the mix to
+// the output until it is no longer routed to or the session closes
+func (s *RoomSession) runRouteOutput(output *routeOutput) {
+	ticker := time.NewTicker(mixdownFrame)
+	defer ticker.Stop()
+
+	ctx := context.WithValue(s.ctx, routedAudioKey{}, true)
+	trackName := strings.TrimPrefix(output.name, routeTrack)
+	failing := false
+	for {
+		select {
+		case now := <-ticker.C:
+			s.routing.generateTones(output)
+			pcm, ok := output.mix.mix(now)
+			if !ok {
+				continue
+			}
+
+			var err error
+			switch output.name {
+			case routeASR:
+				if !s.asr.Write(pcm) {
+					framesDropped.WithLabelValues("asr").Inc()
+				}
+			case routeRecording:
+				if recording := s.recording.Load(); recording != nil {
+					samples := pooledSamples(pcm)
+					recording.write(s.log(), "routed", *samples, output.mix.sampleRate, 1)
+					putSamples(samples)
+				}
+			default:
+				samples := pooledSamples(pcm)
+				err = s.writeSamplesToTrack(ctx, *samples, trackName, output.mix.sampleRate, 1)
+				putSamples(samples)
+			}
+			switch {
+			case err != nil && !failing && s.ctx.Err() == nil:
+				s.log().Warn("Failed to write routed audio", "output", output.name, "error", err)
+				failing = true
+			case err == nil:
+				failing = false
+			}
+		case <-output.done:
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// SetRoutes replaces a session's routing matrix: which inputs (remote
+// participants, audio played on tracks, generated tones) feed which
+// outputs (published tracks, the ASR feed, the recording), at what gain
+func (s *LiveKitBridgeService) SetRoutes(
+	ctx context.Context,
+	req *pb.SetRoutesRequest,
+) (*pb.SetRoutesResponse, error) {
+	slog.Info("SetRoutes request", "user_id", req.UserId, "routes", len(req.Routes))
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetRoutesResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	if len(req.Routes) > maxRoutes {
+		return &pb.SetRoutesResponse{
+			Success: false,
+			Error:   fmt.Sprintf("at most %d routes are allowed", maxRoutes),
+			Routes:  session.routesFor(),
+		}, nil
+	}
+	routes := make([]audioRoute, 0, len(req.Routes))
+	seen := make(map[[2]string]bool, len(req.Routes))
+	for _, r := range req.Routes {
+		route, err := parseRoute(r)
+		if err == nil && seen[[2]string{route.input, route.output}] {
+			err = fmt.Errorf("%s is routed to %s more than once", route.input, route.output)
+		}
+		if err != nil {
+			return &pb.SetRoutesResponse{
+				Success: false,
+				Error:   err.Error(),
+				Routes:  session.routesFor(),
+			}, nil
+		}
+		seen[[2]string{route.input, route.output}] = true
+		routes = append(routes, route)
+	}
+
+	session.setRoutes(routes)
+	session.log().Info("Routing matrix updated", "routes", len(routes))
+	return &pb.SetRoutesResponse{
+		Success: true,
+		Routes:  session.routesFor(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// scheduledStartKey carries a playback request's start time in its context
+type scheduledStartKey struct{}
+
+// withScheduledStart makes the first sample written under ctx wait for at,
+// so the clip starts on time however long the fetch and decode take
+func withScheduledStart(ctx context.Context, at time.Time) context.Context {
+	return context.WithValue(ctx, scheduledStartKey{}, at)
+}
+
+// scheduledStart returns the start time set on ctx (zero when unscheduled)
+func scheduledStart(ctx context.Context) time.Time {
+	at, _ := ctx.Value(scheduledStartKey{}).(time.Time)
+	return at
+}
+
+// waitForScheduledStart blocks until ctx's start time; it returns at once
+// when none is set or it has passed
+func waitForScheduledStart(ctx context.Context) error {
+	wait := time.Until(scheduledStart(ctx))
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
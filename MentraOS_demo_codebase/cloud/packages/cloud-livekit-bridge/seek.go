@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// activePlayback is file playback running on a track, which SeekTrack can
+// move to another position
+type activePlayback struct {
+	requestID string
+	req       *pb.PlayAudioRequest // kept for handoff
+	seeks     chan time.Duration   // latest requested position, read by playSeekable
+
+	mu     sync.Mutex
+	offset time.Duration  // where in the clip the current pass started
+	clock  *playbackClock // measures the current pass
+}
+
+// startPass records that playback (re)started at offset into the clip
+func (p *activePlayback) startPass(offset time.Duration, clock *playbackClock) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.offset, p.clock = offset, clock
+}
+
+// position returns how far into the clip playback has been heard
+func (p *activePlayback) position() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.clock == nil {
+		return p.offset
+	}
+	return p.offset + p.clock.position()
+}
+
+// requestSeek asks the playback to move to a position; a seek not yet acted
+// on is replaced
+func (p *activePlayback) requestSeek(to time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	select {
+	case <-p.seeks:
+	default:
+	}
+	p.seeks <- to
+}
+
+// seekSkip drops decoded audio up to a seek position; it rides in the
+// context of the pass that starts there
+type seekSkip struct {
+	offset  time.Duration
+	skipped int64 // frames dropped so far
+}
+
+// seekSkipKey carries a pass's seekSkip in its context
+type seekSkipKey struct{}
+
+// withSeekSkip makes writes under ctx drop the first offset of audio
+func withSeekSkip(ctx context.Context, offset time.Duration) context.Context {
+	return context.WithValue(ctx, seekSkipKey{}, &seekSkip{offset: offset})
+}
+
+// seekSkipFrom returns the skip set on ctx (nil when not seeking)
+func seekSkipFrom(ctx context.Context) *seekSkip {
+	skip, _ := ctx.Value(seekSkipKey{}).(*seekSkip)
+	return skip
+}
+
+// drop returns what is left of samples once audio before the seek
+// position has been skipped
+func (k *seekSkip) drop(samples []int16, sampleRate, channels int) []int16 {
+	target := int64(k.offset) * int64(sampleRate) / int64(time.Second)
+	if k.skipped >= target {
+		return samples
+	}
+	n := min(target-k.skipped, int64(len(samples)/channels))
+	k.skipped += n
+	return samples[n*int64(channels):]
+}
+
+// startSeekable registers file playback on a track for SeekTrack
+func (s *RoomSession) startSeekable(trackName string, req *pb.PlayAudioRequest) *activePlayback {
+	playback := &activePlayback{requestID: req.RequestId, req: req, seeks: make(chan time.Duration, 1)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.playbacks[trackName] = playback
+	return playback
+}
+
+// endSeekable unregisters playback unless newer playback replaced it
+func (s *RoomSession) endSeekable(trackName string, playback *activePlayback) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.playbacks[trackName] == playback {
+		delete(s.playbacks, trackName)
+	}
+}
+
+// seekTrack moves the file playback on a track to position, or by position
+// from where it is when relative is set. Returns the request moved and
+// where to.
+func (s *RoomSession) seekTrack(trackName string, position time.Duration, relative bool) (string, time.Duration, error) {
+	s.mu.RLock()
+	playback := s.playbacks[trackName]
+	s.mu.RUnlock()
+	if playback == nil {
+		return "", 0, fmt.Errorf("no seekable playback on track %q", trackName)
+	}
+
+	if relative {
+		position += playback.position()
+	}
+	position = max(0, position)
+	playback.requestSeek(position)
+	return playback.requestID, position, nil
+}
+
+// flushTrack drops a PCM track's queued audio, keeping it published
+func (s *RoomSession) flushTrack(trackName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushTrackLocked(trackName, s.stopFade)
+}
+
+// playSeekable plays file audio, restarting from the new position whenever
+// SeekTrack moves it. Each seek flushes what the old position still has
+// queued and decodes the source again (re-fetching a URL), dropping the
+// audio before the new position.
+func (s *LiveKitBridgeService) playSeekable(
+	ctx context.Context,
+	req *pb.PlayAudioRequest,
+	session *RoomSession,
+	stream pb.LiveKitBridge_PlayAudioServer,
+	trackName string,
+) (int64, error) {
+	playback := session.startSeekable(trackName, req)
+	defer session.endSeekable(trackName, playback)
+
+	type passResult struct {
+		duration int64
+		err      error
+	}
+
+	// Playback resumed after a handoff starts where it was heard
+	offset := resumeOffset(ctx)
+	for {
+		passCtx, cancelPass := context.WithCancel(ctx)
+		playback.startPass(offset, session.newPlaybackClock(trackName))
+
+		result := make(chan passResult, 1)
+		go func(offset time.Duration) {
+			duration, err := s.playSource(passCtx, req, session, stream, trackName, offset)
+			result <- passResult{duration, err}
+		}(offset)
+
+		select {
+		case r := <-result:
+			cancelPass()
+			return r.duration, r.err
+		case to := <-playback.seeks:
+			from := playback.position()
+			cancelPass()
+			<-result
+			session.flushTrack(trackName)
+			session.log().Info("Seeking playback", "request_id", req.RequestId, "track_name", trackName,
+				"from_ms", from.Milliseconds(), "to_ms", to.Milliseconds())
+			offset = to
+		}
+	}
+}
@@ -2,15 +2,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/asr"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/eventstore"
 	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/logger"
 	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/sounds"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/tracing"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/usage"
 	lksdk "github.com/livekit/server-sdk-go/v2"
+	"github.com/pion/webrtc/v4"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -29,22 +39,65 @@ func trackIDToName(trackID int32) string {
 	}
 }
 
+// requestTrackName returns a request's track_name, falling back to the
+// name of its track_id
+func requestTrackName(trackName string, trackID int32) string {
+	if trackName != "" {
+		return trackName
+	}
+	return trackIDToName(trackID)
+}
+
+// trackNameStatus converts an appTrackName error to a gRPC status
+func trackNameStatus(err error) error {
+	if errors.Is(err, errNotTrackOwner) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
 // LiveKitBridgeService implements the gRPC service
 type LiveKitBridgeService struct {
 	pb.UnimplementedLiveKitBridgeServer
 
-	sessions sync.Map // userId -> *RoomSession
-	config   *Config
-	bsLogger *logger.BetterStackLogger
-	mu       sync.RWMutex
+	sessions   *SessionManager
+	config     *Config
+	bsLogger   *logger.BetterStackLogger
+	eventStore *eventstore.Store  // Session history (disabled without MONGODB_URI)
+	usage      *usage.Meter       // Audio usage per user and app (disabled without MONGODB_URI)
+	asr        *asr.Forwarder     // Speech recognition of mic audio (disabled without ASR_PROVIDER)
+	sounds     *sounds.Classifier // Sound event classification of mic audio (disabled without SOUND_MODEL_PATH)
+	daily      *dailyQuotas       // Daily audio minutes per user and app (nil = unlimited)
+	livekit    *livekitProbe      // LiveKit reachability, for /readyz
+	shared     *sharedRooms       // Connections shared by sessions in conference mode
+	draining   atomic.Bool        // shutting down: no new sessions
+	mu         sync.RWMutex
 }
 
 // NewLiveKitBridgeService creates a new service instance
-func NewLiveKitBridgeService(config *Config, bsLogger *logger.BetterStackLogger) *LiveKitBridgeService {
-	return &LiveKitBridgeService{
-		config:   config,
-		bsLogger: bsLogger,
+func NewLiveKitBridgeService(config *Config, bsLogger *logger.BetterStackLogger, eventStore *eventstore.Store, usageMeter *usage.Meter, asrForwarder *asr.Forwarder, soundClassifier *sounds.Classifier, sessionRegistry *registry.Registry) *LiveKitBridgeService {
+	sessions := NewSessionManager(config, bsLogger, sessionRegistry)
+	registerSessionMetrics(sessions)
+
+	livekit := newLiveKitProbe(config.LiveKitURL)
+	go livekit.run(context.Background())
+
+	service := &LiveKitBridgeService{
+		sessions:   sessions,
+		config:     config,
+		bsLogger:   bsLogger,
+		eventStore: eventStore,
+		usage:      usageMeter,
+		asr:        asrForwarder,
+		sounds:     soundClassifier,
+		daily:      newDailyQuotas(config, usageMeter),
+		livekit:    livekit,
+		shared:     newSharedRooms(),
 	}
+	if sessionRegistry.Enabled() && config.SnapshotInterval > 0 {
+		go service.snapshotLoop(config.SnapshotInterval)
+	}
+	return service
 }
 
 // JoinRoom handles room join requests
@@ -52,32 +105,257 @@ func (s *LiveKitBridgeService) JoinRoom(
 	ctx context.Context,
 	req *pb.JoinRoomRequest,
 ) (*pb.JoinRoomResponse, error) {
-	log.Printf("JoinRoom request: userId=%s, room=%s", req.UserId, req.RoomName)
+	slog.Info("JoinRoom request", "user_id", req.UserId, "room_name", req.RoomName)
+	ctx, span := tracing.Start(ctx, "JoinRoom",
+		attribute.String("user_id", req.UserId),
+		attribute.String("room_name", req.RoomName))
+	defer span.End()
 	s.bsLogger.LogInfo("JoinRoom request received", map[string]interface{}{
 		"user_id":     req.UserId,
 		"room_name":   req.RoomName,
 		"livekit_url": req.LivekitUrl,
 	})
 
+	// A draining bridge takes no new sessions; the cloud should join elsewhere
+	if s.draining.Load() {
+		slog.Warn("JoinRoom rejected: bridge is draining", "user_id", req.UserId)
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Error:   "bridge is draining",
+		}, nil
+	}
+
+	// Tracks and received audio run at the session's rate
+	sampleRate := s.config.SampleRate
+	if req.SampleRate > 0 {
+		sampleRate = int(req.SampleRate)
+	}
+	if !validSampleRate(sampleRate) {
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Error:   fmt.Sprintf("unsupported sample rate %d Hz (%d-%d, a multiple of 100)", sampleRate, minSampleRate, maxSampleRate),
+		}, nil
+	}
+
+	// Tracks end in the session's own limiter when it sets one
+	limiterSettings, err := sessionLimiterSettings(s.config.Limiter, req)
+	if err != nil {
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	// In a shared room incoming audio is routed to each user by who sent it
+	if req.SharedRoom && req.TargetIdentity == "" {
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Error:   "shared_room requires target_identity (the user's own participant)",
+		}, nil
+	}
+
 	// Always replace existing session if present (handles reconnections, crashes, zombie sessions)
-	if existingVal, exists := s.sessions.Load(req.UserId); exists {
+	if existingSession, exists := s.sessions.Load(req.UserId); exists {
 		s.bsLogger.LogInfo("Replacing existing bridge session", map[string]interface{}{
 			"user_id":   req.UserId,
 			"room_name": req.RoomName,
 			"reason":    "new_join_request",
 		})
 
-		existingSession := existingVal.(*RoomSession)
-		existingSession.Close() // Calls room.Disconnect(), closes goroutines
+		existingSession.CloseWithReason("replaced") // Calls room.Disconnect(), closes goroutines
 		s.sessions.Delete(req.UserId)
 	}
 
+	// Reserve a session slot (rejects or queues when the bridge is full)
+	if err := s.sessions.Admit(ctx); err != nil {
+		active, max, queued := s.sessions.Counts()
+		s.bsLogger.LogWarn("JoinRoom rejected by session limit", map[string]interface{}{
+			"user_id":         req.UserId,
+			"active_sessions": active,
+			"max_sessions":    max,
+			"queued_joins":    queued,
+			"error":           err.Error(),
+		})
+		slog.Warn("JoinRoom rejected by session limit", "user_id", req.UserId, "error", err,
+			"active_sessions", active, "max_sessions", max)
+		tracing.RecordError(span, err)
+		return &pb.JoinRoomResponse{
+			Success: false,
+			Error:   fmt.Sprintf("bridge at capacity: %v", err),
+		}, nil
+	}
+
 	// Create new session
-	session := NewRoomSession(req.UserId)
+	session := NewRoomSession(req.UserId, s.config)
+	session.roomName = req.RoomName
+	session.joinRequest = req
+	session.eventStore = s.eventStore
+	session.usage = s.usage
+	session.registry = s.sessions.registry
+	session.sampleRate = sampleRate
+	session.limiterSettings = limiterSettings
+	if req.NoiseSuppression {
+		session.SetNoiseSuppression(true)
+	}
+	if req.CatchupRate != 0 {
+		session.catchUpRate = float64(req.CatchupRate)
+	}
+
+	// Per-session track priorities override the bridge's defaults
+	priorities := make(map[string]int, len(req.TrackPriorities))
+	for trackName, level := range req.TrackPriorities {
+		priorities[trackName] = int(level)
+	}
+	session.priorities.configure(priorityPolicies[req.PriorityPolicy], priorities)
+	session.quota.configure(int(req.MaxTracks), int(req.BandwidthLimitKbps))
+	session.audioFromLiveKit.configure(overflowPolicies[req.IncomingOverflowPolicy], int(req.IncomingBufferFrames), 0)
+	if req.Asr {
+		session.startASR(s.asr, req.AsrLanguage)
+	}
+	if req.Endpointing || s.config.Endpointing {
+		session.endpointer = newEndpointer(s.config,
+			time.Duration(req.EndpointingSilenceMs)*time.Millisecond, req.EndpointingAudio || s.config.EndpointAudio)
+	}
+	cleanMicTrack := req.CleanMicTrack
+	if cleanMicTrack == "" {
+		cleanMicTrack = s.config.CleanMicTrack
+	}
+	if cleanMicTrack != "" {
+		session.startCleanMic(cleanMicTrack)
+	}
+	if req.Diarization || s.config.Diarization {
+		session.diarizers = newDiarizerSet(s.config)
+	}
+	if req.Mixdown {
+		session.startMixdown(req.MixdownGains)
+	}
+	if req.MixTracks {
+		session.bus = newTrackBus(req.MixTrackName, sampleRate)
+	}
+	soundEvents := req.SoundEvents
+	if len(soundEvents) == 0 {
+		soundEvents = s.config.SoundEvents
+	}
+	if len(soundEvents) > 0 {
+		session.soundEvents = newSoundEventDetector(s.config, s.sounds, soundEvents, req.SoundEventNames)
+		if !s.sounds.Enabled() {
+			session.log().Warn("Sound classification is not configured (SOUND_MODEL_PATH); only name_called can be detected",
+				"sound_events", soundEvents)
+		}
+	}
+	wakeWords := req.WakeWords
+	if len(wakeWords) == 0 {
+		wakeWords = s.config.WakeWords
+	}
+	if len(wakeWords) > 0 {
+		if _, err := session.setWakeWords(s.config, wakeWords, float64(req.WakeWordSensitivity)); err != nil {
+			session.log().Warn("Wake word detection not started", "keywords", wakeWords, "error", err)
+		}
+	}
 
 	// Setup callbacks for LiveKit room
 	var receivedPackets int64
-	var droppedPackets int64
+
+	// deliver runs received mic audio (data packets or decoded remote
+	// tracks, at the session's rate) through metering, then it and its 16kHz
+	// ASR feed through denoising, the feed through barge-in detection and
+	// the other listeners, and hands both to StreamAudio and SubscribeAudio
+	// consumers. Remote tracks decode on their own goroutines, so deliveries
+	// are serialized.
+	var deliverMu sync.Mutex
+	deliver := func(pcmData []byte, identity, trackName string, stamp captureStamp) {
+		deliverMu.Lock()
+		defer deliverMu.Unlock()
+
+		receivedPackets++
+		session.touch()
+		session.usage.AddReceived(session.userId, trackOwner(trackName),
+			time.Duration(len(pcmData)/2)*time.Second/time.Duration(session.sampleRate))
+
+		// Meter the raw mic audio, so a dead or muted mic shows up as silence
+		samples := pooledSamples(pcmData)
+		session.incomingMeter(identity).observe(*samples)
+		putSamples(samples)
+
+		// Record it raw too, as what the mic actually sent, and listen for a
+		// latency probe's chirp in it
+		session.recordIncoming(identity, pcmData)
+		session.probeIncoming(pcmData, identity, time.Now())
+
+		// Clean up the audio and its ASR feed first so VAD and consumers
+		// all get it denoised; at 16kHz the feed is the audio itself
+		native := session.sampleRate != incomingSampleRate
+		pcmData, feed := session.denoiseReceived(identity, pcmData)
+		if len(pcmData) == 0 {
+			return
+		}
+
+		// Watch for the user talking over TTS
+		session.processIncomingAudio(feed)
+
+		// Disguise the voice for consumers; speech recognition and the
+		// detectors keep hearing it as it was
+		pcmData = session.transformIncoming(identity, pcmData, session.sampleRate)
+		voicedFeed := pcmData
+		if native {
+			voicedFeed = session.transformIncoming(identity, feed, incomingSampleRate)
+		}
+
+		// Buffer for StreamAudio (never blocks; a full buffer drops a frame
+		// per the overflow policy); the sequence number is taken first so
+		// drops show up as gaps, and the feed frame shares it
+		frame := session.newAudioFrame(pcmData, identity, trackName, stamp)
+		feedFrame := frame
+		feedFrame.PCM, feedFrame.SampleRate = feed, incomingSampleRate
+		session.diarize(&feedFrame)
+		frame.SpeakerID = feedFrame.SpeakerID
+		voicedFeedFrame := feedFrame
+		voicedFeedFrame.PCM = voicedFeed
+		session.audioSubs.publish(frame, voicedFeedFrame)
+		session.mixdown.push(frame)
+		samples = pooledSamples(pcmData)
+		session.routeInput(session.ctx, routeParticipant+identity, *samples, session.sampleRate, 1)
+		putSamples(samples)
+		session.echoIncoming(frame)
+		session.detectWakeWords(feedFrame)
+		session.detectUtterances(feedFrame)
+		session.detectSounds(feedFrame)
+		// Unless the routing matrix decides what it hears instead
+		if !session.routing.feedsASR() && !session.asr.Write(feed) {
+			framesDropped.WithLabelValues("asr").Inc()
+		}
+		incoming := session.audioFromLiveKit
+		if !incoming.push(frame) {
+			// Log periodically to show audio is flowing
+			if receivedPackets%100 == 0 {
+				s.bsLogger.LogDebug("Audio flowing from LiveKit", map[string]interface{}{
+					"user_id":     req.UserId,
+					"received":    receivedPackets,
+					"dropped":     incoming.droppedFrames(),
+					"channel_len": incoming.len(),
+					"room_name":   req.RoomName,
+				})
+				session.log().Debug("Audio flowing from LiveKit", "received", receivedPackets,
+					"dropped", incoming.droppedFrames(), "channel_len", incoming.len())
+			}
+			return
+		}
+
+		policy := incoming.overflowPolicy()
+		framesDropped.WithLabelValues("incoming").Inc()
+		incomingOverflows.WithLabelValues(string(policy)).Inc()
+		if dropped := incoming.droppedFrames(); dropped%50 == 0 {
+			s.bsLogger.LogWarn("Dropping audio frames", map[string]interface{}{
+				"user_id":       req.UserId,
+				"total_dropped": dropped,
+				"channel_full":  incoming.len(),
+				"policy":        string(policy),
+				"room_name":     req.RoomName,
+			})
+			session.log().Warn("Dropping audio frames", "total_dropped", dropped,
+				"channel_len", incoming.len(), "policy", policy)
+		}
+	}
 
 	roomCallback := &lksdk.RoomCallback{
 		ParticipantCallback: lksdk.ParticipantCallback{
@@ -92,8 +370,11 @@ func (s *LiveKitBridgeService) JoinRoom(
 				if !ok || len(userPacket.Payload) == 0 {
 					return
 				}
+				if !session.subscriptions.accepts(params.SenderIdentity, userPacket.Topic) {
+					return
+				}
 
-				receivedPackets++
+				incomingBytes.Add(float64(len(userPacket.Payload)))
 
 				// Match old bridge behavior exactly
 				pcmData := userPacket.Payload
@@ -107,85 +388,108 @@ func (s *LiveKitBridgeService) JoinRoom(
 					return
 				}
 
-				// Send to channel (non-blocking)
-				select {
-				case session.audioFromLiveKit <- pcmData:
-					// Log periodically to show audio is flowing
-					if receivedPackets%100 == 0 {
-						s.bsLogger.LogDebug("Audio flowing from LiveKit", map[string]interface{}{
-							"user_id":     req.UserId,
-							"received":    receivedPackets,
-							"dropped":     droppedPackets,
-							"channel_len": len(session.audioFromLiveKit),
-							"room_name":   req.RoomName,
-						})
-						log.Printf("Audio flowing for %s: received=%d, dropped=%d, channelLen=%d",
-							req.UserId, receivedPackets, droppedPackets, len(session.audioFromLiveKit))
-					}
-				default:
-					// Drop frame if channel full (backpressure)
-					droppedPackets++
-					if droppedPackets%50 == 0 {
-						s.bsLogger.LogWarn("Dropping audio frames", map[string]interface{}{
-							"user_id":       req.UserId,
-							"total_dropped": droppedPackets,
-							"channel_full":  len(session.audioFromLiveKit),
-							"room_name":     req.RoomName,
-						})
-						log.Printf("Dropping audio frames for %s: total_dropped=%d, channel_full=%d",
-							req.UserId, droppedPackets, len(session.audioFromLiveKit))
-					}
-				}
+				deliver(pcmData, params.SenderIdentity, userPacket.Topic, captureStamp{})
+			},
+			OnTrackPublished: func(publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				session.remoteTrackChanged(publication, participant, EventRemoteTrackPublished)
+				session.applyTrackSubscription(publication, participant)
+			},
+			OnTrackUnpublished: func(publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				session.remoteTrackChanged(publication, participant, EventRemoteTrackUnpublished)
+			},
+			OnTrackMuted: func(publication lksdk.TrackPublication, participant lksdk.Participant) {
+				session.remoteTrackChanged(publication, participant, EventRemoteTrackMuted)
+			},
+			OnTrackUnmuted: func(publication lksdk.TrackPublication, participant lksdk.Participant) {
+				session.remoteTrackChanged(publication, participant, EventRemoteTrackUnmuted)
+			},
+			OnTrackSubscribed: func(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				session.consumeRemoteTrack(track, publication, participant, deliver)
+			},
+			OnTrackUnsubscribed: func(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				session.releaseRemoteTrack(publication)
 			},
 		},
-		OnDisconnected: func() {
-			log.Printf("Disconnected from LiveKit room: %s", req.RoomName)
+		OnDisconnectedWithReason: func(reason lksdk.DisconnectionReason) {
+			session.log().Warn("Disconnected from LiveKit room", "reason", string(reason))
 			s.bsLogger.LogWarn("Disconnected from LiveKit room", map[string]interface{}{
 				"user_id":   req.UserId,
 				"room_name": req.RoomName,
+				"reason":    string(reason),
 			})
 
-			// Mark session as disconnected for status RPC
-			if sessVal, ok := s.sessions.Load(req.UserId); ok {
-				session := sessVal.(*RoomSession)
-				session.mu.Lock()
-				session.connected = false
-				session.lastDisconnectAt = time.Now()
-				if session.lastDisconnectReason == "" {
-					session.lastDisconnectReason = "disconnected"
-				}
-				session.mu.Unlock()
-			}
+			// Record it for the status RPC and re-join unless it was final
+			session.handleDisconnect(reason)
+		},
+		OnParticipantConnected: func(participant *lksdk.RemoteParticipant) {
+			session.participantChanged(participant, EventParticipantJoined)
+		},
+		OnParticipantDisconnected: func(participant *lksdk.RemoteParticipant) {
+			session.participantChanged(participant, EventParticipantLeft)
+		},
+		OnActiveSpeakersChanged: func(speakers []lksdk.Participant) {
+			session.activeSpeakersChanged(speakers)
+		},
+		OnReconnecting: func() {
+			session.log().Warn("LiveKit connection interrupted, resuming")
+			session.setConnectionState(StateReconnecting, map[string]string{"reason": "resuming"})
+		},
+		OnReconnected: func() {
+			session.log().Info("LiveKit connection resumed")
+			session.setConnectionState(StateConnected, nil)
 		},
 	}
 
-	// Connect to LiveKit room
-	room, err := lksdk.ConnectToRoomWithToken(
-		req.LivekitUrl,
-		req.Token,
-		roomCallback,
-		lksdk.WithAutoSubscribe(false),
-	)
+	// Connect to LiveKit room. Reconnects reuse the JoinRoom token, so once
+	// it expires only a new JoinRoom can bring the session back.
+	session.dial = func() (*lksdk.Room, error) {
+		opts := []lksdk.ConnectOption{lksdk.WithAutoSubscribe(false)}
+		if session.rtc != nil {
+			interceptors, err := session.rtc.interceptors()
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up WebRTC stats: %w", err)
+			}
+			opts = append(opts, lksdk.WithInterceptors(interceptors))
+		}
+		if req.SharedRoom {
+			member := &sharedMember{callback: roomCallback, deliver: deliver}
+			return s.shared.attach(session, req.LivekitUrl, req.Token, member, opts...)
+		}
+		return lksdk.ConnectToRoomWithToken(req.LivekitUrl, req.Token, roomCallback, opts...)
+	}
+	_, connectSpan := tracing.Start(ctx, "livekit.connect")
+	room, err := session.dial()
+	tracing.RecordError(connectSpan, err)
+	connectSpan.End()
 	if err != nil {
+		tracing.RecordError(span, err)
 		s.bsLogger.LogError("Failed to connect to LiveKit room", err, map[string]interface{}{
 			"user_id":     req.UserId,
 			"room_name":   req.RoomName,
 			"livekit_url": req.LivekitUrl,
 		})
+		// Stop the goroutines already started for the session (clean mic,
+		// mixdown), which run until its context ends
+		session.cancel()
+		session.asr.Close()
+		session.closeWakeWords()
+		s.sessions.Release()
 		return &pb.JoinRoomResponse{
 			Success: false,
 			Error:   fmt.Sprintf("failed to connect to room: %v", err),
 		}, nil
 	}
 
-	session.room = room
-
-	// Update connectivity state for status RPC
+	// Install the room and update connectivity state for status RPC
 	session.mu.Lock()
+	session.connMu.Lock()
+	session.room = room
 	session.connected = true
+	session.connState = StateConnected
 	session.participantID = string(room.LocalParticipant.Identity())
 	session.participantCount = len(room.GetRemoteParticipants()) + 1
 	session.lastDisconnectReason = "" // clear previous reason on fresh join
+	session.connMu.Unlock()
 	session.mu.Unlock()
 
 	// DON'T create track here - only create when actually playing audio
@@ -194,9 +498,11 @@ func (s *LiveKitBridgeService) JoinRoom(
 	// Store session
 	s.sessions.Store(req.UserId, session)
 
-	log.Printf("Successfully joined room: userId=%s, participantId=%s",
-		req.UserId, room.LocalParticipant.Identity())
+	session.annotateLogs(room)
+	session.log().Info("Successfully joined room", "participant_id", string(room.LocalParticipant.Identity()))
+	session.emitEvent(EventSessionCreated, "", map[string]string{"room_name": req.RoomName})
 
+	span.SetAttributes(attribute.String("participant_id", string(room.LocalParticipant.Identity())))
 	s.bsLogger.LogInfo("Successfully joined LiveKit room", map[string]interface{}{
 		"user_id":           req.UserId,
 		"room_name":         req.RoomName,
@@ -216,12 +522,12 @@ func (s *LiveKitBridgeService) LeaveRoom(
 	ctx context.Context,
 	req *pb.LeaveRoomRequest,
 ) (*pb.LeaveRoomResponse, error) {
-	log.Printf("LeaveRoom request: userId=%s", req.UserId)
+	slog.Info("LeaveRoom request", "user_id", req.UserId)
 	s.bsLogger.LogInfo("LeaveRoom request received", map[string]interface{}{
 		"user_id": req.UserId,
 	})
 
-	sessionVal, ok := s.sessions.Load(req.UserId)
+	session, ok := s.sessions.Load(req.UserId)
 	if !ok {
 		return &pb.LeaveRoomResponse{
 			Success: false,
@@ -229,11 +535,10 @@ func (s *LiveKitBridgeService) LeaveRoom(
 		}, nil
 	}
 
-	session := sessionVal.(*RoomSession)
-	session.Close()
+	session.CloseWithReason("leave_room")
 	s.sessions.Delete(req.UserId)
 
-	log.Printf("Successfully left room: userId=%s", req.UserId)
+	session.log().Info("Successfully left room")
 
 	return &pb.LeaveRoomResponse{
 		Success: true,
@@ -255,108 +560,192 @@ func (s *LiveKitBridgeService) StreamAudio(
 		return status.Errorf(codes.InvalidArgument, "userId required in first chunk")
 	}
 
-	log.Printf("StreamAudio started: userId=%s", userId)
+	slog.Info("StreamAudio started", "user_id", userId)
+	_, span := tracing.Start(stream.Context(), "StreamAudio", attribute.String("user_id", userId))
+	defer span.End()
 
 	// Get session
-	sessionVal, ok := s.sessions.Load(userId)
+	session, ok := s.sessions.Load(userId)
 	if !ok {
 		return status.Errorf(codes.NotFound, "session not found for user %s", userId)
 	}
-	session := sessionVal.(*RoomSession)
 
 	// Error channel for goroutine communication
 	errChan := make(chan error, 2)
 
-	// Goroutine 1: Receive from client → LiveKit
-	go func() {
-		defer log.Printf("StreamAudio receive goroutine ended: userId=%s", userId)
+	// Audio and acks share the stream, which takes one Send at a time
+	var sendMu sync.Mutex
+	send := func(chunk *pb.AudioChunk) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(chunk)
+	}
 
-		// Process first chunk with track ID
-		trackName := trackIDToName(firstChunk.TrackId)
-		if err := session.writeAudioToTrack(firstChunk.PcmData, trackName); err != nil {
-			errChan <- fmt.Errorf("failed to write first chunk: %w", err)
-			return
-		}
+	// Requests the client tags its chunks with are acknowledged as they play
+	acks := newStreamAcks(session, s.config.AckInterval)
+	if acks != nil {
+		session.spawn("stream_audio_acks", func() {
+			acks.run(stream.Context(), send)
+		})
+	}
 
-		// Continue receiving
-		for {
-			chunk, err := stream.Recv()
-			if err == io.EOF {
-				return
+	// Goroutine 1: Receive from client → LiveKit
+	session.spawn("stream_audio_receive", func() {
+		defer session.log().Debug("StreamAudio receive goroutine ended")
+
+		// Audio over the session's quota, that a stalled track couldn't
+		// take within the write timeout, or that can't play at its
+		// presentation time, is dropped rather than ending the stream
+		// (which would close the session)
+		var quotaDrops, backpressureDrops, presentationDrops int64
+		write := func(chunk *pb.AudioChunk) error {
+			ledger := acks.ledger(chunk)
+			defer acks.ended(chunk)
+
+			err := writeChunkToSession(session, chunk)
+			if err == nil {
+				ledger.mark(chunk.Sequence)
+				return nil
 			}
-			if err != nil {
-				errChan <- fmt.Errorf("receive error: %w", err)
-				return
+			dropped := pcmDuration(chunk.PcmData, int(chunk.SampleRate), int(chunk.Channels))
+			var quota *QuotaError
+			if errors.As(err, &quota) {
+				ledger.reject(dropped)
+				quotaDrops++
+				if quotaDrops%100 == 1 {
+					session.log().Warn("Dropping StreamAudio chunks over quota", "error", err, "dropped", quotaDrops)
+				}
+				return nil
+			}
+			if errors.Is(err, ErrBackpressure) {
+				ledger.reject(dropped)
+				backpressureDrops++
+				if backpressureDrops%100 == 1 {
+					session.log().Warn("Dropping StreamAudio chunks on backpressure", "error", err, "dropped", backpressureDrops)
+				}
+				return nil
+			}
+			if errors.Is(err, errPresentationLate) || errors.Is(err, errPresentationTooFar) {
+				ledger.reject(dropped)
+				presentationDrops++
+				if presentationDrops%100 == 1 {
+					session.log().Warn("Dropping StreamAudio chunks outside their presentation time", "error", err, "dropped", presentationDrops)
+				}
+				return nil
 			}
+			return err
+		}
 
-			// Convert track_id to track name
-			trackName := trackIDToName(chunk.TrackId)
-			if err := session.writeAudioToTrack(chunk.PcmData, trackName); err != nil {
-				errChan <- fmt.Errorf("failed to write audio: %w", err)
-				return
+		// Chunks play in sequence order, starting with the first one received
+		first := true
+		recv := func() (*pb.AudioChunk, error) {
+			if first {
+				first = false
+				return firstChunk, nil
 			}
+			return stream.Recv()
+		}
+		var writeErr error
+		err := recvInOrder(stream.Context(), session.log(), s.config.Reorder, recv,
+			(*pb.AudioChunk).GetSequence,
+			func(chunk *pb.AudioChunk) error {
+				writeErr = write(chunk)
+				return writeErr
+			})
+		switch {
+		case writeErr != nil:
+			errChan <- fmt.Errorf("failed to write audio: %w", writeErr)
+		case err != nil:
+			errChan <- fmt.Errorf("receive error: %w", err)
 		}
-	}()
+	})
 
 	// Goroutine 2: Send from LiveKit → client
-	go func() {
-		defer log.Printf("StreamAudio send goroutine ended: userId=%s", userId)
+	//
+	// stream.Send blocks on gRPC flow control, so a single long-lived sender
+	// goroutine drains outgoing chunks while this loop watches for stalls.
+	// This avoids spawning a goroutine and timer per 10ms frame.
+	session.spawn("stream_audio_send", func() {
+		defer session.log().Debug("StreamAudio send goroutine ended")
+
+		const sendTimeout = 2 * time.Second
+
+		outgoing := make(chan *pb.AudioChunk)
+		sendDone := make(chan error, 1)
+		defer close(outgoing)
+
+		session.spawn("stream_audio_sender", func() {
+			for chunk := range outgoing {
+				sendDone <- send(chunk)
+			}
+		})
+
+		timer := time.NewTimer(sendTimeout)
+		timer.Stop()
+		defer timer.Stop()
 
 		var sentPackets int64
 		var sendErrors int64
 
 		for {
+			// Ends once the session closes the buffer
+			frame, ok := session.audioFromLiveKit.pop(stream.Context().Done())
+			if !ok {
+				return
+			}
+
+			timer.Reset(sendTimeout)
+
+			// Hand the chunk to the sender; if it is still stuck on a previous
+			// Send this blocks, which is exactly the stall we want to detect.
+			select {
+			case outgoing <- &pb.AudioChunk{
+				PcmData:             frame.PCM,
+				SampleRate:          int32(frame.SampleRate),
+				Channels:            1,
+				TimestampMs:         frame.CapturedAt.UnixMilli(),
+				ParticipantIdentity: frame.ParticipantIdentity,
+				TrackName:           frame.TrackName,
+				Sequence:            frame.Sequence,
+				SpeakerId:           frame.SpeakerID,
+				RtpTimestamp:        frame.RTPTimestamp,
+				CaptureTimeMs:       unixMilliOrZero(frame.SenderTime),
+				Mixed:               frame.Mixed,
+			}:
+			case <-timer.C:
+				s.sendTimedOut(userId, errChan)
+				return
+			case <-session.ctx.Done():
+				return
+			}
+
 			select {
-			case audioData, ok := <-session.audioFromLiveKit:
-				if !ok {
+			case err := <-sendDone:
+				timer.Stop()
+				if err != nil {
+					sendErrors++
+					session.log().Error("StreamAudio send error", "error", err, "errors", sendErrors)
+					errChan <- fmt.Errorf("send error: %w", err)
 					return
 				}
-
-				// Send to client with timeout to prevent blocking forever
-				sendDone := make(chan error, 1)
-				go func() {
-					sendDone <- stream.Send(&pb.AudioChunk{
-						PcmData:     audioData,
-						SampleRate:  16000,
-						Channels:    1,
-						TimestampMs: 0,
+				sentPackets++
+				if sentPackets%100 == 0 {
+					s.bsLogger.LogDebug("Sent audio chunks to TypeScript", map[string]interface{}{
+						"user_id":     userId,
+						"sent":        sentPackets,
+						"channel_len": session.audioFromLiveKit.len(),
 					})
-				}()
-
-				select {
-				case err := <-sendDone:
-					if err != nil {
-						sendErrors++
-						log.Printf("StreamAudio send error for %s: %v (errors=%d)", userId, err, sendErrors)
-						errChan <- fmt.Errorf("send error: %w", err)
-						return
-					}
-					sentPackets++
-					if sentPackets%100 == 0 {
-						s.bsLogger.LogDebug("Sent audio chunks to TypeScript", map[string]interface{}{
-							"user_id":     userId,
-							"sent":        sentPackets,
-							"channel_len": len(session.audioFromLiveKit),
-						})
-						log.Printf("Sent %d audio chunks to TypeScript for user %s (channelLen=%d)",
-							sentPackets, userId, len(session.audioFromLiveKit))
-					}
-				case <-time.After(2 * time.Second):
-					s.bsLogger.LogError("StreamAudio send timeout", fmt.Errorf("timeout after 2s"), map[string]interface{}{
-						"user_id": userId,
-					})
-					log.Printf("StreamAudio send timeout for %s after 2s, client may be stuck", userId)
-					errChan <- fmt.Errorf("send timeout after 2s")
-					return
-				case <-session.ctx.Done():
-					return
+					session.log().Debug("Sent audio chunks to TypeScript", "sent", sentPackets,
+						"channel_len", session.audioFromLiveKit.len())
 				}
-
+			case <-timer.C:
+				s.sendTimedOut(userId, errChan)
+				return
 			case <-session.ctx.Done():
 				return
 			}
 		}
-	}()
+	})
 
 	// Wait for error or cancellation
 	select {
@@ -364,22 +753,60 @@ func (s *LiveKitBridgeService) StreamAudio(
 		s.bsLogger.LogError("StreamAudio error", err, map[string]interface{}{
 			"user_id": userId,
 		})
-		log.Printf("StreamAudio error for userId=%s: %v", userId, err)
+		session.log().Error("StreamAudio error", "error", err)
+		tracing.RecordError(span, err)
 
 		// CRITICAL: Clean up session on stream error
 		// This prevents zombie sessions and "channel full" errors after reconnection issues
 		s.bsLogger.LogWarn("Cleaning up session due to stream error", map[string]interface{}{
 			"user_id": userId,
 		})
-		log.Printf("Cleaning up session for %s due to stream error", userId)
-		session.Close()
-		s.sessions.Delete(userId)
+		session.log().Warn("Cleaning up session due to stream error")
+		session.CloseWithReason("stream_error")
+		s.sessions.Remove(session)
 
 		return err
 	case <-session.ctx.Done():
-		log.Printf("StreamAudio context done: userId=%s", userId)
+		session.log().Info("StreamAudio ended: session closed")
 		return nil
+	case <-stream.Context().Done():
+		session.log().Info("StreamAudio stream closed by client")
+		return nil
+	}
+}
+
+// writeChunkToSession routes an incoming StreamAudio chunk to its track,
+// publishing Opus packets directly and PCM through the resampling path
+func writeChunkToSession(session *RoomSession, chunk *pb.AudioChunk) error {
+	// Convert track_id to track name, under the sending app if any
+	trackName, err := appTrackName(chunk.AppId, "", chunk.TrackId)
+	if err != nil {
+		return err
+	}
+
+	if chunk.Encoding == pb.AudioEncoding_OPUS {
+		duration := time.Duration(chunk.FrameDurationMs) * time.Millisecond
+		return session.writeOpusToTrack(chunk.PcmData, trackName, duration)
 	}
+
+	if chunk.CrossfadeMs > 0 {
+		session.crossfadeTrack(trackName, time.Duration(chunk.CrossfadeMs)*time.Millisecond)
+	}
+
+	if chunk.PresentationTimeMs > 0 {
+		return session.writeAudioToTrackPresented(chunk.PcmData, trackName, int(chunk.SampleRate), int(chunk.Channels),
+			time.UnixMilli(chunk.PresentationTimeMs))
+	}
+	return session.writeAudioToTrackAt(chunk.PcmData, trackName, int(chunk.SampleRate), int(chunk.Channels))
+}
+
+// sendTimedOut reports a stalled StreamAudio send to the stream's error channel
+func (s *LiveKitBridgeService) sendTimedOut(userId string, errChan chan<- error) {
+	s.bsLogger.LogError("StreamAudio send timeout", fmt.Errorf("timeout after 2s"), map[string]interface{}{
+		"user_id": userId,
+	})
+	slog.Warn("StreamAudio send timeout, client may be stuck", "user_id", userId, "timeout", "2s")
+	errChan <- fmt.Errorf("send timeout after 2s")
 }
 
 // PlayAudio handles server-side audio playback
@@ -387,189 +814,1130 @@ func (s *LiveKitBridgeService) PlayAudio(
 	req *pb.PlayAudioRequest,
 	stream pb.LiveKitBridge_PlayAudioServer,
 ) error {
-	log.Printf("PlayAudio request: userId=%s, url=%s", req.UserId, req.AudioUrl)
-
-	sessionVal, ok := s.sessions.Load(req.UserId)
+	slog.Info("PlayAudio request", "user_id", req.UserId, "request_id", req.RequestId, "url", req.AudioUrl)
+	ctx, span := tracing.Start(stream.Context(), "PlayAudio",
+		attribute.String("user_id", req.UserId),
+		attribute.String("request_id", req.RequestId),
+		attribute.Int("track_id", int(req.TrackId)),
+		attribute.Bool("stop_other", req.StopOther))
+	defer span.End()
+
+	session, ok := s.sessions.Load(req.UserId)
 	if !ok {
 		return status.Errorf(codes.NotFound, "session not found for user %s", req.UserId)
 	}
-	session := sessionVal.(*RoomSession)
+	session.touch()
 
-	// Convert track_id to track name FIRST (before any stopping logic)
-	trackName := trackIDToName(req.TrackId)
+	// Resolve the track name FIRST (before any stopping logic)
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return trackNameStatus(err)
+	}
+	if err := s.daily.check(ctx, session, trackName); err != nil {
+		return err
+	}
+	if err := validateAudioFormat(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := applyTone(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// Handle stopping logic based on StopOther flag
-	if req.StopOther {
-		// StopOther=true: Stop ALL tracks (interrupt mode)
-		log.Printf("StopOther flag set, stopping ALL tracks for user %s", req.UserId)
-		session.stopPlayback()
-	} else {
+	if !interruptPlayback(ctx, session, req) {
 		// StopOther=false: Only stop THIS specific track to avoid conflicts (mixing mode)
 		// This allows different tracks (speaker, tts, app_audio) to play simultaneously
-		log.Printf("Audio mixing mode: stopping only track '%s' for user %s", trackName, req.UserId)
+		session.log().Info("Audio mixing mode: stopping only this track", "request_id", req.RequestId, "track_name", trackName)
 		session.stopTrackPlayback(trackName)
 	}
 
 	// Send STARTED event
-	if err := stream.Send(&pb.PlayAudioEvent{
+	if err := sendPlaybackEvent(stream, session, trackName, &pb.PlayAudioEvent{
 		Type:      pb.PlayAudioEvent_STARTED,
 		RequestId: req.RequestId,
 	}); err != nil {
 		return err
 	}
 
+	// Publish word-timed captions in step with what the track plays out
+	captionCtx, cancelCaptions := context.WithCancel(ctx)
+	defer cancelCaptions()
+	captions := session.startCaptions(captionCtx, s.config.CaptionTopic, req.RequestId, trackName, req.WordTimings)
+	ledger := session.newDeliveryLedger(trackName)
+
 	// Play audio file synchronously - MUST wait to keep gRPC stream open
 	// Multiple PlayAudio RPC calls can run concurrently on different tracks
 	// This is the key to audio mixing: concurrent RPC calls = concurrent tracks
-	duration, err := s.playAudioFile(req, session, stream, trackName)
+	duration, err := s.playAudioFile(ctx, req, session, stream, trackName)
 	if err != nil {
-		// Send FAILED event
-		stream.Send(&pb.PlayAudioEvent{
-			Type:      pb.PlayAudioEvent_FAILED,
+		cancelCaptions()
+		tracing.RecordError(span, err)
+		// Send FAILED (or INTERRUPTED) event
+		outcome := playbackOutcome(err)
+		sendPlaybackEvent(stream, session, trackName, withDelivery(&pb.PlayAudioEvent{
+			Type:      outcome,
 			RequestId: req.RequestId,
 			Error:     err.Error(),
-		})
+		}, ledger.finish(false)))
 
-		// Close only this specific track on error
-		session.closeTrack(trackName)
+		// Close only this specific track on error. A cancelled or stopped
+		// playback was interrupted on purpose and the track name may already
+		// carry new audio.
+		if outcome == pb.PlayAudioEvent_FAILED {
+			session.closeTrack(trackName)
+		}
 		return err
 	}
 
+	captions.finish()
+
 	// Send COMPLETED event
-	if err := stream.Send(&pb.PlayAudioEvent{
+	if err := sendPlaybackEvent(stream, session, trackName, withDelivery(&pb.PlayAudioEvent{
 		Type:       pb.PlayAudioEvent_COMPLETED,
 		RequestId:  req.RequestId,
 		DurationMs: duration,
-	}); err != nil {
+	}, ledger.finish(true))); err != nil {
 		return err
 	}
 
+	span.SetAttributes(attribute.Int64("duration_ms", duration))
+
 	// DON'T close the track after playback - keep it alive for reuse
 	// Tracks are only closed when explicitly stopped via StopAudio or session cleanup
 	// This prevents the "no audio after first play" issue
-	log.Printf("Playback completed for track '%s', keeping track alive for reuse", trackName)
+	session.log().Info("Playback completed, keeping track alive for reuse", "request_id", req.RequestId, "track_name", trackName)
 
 	return nil
 }
 
-// StopAudio handles stopping audio playback
-func (s *LiveKitBridgeService) StopAudio(
-	ctx context.Context,
-	req *pb.StopAudioRequest,
-) (*pb.StopAudioResponse, error) {
-	log.Printf("StopAudio request: userId=%s, trackId=%d", req.UserId, req.TrackId)
-
-	sessionVal, ok := s.sessions.Load(req.UserId)
-	if !ok {
-		return &pb.StopAudioResponse{
-			Success: false,
-			Error:   "session not found",
-		}, nil
+// interruptPlayback stops (or crossfades out) everything the session is
+// playing, or just req's app's tracks, when req has StopOther set; returns
+// whether it did
+func interruptPlayback(ctx context.Context, session *RoomSession, req *pb.PlayAudioRequest) bool {
+	if !req.StopOther {
+		return false
 	}
 
-	session := sessionVal.(*RoomSession)
-
-	// Cancel any ongoing playback (this stops the audio without closing tracks)
-	session.stopPlayback()
-	log.Printf("Stopped playback for user %s", req.UserId)
-
-	// NOTE: We do NOT close tracks here anymore!
-	// Tracks should remain alive for reuse to prevent "no audio after stop" issues.
-	// Tracks are only closed during:
-	// 1. Session cleanup (session.Close())
-	// 2. PlayAudio errors
-	//
-	// If you need to explicitly close tracks, use a different RPC or add a flag to this request.
-
-	return &pb.StopAudioResponse{
-		Success:          true,
-		StoppedRequestId: req.RequestId,
-	}, nil
-}
-
-// HealthCheck handles health check requests
-func (s *LiveKitBridgeService) HealthCheck(
-	ctx context.Context,
-	req *pb.HealthCheckRequest,
-) (*pb.HealthCheckResponse, error) {
-	var activeSessions int32
-	var activeStreams int32
+	// An app only interrupts its own tracks (the caller has already checked
+	// the app ID with appTrackName)
+	match, _ := appTrackMatcher(req.AppId, "")
 
-	s.sessions.Range(func(key, value interface{}) bool {
-		activeSessions++
-		session := value.(*RoomSession)
-		if session.room != nil {
-			activeStreams++
-		}
+	if req.CrossfadeMs > 0 {
+		// Crossfade mode: keep tracks published and blend the interrupted
+		// audio into the new one instead of cutting it off
+		session.log().Info("StopOther with crossfade", "request_id", req.RequestId, "crossfade_ms", req.CrossfadeMs,
+			"app_id", req.AppId)
+		session.crossfadePlayback(match, time.Duration(req.CrossfadeMs)*time.Millisecond)
 		return true
-	})
+	}
 
-	return &pb.HealthCheckResponse{
-		Status:         pb.HealthCheckResponse_SERVING,
-		ActiveSessions: activeSessions,
-		ActiveStreams:  activeStreams,
-		UptimeSeconds:  0, // Could track uptime if needed
-	}, nil
+	// StopOther=true: Stop ALL tracks (interrupt mode), or all of the app's
+	session.log().Info("StopOther flag set, stopping all tracks", "request_id", req.RequestId, "app_id", req.AppId)
+	_, stopSpan := tracing.Start(ctx, "stopPlayback")
+	session.stopMatchingPlayback(match, session.stopFade)
+	stopSpan.End()
+	return true
 }
 
-// getSession is a helper to safely get a session
-func (s *LiveKitBridgeService) getSession(userId string) (*RoomSession, error) {
-	sessionVal, ok := s.sessions.Load(userId)
+// EnqueueAudio plays a clip after everything already queued on its track.
+// A clip is written to the track as soon as the one ahead of it has been
+// decoded, so consecutive clips join sample-for-sample with no gap; each
+// still reports COMPLETED only once its own audio has played out.
+func (s *LiveKitBridgeService) EnqueueAudio(
+	req *pb.PlayAudioRequest,
+	stream pb.LiveKitBridge_EnqueueAudioServer,
+) error {
+	slog.Info("EnqueueAudio request", "user_id", req.UserId, "request_id", req.RequestId, "url", req.AudioUrl)
+	ctx, span := tracing.Start(stream.Context(), "EnqueueAudio",
+		attribute.String("user_id", req.UserId),
+		attribute.String("request_id", req.RequestId),
+		attribute.Int("track_id", int(req.TrackId)))
+	defer span.End()
+
+	session, ok := s.sessions.Load(req.UserId)
 	if !ok {
-		return nil, fmt.Errorf("session not found for user %s", userId)
+		return status.Errorf(codes.NotFound, "session not found for user %s", req.UserId)
 	}
-	return sessionVal.(*RoomSession), nil
-}
+	session.touch()
 
-// GetStatus returns room connectivity state for a given user session
-func (s *LiveKitBridgeService) GetStatus(ctx context.Context, req *pb.BridgeStatusRequest) (*pb.BridgeStatusResponse, error) {
-	// Default response if no session
-	resp := &pb.BridgeStatusResponse{
-		Connected:            false,
-		ParticipantId:        "",
-		ParticipantCount:     0,
-		LastDisconnectAt:     0,
-		LastDisconnectReason: "",
-		ServerVersion:        "1.0.0",
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return trackNameStatus(err)
 	}
-
-	if req == nil || req.UserId == "" {
-		return resp, nil
+	if err := s.daily.check(ctx, session, trackName); err != nil {
+		return err
 	}
-
-	val, ok := s.sessions.Load(req.UserId)
-	if !ok {
-		// No session found: return defaults (connected=false)
-		return resp, nil
+	if err := validateAudioFormat(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := applyTone(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	session := val.(*RoomSession)
+	// StopOther replaces whatever is playing or queued with this clip
+	interruptPlayback(ctx, session, req)
 
-	// Collect state under lock
-	session.mu.RLock()
-	connected := session.connected
-	participantID := session.participantID
-	participantCount := session.participantCount
-	lastDiscAt := session.lastDisconnectAt
-	lastDiscReason := session.lastDisconnectReason
-	room := session.room
-	session.mu.RUnlock()
+	queue := session.audioQueue(trackName)
+	item := queue.push(req)
+	defer queue.done(item)
 
-	// If we have a room, prefer live counts/ids
-	if room != nil {
-		if participantID == "" {
-			participantID = string(room.LocalParticipant.Identity())
-		}
-		participantCount = len(room.GetRemoteParticipants()) + 1
+	select {
+	case <-item.turn:
+	case <-item.removed:
+		session.log().Info("Queued audio removed before playing", "request_id", req.RequestId, "track_name", trackName)
+		return sendPlaybackEvent(stream, session, trackName, &pb.PlayAudioEvent{
+			Type:      pb.PlayAudioEvent_INTERRUPTED,
+			RequestId: req.RequestId,
+			Error:     errRemovedFromQueue.Error(),
+		})
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 
-	resp.Connected = connected
-	resp.ParticipantId = participantID
-	resp.ParticipantCount = int32(participantCount)
-	if !lastDiscAt.IsZero() {
-		resp.LastDisconnectAt = lastDiscAt.UnixMilli()
-	}
+	if err := sendPlaybackEvent(stream, session, trackName, &pb.PlayAudioEvent{
+		Type:      pb.PlayAudioEvent_STARTED,
+		RequestId: req.RequestId,
+	}); err != nil {
+		return err
+	}
+
+	// Captions and progress follow the track's clock from the end of the
+	// audio queued ahead of this clip
+	start := session.playoutMark(trackName)
+	captionCtx, cancelCaptions := context.WithCancel(ctx)
+	defer cancelCaptions()
+	captions := session.startCaptions(captionCtx, s.config.CaptionTopic, req.RequestId, trackName, req.WordTimings)
+	progress := s.startProgress(ctx, stream, req, session, trackName, 0)
+	ledger := session.newDeliveryLedger(trackName)
+
+	// Write the clip without waiting for it to play, hand the track to the
+	// next clip, then wait for this clip's last sample to be heard
+	decoded, err := s.playAudioFile(deferPlayout(ctx), req, session, nil, trackName)
+	end := session.playoutMark(trackName)
+	ledger.seal()
+	queue.done(item)
+	if err == nil {
+		err = end.wait(ctx)
+	}
+	progress.stop()
+
+	if err != nil {
+		cancelCaptions()
+		tracing.RecordError(span, err)
+		sendPlaybackEvent(stream, session, trackName, withDelivery(&pb.PlayAudioEvent{
+			Type:      playbackOutcome(err),
+			RequestId: req.RequestId,
+			Error:     err.Error(),
+		}, ledger.finish(false)))
+		// The track is left open: clips queued behind this one still need it
+		return err
+	}
+
+	captions.finish()
+
+	duration := end.since(start).Milliseconds()
+	if duration == 0 {
+		duration = decoded
+	}
+	if err := sendPlaybackEvent(stream, session, trackName, withDelivery(&pb.PlayAudioEvent{
+		Type:       pb.PlayAudioEvent_COMPLETED,
+		RequestId:  req.RequestId,
+		DurationMs: duration,
+	}, ledger.finish(true))); err != nil {
+		return err
+	}
+
+	span.SetAttributes(attribute.Int64("duration_ms", duration))
+	session.log().Info("Queued playback completed", "request_id", req.RequestId, "track_name", trackName)
+	return nil
+}
+
+// GetAudioQueue lists the clips queued on a track
+func (s *LiveKitBridgeService) GetAudioQueue(
+	ctx context.Context,
+	req *pb.AudioQueueRequest,
+) (*pb.AudioQueueResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.AudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return &pb.AudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.AudioQueueResponse{
+		Success: true,
+		Items:   session.audioQueue(trackName).list(),
+	}, nil
+}
+
+// MoveQueuedAudio changes when a waiting clip plays
+func (s *LiveKitBridgeService) MoveQueuedAudio(
+	ctx context.Context,
+	req *pb.MoveQueuedAudioRequest,
+) (*pb.AudioQueueResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.AudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return &pb.AudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	queue := session.audioQueue(trackName)
+	if err := queue.move(req.RequestId, int(req.Position)); err != nil {
+		return &pb.AudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+			Items:   queue.list(),
+		}, nil
+	}
+
+	session.log().Info("Moved queued audio", "request_id", req.RequestId, "position", req.Position)
+	return &pb.AudioQueueResponse{
+		Success: true,
+		Items:   queue.list(),
+	}, nil
+}
+
+// ClearAudioQueue drops the clips waiting on a track, leaving the one that
+// is playing to finish
+func (s *LiveKitBridgeService) ClearAudioQueue(
+	ctx context.Context,
+	req *pb.AudioQueueRequest,
+) (*pb.ClearAudioQueueResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.ClearAudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return &pb.ClearAudioQueueResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	cleared := session.audioQueue(trackName).clear()
+	session.log().Info("Cleared audio queue", "track_name", trackName, "request_ids", cleared)
+
+	return &pb.ClearAudioQueueResponse{
+		Success:           true,
+		ClearedRequestIds: cleared,
+	}, nil
+}
+
+// SeekTrack moves the file playback on a track to another position
+func (s *LiveKitBridgeService) SeekTrack(
+	ctx context.Context,
+	req *pb.SeekTrackRequest,
+) (*pb.SeekTrackResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SeekTrackResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, req.TrackId)
+	if err != nil {
+		return &pb.SeekTrackResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	requestID, position, err := session.seekTrack(trackName, time.Duration(req.PositionMs)*time.Millisecond, req.Relative)
+	if err != nil {
+		return &pb.SeekTrackResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	session.log().Info("Seek requested", "request_id", requestID, "track_name", trackName,
+		"position_ms", position.Milliseconds(), "relative", req.Relative)
+	return &pb.SeekTrackResponse{
+		Success:    true,
+		RequestId:  requestID,
+		PositionMs: position.Milliseconds(),
+	}, nil
+}
+
+// PauseTrack holds a track's playback, keeping its queued audio
+func (s *LiveKitBridgeService) PauseTrack(
+	ctx context.Context,
+	req *pb.TrackPauseRequest,
+) (*pb.TrackPauseResponse, error) {
+	return s.setTrackPaused(req, true)
+}
+
+// ResumeTrack continues a paused track's playback from where it stopped
+func (s *LiveKitBridgeService) ResumeTrack(
+	ctx context.Context,
+	req *pb.TrackPauseRequest,
+) (*pb.TrackPauseResponse, error) {
+	return s.setTrackPaused(req, false)
+}
+
+// setTrackPaused pauses or resumes the track a request names
+func (s *LiveKitBridgeService) setTrackPaused(req *pb.TrackPauseRequest, paused bool) (*pb.TrackPauseResponse, error) {
+	slog.Info("Track pause request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "paused", paused)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.TrackPauseResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.TrackPauseResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if paused {
+		err = session.PauseTrack(trackName)
+	} else {
+		err = session.ResumeTrack(trackName)
+	}
+	if err != nil {
+		return &pb.TrackPauseResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &pb.TrackPauseResponse{Success: true}, nil
+}
+
+// SetTrackVolume sets a track's linear gain
+func (s *LiveKitBridgeService) SetTrackVolume(
+	ctx context.Context,
+	req *pb.SetTrackVolumeRequest,
+) (*pb.SetTrackVolumeResponse, error) {
+	slog.Info("SetTrackVolume request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "volume", req.Volume)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetTrackVolumeResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.SetTrackVolumeResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if err := session.SetTrackVolume(trackName, float64(req.Volume)); err != nil {
+		return &pb.SetTrackVolumeResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &pb.SetTrackVolumeResponse{
+		Success: true,
+		Volume:  min(req.Volume, maxTrackGain),
+	}, nil
+}
+
+// SetTrackAGC turns automatic gain control on or off for a track
+func (s *LiveKitBridgeService) SetTrackAGC(
+	ctx context.Context,
+	req *pb.SetTrackAGCRequest,
+) (*pb.SetTrackAGCResponse, error) {
+	slog.Info("SetTrackAGC request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "enabled", req.Enabled)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetTrackAGCResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.SetTrackAGCResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	session.SetTrackAGC(trackName, req.Enabled)
+	return &pb.SetTrackAGCResponse{Success: true}, nil
+}
+
+// MuteTrack silences a track without unpublishing it
+func (s *LiveKitBridgeService) MuteTrack(
+	ctx context.Context,
+	req *pb.TrackMuteRequest,
+) (*pb.TrackMuteResponse, error) {
+	return s.setTrackMuted(req, true)
+}
+
+// UnmuteTrack makes a muted track audible again
+func (s *LiveKitBridgeService) UnmuteTrack(
+	ctx context.Context,
+	req *pb.TrackMuteRequest,
+) (*pb.TrackMuteResponse, error) {
+	return s.setTrackMuted(req, false)
+}
+
+// setTrackMuted mutes or unmutes the track a request names
+func (s *LiveKitBridgeService) setTrackMuted(req *pb.TrackMuteRequest, muted bool) (*pb.TrackMuteResponse, error) {
+	slog.Info("Track mute request", "user_id", req.UserId, "track_name", req.TrackName,
+		"app_id", req.AppId, "muted", muted)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.TrackMuteResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.TrackMuteResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if muted {
+		err = session.MuteTrack(trackName)
+	} else {
+		err = session.UnmuteTrack(trackName)
+	}
+	if err != nil {
+		return &pb.TrackMuteResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &pb.TrackMuteResponse{Success: true}, nil
+}
+
+// ListTracks reports every track in the session with its owner, format,
+// playback state and queue depth
+func (s *LiveKitBridgeService) ListTracks(
+	ctx context.Context,
+	req *pb.ListTracksRequest,
+) (*pb.ListTracksResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.ListTracksResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	tracks := session.listTracks()
+	if req.AppId != "" {
+		owned := tracks[:0]
+		for _, track := range tracks {
+			if track.OwnerApp == req.AppId {
+				owned = append(owned, track)
+			}
+		}
+		tracks = owned
+	}
+
+	return &pb.ListTracksResponse{
+		Success: true,
+		Tracks:  tracks,
+	}, nil
+}
+
+// StopAudio handles stopping audio playback
+func (s *LiveKitBridgeService) StopAudio(
+	ctx context.Context,
+	req *pb.StopAudioRequest,
+) (*pb.StopAudioResponse, error) {
+	slog.Info("StopAudio request", "user_id", req.UserId, "request_id", req.RequestId, "track_id", req.TrackId)
+
+	session, ok := s.sessions.Load(req.UserId)
+	if !ok {
+		return &pb.StopAudioResponse{
+			Success: false,
+			Error:   "session not found",
+		}, nil
+	}
+	session.touch()
+
+	// A track pattern limits the stop to matching tracks, and an app may
+	// only stop its own
+	match, err := appTrackMatcher(req.AppId, req.TrackPattern)
+	if err != nil {
+		return &pb.StopAudioResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	// Cancel any ongoing playback (this stops the audio without closing tracks)
+	_, span := tracing.Start(ctx, "stopPlayback",
+		attribute.String("user_id", req.UserId),
+		attribute.String("request_id", req.RequestId),
+		attribute.String("mode", req.Mode.String()),
+		attribute.String("track_pattern", req.TrackPattern),
+		attribute.String("app_id", req.AppId))
+	switch req.Mode {
+	case pb.StopAudioRequest_IMMEDIATE:
+		session.stopMatchingPlayback(match, 0)
+	case pb.StopAudioRequest_FINISH_CURRENT:
+		session.finishPlayback(match)
+	default:
+		session.stopMatchingPlayback(match, session.stopFade)
+	}
+	span.End()
+	session.log().Info("Stopped playback", "request_id", req.RequestId, "mode", req.Mode.String(),
+		"track_pattern", req.TrackPattern, "app_id", req.AppId)
+
+	// NOTE: We do NOT close tracks here anymore!
+	// Tracks should remain alive for reuse to prevent "no audio after stop" issues.
+	// Tracks are only closed during:
+	// 1. Session cleanup (session.Close())
+	// 2. PlayAudio errors
+	//
+	// If you need to explicitly close tracks, use a different RPC or add a flag to this request.
+
+	return &pb.StopAudioResponse{
+		Success:          true,
+		StoppedRequestId: req.RequestId,
+	}, nil
+}
+
+// HealthCheck handles health check requests
+func (s *LiveKitBridgeService) HealthCheck(
+	ctx context.Context,
+	req *pb.HealthCheckRequest,
+) (*pb.HealthCheckResponse, error) {
+	var activeSessions int32
+	var activeStreams int32
+
+	s.sessions.Range(func(userId string, session *RoomSession) bool {
+		activeSessions++
+		if session.currentRoom() != nil {
+			activeStreams++
+		}
+		return true
+	})
+
+	return &pb.HealthCheckResponse{
+		Status:         pb.HealthCheckResponse_SERVING,
+		ActiveSessions: activeSessions,
+		ActiveStreams:  activeStreams,
+		UptimeSeconds:  0, // Could track uptime if needed
+	}, nil
+}
+
+// getSession is a helper to safely get a session
+func (s *LiveKitBridgeService) getSession(userId string) (*RoomSession, error) {
+	session, ok := s.sessions.Load(userId)
+	if !ok {
+		return nil, fmt.Errorf("session not found for user %s", userId)
+	}
+	return session, nil
+}
+
+// GetStatus returns room connectivity state for a given user session
+func (s *LiveKitBridgeService) GetStatus(ctx context.Context, req *pb.BridgeStatusRequest) (*pb.BridgeStatusResponse, error) {
+	userId := ""
+	if req != nil {
+		userId = req.UserId
+	}
+	return s.bridgeStatus(userId), nil
+}
+
+// bridgeStatus builds the status payload for a user's session, or just the
+// bridge-wide fields when the user has none
+func (s *LiveKitBridgeService) bridgeStatus(userId string) *pb.BridgeStatusResponse {
+	// Default response if no session
+	resp := &pb.BridgeStatusResponse{
+		Connected:            false,
+		ParticipantId:        "",
+		ParticipantCount:     0,
+		LastDisconnectAt:     0,
+		LastDisconnectReason: "",
+		ServerVersion:        "1.0.0",
+		ConnectionState:      string(StateDisconnected),
+		Draining:             s.draining.Load(),
+	}
+
+	active, max, queued := s.sessions.Counts()
+	resp.ActiveSessions = int32(active)
+	resp.MaxSessions = int32(max)
+	resp.QueuedJoins = int32(queued)
+
+	if userId == "" {
+		return resp
+	}
+
+	session, ok := s.sessions.Load(userId)
+	if !ok {
+		// No session found: return defaults (connected=false)
+		return resp
+	}
+
+	// Collect state under the connectivity lock, which audio writes never hold
+	session.connMu.RLock()
+	connected := session.connected
+	connState := session.connState
+	participantID := session.participantID
+	participantCount := session.participantCount
+	lastDiscAt := session.lastDisconnectAt
+	lastDiscReason := session.lastDisconnectReason
+	room := session.room
+	session.connMu.RUnlock()
+
+	// If we have a room, prefer live counts/ids
+	if room != nil {
+		if participantID == "" {
+			participantID = string(room.LocalParticipant.Identity())
+		}
+		participantCount = len(room.GetRemoteParticipants()) + 1
+	}
+
+	resp.Connected = connected
+	resp.ConnectionState = string(connState)
+	resp.ParticipantId = participantID
+	resp.ParticipantCount = int32(participantCount)
+	if !lastDiscAt.IsZero() {
+		resp.LastDisconnectAt = lastDiscAt.UnixMilli()
+	}
 	resp.LastDisconnectReason = lastDiscReason
+	resp.TrackLevels = session.trackLevels()
+	resp.TrackStats = session.trackRTCStats()
+	resp.PublishedTracks = session.publishedTracks()
+	resp.RemoteParticipants = remoteParticipants(room)
+	resp.LatencyProbe = session.latencyProbeStatus()
+
+	return resp
+}
+
+// WatchStatus pushes the session's status whenever its connection, tracks or
+// participants change, starting with the current status, until the client
+// goes away or the session closes
+func (s *LiveKitBridgeService) WatchStatus(req *pb.BridgeStatusRequest, stream pb.LiveKitBridge_WatchStatusServer) error {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	id, events := session.events.subscribe()
+	defer session.events.unsubscribe(id)
+
+	session.log().Info("WatchStatus started")
+
+	send := func(change, trackName string, attributes map[string]string) error {
+		return stream.Send(&pb.BridgeStatusUpdate{
+			Change:      change,
+			TrackName:   trackName,
+			Attributes:  attributes,
+			TimestampMs: time.Now().UnixMilli(),
+			Status:      s.bridgeStatus(req.UserId),
+		})
+	}
+
+	if err := send("snapshot", "", nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				session.log().Info("WatchStatus ended: session closed")
+				return send("session_closed", "", nil)
+			}
+			if !isStatusEvent(event.Type) {
+				continue
+			}
+			if err := send(event.Type, event.TrackName, event.Attributes); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			session.log().Info("WatchStatus closed by client")
+			return nil
+		}
+	}
+}
+
+// StreamEvents pushes a session's events to the cloud until the client goes
+// away or the session closes
+func (s *LiveKitBridgeService) StreamEvents(req *pb.StreamEventsRequest, stream pb.LiveKitBridge_StreamEventsServer) error {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	id, events := session.events.subscribe()
+	defer session.events.unsubscribe(id)
+
+	session.log().Info("StreamEvents started")
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				session.log().Info("StreamEvents ended: session closed")
+				return nil
+			}
+			if err := stream.Send(&pb.SessionEvent{
+				Type:        event.Type,
+				UserId:      req.UserId,
+				TrackName:   event.TrackName,
+				TimestampMs: event.Timestamp.UnixMilli(),
+				Attributes:  event.Attributes,
+				Audio:       event.Audio,
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			session.log().Info("StreamEvents closed by client")
+			return nil
+		}
+	}
+}
+
+// SubscribeAudio streams incoming audio from one remote participant and/or
+// data track, so multi-party rooms can be transcribed per speaker. It runs
+// alongside StreamAudio, which keeps receiving the merged audio.
+func (s *LiveKitBridgeService) SubscribeAudio(req *pb.SubscribeAudioRequest, stream pb.LiveKitBridge_SubscribeAudioServer) error {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	filter := AudioFilter{
+		ParticipantIdentity: req.ParticipantIdentity,
+		TrackName:           req.TrackName,
+	}
+	id, frames := session.audioSubs.subscribe(filter, req.AsrFeed)
+	defer session.audioSubs.unsubscribe(id)
+
+	session.log().Info("SubscribeAudio started", "participant", filter.ParticipantIdentity, "track", filter.TrackName,
+		"asr_feed", req.AsrFeed)
+
+	for {
+		select {
+		case frame, ok := <-frames:
+			if !ok {
+				session.log().Info("SubscribeAudio ended: session closed")
+				return nil
+			}
+			if err := stream.Send(&pb.AudioChunk{
+				PcmData:             frame.PCM,
+				SampleRate:          int32(frame.SampleRate),
+				Channels:            1,
+				TimestampMs:         frame.CapturedAt.UnixMilli(),
+				UserId:              req.UserId,
+				ParticipantIdentity: frame.ParticipantIdentity,
+				TrackName:           frame.TrackName,
+				Sequence:            frame.Sequence,
+				SpeakerId:           frame.SpeakerID,
+				RtpTimestamp:        frame.RTPTimestamp,
+				CaptureTimeMs:       unixMilliOrZero(frame.SenderTime),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			session.log().Info("SubscribeAudio closed by client")
+			return nil
+		}
+	}
+}
+
+// UpdateSubscription adds or removes a rule selecting which remote audio the
+// session takes in, and subscribes or unsubscribes remote audio tracks to
+// match
+func (s *LiveKitBridgeService) UpdateSubscription(
+	ctx context.Context,
+	req *pb.UpdateSubscriptionRequest,
+) (*pb.UpdateSubscriptionResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	rule := AudioFilter{
+		ParticipantIdentity: req.ParticipantIdentity,
+		TrackName:           req.TrackName,
+	}
+	if rule == (AudioFilter{}) {
+		return &pb.UpdateSubscriptionResponse{
+			Success:       false,
+			Error:         "participant_identity or track_name is required",
+			Subscriptions: session.subscriptionRules(),
+		}, nil
+	}
+
+	var changed bool
+	if req.Subscribe {
+		changed = session.subscriptions.add(rule)
+	} else {
+		changed = session.subscriptions.remove(rule)
+	}
+	if changed {
+		session.log().Info("Audio subscription updated", "participant", rule.ParticipantIdentity,
+			"track", rule.TrackName, "subscribe", req.Subscribe)
+		session.applySubscriptions()
+	}
+
+	return &pb.UpdateSubscriptionResponse{
+		Success:       true,
+		Subscriptions: session.subscriptionRules(),
+	}, nil
+}
+
+// PublishTranscription publishes transcript segments to the room with
+// LiveKit's transcription protocol, attributed to a participant's track
+func (s *LiveKitBridgeService) PublishTranscription(
+	ctx context.Context,
+	req *pb.PublishTranscriptionRequest,
+) (*pb.PublishTranscriptionResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.PublishTranscriptionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	if len(req.Segments) == 0 {
+		return &pb.PublishTranscriptionResponse{
+			Success: false,
+			Error:   "no segments",
+		}, nil
+	}
+
+	if err := session.publishTranscription(req.ParticipantIdentity, req.TrackSid, req.TrackName, req.Segments); err != nil {
+		session.log().Warn("Failed to publish transcription", "participant", req.ParticipantIdentity,
+			"track", req.TrackName, "error", err)
+		return &pb.PublishTranscriptionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.PublishTranscriptionResponse{
+		Success: true,
+	}, nil
+}
+
+// SetLogLevel changes the minimum level logged, taking effect immediately
+func (s *LiveKitBridgeService) SetLogLevel(
+	ctx context.Context,
+	req *pb.SetLogLevelRequest,
+) (*pb.SetLogLevelResponse, error) {
+	level, err := parseLogLevel(req.Level)
+	if err != nil {
+		return &pb.SetLogLevelResponse{
+			Success: false,
+			Error:   fmt.Sprintf("invalid log level %q", req.Level),
+			Level:   logLevel.Level().String(),
+		}, nil
+	}
+
+	previous := logLevel.Level()
+	logLevel.Set(level)
+	slog.Warn("Log level changed", "from", previous.String(), "to", level.String())
+	s.bsLogger.LogInfo("Log level changed", map[string]interface{}{
+		"from": previous.String(),
+		"to":   level.String(),
+	})
+
+	return &pb.SetLogLevelResponse{
+		Success: true,
+		Level:   level.String(),
+	}, nil
+}
+
+// GetSessionResources reports the goroutines, tracks and channels held by
+// each session, including closed sessions that haven't released them all
+func (s *LiveKitBridgeService) GetSessionResources(
+	ctx context.Context,
+	req *pb.SessionResourcesRequest,
+) (*pb.SessionResourcesResponse, error) {
+	resp := &pb.SessionResourcesResponse{}
+	add := func(session *RoomSession, flagged bool) {
+		if req.UserId != "" && session.userId != req.UserId {
+			return
+		}
+		usage := session.resourceUsage()
+		usage.Leaked = flagged
+		if req.LeakedOnly && (usage.ClosedAtMs == 0 || !holdsResources(usage)) {
+			return
+		}
+		resp.Sessions = append(resp.Sessions, usage)
+	}
+
+	closed := s.sessions.closedSessions()
+	s.sessions.Range(func(userId string, session *RoomSession) bool {
+		if _, watched := closed[session]; !watched {
+			add(session, false)
+		}
+		return true
+	})
+	for session, flagged := range closed {
+		add(session, flagged)
+	}
+
+	sort.Slice(resp.Sessions, func(i, j int) bool { return resp.Sessions[i].UserId < resp.Sessions[j].UserId })
+	return resp, nil
+}
+
+// QuerySessionEvents returns stored session history, newest first, for the
+// dashboard and support tooling
+func (s *LiveKitBridgeService) QuerySessionEvents(
+	ctx context.Context,
+	req *pb.QuerySessionEventsRequest,
+) (*pb.QuerySessionEventsResponse, error) {
+	query := eventstore.Query{
+		UserID: req.UserId,
+		Types:  req.Types,
+		Limit:  int(req.Limit),
+	}
+	if req.SinceMs > 0 {
+		query.Since = time.UnixMilli(req.SinceMs)
+	}
+	if req.UntilMs > 0 {
+		query.Until = time.UnixMilli(req.UntilMs)
+	}
 
+	events, err := s.eventStore.Query(ctx, query)
+	if err != nil {
+		return &pb.QuerySessionEventsResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	resp := &pb.QuerySessionEventsResponse{Success: true}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.SessionEvent{
+			Type:        event.Type,
+			UserId:      event.UserID,
+			TrackName:   event.TrackName,
+			TimestampMs: event.Time.UnixMilli(),
+			Attributes:  event.Attributes,
+		})
+	}
 	return resp, nil
 }
+
+// GetUsage returns seconds of audio published and received per user and
+// app, for billing and quotas
+func (s *LiveKitBridgeService) GetUsage(
+	ctx context.Context,
+	req *pb.GetUsageRequest,
+) (*pb.GetUsageResponse, error) {
+	query := usage.Query{
+		UserID: req.UserId,
+		AppID:  req.AppId,
+	}
+	if req.SinceMs > 0 {
+		query.Since = time.UnixMilli(req.SinceMs)
+	}
+	if req.UntilMs > 0 {
+		query.Until = time.UnixMilli(req.UntilMs)
+	}
+
+	totals, err := s.usage.Query(ctx, query)
+	if err != nil {
+		return &pb.GetUsageResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	resp := &pb.GetUsageResponse{Success: true}
+	for _, total := range totals {
+		resp.Usage = append(resp.Usage, &pb.AppUsage{
+			UserId:           total.UserID,
+			AppId:            total.AppID,
+			PublishedSeconds: total.Published.Seconds(),
+			ReceivedSeconds:  total.Received.Seconds(),
+		})
+	}
+	sort.Slice(resp.Usage, func(i, j int) bool {
+		if resp.Usage[i].UserId != resp.Usage[j].UserId {
+			return resp.Usage[i].UserId < resp.Usage[j].UserId
+		}
+		return resp.Usage[i].AppId < resp.Usage[j].AppId
+	})
+	return resp, nil
+}
+
+// LocateSession reports which bridge replica owns a user's session, so the
+// cloud can send the user's RPCs there
+func (s *LiveKitBridgeService) LocateSession(
+	ctx context.Context,
+	req *pb.LocateSessionRequest,
+) (*pb.LocateSessionResponse, error) {
+	entry, err := s.sessions.registry.Lookup(ctx, req.UserId)
+	if errors.Is(err, registry.ErrNotFound) {
+		return &pb.LocateSessionResponse{Success: true, Found: false}, nil
+	}
+	if err != nil {
+		return &pb.LocateSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.LocateSessionResponse{
+		Success:         true,
+		Found:           true,
+		InstanceId:      entry.Instance,
+		Address:         entry.Address,
+		Local:           entry.Instance == s.sessions.registry.InstanceID(),
+		RoomName:        entry.RoomName,
+		ConnectionState: entry.State,
+		UpdatedAtMs:     entry.UpdatedAt.UnixMilli(),
+	}, nil
+}
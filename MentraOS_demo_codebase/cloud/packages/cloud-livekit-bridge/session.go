@@ -3,31 +3,149 @@ package main
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"math"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/asr"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/eventstore"
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/tracing"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/usage"
 	lksdk "github.com/livekit/server-sdk-go/v2"
 	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+	"github.com/pion/webrtc/v4"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// defaultSampleRate is the rate of published tracks and received audio for
+// sessions that don't pick their own
+const defaultSampleRate = 16000
+
+// maxTrackChannels is the largest channel count a PCM track can publish
+const maxTrackChannels = 2
+
+// InterruptMode selects how stopPlayback silences tracks
+type InterruptMode string
+
+const (
+	// InterruptUnpublish unpublishes and closes tracks; they are recreated
+	// (and renegotiated) on the next playback
+	InterruptUnpublish InterruptMode = "unpublish"
+	// InterruptFlush keeps tracks published and only empties their queues
+	InterruptFlush InterruptMode = "flush"
+)
+
+// parseInterruptMode maps a config string to an InterruptMode (default unpublish)
+func parseInterruptMode(mode string) InterruptMode {
+	if strings.EqualFold(strings.TrimSpace(mode), string(InterruptFlush)) {
+		return InterruptFlush
+	}
+	return InterruptUnpublish
+}
+
 // RoomSession manages a single user's LiveKit room connection
 type RoomSession struct {
-	userId           string
-	room             *lksdk.Room
-	publishTrack     *lkmedia.PCMLocalTrack // Deprecated: use tracks map
-	tracks           map[string]*lkmedia.PCMLocalTrack
-	publications     map[string]*lksdk.LocalTrackPublication // Track publications for unpublishing
-	audioFromLiveKit chan []byte
-	ctx              context.Context
-	cancel           context.CancelFunc
-	closeOnce        sync.Once
-	playbackCancel   context.CancelFunc
-	playbackDone     chan struct{} // Signals when playback actually stops
-	mu               sync.RWMutex
-
-	// Connectivity state (tracked for status RPC)
+	userId             string
+	roomName           string                 // LiveKit room joined; set by JoinRoom before the session is stored
+	joinRequest        *pb.JoinRoomRequest    // What the session joined with, kept for handoff
+	snapshotMu         sync.Mutex             // Orders snapshot saves against the close that deletes it
+	room               *lksdk.Room            // written under both mu and connMu; either is enough to read it
+	publishTrack       *lkmedia.PCMLocalTrack // Deprecated: use tracks map
+	tracks             map[string]*lkmedia.PCMLocalTrack
+	publications       map[string]*lksdk.LocalTrackPublication // Track publications for unpublishing
+	trackStates        map[string]*trackState                  // Per-track processing state (resampler, ...)
+	opusTracks         map[string]*opusTrack                   // Opus passthrough tracks (pre-encoded audio)
+	bus                *trackBus                               // Publishes the PCM tracks mixed into one (nil = a track each)
+	trackGains         map[string]float64                      // Per-track volume, kept across track recreation
+	trackAGC           map[string]bool                         // Per-track AGC overrides of agcDefault
+	trackPans          map[string]trackPan                     // Per-track pan position, kept across track recreation
+	trackVoices        map[string]voiceEffect                  // Per-track voice effect, kept across track recreation
+	trackRates         map[string]float64                      // Per-track playback rate, kept across track recreation
+	audioQueues        map[string]*audioQueue                  // EnqueueAudio clips waiting per track
+	playbacks          map[string]*activePlayback              // Seekable file playback per track (SeekTrack)
+	running            map[*runningPlayback]struct{}           // PlayAudio/EnqueueAudio calls writing to tracks
+	finishGenerations  map[string]int64                        // bumped per track by finishPlayback; loops stop repeating when it changes
+	resampleMode       ResampleMode
+	sampleRate         int           // Native rate of published tracks and received audio
+	negotiationTimeout time.Duration // Max wait for a new track's SDP negotiation before writing
+	playbackQueue      time.Duration // Audio buffered per track before writers block
+	writeTimeout       time.Duration // How long a streamed write may wait on a full queue (0 = no limit)
+	stopFade           time.Duration // Fade-out applied when playback is stopped (0 = cut)
+	keepalive          KeepaliveMode // Fed to PCM tracks on underrun
+	keepaliveFor       time.Duration // How long after the last audio (0 = always)
+	catchUpRate        float64       // Speed PCM tracks catch up on backlogs at (1 or less = off)
+	catchUpThreshold   time.Duration // Smallest backlog worth catching up on
+	interruptMode      InterruptMode // How stopPlayback silences tracks
+	agcDefault         bool          // Whether new tracks get automatic gain control
+	agcTargetDb        float64
+	agcMaxGainDb       float64
+	limiterSettings    LimiterSettings
+	clipSettings       ClipSettings
+	ducker             *ducker                          // Lowers background tracks during speech (nil = disabled)
+	priorities         *trackPriorities                 // Ducks or stops tracks outranked by what is playing
+	quota              *sessionQuota                    // Track count and bandwidth limits
+	bargeIn            *bargeInDetector                 // Detects the user talking over TTS (nil = disabled)
+	events             *eventBus                        // Session events pushed to StreamEvents subscribers
+	eventStore         *eventstore.Store                // Session history in MongoDB (nil or disabled = not kept)
+	usage              *usage.Meter                     // Seconds of audio published and received, for billing (nil or disabled = not metered)
+	recording          atomic.Pointer[sessionRecording] // Audio being recorded to files (nil = not recording)
+	audioRings         *audioRings                      // Last few seconds of audio per source, for DumpAudio
+	asr                *asr.Stream                      // Mic audio forwarded to the speech recognizer (nil = off)
+	wakeWords          *wakeWordDetector                // Keywords listened for in mic audio (nil = off)
+	wakeMu             sync.Mutex
+	endpointer         *endpointer                   // Splits mic audio into utterances (nil = off)
+	soundEvents        *soundEventDetector           // Sirens, alarms, the user's name... in mic audio (nil = off)
+	diarizers          *diarizerSet                  // Tells apart people sharing a mic (nil = off)
+	cleanMic           *cleanMic                     // Processed copy of mic audio republished to the room (nil = off)
+	mixdown            *mixdown                      // Sum of all remote participants for StreamAudio (nil = off)
+	routing            *audioRouter                  // Routing matrix of inputs to outputs (SetRoutes)
+	loopback           atomic.Pointer[loopback]      // Mic audio echoed back for testing (nil = off)
+	probe              atomic.Pointer[latencyProbe]  // Listening for a ProbeLatency chirp (nil = none running)
+	lastProbe          atomic.Pointer[latencyResult] // Last round-trip latency measured, for GetStatus
+	registry           *registry.Registry            // Cross-replica session ownership in Redis (nil or disabled = single instance)
+	denoisers          map[int]*denoiser             // Noise suppression for incoming mic audio, by sample rate (nil = off)
+	denoiseMu          sync.Mutex
+	incomingVoice      *voiceEffect             // Voice effect on received mic audio (nil = off)
+	voiceShifters      map[string]*voiceShifter // Apply incomingVoice, by sender identity and rate
+	voiceMu            sync.Mutex
+	incomingMeters     map[string]*levelMeter // Levels of received mic audio, by sender identity
+	meterMu            sync.Mutex
+	feedResamplers     map[string]Resampler // Downsample received audio to the 16kHz ASR feed, by sender identity
+	feedMu             sync.Mutex
+	audioFromLiveKit   *incomingBuffer
+	incomingSeq        atomic.Uint64                      // Sequence number of the last received mic frame
+	audioSubs          *audioFanout                       // Per-participant/track copies of incoming audio (SubscribeAudio)
+	subscriptions      audioSubscriptions                 // Which remote audio is taken in (UpdateSubscription)
+	remoteTracks       map[string]*lkmedia.PCMRemoteTrack // Subscribed remote audio being decoded, by track SID
+	shared             atomic.Pointer[sharedRoom]         // Connection shared with other users' sessions (nil = own connection)
+	mirror             atomic.Pointer[roomMirror]         // Second room the tracks are also published in (nil = none)
+	ctx                context.Context
+	cancel             context.CancelFunc
+	closeOnce          sync.Once
+	closedAt           atomic.Int64       // Unix nanos when Close ran (0 = open)
+	onClosed           func(*RoomSession) // set by the session manager to watch for leaks
+	resources          *sessionResources  // goroutines the session started (leak detection)
+	playbackCancel     context.CancelFunc
+	playbackDone       chan struct{}               // Signals when playback actually stops
+	lastActivity       atomic.Int64                // Unix nanos of the last audio or RPC activity (idle eviction)
+	logger             atomic.Pointer[slog.Logger] // tagged with user and room; see log()
+	reconnect          ReconnectSettings
+	dial               func() (*lksdk.Room, error) // joins the room; set by JoinRoom, reused to reconnect
+	reconnecting       bool                        // a reconnect loop is running
+	rtc                *rtcStats                   // RTP loss/jitter/RTT per track (nil = off)
+	mu                 sync.RWMutex                // track state: tracks, players, queues and playback
+
+	// Connectivity state (tracked for status RPC), under its own lock so
+	// status polling never waits behind track work. Lock order: mu, then connMu.
+	connMu               sync.RWMutex
+	connState            ConnectionState
 	connected            bool
 	participantID        string
 	participantCount     int
@@ -35,17 +153,139 @@ type RoomSession struct {
 	lastDisconnectReason string
 }
 
+// trackState holds per-track processing state that lives alongside the PCM track
+type trackState struct {
+	channels  int          // 1 = mono, 2 = interleaved stereo
+	resampler Resampler    // nil when source audio already matches the publish rate
+	agc       *agc         // nil when automatic gain control is off for the track
+	player    *trackPlayer // paces queued frames into the track in real time
+}
+
+// AudioFrame is one chunk of mic audio received from the room, tagged with
+// where it came from
+type AudioFrame struct {
+	PCM                 []byte    // PCM16 LE mono
+	ParticipantIdentity string    // sender of the data packet
+	TrackName           string    // data packet topic ("" when the sender set none)
+	CapturedAt          time.Time // when the bridge received the packet
+	SampleRate          int
+	Sequence            uint64    // per session, counting from 1; gaps mean frames were dropped
+	SpeakerID           int32     // who is talking on the sender's mic, when diarizing (0 = nobody/unknown)
+	RTPTimestamp        uint32    // first sample's RTP timestamp (remote tracks only)
+	SenderTime          time.Time // first sample's capture time on the sender's clock, from RTCP sender reports (zero if unknown)
+	Mixed               bool      // the mixdown of every remote participant rather than one sender's audio
+}
+
+// newAudioFrame stamps received mic audio with the next sequence number and
+// where it sits on its sender's clocks
+func (s *RoomSession) newAudioFrame(pcmData []byte, identity, topic string, stamp captureStamp) AudioFrame {
+	return AudioFrame{
+		PCM:                 pcmData,
+		ParticipantIdentity: identity,
+		TrackName:           topic,
+		CapturedAt:          time.Now(),
+		SampleRate:          s.sampleRate,
+		Sequence:            s.incomingSeq.Add(1),
+		RTPTimestamp:        stamp.RTPTimestamp,
+		SenderTime:          stamp.SenderTime,
+	}
+}
+
+// negotiatedTrack wraps a PCM track so the session learns when pion binds it
+// to the publisher peer connection, which happens once SDP negotiation is done
+type negotiatedTrack struct {
+	*lkmedia.PCMLocalTrack
+	onBind func()
+}
+
+// Bind is called by pion when the track is attached to a negotiated sender
+func (t *negotiatedTrack) Bind(ctx webrtc.TrackLocalContext) (webrtc.RTPCodecParameters, error) {
+	params, err := t.PCMLocalTrack.Bind(ctx)
+	if err == nil && t.onBind != nil {
+		t.onBind()
+	}
+	return params, err
+}
+
 // NewRoomSession creates a new room session
-func NewRoomSession(userId string) *RoomSession {
+func NewRoomSession(userId string, config *Config) *RoomSession {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &RoomSession{
-		userId:           userId,
-		tracks:           make(map[string]*lkmedia.PCMLocalTrack),
-		publications:     make(map[string]*lksdk.LocalTrackPublication),
-		audioFromLiveKit: make(chan []byte, 200), // Increased buffer for bursty audio
-		ctx:              ctx,
-		cancel:           cancel,
+	session := &RoomSession{
+		userId:             userId,
+		tracks:             make(map[string]*lkmedia.PCMLocalTrack),
+		publications:       make(map[string]*lksdk.LocalTrackPublication),
+		trackStates:        make(map[string]*trackState),
+		opusTracks:         make(map[string]*opusTrack),
+		trackGains:         make(map[string]float64),
+		trackAGC:           make(map[string]bool),
+		trackPans:          make(map[string]trackPan),
+		trackVoices:        make(map[string]voiceEffect),
+		trackRates:         make(map[string]float64),
+		audioQueues:        make(map[string]*audioQueue),
+		playbacks:          make(map[string]*activePlayback),
+		running:            make(map[*runningPlayback]struct{}),
+		finishGenerations:  make(map[string]int64),
+		incomingMeters:     make(map[string]*levelMeter),
+		feedResamplers:     make(map[string]Resampler),
+		voiceShifters:      make(map[string]*voiceShifter),
+		remoteTracks:       make(map[string]*lkmedia.PCMRemoteTrack),
+		agcDefault:         config.AGCEnabled,
+		agcTargetDb:        config.AGCTargetDb,
+		agcMaxGainDb:       config.AGCMaxGainDb,
+		limiterSettings:    config.Limiter,
+		clipSettings:       config.Clip,
+		resampleMode:       parseResampleMode(config.ResampleMode),
+		sampleRate:         config.SampleRate,
+		negotiationTimeout: config.TrackNegotiationTimeout,
+		playbackQueue:      config.PlaybackQueueDuration,
+		writeTimeout:       config.WriteTimeout,
+		stopFade:           config.StopFadeDuration,
+		keepalive:          parseKeepaliveMode(config.KeepaliveMode),
+		keepaliveFor:       config.KeepaliveDuration,
+		catchUpRate:        config.CatchUpRate,
+		catchUpThreshold:   config.CatchUpThreshold,
+		interruptMode:      parseInterruptMode(config.InterruptMode),
+		ducker:             newDucker(config),
+		priorities:         newTrackPriorities(config),
+		quota:              newSessionQuota(config),
+		bargeIn:            newBargeInDetector(config),
+		events:             newEventBus(userId),
+		resources:          newSessionResources(),
+		audioRings:         newAudioRings(config.AudioRingDuration),
+		audioSubs:          newAudioFanout(userId),
+		routing:            newAudioRouter(),
+		reconnect:          config.Reconnect,
+		rtc:                newRTCStats(config),
+		connState:          StateDisconnected,
+		audioFromLiveKit:   newIncomingBuffer(config),
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+	if config.NoiseSuppression {
+		session.denoisers = make(map[int]*denoiser)
 	}
+	session.logger.Store(slog.With("user_id", userId))
+	session.touch()
+	return session
+}
+
+// currentRoom returns the joined room (nil while disconnected) without
+// waiting on track state
+func (s *RoomSession) currentRoom() *lksdk.Room {
+	s.connMu.RLock()
+	defer s.connMu.RUnlock()
+
+	return s.room
+}
+
+// touch records activity on the session so it isn't evicted as idle
+func (s *RoomSession) touch() {
+	s.lastActivity.Store(time.Now().UnixNano())
+}
+
+// idleFor returns how long the session has had no activity
+func (s *RoomSession) idleFor() time.Duration {
+	return time.Since(time.Unix(0, s.lastActivity.Load()))
 }
 
 // createPublishTrack creates and publishes an audio track (deprecated, kept for compatibility)
@@ -54,12 +294,19 @@ func (s *RoomSession) createPublishTrack() (*lkmedia.PCMLocalTrack, error) {
 	return s.getOrCreateTrack("speaker")
 }
 
-// getOrCreateTrack gets or creates a named audio track
+// getOrCreateTrack gets or creates a named mono audio track
 func (s *RoomSession) getOrCreateTrack(trackName string) (*lkmedia.PCMLocalTrack, error) {
+	return s.getOrCreateTrackWithChannels(s.ctx, trackName, 1)
+}
+
+// getOrCreateTrackWithChannels gets or creates a named audio track. The channel
+// count only applies when the track is created; existing tracks keep their layout.
+// Publishing is traced as a child of the span in ctx.
+func (s *RoomSession) getOrCreateTrackWithChannels(ctx context.Context, trackName string, channels int) (*lkmedia.PCMLocalTrack, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.room == nil {
+	if s.room == nil && !s.reconnecting {
 		return nil, fmt.Errorf("room not connected")
 	}
 
@@ -73,48 +320,206 @@ func (s *RoomSession) getOrCreateTrack(trackName string) (*lkmedia.PCMLocalTrack
 		return track, nil
 	}
 
-	// Create new PCM track (16kHz, mono)
-	track, err := lkmedia.NewPCMLocalTrack(16000, 1, nil)
+	// A name can only be published once, either as PCM or as Opus
+	if _, exists := s.opusTracks[trackName]; exists {
+		return nil, fmt.Errorf("track '%s' is already published as Opus", trackName)
+	}
+
+	if channels < 1 || channels > maxTrackChannels {
+		return nil, fmt.Errorf("unsupported channel count: %d", channels)
+	}
+	// Panned tracks are published in stereo whatever is played on them
+	if _, panned := s.trackPans[trackName]; panned {
+		channels = 2
+	}
+
+	if err := s.quota.checkTracks(len(s.tracks) + len(s.opusTracks)); err != nil {
+		s.log().Warn("Track limit reached", "track_name", trackName, "error", err)
+		return nil, err
+	}
+
+	if s.bus != nil {
+		return s.createBusTrackLocked(trackName, channels)
+	}
+
+	// Create new PCM track (at the session's rate, interleaved when stereo)
+	track, err := lkmedia.NewPCMLocalTrack(s.sampleRate, channels, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create PCM track: %w", err)
 	}
 
+	if s.room == nil {
+		// Reconnecting: buffer into an unpublished track that restoreRoom
+		// publishes once the room is back
+		ready := make(chan struct{})
+		close(ready)
+		s.tracks[trackName] = track
+		s.trackStates[trackName] = &trackState{
+			channels: channels,
+			player:   s.newPlayerLocked(trackName, track, channels, ready),
+			agc:      s.newAGCLocked(trackName, channels),
+		}
+		s.trackStates[trackName].player.suspendOutput(s.reconnect.Buffer)
+		s.log().Info("Created PCM track while reconnecting", "track_name", trackName, "channels", channels)
+		return track, nil
+	}
+
+	_, span := tracing.Start(ctx, "track.publish",
+		attribute.String("user_id", s.userId),
+		attribute.String("track_name", trackName),
+		attribute.Int("channels", channels))
+	defer span.End()
+
+	onBind, ready := s.negotiationGate(trackName)
+
 	// Publish track to room with specified name
-	publication, err := s.room.LocalParticipant.PublishTrack(track, &lksdk.TrackPublicationOptions{
-		Name: trackName,
+	publication, err := s.room.LocalParticipant.PublishTrack(&negotiatedTrack{PCMLocalTrack: track, onBind: onBind}, &lksdk.TrackPublicationOptions{
+		Name:   s.publishName(trackName),
+		Stereo: channels == 2,
 	})
 	if err != nil {
 		track.Close()
+		tracing.RecordError(span, err)
 		return nil, fmt.Errorf("failed to publish track: %w", err)
 	}
+	span.SetAttributes(attribute.String("track_sid", publication.SID()))
 
 	s.tracks[trackName] = track
 	s.publications[trackName] = publication
+	s.trackStates[trackName] = &trackState{
+		channels: channels,
+		player:   s.newPlayerLocked(trackName, track, channels, ready),
+		agc:      s.newAGCLocked(trackName, channels),
+	}
 
-	// Allow WebRTC negotiation to complete before returning
-	// This prevents audio loss on the first chunk (~100ms for SDP offer/answer)
-	time.Sleep(100 * time.Millisecond)
-
-	log.Printf("Published PCM track '%s' for user %s (WebRTC warmed)", trackName, s.userId)
+	s.log().Info("Published PCM track", "track_name", trackName, "track_sid", publication.SID(), "channels", channels)
+	s.emitEvent(EventTrackPublished, trackName, map[string]string{"track_sid": publication.SID(), "encoding": "pcm"})
 	return track, nil
 }
 
+// newPlayerLocked creates the paced player for a PCM track, applying the
+// track's volume and the current ducking state; caller must hold s.mu
+func (s *RoomSession) newPlayerLocked(trackName string, track *lkmedia.PCMLocalTrack, channels int, ready <-chan struct{}) *trackPlayer {
+	gain, hasGain := s.trackGains[trackName]
+	if !hasGain {
+		gain = 1.0
+	}
+
+	limiter := newLimiter(s.limiterSettings, s.sampleRate, channels)
+	clip := newClipDetector(s.clipSettings, channels)
+	clip.setHandler(func(stats clipStats, sustained time.Duration) { s.onTrackClipping(trackName, stats, sustained) })
+	player := newTrackPlayer(trackName, s.log().With("track_name", trackName), track, s.sampleRate, channels, gain, limiter, clip, s.playbackQueue, ready)
+	player.setActivityHandler(func(active bool) { s.onTrackActivity(trackName, active) })
+	player.setKeepalive(s.keepalive, s.keepaliveFor)
+	player.setCatchUp(s.catchUpRate, s.catchUpThreshold)
+	s.watch("track_player", player.stopped)
+	// Tracks created mid-speech or under a higher-priority track start out ducked
+	player.setDuck(s.duckLevel(trackName), 0)
+	if pan, panned := s.trackPans[trackName]; panned {
+		player.setPan(&pan)
+	}
+	if voice, transformed := s.trackVoices[trackName]; transformed {
+		player.setVoice(&voice)
+	}
+	if rate, changed := s.trackRates[trackName]; changed {
+		player.setRate(rate)
+	}
+	if s.bus != nil {
+		player.setBus(s.bus)
+	}
+	if m := s.mirror.Load(); m != nil {
+		s.mirrorTrackLocked(m, trackName, player, channels)
+	}
+	return player
+}
+
+// negotiationGate returns a bind callback for a newly published track and a
+// channel that closes once the track is bound (SDP offer/answer finished) or
+// the configured negotiation timeout elapses, whichever comes first.
+// Samples written before binding are silently dropped by pion, so writers
+// wait on the channel before their first write.
+func (s *RoomSession) negotiationGate(trackName string) (func(), chan struct{}) {
+	bound := make(chan struct{})
+	ready := make(chan struct{})
+	var bindOnce sync.Once
+	start := time.Now()
+
+	s.spawn("track_negotiation", func() {
+		defer close(ready)
+
+		timer := time.NewTimer(s.negotiationTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-bound:
+			s.log().Debug("Track negotiated", "track_name", trackName, "elapsed", time.Since(start))
+		case <-timer.C:
+			s.log().Warn("Track not negotiated in time, writing anyway",
+				"track_name", trackName, "timeout", s.negotiationTimeout)
+		case <-s.ctx.Done():
+		}
+	})
+
+	return func() { bindOnce.Do(func() { close(bound) }) }, ready
+}
+
+// waitForNegotiation blocks until a track's negotiation gate has opened
+func (s *RoomSession) waitForNegotiation(ready <-chan struct{}) {
+	if ready == nil {
+		return
+	}
+	select {
+	case <-ready:
+	case <-s.ctx.Done():
+	}
+}
+
+// trackPlayer returns the paced player feeding a PCM track (nil if unknown)
+func (s *RoomSession) trackPlayer(trackName string) *trackPlayer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if state, exists := s.trackStates[trackName]; exists {
+		return state.player
+	}
+	return nil
+}
+
+// waitForTrackPlayout blocks until everything queued on a track has played;
+// it returns at once for a queued clip (see deferPlayout)
+func (s *RoomSession) waitForTrackPlayout(ctx context.Context, trackName string) error {
+	if playoutDeferred(ctx) {
+		return nil
+	}
+	player := s.trackPlayer(trackName)
+	if player == nil {
+		return nil
+	}
+	return player.waitForPlayout(ctx)
+}
+
 // writeAudioToLiveKit writes PCM audio data to the LiveKit track
 func (s *RoomSession) writeAudioToLiveKit(pcmData []byte) error {
 	return s.writeAudioToTrack(pcmData, "speaker")
 }
 
-// writeAudioToTrack writes PCM audio data to a specific named track
+// writeAudioToTrack writes 16kHz mono PCM audio data to a specific named track
 func (s *RoomSession) writeAudioToTrack(pcmData []byte, trackName string) error {
-	if trackName == "" {
-		trackName = "speaker"
-	}
+	return s.writeAudioToTrackAt(pcmData, trackName, defaultSampleRate, 1)
+}
 
-	track, err := s.getOrCreateTrack(trackName)
-	if err != nil {
-		return err
-	}
+// ErrBackpressure is returned when a track's queue stays full for the whole
+// write timeout (playout has stalled or the writer is far ahead of it). Part
+// of the audio may already have been queued; the caller should slow down
+// rather than resend it.
+var ErrBackpressure = errors.New("track buffer full")
 
+// writeAudioToTrackAt writes interleaved PCM audio recorded at sampleRate with
+// the given channel count to a named track, resampling to the track's publish
+// rate and converting to the track's channel layout when they differ. A write
+// that can't be queued within the session's write timeout fails with
+// ErrBackpressure.
+func (s *RoomSession) writeAudioToTrackAt(pcmData []byte, trackName string, sampleRate, channels int) error {
 	// Ensure even-length PCM data
 	if len(pcmData)%2 == 1 {
 		pcmData = pcmData[:len(pcmData)-1]
@@ -124,28 +529,370 @@ func (s *RoomSession) writeAudioToTrack(pcmData []byte, trackName string) error
 		return nil
 	}
 
-	// Convert bytes to int16 samples
-	samples := bytesToInt16(pcmData)
+	ctx := s.ctx
+	if s.writeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.writeTimeout)
+		defer cancel()
+	}
+
+	// Queuing copies the samples, so the conversion buffer can be reused
+	samples := pooledSamples(pcmData)
+	defer putSamples(samples)
+
+	err := s.writeSamplesToTrack(ctx, *samples, trackName, sampleRate, channels)
+	if errors.Is(err, context.DeadlineExceeded) && s.ctx.Err() == nil {
+		return fmt.Errorf("%w: track '%s' did not drain within %v", ErrBackpressure, trackName, s.writeTimeout)
+	}
+	return err
+}
+
+// writeSamplesToTrack writes interleaved int16 samples recorded at sampleRate
+// with the given channel count to a named track. Blocks while the track's
+// queue is full, until ctx ends.
+func (s *RoomSession) writeSamplesToTrack(ctx context.Context, samples []int16, trackName string, sampleRate, channels int) error {
+	s.touch()
+
+	if trackName == "" {
+		trackName = "speaker"
+	}
+	if sampleRate <= 0 {
+		sampleRate = defaultSampleRate
+	}
+	if channels <= 0 {
+		channels = 1
+	}
+	if channels > maxTrackChannels {
+		return fmt.Errorf("unsupported channel count: %d", channels)
+	}
+
+	// After a seek, decoded audio before the new position is dropped
+	if skip := seekSkipFrom(ctx); skip != nil {
+		if samples = skip.drop(samples, sampleRate, channels); len(samples) == 0 {
+			return nil
+		}
+	}
+
+	if err := s.quota.spend(len(samples) * 2); err != nil {
+		return err
+	}
+
+	// Scheduled playback: publish the track early so negotiation can't make
+	// the first sample late, then hold it until the start time
+	if at := scheduledStart(ctx); time.Until(at) > 0 {
+		if _, err := s.getOrCreateTrackWithChannels(ctx, trackName, channels); err != nil {
+			return err
+		}
+		if err := waitForScheduledStart(ctx); err != nil {
+			return err
+		}
+	}
+
+	defer func(start time.Time) { writeLatency.Observe(time.Since(start).Seconds()) }(time.Now())
+
+	// Drop any trailing partial frame so channels stay aligned
+	samples = samples[:len(samples)-len(samples)%channels]
+
+	// A looping clip's first pass records its loop region and ends with it
+	var loopErr error
+	if capture := loopCaptureFrom(ctx); capture != nil {
+		samples, loopErr = capture.add(samples, sampleRate, channels)
+		if loopErr != nil && !errors.Is(loopErr, errLoopEnd) {
+			return loopErr
+		}
+	}
+
+	if _, err := s.getOrCreateTrackWithChannels(ctx, trackName, channels); err != nil {
+		return err
+	}
+
+	player := s.trackPlayer(trackName)
+	if player == nil {
+		return fmt.Errorf("track '%s' was closed", trackName)
+	}
+
+	trackChannels := s.trackChannels(trackName)
+	samples = convertChannels(samples, channels, trackChannels)
+
+	samples = s.resampleForTrack(trackName, samples, sampleRate, trackChannels)
+	if len(samples) == 0 {
+		return loopErr
+	}
+	s.applyTrackAGC(trackName, samples)
+
+	// Queue for paced playout in 10ms frames; blocks while the queue is full
+	if err := player.enqueue(ctx, samples); err != nil {
+		return fmt.Errorf("failed to write sample: %w", err)
+	}
+	s.usage.AddPublished(s.userId, trackOwner(trackName),
+		time.Duration(len(samples)/trackChannels)*time.Second/time.Duration(s.sampleRate))
+	s.recordPublished(trackName, samples, trackChannels)
+	s.routeInput(ctx, routeTrack+trackName, samples, s.sampleRate, trackChannels)
+
+	return loopErr
+}
+
+// trackChannels returns the channel count a track was published with (1 if unknown)
+func (s *RoomSession) trackChannels(trackName string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if state, exists := s.trackStates[trackName]; exists && state.channels > 0 {
+		return state.channels
+	}
+	return 1
+}
+
+// resampleForTrack converts samples to the session's rate using the track's
+// streaming resampler, (re)creating it when the source rate changes
+func (s *RoomSession) resampleForTrack(trackName string, samples []int16, sampleRate, channels int) []int16 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, exists := s.trackStates[trackName]
+	if !exists {
+		return samples
+	}
+
+	if sampleRate == s.sampleRate {
+		state.resampler = nil
+		return samples
+	}
+
+	if state.resampler == nil || state.resampler.SourceRate() != sampleRate {
+		state.resampler = NewResampler(s.resampleMode, sampleRate, s.sampleRate, channels)
+		s.log().Info("Resampling track", "track_name", trackName,
+			"from_hz", sampleRate, "to_hz", s.sampleRate, "mode", s.resampleMode)
+	}
+
+	return state.resampler.Process(samples)
+}
 
-	// Write in 10ms chunks (160 samples at 16kHz)
-	sampleRate := 16000
-	frameSamples := sampleRate / 100 // 10ms chunks
+// crossfadeTrack fades out what a track is currently playing over fade and
+// mixes the next audio written to it over that tail, so replacing audio
+// doesn't pop. Only PCM tracks support this; other tracks are left alone.
+func (s *RoomSession) crossfadeTrack(trackName string, fade time.Duration) {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+	if player := s.trackPlayer(trackName); player != nil && fade > 0 {
+		player.fadeOutTail(fade)
+	}
+}
+
+// crossfadePlaybackWait bounds how long crossfadePlayback waits for the
+// interrupted playback to stop writing before fading its tail
+const crossfadePlaybackWait = time.Second
+
+// crossfadePlayback is the crossfading alternative to stopPlayback: it cancels
+// the running playback on matching tracks (all tracks when match is nil) but
+// keeps every PCM track published, fading out what each one has queued so
+// the next audio blends in. Opus tracks are still closed since their packets
+// can't be mixed.
+func (s *RoomSession) crossfadePlayback(match func(string) bool, fade time.Duration) {
+	s.mu.Lock()
+	pending := s.cancelPlaybackLocked(match)
+	if match == nil && s.playbackCancel != nil {
+		s.playbackCancel()
+		s.playbackCancel = nil
+		if s.playbackDone != nil {
+			pending = append(pending, s.playbackDone)
+		}
+	}
+	s.clearAudioQueuesLocked(match)
+	s.mu.Unlock()
 
-	for offset := 0; offset < len(samples); offset += frameSamples {
-		end := offset + frameSamples
-		if end > len(samples) {
-			end = len(samples)
+	// Let the old writers finish so they can't append to the tails being faded
+	if len(pending) > 0 {
+		select {
+		case <-allClosed(pending):
+		case <-time.After(crossfadePlaybackWait):
+			s.log().Warn("Interrupted playback still running, crossfading anyway", "waited", crossfadePlaybackWait)
 		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		frame := samples[offset:end]
-		if err := track.WriteSample(frame); err != nil {
-			return fmt.Errorf("failed to write sample: %w", err)
+	for trackName, state := range s.trackStates {
+		if state.player != nil && matches(match, trackName) {
+			state.player.fadeOutTail(fade)
 		}
 	}
+	for trackName := range s.opusTracks {
+		if !matches(match, trackName) {
+			continue
+		}
+		if publication, exists := s.publications[trackName]; exists && s.room != nil && s.room.LocalParticipant != nil {
+			s.room.LocalParticipant.UnpublishTrack(publication.SID())
+			delete(s.publications, trackName)
+			s.emitEvent(EventTrackUnpublished, trackName, map[string]string{"track_sid": publication.SID()})
+		}
+		s.closeOpusTrackLocked(trackName)
+	}
+}
+
+// PauseTrack holds a track's queued audio without discarding it, so long-form
+// playback (audiobooks, podcasts) can later resume from the same position.
+// Writers keep filling the queue until it is full, then block until resume.
+func (s *RoomSession) PauseTrack(trackName string) error {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	player := s.trackPlayer(trackName)
+	if player == nil {
+		return fmt.Errorf("track '%s' not found", trackName)
+	}
 
+	player.setPaused(true)
+	s.log().Info("Paused track", "track_name", trackName, "queued_frames", player.queuedFrames())
 	return nil
 }
 
+// ResumeTrack continues playback of a paused track from where it stopped
+func (s *RoomSession) ResumeTrack(trackName string) error {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	player := s.trackPlayer(trackName)
+	if player == nil {
+		return fmt.Errorf("track '%s' not found", trackName)
+	}
+
+	player.setPaused(false)
+	s.log().Info("Resumed track", "track_name", trackName)
+	return nil
+}
+
+// SetTrackVolume sets a linear gain for a named track (1.0 = unchanged).
+// Loud results are soft clipped rather than wrapped or hard clipped. The
+// setting applies to audio already queued and survives the track being
+// recreated by later playback. Opus passthrough tracks are not affected,
+// since their audio is never decoded.
+func (s *RoomSession) SetTrackVolume(trackName string, gain float64) error {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+	if math.IsNaN(gain) || gain < 0 {
+		return fmt.Errorf("invalid gain: %v", gain)
+	}
+	if gain > maxTrackGain {
+		gain = maxTrackGain
+	}
+
+	s.mu.Lock()
+	s.trackGains[trackName] = gain
+	state, exists := s.trackStates[trackName]
+	s.mu.Unlock()
+
+	if exists && state.player != nil {
+		state.player.setGain(gain)
+	}
+
+	s.log().Info("Set track volume", "track_name", trackName, "gain", gain)
+	return nil
+}
+
+// newAGCLocked returns an AGC stage for a track if AGC is enabled for it;
+// caller must hold s.mu
+func (s *RoomSession) newAGCLocked(trackName string, channels int) *agc {
+	enabled, overridden := s.trackAGC[trackName]
+	if !overridden {
+		enabled = s.agcDefault
+	}
+	if !enabled {
+		return nil
+	}
+	return newAGC(s.agcTargetDb, s.agcMaxGainDb, s.sampleRate, channels)
+}
+
+// SetTrackAGC turns automatic gain control on or off for a named track. The
+// setting survives the track being recreated by later playback.
+func (s *RoomSession) SetTrackAGC(trackName string, enabled bool) {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trackAGC[trackName] = enabled
+	if state, exists := s.trackStates[trackName]; exists {
+		switch {
+		case !enabled:
+			state.agc = nil
+		case state.agc == nil:
+			state.agc = newAGC(s.agcTargetDb, s.agcMaxGainDb, s.sampleRate, state.channels)
+		}
+	}
+
+	s.log().Info("Set track AGC", "track_name", trackName, "enabled", enabled)
+}
+
+// applyTrackAGC runs a track's AGC stage over samples in place
+func (s *RoomSession) applyTrackAGC(trackName string, samples []int16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, exists := s.trackStates[trackName]; exists && state.agc != nil {
+		state.agc.process(samples)
+	}
+}
+
+// MuteTrack silences a track through LiveKit's publication mute. Unlike
+// closeTrack it keeps the track published, so its SID stays stable and no
+// renegotiation happens; playback continues silently underneath.
+func (s *RoomSession) MuteTrack(trackName string) error {
+	return s.setTrackMuted(trackName, true)
+}
+
+// UnmuteTrack makes a muted track audible again
+func (s *RoomSession) UnmuteTrack(trackName string) error {
+	return s.setTrackMuted(trackName, false)
+}
+
+// setTrackMuted mutes or unmutes a track's publication and its player
+func (s *RoomSession) setTrackMuted(trackName string, muted bool) error {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	s.mu.RLock()
+	publication := s.publications[trackName]
+	var player *trackPlayer
+	if state, exists := s.trackStates[trackName]; exists {
+		player = state.player
+	}
+	s.mu.RUnlock()
+
+	if publication == nil {
+		return fmt.Errorf("track '%s' not found", trackName)
+	}
+
+	// The publication only signals subscribers (and mutes Opus sample
+	// tracks); PCM tracks are silenced by their player
+	publication.SetMuted(muted)
+	if player != nil {
+		player.setMuted(muted)
+	}
+
+	s.log().Info("Set track mute", "track_name", trackName, "track_sid", publication.SID(), "muted", muted)
+	return nil
+}
+
+// releaseTrackStateLocked stops a track's player and forgets its processing
+// state; caller must hold s.mu
+func (s *RoomSession) releaseTrackStateLocked(trackName string) {
+	if state, exists := s.trackStates[trackName]; exists {
+		if state.player != nil {
+			state.player.close()
+		}
+		delete(s.trackStates, trackName)
+	}
+}
+
 // closeTrack closes and unpublishes a specific track
 func (s *RoomSession) closeTrack(trackName string) {
 	s.mu.Lock()
@@ -155,7 +902,8 @@ func (s *RoomSession) closeTrack(trackName string) {
 	if publication, exists := s.publications[trackName]; exists {
 		if s.room != nil && s.room.LocalParticipant != nil {
 			s.room.LocalParticipant.UnpublishTrack(publication.SID())
-			log.Printf("Unpublished track '%s' (SID: %s) for user %s", trackName, publication.SID(), s.userId)
+			s.log().Info("Unpublished track", "track_name", trackName, "track_sid", publication.SID())
+			s.emitEvent(EventTrackUnpublished, trackName, map[string]string{"track_sid": publication.SID()})
 		}
 		delete(s.publications, trackName)
 	}
@@ -164,83 +912,282 @@ func (s *RoomSession) closeTrack(trackName string) {
 	if track, exists := s.tracks[trackName]; exists {
 		track.Close()
 		delete(s.tracks, trackName)
-		log.Printf("Closed track '%s' for user %s", trackName, s.userId)
+		s.log().Info("Closed track", "track_name", trackName)
 	}
+	s.releaseTrackStateLocked(trackName)
+	s.closeOpusTrackLocked(trackName)
 }
 
-// stopPlayback cancels any ongoing audio playback and unpublishes all tracks to immediately stop audio
-// Returns a channel that closes when the old playback has actually stopped
-func (s *RoomSession) stopPlayback() <-chan struct{} {
-	s.mu.Lock()
+// detachedTrack is a PCM track removed from the session maps but not yet
+// torn down, so its faded-out tail can finish playing
+type detachedTrack struct {
+	name        string
+	publication *lksdk.LocalTrackPublication
+	track       *lkmedia.PCMLocalTrack
+	player      *trackPlayer
+}
 
-	// Unpublish all tracks immediately to stop audio output
-	// This ensures the currently playing audio is cut off right away
-	if s.room != nil && s.room.LocalParticipant != nil {
-		for trackName, publication := range s.publications {
+// detachTrackLocked removes a track from the session so new playback gets a
+// fresh one, fading out what it still has queued over fade. Opus tracks
+// can't be faded and are closed right away. Caller must hold s.mu.
+func (s *RoomSession) detachTrackLocked(trackName string, fade time.Duration) *detachedTrack {
+	if _, isOpus := s.opusTracks[trackName]; isOpus {
+		if publication, exists := s.publications[trackName]; exists && s.room != nil && s.room.LocalParticipant != nil {
 			s.room.LocalParticipant.UnpublishTrack(publication.SID())
-			log.Printf("Unpublished track '%s' (SID: %s) to interrupt audio for user %s", trackName, publication.SID(), s.userId)
+			s.emitEvent(EventTrackUnpublished, trackName, map[string]string{"track_sid": publication.SID()})
 		}
-		// Clear publications map - tracks will be recreated on next playback
-		s.publications = make(map[string]*lksdk.LocalTrackPublication)
+		delete(s.publications, trackName)
+		s.closeOpusTrackLocked(trackName)
+		return nil
 	}
 
-	// Close all tracks to clean up resources
-	for trackName, track := range s.tracks {
-		track.Close()
-		log.Printf("Closed track '%s' to interrupt audio for user %s", trackName, s.userId)
+	detached := &detachedTrack{name: trackName, publication: s.publications[trackName], track: s.tracks[trackName]}
+	if state, exists := s.trackStates[trackName]; exists {
+		detached.player = state.player
+		delete(s.trackStates, trackName)
 	}
-	// Clear tracks map - tracks will be recreated on next playback
-	s.tracks = make(map[string]*lkmedia.PCMLocalTrack)
+	delete(s.publications, trackName)
+	delete(s.tracks, trackName)
 
-	// If no playback is running, return closed channel immediately
-	if s.playbackCancel == nil {
-		s.mu.Unlock()
-		done := make(chan struct{})
+	if detached.publication == nil && detached.track == nil && detached.player == nil {
+		return nil
+	}
+	if detached.player != nil {
+		detached.player.fadeOut(fade)
+	}
+	return detached
+}
+
+// teardownDetachedLocked unpublishes and closes detached tracks once their
+// fade-out has played, or right away when fade is 0. The returned channel
+// closes when every track has been torn down. Caller must hold s.mu.
+func (s *RoomSession) teardownDetachedLocked(detached []*detachedTrack, fade time.Duration, reason string) <-chan struct{} {
+	done := make(chan struct{})
+
+	if fade <= 0 || len(detached) == 0 {
+		s.closeDetachedLocked(detached, reason)
 		close(done)
 		return done
 	}
 
-	// Cancel the current playback
-	s.playbackCancel()
-	s.playbackCancel = nil
+	s.spawn("track_fade_out", func() {
+		defer close(done)
 
-	// Return the done channel so caller can wait for completion
-	done := s.playbackDone
-	s.mu.Unlock()
+		// Bounded by the fade plus the SDK lead; cut short if the session closes
+		ctx, cancel := context.WithTimeout(s.ctx, fade+playbackLeadFrames*playbackFrameDuration+100*time.Millisecond)
+		defer cancel()
+		for _, d := range detached {
+			if d.player != nil {
+				d.player.waitForPlayout(ctx)
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closeDetachedLocked(detached, reason)
+	})
 
 	return done
 }
 
+// closeDetachedLocked unpublishes and closes detached tracks; caller must hold s.mu
+func (s *RoomSession) closeDetachedLocked(detached []*detachedTrack, reason string) {
+	for _, d := range detached {
+		if d.publication != nil && s.room != nil && s.room.LocalParticipant != nil {
+			s.room.LocalParticipant.UnpublishTrack(d.publication.SID())
+			s.log().Info("Unpublished track", "track_name", d.name, "track_sid", d.publication.SID(), "reason", reason)
+			s.emitEvent(EventTrackUnpublished, d.name, map[string]string{"track_sid": d.publication.SID(), "reason": reason})
+		}
+		if d.player != nil {
+			d.player.close()
+		}
+		if d.track != nil {
+			d.track.Close()
+			s.log().Info("Closed track", "track_name", d.name, "reason", reason)
+		}
+	}
+}
+
+// flushTrackLocked interrupts a PCM track without unpublishing it: the
+// current player fades out over fade (or drops) what it has queued and hands
+// the track to a fresh player once that has played, so the publication SID
+// stays the same and no renegotiation is needed. LiveKit sends silence while
+// the track has nothing queued. Returns a channel that closes when the fade
+// is done. Caller must hold s.mu.
+func (s *RoomSession) flushTrackLocked(trackName string, fade time.Duration) <-chan struct{} {
+	state, exists := s.trackStates[trackName]
+	track := s.tracks[trackName]
+	ready := make(chan struct{})
+	if !exists || track == nil {
+		close(ready)
+		return ready
+	}
+
+	old := state.player
+	switch {
+	case old == nil:
+		close(ready)
+	case fade <= 0:
+		old.close()
+		old.flush()
+		close(ready)
+	default:
+		old.fadeOut(fade)
+		s.spawn("track_crossfade", func() {
+			defer close(ready)
+			ctx, cancel := context.WithTimeout(s.ctx, fade+playbackLeadFrames*playbackFrameDuration+100*time.Millisecond)
+			defer cancel()
+			old.waitForPlayout(ctx)
+			old.close()
+		})
+	}
+
+	// The new player starts writing only after the old one has finished;
+	// the publication is unchanged, so it inherits the mute state
+	state.player = s.newPlayerLocked(trackName, track, state.channels, ready)
+	if old != nil && old.isMuted() {
+		state.player.setMuted(true)
+	}
+	if s.reconnecting {
+		// Hold output until the track is republished on the new room
+		state.player.suspendOutput(s.reconnect.Buffer)
+	}
+	state.resampler = nil
+
+	s.log().Info("Flushed track to interrupt audio", "track_name", trackName)
+	return ready
+}
+
+// stopPlayback cancels any ongoing audio playback and stops all tracks,
+// fading them out first when a stop fade is configured. In the default
+// unpublish mode tracks are removed from the session immediately so new
+// playback gets fresh ones; in flush mode PCM tracks stay published and are
+// only emptied.
+// Returns a channel that closes when the old playback has actually stopped
+// and the fade-out has finished
+func (s *RoomSession) stopPlayback() <-chan struct{} {
+	return s.stopPlaybackFade(s.stopFade)
+}
+
+// stopPlaybackFade is stopPlayback with an explicit fade-out (0 = hard cut)
+func (s *RoomSession) stopPlaybackFade(fade time.Duration) <-chan struct{} {
+	return s.stopMatchingPlayback(nil, fade)
+}
+
+// stopMatchingPlayback stops the tracks whose names match (all tracks when
+// match is nil) the way stopPlayback does, cancelling only the playback and
+// queued clips on those tracks
+func (s *RoomSession) stopMatchingPlayback(match func(string) bool, fade time.Duration) <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make(map[string]bool)
+	for trackName := range s.publications {
+		names[trackName] = matches(match, trackName)
+	}
+	for trackName := range s.tracks {
+		names[trackName] = matches(match, trackName)
+	}
+	for trackName := range s.opusTracks {
+		names[trackName] = matches(match, trackName)
+	}
+
+	var pending []<-chan struct{}
+	var detached []*detachedTrack
+	for trackName, matched := range names {
+		if !matched {
+			continue
+		}
+		if _, isPCM := s.tracks[trackName]; isPCM && s.interruptMode == InterruptFlush {
+			pending = append(pending, s.flushTrackLocked(trackName, fade))
+			continue
+		}
+		if d := s.detachTrackLocked(trackName, fade); d != nil {
+			detached = append(detached, d)
+		}
+	}
+	pending = append(pending, s.teardownDetachedLocked(detached, fade, "to interrupt audio"))
+
+	// Cancel the current playback, if any, and anything queued behind it
+	pending = append(pending, s.cancelPlaybackLocked(match)...)
+	if match == nil && s.playbackCancel != nil {
+		s.playbackCancel()
+		s.playbackCancel = nil
+		if s.playbackDone != nil {
+			pending = append(pending, s.playbackDone)
+		}
+	}
+	s.clearAudioQueuesLocked(match)
+
+	// Return a channel that closes once playback and fades have all completed
+	return allClosed(pending)
+}
+
 // stopTrackPlayback stops playback on a specific track only (for audio mixing)
 // This allows other tracks to continue playing
 func (s *RoomSession) stopTrackPlayback(trackName string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Unpublish this specific track immediately to stop its audio output
-	if s.room != nil && s.room.LocalParticipant != nil {
-		if publication, exists := s.publications[trackName]; exists {
-			s.room.LocalParticipant.UnpublishTrack(publication.SID())
-			log.Printf("Unpublished track '%s' (SID: %s) for mixing mode, user %s", trackName, publication.SID(), s.userId)
-			delete(s.publications, trackName)
-		}
+	if queue, exists := s.audioQueues[trackName]; exists {
+		queue.clear()
 	}
 
-	// Close this specific track to clean up resources
-	if track, exists := s.tracks[trackName]; exists {
-		track.Close()
-		log.Printf("Closed track '%s' for mixing mode, user %s", trackName, s.userId)
-		delete(s.tracks, trackName)
+	if _, isPCM := s.tracks[trackName]; isPCM && s.interruptMode == InterruptFlush {
+		s.flushTrackLocked(trackName, s.stopFade)
+		return
+	}
+
+	if d := s.detachTrackLocked(trackName, s.stopFade); d != nil {
+		s.teardownDetachedLocked([]*detachedTrack{d}, s.stopFade, "for mixing mode")
+	}
+}
+
+// finishPlayback is the gentle stop: whatever each matching track (all
+// tracks when match is nil) is playing now finishes, but queued clips are
+// dropped and looping clips stop repeating, so nothing new starts afterwards
+func (s *RoomSession) finishPlayback(match func(string) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clearAudioQueuesLocked(match)
+	for playback := range s.running {
+		if matches(match, playback.trackName) {
+			s.finishGenerations[playback.trackName]++
+		}
 	}
 }
 
 // Close cleans up all resources
 func (s *RoomSession) Close() {
+	s.CloseWithReason("closed")
+}
+
+// CloseWithReason closes the session, recording why in its history
+func (s *RoomSession) CloseWithReason(reason string) {
 	s.closeOnce.Do(func() {
-		log.Printf("Closing room session for user %s", s.userId)
+		s.log().Info("Closing room session", "reason", reason)
+
+		// A session closed by shutdown keeps a fresh snapshot to be restored
+		// from once a bridge is back; any other close ends it for good
+		s.snapshotMu.Lock()
+		if reason == "shutdown" {
+			s.saveSnapshotLocked()
+		} else {
+			s.registry.DeleteSnapshot(s.userId)
+		}
 
 		// Cancel context (stops all goroutines)
 		s.cancel()
+		s.snapshotMu.Unlock()
+		if s.ducker != nil {
+			s.ducker.stop()
+		}
+		s.priorities.stop()
+		s.stopRecording()
+		s.asr.Close()
+		s.closeWakeWords()
+		s.stopMirror()
 
 		// Stop any playback
 		s.stopPlayback()
@@ -252,17 +1199,24 @@ func (s *RoomSession) Close() {
 		if s.room != nil && s.room.LocalParticipant != nil {
 			for name, publication := range s.publications {
 				s.room.LocalParticipant.UnpublishTrack(publication.SID())
-				log.Printf("Unpublished track '%s' for user %s", name, s.userId)
+				s.log().Info("Unpublished track", "track_name", name, "track_sid", publication.SID())
 			}
 		}
 		s.publications = make(map[string]*lksdk.LocalTrackPublication)
 
 		// Close all tracks
+		s.closeBusLocked()
 		for name, track := range s.tracks {
 			track.Close()
-			log.Printf("Closed track '%s' for user %s", name, s.userId)
+			s.log().Info("Closed track", "track_name", name)
 		}
 		s.tracks = make(map[string]*lkmedia.PCMLocalTrack)
+		for name := range s.trackStates {
+			s.releaseTrackStateLocked(name)
+		}
+		for name := range s.opusTracks {
+			s.closeOpusTrackLocked(name)
+		}
 
 		// Close deprecated single track if still present
 		if s.publishTrack != nil {
@@ -270,41 +1224,135 @@ func (s *RoomSession) Close() {
 			s.publishTrack = nil
 		}
 
-		// Disconnect from room
+		// Stop decoding remote tracks before their audio channel closes
+		s.closeRemoteTracksLocked()
+
+		// Disconnect from room (or leave a shared one to its other users)
 		if s.room != nil {
-			s.room.Disconnect()
-			s.room = nil
+			s.disconnectRoom(s.room)
+		} else if shared := s.shared.Swap(nil); shared != nil {
+			shared.leave(s)
 		}
 
 		// Update connectivity state
+		s.connMu.Lock()
+		s.room = nil
 		s.connected = false
 		s.lastDisconnectAt = time.Now()
-		s.lastDisconnectReason = "closed"
+		s.lastDisconnectReason = reason
+		s.connMu.Unlock()
 
-		// Close audio channel
-		close(s.audioFromLiveKit)
+		// Close audio channels and end event subscriptions
+		s.emitEvent(EventSessionClosed, "", map[string]string{"reason": reason})
+		s.audioFromLiveKit.close()
+		s.audioSubs.close()
+		s.events.close()
 
-		log.Printf("Closed room session for user %s", s.userId)
+		s.log().Info("Closed room session")
+		s.closedAt.Store(time.Now().UnixNano())
+		if s.onClosed != nil {
+			s.onClosed(s)
+		}
 	})
 }
 
+// allClosed returns a channel that closes once every channel in chans has closed
+func allClosed(chans []<-chan struct{}) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, ch := range chans {
+			<-ch
+		}
+	}()
+	return done
+}
+
 // bytesToInt16 converts byte slice to int16 samples (little-endian)
 func bytesToInt16(pcmData []byte) []int16 {
-	if len(pcmData)%2 == 1 {
-		pcmData = pcmData[:len(pcmData)-1]
-	}
+	return bytesToInt16Into(nil, pcmData)
+}
 
-	samples := make([]int16, len(pcmData)/2)
-	for i := 0; i < len(samples); i++ {
+// bytesToInt16Into converts PCM bytes to int16 samples in dst, reusing its
+// capacity (growing it only when too small), and returns the samples
+func bytesToInt16Into(dst []int16, pcmData []byte) []int16 {
+	n := len(pcmData) / 2
+	if cap(dst) < n {
+		dst = make([]int16, n)
+	}
+	samples := dst[:n]
+	for i := range samples {
 		samples[i] = int16(binary.LittleEndian.Uint16(pcmData[i*2:]))
 	}
 
 	return samples
 }
 
+// maxPooledSamples caps the buffers kept in samplePool, so one huge write
+// doesn't pin its memory
+const maxPooledSamples = 64 * 1024
+
+// samplePool reuses sample buffers for conversions whose result isn't kept,
+// which run for every 10ms frame of every session
+var samplePool = sync.Pool{New: func() any { return new([]int16) }}
+
+// pooledSamples converts PCM bytes into a pooled buffer. The samples are
+// only valid until putSamples returns the buffer, so they must not be kept.
+func pooledSamples(pcmData []byte) *[]int16 {
+	buf := samplePool.Get().(*[]int16)
+	*buf = bytesToInt16Into(*buf, pcmData)
+	return buf
+}
+
+// putSamples returns a buffer from pooledSamples to the pool
+func putSamples(buf *[]int16) {
+	if cap(*buf) > maxPooledSamples {
+		return
+	}
+	*buf = (*buf)[:0]
+	samplePool.Put(buf)
+}
+
+// convertChannels converts interleaved samples between mono and stereo layouts.
+// Mono is duplicated into both channels; stereo is averaged down to mono.
+func convertChannels(samples []int16, from, to int) []int16 {
+	if from == to {
+		return samples
+	}
+
+	if from == 1 && to == 2 {
+		out := make([]int16, len(samples)*2)
+		for i, v := range samples {
+			out[i*2] = v
+			out[i*2+1] = v
+		}
+		return out
+	}
+
+	if from == 2 && to == 1 {
+		out := make([]int16, len(samples)/2)
+		for i := range out {
+			out[i] = int16((int32(samples[i*2]) + int32(samples[i*2+1])) / 2)
+		}
+		return out
+	}
+
+	return samples
+}
+
 // int16ToBytes converts int16 samples to byte slice (little-endian)
 func int16ToBytes(samples []int16) []byte {
-	pcmData := make([]byte, len(samples)*2)
+	return int16ToBytesInto(nil, samples)
+}
+
+// int16ToBytesInto converts int16 samples to PCM bytes in dst, reusing its
+// capacity (growing it only when too small), and returns the bytes
+func int16ToBytesInto(dst []byte, samples []int16) []byte {
+	n := len(samples) * 2
+	if cap(dst) < n {
+		dst = make([]byte, n)
+	}
+	pcmData := dst[:n]
 	for i, sample := range samples {
 		binary.LittleEndian.PutUint16(pcmData[i*2:], uint16(sample))
 	}
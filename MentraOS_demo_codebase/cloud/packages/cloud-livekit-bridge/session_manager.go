@@ -0,0 +1,442 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/logger"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+)
+
+// SessionLimitPolicy decides what happens to a JoinRoom when the bridge is
+// already at its session cap
+type SessionLimitPolicy string
+
+const (
+	SessionLimitReject SessionLimitPolicy = "reject" // fail the join immediately
+	SessionLimitQueue  SessionLimitPolicy = "queue"  // wait for a slot, up to a timeout
+)
+
+// parseSessionLimitPolicy maps a config string to a policy (default reject)
+func parseSessionLimitPolicy(policy string) SessionLimitPolicy {
+	if strings.EqualFold(strings.TrimSpace(policy), string(SessionLimitQueue)) {
+		return SessionLimitQueue
+	}
+	return SessionLimitReject
+}
+
+// errSessionLimit is returned when a join can't get a session slot
+var errSessionLimit = fmt.Errorf("session limit reached")
+
+// sessionShardCount is how many shards the session registry is split into,
+// so lookups from every RPC and audio callback don't contend on one lock
+const sessionShardCount = 32
+
+// sessionShard holds the sessions of the users that hash to it
+type sessionShard struct {
+	mu       sync.RWMutex
+	sessions map[string]*RoomSession
+}
+
+// shardFor returns the shard a user's session lives in (FNV-1a of the ID)
+func (m *SessionManager) shardFor(userId string) *sessionShard {
+	hash := uint32(2166136261)
+	for i := 0; i < len(userId); i++ {
+		hash ^= uint32(userId[i])
+		hash *= 16777619
+	}
+	return &m.shards[hash%sessionShardCount]
+}
+
+// SessionManager owns every RoomSession on this bridge: it enforces the
+// concurrent session cap, closes sessions that have gone idle, unpublishes
+// tracks that have gone idle, and flags closed sessions that leak resources
+type SessionManager struct {
+	maxSessions      int // 0 = unlimited
+	policy           SessionLimitPolicy
+	queueTimeout     time.Duration
+	idleTimeout      time.Duration // 0 = never evict
+	trackIdleTimeout time.Duration // 0 = keep idle tracks until the session closes
+	leakGrace        time.Duration // 0 = don't watch closed sessions
+	bsLogger         *logger.BetterStackLogger
+	registry         *registry.Registry // Publishes which sessions this replica owns
+
+	shards [sessionShardCount]sessionShard
+
+	// Slot accounting; a shard's lock is never held while taking mu
+	mu       sync.Mutex
+	active   int           // sessions stored across all shards
+	reserved int           // slots admitted but not yet stored
+	queued   int           // joins waiting for a slot
+	freed    chan struct{} // closed and replaced whenever a slot frees up
+
+	// Closed sessions still being watched for leaks, and whether each has
+	// been flagged
+	closedMu sync.Mutex
+	closed   map[*RoomSession]bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewSessionManager creates a session manager and starts idle eviction
+func NewSessionManager(config *Config, bsLogger *logger.BetterStackLogger, sessionRegistry *registry.Registry) *SessionManager {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &SessionManager{
+		maxSessions:      config.MaxSessions,
+		policy:           parseSessionLimitPolicy(config.SessionLimitPolicy),
+		queueTimeout:     config.SessionQueueTimeout,
+		idleTimeout:      config.SessionIdleTimeout,
+		trackIdleTimeout: config.TrackIdleTimeout,
+		leakGrace:        config.SessionLeakGrace,
+		bsLogger:         bsLogger,
+		registry:         sessionRegistry,
+		freed:            make(chan struct{}),
+		closed:           make(map[*RoomSession]bool),
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+	for i := range m.shards {
+		m.shards[i].sessions = make(map[string]*RoomSession)
+	}
+
+	if m.idleTimeout > 0 {
+		go m.evictIdleLoop()
+	}
+	if m.trackIdleTimeout > 0 {
+		go m.unpublishIdleTracksLoop()
+	}
+	if m.leakGrace > 0 {
+		go m.checkLeaksLoop()
+	}
+	return m
+}
+
+// Load returns the session for a user
+func (m *SessionManager) Load(userId string) (*RoomSession, bool) {
+	shard := m.shardFor(userId)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	session, ok := shard.sessions[userId]
+	return session, ok
+}
+
+// Store adds a session admitted by Admit, replacing any previous one for the user
+func (m *SessionManager) Store(userId string, session *RoomSession) {
+	if m.leakGrace > 0 {
+		session.onClosed = m.watchClosed
+	}
+
+	shard := m.shardFor(userId)
+	shard.mu.Lock()
+	_, replaced := shard.sessions[userId]
+	shard.sessions[userId] = session
+	shard.mu.Unlock()
+
+	session.connMu.RLock()
+	state := session.connState
+	session.connMu.RUnlock()
+	m.registry.Register(userId, session.roomName, string(state))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reserved > 0 {
+		m.reserved--
+	}
+	if replaced {
+		// Replacing frees the old session's slot
+		m.signalFreedLocked()
+	} else {
+		m.active++
+	}
+}
+
+// Delete removes a user's session (without closing it)
+func (m *SessionManager) Delete(userId string) {
+	m.remove(userId, nil)
+}
+
+// Remove removes a session only if it is still the one registered for its
+// user, so cleanup of a replaced session can't drop its successor
+func (m *SessionManager) Remove(session *RoomSession) {
+	m.remove(session.userId, session)
+}
+
+// remove deletes a user's session, when it is expected (or expected is nil),
+// and frees its slot
+func (m *SessionManager) remove(userId string, expected *RoomSession) {
+	shard := m.shardFor(userId)
+	shard.mu.Lock()
+	current, exists := shard.sessions[userId]
+	removed := exists && (expected == nil || current == expected)
+	if removed {
+		delete(shard.sessions, userId)
+	}
+	shard.mu.Unlock()
+
+	if !removed {
+		return
+	}
+	m.registry.Unregister(userId)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active--
+	m.signalFreedLocked()
+}
+
+// Range calls f for each session until it returns false. Shards are
+// snapshotted one at a time, so f never runs under a registry lock.
+func (m *SessionManager) Range(f func(userId string, session *RoomSession) bool) {
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.RLock()
+		snapshot := make(map[string]*RoomSession, len(shard.sessions))
+		for userId, session := range shard.sessions {
+			snapshot[userId] = session
+		}
+		shard.mu.RUnlock()
+
+		for userId, session := range snapshot {
+			if !f(userId, session) {
+				return
+			}
+		}
+	}
+}
+
+// Counts returns active sessions, the cap (0 = unlimited) and queued joins
+func (m *SessionManager) Counts() (active, max, queued int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.active, m.maxSessions, m.queued
+}
+
+// Admit reserves a slot for a new session, applying the limit policy when
+// the bridge is full. Every successful Admit must be followed by Store or
+// Release.
+func (m *SessionManager) Admit(ctx context.Context) error {
+	m.mu.Lock()
+	if m.hasSlotLocked() {
+		m.reserved++
+		m.mu.Unlock()
+		return nil
+	}
+
+	if m.policy != SessionLimitQueue {
+		m.mu.Unlock()
+		return errSessionLimit
+	}
+
+	m.queued++
+	defer func() {
+		m.mu.Lock()
+		m.queued--
+		m.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(m.queueTimeout)
+	defer timer.Stop()
+
+	for {
+		freed := m.freed
+		m.mu.Unlock()
+
+		select {
+		case <-freed:
+		case <-timer.C:
+			return errSessionLimit
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		m.mu.Lock()
+		if m.hasSlotLocked() {
+			m.reserved++
+			m.mu.Unlock()
+			return nil
+		}
+	}
+}
+
+// Release gives back a slot reserved by Admit that was never used
+func (m *SessionManager) Release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reserved > 0 {
+		m.reserved--
+		m.signalFreedLocked()
+	}
+}
+
+// hasSlotLocked reports whether another session fits; caller holds m.mu
+func (m *SessionManager) hasSlotLocked() bool {
+	return m.maxSessions <= 0 || m.active+m.reserved < m.maxSessions
+}
+
+// signalFreedLocked wakes queued joins; caller holds m.mu
+func (m *SessionManager) signalFreedLocked() {
+	close(m.freed)
+	m.freed = make(chan struct{})
+}
+
+// evictIdleLoop periodically closes sessions with no recent activity
+func (m *SessionManager) evictIdleLoop() {
+	m.sweep(m.idleTimeout, m.evictIdle)
+}
+
+// unpublishIdleTracksLoop periodically unpublishes tracks that have had no
+// audio for the track idle timeout
+func (m *SessionManager) unpublishIdleTracksLoop() {
+	m.sweep(m.trackIdleTimeout, func() {
+		var sessions []*RoomSession
+		m.Range(func(userId string, session *RoomSession) bool {
+			sessions = append(sessions, session)
+			return true
+		})
+		for _, session := range sessions {
+			session.unpublishIdleTracks(m.trackIdleTimeout)
+		}
+	})
+}
+
+// sweep runs f every quarter of timeout, but at most once a second and at
+// least once a minute, until the manager is closed
+func (m *SessionManager) sweep(timeout time.Duration, f func()) {
+	interval := timeout / 4
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			f()
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// evictIdle closes every session idle for longer than the idle timeout
+func (m *SessionManager) evictIdle() {
+	var idle []*RoomSession
+	m.Range(func(userId string, session *RoomSession) bool {
+		if session.idleFor() > m.idleTimeout {
+			idle = append(idle, session)
+		}
+		return true
+	})
+
+	for _, session := range idle {
+		idleFor := session.idleFor()
+		session.log().Info("Evicting idle session", "idle", idleFor.Round(time.Second))
+		m.bsLogger.LogInfo("Evicting idle bridge session", map[string]interface{}{
+			"user_id":      session.userId,
+			"idle_seconds": int(idleFor.Seconds()),
+		})
+
+		m.Remove(session)
+		session.CloseWithReason("idle")
+	}
+}
+
+// watchClosed starts watching a session that has just closed, until
+// everything it owned is gone
+func (m *SessionManager) watchClosed(session *RoomSession) {
+	m.closedMu.Lock()
+	defer m.closedMu.Unlock()
+
+	m.closed[session] = false
+}
+
+// checkLeaksLoop periodically checks closed sessions for leaked resources
+func (m *SessionManager) checkLeaksLoop() {
+	m.sweep(m.leakGrace, m.checkLeaks)
+}
+
+// checkLeaks stops watching closed sessions that have released everything,
+// and flags the ones still holding goroutines, tracks or channels after the
+// grace period
+func (m *SessionManager) checkLeaks() {
+	for session, flagged := range m.closedSessions() {
+		usage := session.resourceUsage()
+		if !holdsResources(usage) {
+			if flagged {
+				session.log().Info("Leaked session resources released")
+			}
+			m.closedMu.Lock()
+			delete(m.closed, session)
+			m.closedMu.Unlock()
+			continue
+		}
+
+		closedFor := time.Since(time.UnixMilli(usage.ClosedAtMs))
+		if flagged || closedFor < m.leakGrace {
+			continue
+		}
+
+		sessionLeaks.Inc()
+		session.log().Error("Session holds resources after Close", "closed_for", closedFor.Round(time.Second),
+			"goroutines", usage.Goroutines, "tracks", usage.Tracks, "publications", usage.Publications,
+			"remote_tracks", usage.RemoteTracks, "running_playbacks", usage.RunningPlaybacks,
+			"event_subscribers", usage.EventSubscribers, "audio_subscribers", usage.AudioSubscribers)
+		m.bsLogger.LogError("Session leaked resources", fmt.Errorf("resources held %s after close", closedFor.Round(time.Second)), map[string]interface{}{
+			"user_id":      session.userId,
+			"goroutines":   usage.Goroutines,
+			"tracks":       usage.Tracks,
+			"publications": usage.Publications,
+		})
+		m.closedMu.Lock()
+		if _, watched := m.closed[session]; watched {
+			m.closed[session] = true
+		}
+		m.closedMu.Unlock()
+	}
+}
+
+// closedSessions returns the closed sessions still being watched, with
+// whether each has been flagged as leaking
+func (m *SessionManager) closedSessions() map[*RoomSession]bool {
+	m.closedMu.Lock()
+	defer m.closedMu.Unlock()
+
+	snapshot := make(map[*RoomSession]bool, len(m.closed))
+	for session, flagged := range m.closed {
+		snapshot[session] = flagged
+	}
+	return snapshot
+}
+
+// CloseAll closes and removes every session
+func (m *SessionManager) CloseAll() {
+	var all []*RoomSession
+	m.Range(func(userId string, session *RoomSession) bool {
+		all = append(all, session)
+		return true
+	})
+
+	for _, session := range all {
+		m.Remove(session)
+		session.CloseWithReason("shutdown")
+	}
+}
+
+// Close stops idle eviction; sessions are left to their owners
+func (m *SessionManager) Close() {
+	m.cancel()
+}
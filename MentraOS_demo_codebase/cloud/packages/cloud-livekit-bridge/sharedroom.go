@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+	"github.com/pion/webrtc/v4"
+)
+
+// sharedRooms holds the LiveKit connections that sessions joined with
+// shared_room have in common: one per room, however many of the room's
+// users the bridge serves
+type sharedRooms struct {
+	mu    sync.Mutex
+	rooms map[string]*sharedRoom // by LiveKit URL and room name
+}
+
+// newSharedRooms creates an empty set of shared connections
+func newSharedRooms() *sharedRooms {
+	return &sharedRooms{rooms: make(map[string]*sharedRoom)}
+}
+
+// sharedRoom is one connection serving several users' sessions. Its
+// callbacks are passed on to every member, which filter on their own
+// target identity as they would on a connection of their own; remote
+// tracks are decoded once and routed to the member they belong to.
+type sharedRoom struct {
+	key        string
+	rooms      *sharedRooms
+	owner      *RoomSession // dialled the connection; its interceptors follow the room's RTP clocks
+	sampleRate int
+
+	mu           sync.Mutex
+	room         *lksdk.Room // nil until connected, and once disconnected
+	members      map[*RoomSession]*sharedMember
+	remoteTracks map[string]*lkmedia.PCMRemoteTrack // decoded remote audio, by track SID
+}
+
+// sharedMember is how a session takes part in a shared room
+type sharedMember struct {
+	callback *lksdk.RoomCallback
+	deliver  func(pcmData []byte, identity, trackName string, stamp captureStamp)
+}
+
+// attach joins session to the shared connection for its room, connecting
+// with the session's token when there is none yet. Joins of shared rooms
+// are serialized, so two users joining at once share one connection.
+func (r *sharedRooms) attach(session *RoomSession, url, token string, member *sharedMember, opts ...lksdk.ConnectOption) (*lksdk.Room, error) {
+	key := url + "/" + session.roomName
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if shared, exists := r.rooms[key]; exists {
+		if shared.sampleRate != session.sampleRate {
+			return nil, fmt.Errorf("shared room runs at %d Hz, not %d Hz", shared.sampleRate, session.sampleRate)
+		}
+		shared.mu.Lock()
+		shared.members[session] = member
+		room := shared.room
+		shared.mu.Unlock()
+
+		session.shared.Store(shared)
+		session.log().Info("Joined shared room", "members", shared.memberCount())
+		return room, nil
+	}
+
+	// Members are in place before connecting, so they hear about the
+	// participants and tracks already in the room
+	shared := &sharedRoom{
+		key:          key,
+		rooms:        r,
+		owner:        session,
+		sampleRate:   session.sampleRate,
+		members:      map[*RoomSession]*sharedMember{session: member},
+		remoteTracks: make(map[string]*lkmedia.PCMRemoteTrack),
+	}
+	room, err := lksdk.ConnectToRoomWithToken(url, token, shared.callback(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	shared.mu.Lock()
+	shared.room = room
+	shared.mu.Unlock()
+
+	r.rooms[key] = shared
+	session.shared.Store(shared)
+	session.log().Info("Connected shared room")
+	return room, nil
+}
+
+// leave removes a session from the room, disconnecting it once no member
+// is left
+func (r *sharedRoom) leave(session *RoomSession) {
+	r.rooms.mu.Lock()
+	r.mu.Lock()
+	delete(r.members, session)
+	empty := len(r.members) == 0
+	room := r.room
+	if empty {
+		r.room = nil
+		r.closeRemoteTracksLocked()
+		if r.rooms.rooms[r.key] == r {
+			delete(r.rooms.rooms, r.key)
+		}
+	}
+	r.mu.Unlock()
+	r.rooms.mu.Unlock()
+
+	if empty && room != nil {
+		room.Disconnect()
+	}
+}
+
+// memberCount returns how many sessions share the room
+func (r *sharedRoom) memberCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.members)
+}
+
+// each calls f for every member, outside the room's lock
+func (r *sharedRoom) each(f func(session *RoomSession, member *sharedMember)) {
+	r.mu.Lock()
+	members := make(map[*RoomSession]*sharedMember, len(r.members))
+	for session, member := range r.members {
+		members[session] = member
+	}
+	r.mu.Unlock()
+
+	for session, member := range members {
+		f(session, member)
+	}
+}
+
+// routesTo reports whether audio from identity belongs to a member: its
+// target identity sent it, and the member's rules take it in
+func routesTo(session *RoomSession, identity, trackName string) bool {
+	return session.joinRequest.TargetIdentity == identity && session.subscriptions.wantsTrack(identity, trackName)
+}
+
+// wantsTrack reports whether any member takes in a remote track, for
+// subscribing the shared connection to it
+func (r *sharedRoom) wantsTrack(identity, trackName string) bool {
+	want := false
+	r.each(func(session *RoomSession, _ *sharedMember) {
+		want = want || routesTo(session, identity, trackName)
+	})
+	return want
+}
+
+// disconnected forgets the connection once the SDK reports it gone; members
+// reconnecting dial a new one
+func (r *sharedRoom) disconnected() {
+	r.rooms.mu.Lock()
+	defer r.rooms.mu.Unlock()
+
+	if r.rooms.rooms[r.key] == r {
+		delete(r.rooms.rooms, r.key)
+	}
+	r.mu.Lock()
+	r.room = nil
+	r.closeRemoteTracksLocked()
+	r.mu.Unlock()
+}
+
+// consumeRemoteTrack decodes a subscribed remote audio track once and
+// delivers it to the members it is routed to
+func (r *sharedRoom) consumeRemoteTrack(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+	if track.Kind() != webrtc.RTPCodecTypeAudio {
+		return
+	}
+
+	identity, name := participant.Identity(), publication.Name()
+	clock := r.owner.remoteClock(uint32(track.SSRC()), identity, name, publication.SID())
+	writer := &remoteAudioWriter{deliver: func(pcmData []byte) {
+		stamp := clock.stamp(len(pcmData)/2, r.sampleRate)
+		r.each(func(session *RoomSession, member *sharedMember) {
+			if routesTo(session, identity, name) {
+				member.deliver(pcmData, identity, name, stamp)
+			}
+		})
+	}}
+	remote, err := lkmedia.NewPCMRemoteTrack(track, writer, lkmedia.WithTargetSampleRate(r.sampleRate))
+	if err != nil {
+		r.owner.log().Warn("Failed to decode shared remote audio track", "participant", identity, "track", name, "error", err)
+		return
+	}
+
+	r.mu.Lock()
+	if previous, exists := r.remoteTracks[publication.SID()]; exists {
+		previous.Close()
+	}
+	r.remoteTracks[publication.SID()] = remote
+	r.mu.Unlock()
+}
+
+// releaseRemoteTrack stops decoding a remote track once it is unsubscribed
+func (r *sharedRoom) releaseRemoteTrack(publication *lksdk.RemoteTrackPublication) {
+	r.mu.Lock()
+	remote, exists := r.remoteTracks[publication.SID()]
+	delete(r.remoteTracks, publication.SID())
+	r.mu.Unlock()
+
+	if exists {
+		remote.Close()
+	}
+}
+
+// closeRemoteTracksLocked stops decoding every remote track; r.mu must be held
+func (r *sharedRoom) closeRemoteTracksLocked() {
+	for sid, remote := range r.remoteTracks {
+		remote.Close()
+		delete(r.remoteTracks, sid)
+	}
+}
+
+// callback builds the connection's callbacks, which pass each event on to
+// every member's own
+func (r *sharedRoom) callback() *lksdk.RoomCallback {
+	return &lksdk.RoomCallback{
+		ParticipantCallback: lksdk.ParticipantCallback{
+			OnDataPacket: func(packet lksdk.DataPacket, params lksdk.DataReceiveParams) {
+				r.each(func(_ *RoomSession, member *sharedMember) {
+					member.callback.OnDataPacket(packet, params)
+				})
+			},
+			OnTrackPublished: func(publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				r.each(func(_ *RoomSession, member *sharedMember) {
+					member.callback.OnTrackPublished(publication, participant)
+				})
+			},
+			OnTrackUnpublished: func(publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				r.each(func(_ *RoomSession, member *sharedMember) {
+					member.callback.OnTrackUnpublished(publication, participant)
+				})
+			},
+			OnTrackMuted: func(publication lksdk.TrackPublication, participant lksdk.Participant) {
+				r.each(func(_ *RoomSession, member *sharedMember) {
+					member.callback.OnTrackMuted(publication, participant)
+				})
+			},
+			OnTrackUnmuted: func(publication lksdk.TrackPublication, participant lksdk.Participant) {
+				r.each(func(_ *RoomSession, member *sharedMember) {
+					member.callback.OnTrackUnmuted(publication, participant)
+				})
+			},
+			OnTrackSubscribed: r.consumeRemoteTrack,
+			OnTrackUnsubscribed: func(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+				r.releaseRemoteTrack(publication)
+			},
+		},
+		OnDisconnectedWithReason: func(reason lksdk.DisconnectionReason) {
+			r.disconnected()
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnDisconnectedWithReason(reason)
+			})
+		},
+		OnParticipantConnected: func(participant *lksdk.RemoteParticipant) {
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnParticipantConnected(participant)
+			})
+		},
+		OnParticipantDisconnected: func(participant *lksdk.RemoteParticipant) {
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnParticipantDisconnected(participant)
+			})
+		},
+		OnActiveSpeakersChanged: func(speakers []lksdk.Participant) {
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnActiveSpeakersChanged(speakers)
+			})
+		},
+		OnReconnecting: func() {
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnReconnecting()
+			})
+		},
+		OnReconnected: func() {
+			r.each(func(_ *RoomSession, member *sharedMember) {
+				member.callback.OnReconnected()
+			})
+		},
+	}
+}
+
+// publishName is the name a track is published under: as given, or under
+// the user's ID in a shared room, where several users' tracks meet
+func (s *RoomSession) publishName(trackName string) string {
+	if s.joinRequest == nil || !s.joinRequest.SharedRoom {
+		return trackName
+	}
+	return s.userId + "/" + trackName
+}
+
+// disconnectRoom leaves room as the session closes: disconnecting it, or in
+// a shared room leaving it to the other members
+func (s *RoomSession) disconnectRoom(room *lksdk.Room) {
+	if shared := s.shared.Swap(nil); shared != nil {
+		shared.leave(s)
+		return
+	}
+	room.Disconnect()
+}
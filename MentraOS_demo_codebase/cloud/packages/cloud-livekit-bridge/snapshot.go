@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/registry"
+	"google.golang.org/protobuf/proto"
+)
+
+// snapshot captures what it takes to rebuild the session on this or another
+// replica: the join request, audio subscriptions and, per track, the clip
+// playing (with how much has been heard) followed by the clips queued
+// behind it. Tracks themselves are recreated as playback resumes or audio
+// is written to them.
+func (s *RoomSession) snapshot() *pb.SessionSnapshot {
+	snapshot := &pb.SessionSnapshot{
+		Join:          s.joinRequest,
+		TakenAtMs:     time.Now().UnixMilli(),
+		Subscriptions: s.subscriptionRules(),
+		WakeWords:     s.wakeWordConfig(),
+	}
+
+	s.mu.RLock()
+	playing := make(map[string]*activePlayback, len(s.playbacks))
+	for trackName, playback := range s.playbacks {
+		playing[trackName] = playback
+	}
+	queues := make(map[string]*audioQueue, len(s.audioQueues))
+	for trackName, queue := range s.audioQueues {
+		queues[trackName] = queue
+	}
+	s.mu.RUnlock()
+
+	for trackName, playback := range playing {
+		snapshot.Playback = append(snapshot.Playback, &pb.QueuedPlayback{
+			TrackName:  trackName,
+			Request:    playback.req,
+			PositionMs: playback.position().Milliseconds(),
+		})
+	}
+	for trackName, queue := range queues {
+		current := ""
+		if playback := playing[trackName]; playback != nil {
+			current = playback.requestID
+		}
+		for _, req := range queue.pending(current) {
+			snapshot.Playback = append(snapshot.Playback, &pb.QueuedPlayback{TrackName: trackName, Request: req})
+		}
+	}
+	return snapshot
+}
+
+// saveSnapshot stores the session's snapshot in the registry, so it can be
+// restored if this replica goes away. Closed sessions aren't saved.
+func (s *RoomSession) saveSnapshot() {
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
+
+	s.saveSnapshotLocked()
+}
+
+// saveSnapshotLocked saves the snapshot; s.snapshotMu must be held
+func (s *RoomSession) saveSnapshotLocked() {
+	if !s.registry.Enabled() || s.joinRequest == nil || s.ctx.Err() != nil {
+		return
+	}
+
+	data, err := proto.Marshal(s.snapshot())
+	if err != nil {
+		s.log().Warn("Failed to encode session snapshot", "error", err)
+		return
+	}
+	s.registry.SaveSnapshot(s.userId, data)
+}
+
+// snapshotLoop saves every session's snapshot each interval
+func (s *LiveKitBridgeService) snapshotLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.sessions.Range(func(userId string, session *RoomSession) bool {
+			session.saveSnapshot()
+			return true
+		})
+	}
+}
+
+// restore rebuilds a session from a snapshot: it joins the room as JoinRoom
+// would, reapplies the audio subscriptions and resumes playback. Returns
+// the participant ID and how many clips were resumed.
+func (s *LiveKitBridgeService) restore(ctx context.Context, snapshot *pb.SessionSnapshot) (string, int, error) {
+	if snapshot == nil || snapshot.Join == nil {
+		return "", 0, fmt.Errorf("snapshot with a join request is required")
+	}
+
+	joined, err := s.JoinRoom(ctx, snapshot.Join)
+	if err != nil {
+		return "", 0, err
+	}
+	if !joined.Success {
+		return "", 0, errors.New(joined.Error)
+	}
+
+	session, err := s.getSession(snapshot.Join.UserId)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, rule := range snapshot.Subscriptions {
+		session.subscriptions.add(AudioFilter{
+			ParticipantIdentity: rule.ParticipantIdentity,
+			TrackName:           rule.TrackName,
+		})
+	}
+	if len(snapshot.Subscriptions) > 0 {
+		session.applySubscriptions()
+	}
+	if wake := snapshot.WakeWords; wake != nil {
+		if _, err := session.setWakeWords(s.config, wake.Keywords, float64(wake.Sensitivity)); err != nil {
+			session.log().Warn("Failed to restore wake words", "keywords", wake.Keywords, "error", err)
+		}
+	}
+
+	resumed := s.resumePlayback(session, snapshot)
+	session.log().Info("Restored session", "subscriptions", len(snapshot.Subscriptions), "resumed_playback", resumed,
+		"snapshot_age", time.Since(time.UnixMilli(snapshot.TakenAtMs)).Round(time.Millisecond))
+	session.saveSnapshot()
+	return joined.ParticipantId, resumed, nil
+}
+
+// loadSnapshot reads a user's last saved snapshot from the registry
+func (s *LiveKitBridgeService) loadSnapshot(ctx context.Context, userId string) (*pb.SessionSnapshot, error) {
+	data, err := s.sessions.registry.LoadSnapshot(ctx, userId)
+	if errors.Is(err, registry.ErrNotFound) {
+		return nil, fmt.Errorf("no saved snapshot for user %s", userId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &pb.SessionSnapshot{}
+	if err := proto.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot for user %s: %w", userId, err)
+	}
+	return snapshot, nil
+}
+
+// restoreSessions rebuilds, on startup, the sessions this replica held
+// before it restarted and those left by replicas that died. Each is claimed
+// in the registry first, so replicas starting together share the work.
+func (s *LiveKitBridgeService) restoreSessions() {
+	ctx := context.Background()
+	orphans, err := s.sessions.registry.Orphans(ctx)
+	if err != nil {
+		slog.Warn("Not restoring sessions", "error", err)
+		return
+	}
+	if len(orphans) == 0 {
+		return
+	}
+	slog.Info("Restoring sessions from snapshots", "sessions", len(orphans))
+
+	var (
+		mu       sync.Mutex
+		restored int
+		wg       sync.WaitGroup
+	)
+	slots := make(chan struct{}, handoffConcurrency)
+	for _, userId := range orphans {
+		if !s.sessions.registry.ClaimRestore(ctx, userId, s.config.HandoffTimeout) {
+			continue
+		}
+
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			ctx, cancel := context.WithTimeout(ctx, s.config.HandoffTimeout)
+			defer cancel()
+
+			snapshot, err := s.loadSnapshot(ctx, userId)
+			if err == nil {
+				_, _, err = s.restore(ctx, snapshot)
+			}
+			if err != nil {
+				slog.Warn("Failed to restore session", "user_id", userId, "error", err)
+				return
+			}
+			mu.Lock()
+			restored++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	slog.Info("Restored sessions from snapshots", "restored", restored, "found", len(orphans))
+	s.bsLogger.LogInfo("Restored bridge sessions from snapshots", map[string]interface{}{
+		"restored": restored,
+		"found":    len(orphans),
+	})
+}
+
+// SerializeSession returns a snapshot of a user's session
+func (s *LiveKitBridgeService) SerializeSession(
+	ctx context.Context,
+	req *pb.SerializeSessionRequest,
+) (*pb.SerializeSessionResponse, error) {
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SerializeSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.SerializeSessionResponse{
+		Success:  true,
+		Snapshot: session.snapshot(),
+	}, nil
+}
+
+// RestoreSession rebuilds a user's session from a snapshot, replacing any
+// session the user has here
+func (s *LiveKitBridgeService) RestoreSession(
+	ctx context.Context,
+	req *pb.RestoreSessionRequest,
+) (*pb.RestoreSessionResponse, error) {
+	slog.Info("RestoreSession request", "user_id", req.UserId, "snapshot_given", req.Snapshot != nil)
+
+	snapshot := req.Snapshot
+	if snapshot == nil {
+		var err error
+		if snapshot, err = s.loadSnapshot(ctx, req.UserId); err != nil {
+			return &pb.RestoreSessionResponse{
+				Success: false,
+				Error:   err.Error(),
+			}, nil
+		}
+	}
+	if snapshot.Join != nil && req.UserId != "" && snapshot.Join.UserId != req.UserId {
+		return &pb.RestoreSessionResponse{
+			Success: false,
+			Error:   fmt.Sprintf("snapshot is for user %s", snapshot.Join.UserId),
+		}, nil
+	}
+	if snapshot.Join != nil && req.Token != "" {
+		join := proto.Clone(snapshot.Join).(*pb.JoinRoomRequest)
+		join.Token = req.Token
+		snapshot.Join = join
+	}
+
+	participantID, resumed, err := s.restore(ctx, snapshot)
+	if err != nil {
+		return &pb.RestoreSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.RestoreSessionResponse{
+		Success:         true,
+		ParticipantId:   participantID,
+		ResumedPlayback: int32(resumed),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/sounds"
+)
+
+// SoundNameCalled is the sound event for someone saying the user's name,
+// heard by the speech recognizer or as a wake word rather than classified
+const SoundNameCalled = "name_called"
+
+// soundEventClasses are the AudioSet classes that make up each sound event;
+// any other event name is taken as a class name itself
+var soundEventClasses = map[string][]string{
+	"siren":    {"Siren", "Civil defense siren", "Police car (siren)", "Ambulance (siren)", "Fire engine, fire truck (siren)"},
+	"doorbell": {"Doorbell", "Ding-dong"},
+	"alarm":    {"Alarm", "Alarm clock", "Smoke detector, smoke alarm", "Fire alarm", "Car alarm", "Buzzer"},
+}
+
+// soundEventDetector watches a session's incoming mic audio for sound
+// events, classifying a window of it every hop, and reports each event once
+// until it has gone unheard for the cooldown
+type soundEventDetector struct {
+	classifier *sounds.Classifier
+	events     []string // watched events, except name_called
+	names      []string // lowercased names for name_called (nil = not watched)
+	threshold  float32
+	cooldown   time.Duration
+	hop        int // samples
+
+	mu       sync.Mutex
+	window   []float32 // ring of the last WindowSamples samples
+	pos      int       // where the next sample goes
+	filled   bool
+	sinceHop int
+	lastSeen map[string]time.Time // by event (and name for name_called)
+}
+
+// newSoundEventDetector builds a detector for events; returns nil when
+// there is nothing it could detect
+func newSoundEventDetector(config *Config, classifier *sounds.Classifier, events, names []string) *soundEventDetector {
+	d := &soundEventDetector{
+		classifier: classifier,
+		threshold:  float32(config.SoundEventThreshold),
+		cooldown:   config.SoundEventCooldown,
+		hop:        max(1, int(config.SoundEventHop.Seconds()*sounds.SampleRate)),
+		window:     make([]float32, sounds.WindowSamples),
+		lastSeen:   make(map[string]time.Time),
+	}
+	for _, event := range events {
+		switch {
+		case event == SoundNameCalled:
+			for _, name := range names {
+				if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+					d.names = append(d.names, name)
+				}
+			}
+		case classifier.Enabled():
+			d.events = append(d.events, event)
+		}
+	}
+	if len(d.events) == 0 && len(d.names) == 0 {
+		return nil
+	}
+	return d
+}
+
+// feed adds received mic samples and returns a window to classify once a
+// hop's worth has arrived
+func (d *soundEventDetector) feed(samples []int16) []float32 {
+	if len(d.events) == 0 {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, sample := range samples {
+		d.window[d.pos] = float32(sample) / 32768
+		d.pos++
+		if d.pos == len(d.window) {
+			d.pos, d.filled = 0, true
+		}
+	}
+	d.sinceHop += len(samples)
+	if !d.filled || d.sinceHop < d.hop {
+		return nil
+	}
+	d.sinceHop = 0
+
+	window := make([]float32, len(d.window))
+	n := copy(window, d.window[d.pos:])
+	copy(window[n:], d.window[:d.pos])
+	return window
+}
+
+// fresh reports whether key wasn't heard within the cooldown, and marks it
+// heard now
+func (d *soundEventDetector) fresh(key string, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	last, seen := d.lastSeen[key]
+	d.lastSeen[key] = now
+	return !seen || now.Sub(last) > d.cooldown
+}
+
+// detectSounds feeds a received mic frame to the session's sound event
+// detector, if any, and has full windows classified
+func (s *RoomSession) detectSounds(frame AudioFrame) {
+	d := s.soundEvents
+	if d == nil {
+		return
+	}
+
+	samples := pooledSamples(frame.PCM)
+	window := d.feed(*samples)
+	putSamples(samples)
+	if window == nil {
+		return
+	}
+
+	windowStart := frame.CapturedAt.Add(-time.Duration(sounds.WindowSamples) * time.Second / sounds.SampleRate)
+	d.classifier.Classify(window, func(scores sounds.Scores) {
+		s.handleSoundScores(d, scores, frame.ParticipantIdentity, windowStart)
+	})
+}
+
+// handleSoundScores emits a sound_event for each watched event scoring
+// above the threshold that wasn't already reported
+func (s *RoomSession) handleSoundScores(d *soundEventDetector, scores sounds.Scores, identity string, windowStart time.Time) {
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, event := range d.events {
+		classes, ok := soundEventClasses[event]
+		if !ok {
+			classes = []string{event}
+		}
+		var best float32
+		var bestClass string
+		for _, class := range classes {
+			if score := scores[class]; score > best {
+				best, bestClass = score, class
+			}
+		}
+		if best < d.threshold || !d.fresh(event, now) {
+			continue
+		}
+
+		soundEvents.WithLabelValues(event).Inc()
+		s.log().Info("Sound event detected", "event", event, "class", bestClass, "score", best)
+		s.emitEvent(EventSoundEvent, "", map[string]string{
+			"event":                event,
+			"class":                bestClass,
+			"score":                strconv.FormatFloat(float64(best), 'f', 3, 32),
+			"participant_identity": identity,
+			"window_start_ms":      strconv.FormatInt(windowStart.UnixMilli(), 10),
+		})
+	}
+}
+
+// heardSpeech checks recognized speech (a final transcript or a wake word)
+// for the user's name and emits a name_called sound event
+func (s *RoomSession) heardSpeech(text, source string) {
+	d := s.soundEvents
+	if d == nil || len(d.names) == 0 {
+		return
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r == '\'' || r == '-' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127)
+	})
+	heard := " " + strings.Join(words, " ") + " "
+	for _, name := range d.names {
+		if !strings.Contains(heard, " "+name+" ") || !d.fresh(SoundNameCalled+"/"+name, time.Now()) {
+			continue
+		}
+
+		soundEvents.WithLabelValues(SoundNameCalled).Inc()
+		s.log().Info("Sound event detected", "event", SoundNameCalled, "name", name, "source", source)
+		s.emitEvent(EventSoundEvent, "", map[string]string{
+			"event":  SoundNameCalled,
+			"name":   name,
+			"source": source,
+		})
+	}
+}
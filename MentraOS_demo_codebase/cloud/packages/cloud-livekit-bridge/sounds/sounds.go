@@ -0,0 +1,288 @@
+package sounds
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+
+	ort "github.com/yalue/onnxruntime_go"
+)
+
+// SampleRate is the rate of the audio the model takes
+const SampleRate = 16000
+
+// WindowSamples is how much audio one classification looks at (0.975s,
+// YAMNet's window)
+const WindowSamples = 15600
+
+// Config for Classifier
+type Config struct {
+	ModelPath    string // YAMNet-style ONNX model: waveform in, per-class scores out
+	ClassMapPath string // CSV of the model's classes (index, mid, display_name)
+	LibraryPath  string // onnxruntime shared library (default: the platform's usual name)
+	Workers      int    // windows classified at once (default: one per CPU)
+	Enabled      bool
+}
+
+// Scores are a window's score per class, by display name
+type Scores map[string]float32
+
+// job is one window waiting for a worker
+type job struct {
+	window   []float32
+	onResult func(Scores)
+}
+
+// Classifier scores one-second windows of audio against the AudioSet
+// classes (sirens, alarms, doorbells, ...) with a small model, on a pool of
+// workers shared by all sessions. A disabled (or nil) classifier classifies
+// nothing.
+type Classifier struct {
+	cfg     Config
+	classes []string
+	jobs    chan job
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// New loads the model and starts the workers. Errors are logged and leave
+// the classifier disabled.
+func New(cfg Config) *Classifier {
+	if cfg.Workers <= 0 {
+		cfg.Workers = runtime.NumCPU()
+	}
+
+	c := &Classifier{cfg: cfg, stopCh: make(chan struct{})}
+	if !cfg.Enabled {
+		return c
+	}
+
+	classes, err := readClassMap(cfg.ClassMapPath)
+	if err != nil {
+		slog.Error("Sound classification disabled: failed to read class map", "path", cfg.ClassMapPath, "error", err)
+		c.cfg.Enabled = false
+		return c
+	}
+	c.classes = classes
+
+	if cfg.LibraryPath != "" {
+		ort.SetSharedLibraryPath(cfg.LibraryPath)
+	}
+	if err := ort.InitializeEnvironment(); err != nil {
+		slog.Error("Sound classification disabled: failed to load onnxruntime", "error", err)
+		c.cfg.Enabled = false
+		return c
+	}
+
+	// Each worker owns a model session bound to its own tensors
+	c.jobs = make(chan job, cfg.Workers)
+	var workers []*worker
+	for i := 0; i < cfg.Workers; i++ {
+		w, err := newWorker(cfg.ModelPath, len(classes))
+		if err != nil {
+			slog.Error("Sound classification disabled: failed to load model", "path", cfg.ModelPath, "error", err)
+			for _, w := range workers {
+				w.destroy()
+			}
+			ort.DestroyEnvironment()
+			c.cfg.Enabled = false
+			return c
+		}
+		workers = append(workers, w)
+	}
+	for _, w := range workers {
+		c.wg.Add(1)
+		go c.work(w)
+	}
+
+	slog.Info("Sound classification enabled", "model", cfg.ModelPath, "classes", len(classes), "workers", cfg.Workers)
+	return c
+}
+
+// NewFromEnv creates a classifier from SOUND_MODEL_PATH and friends;
+// classification is off unless SOUND_MODEL_PATH is set
+func NewFromEnv() *Classifier {
+	modelPath := os.Getenv("SOUND_MODEL_PATH")
+	workers, _ := strconv.Atoi(os.Getenv("SOUND_WORKERS"))
+	return New(Config{
+		ModelPath:    modelPath,
+		ClassMapPath: os.Getenv("SOUND_CLASS_MAP_PATH"),
+		LibraryPath:  os.Getenv("ONNXRUNTIME_LIBRARY_PATH"),
+		Workers:      workers,
+		Enabled:      modelPath != "",
+	})
+}
+
+// Enabled reports whether windows are being classified
+func (c *Classifier) Enabled() bool {
+	return c != nil && c.cfg.Enabled
+}
+
+// Classify queues a window of WindowSamples samples (-1..1) and calls
+// onResult from a worker with its scores. It never blocks: it reports false
+// when every worker is busy and the window was skipped.
+func (c *Classifier) Classify(window []float32, onResult func(Scores)) bool {
+	if !c.Enabled() || len(window) != WindowSamples {
+		return false
+	}
+	select {
+	case c.jobs <- job{window: window, onResult: onResult}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops the workers and releases the model
+func (c *Classifier) Close() {
+	if !c.Enabled() {
+		return
+	}
+	close(c.stopCh)
+	c.wg.Wait()
+	ort.DestroyEnvironment()
+}
+
+// work classifies queued windows until the classifier closes
+func (c *Classifier) work(w *worker) {
+	defer c.wg.Done()
+	defer w.destroy()
+
+	for {
+		select {
+		case j := <-c.jobs:
+			scores, err := w.run(j.window)
+			if err != nil {
+				slog.Warn("Sound classification failed", "error", err)
+				continue
+			}
+			result := make(Scores, len(c.classes))
+			for i, score := range scores {
+				if i < len(c.classes) {
+					result[c.classes[i]] = score
+				}
+			}
+			j.onResult(result)
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+// worker is one model session with its input and output tensors
+type worker struct {
+	session *ort.AdvancedSession
+	input   *ort.Tensor[float32]
+	output  *ort.Tensor[float32]
+}
+
+// newWorker loads the model, taking its first input as the waveform and
+// the output with one score per class as the result
+func newWorker(modelPath string, classes int) (*worker, error) {
+	inputs, outputs, err := ort.GetInputOutputInfo(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("model has no inputs")
+	}
+	scores := -1
+	for i, output := range outputs {
+		if dims := output.Dimensions; len(dims) > 0 && dims[len(dims)-1] == int64(classes) {
+			scores = i
+			break
+		}
+	}
+	if scores < 0 {
+		return nil, fmt.Errorf("model has no output with %d class scores", classes)
+	}
+
+	input, err := ort.NewEmptyTensor[float32](fixedShape(inputs[0].Dimensions, WindowSamples))
+	if err != nil {
+		return nil, err
+	}
+	output, err := ort.NewEmptyTensor[float32](fixedShape(outputs[scores].Dimensions, classes))
+	if err != nil {
+		input.Destroy()
+		return nil, err
+	}
+	session, err := ort.NewAdvancedSession(modelPath,
+		[]string{inputs[0].Name}, []string{outputs[scores].Name},
+		[]ort.Value{input}, []ort.Value{output}, nil)
+	if err != nil {
+		input.Destroy()
+		output.Destroy()
+		return nil, err
+	}
+	return &worker{session: session, input: input, output: output}, nil
+}
+
+// fixedShape replaces a model's dynamic dimensions: the last one with last,
+// the others (batch, frames) with 1
+func fixedShape(dims ort.Shape, last int) ort.Shape {
+	shape := make(ort.Shape, len(dims))
+	for i, dim := range dims {
+		switch {
+		case dim > 0:
+			shape[i] = dim
+		case i == len(dims)-1:
+			shape[i] = int64(last)
+		default:
+			shape[i] = 1
+		}
+	}
+	return shape
+}
+
+// run scores one window
+func (w *worker) run(window []float32) ([]float32, error) {
+	copy(w.input.GetData(), window)
+	if err := w.session.Run(); err != nil {
+		return nil, err
+	}
+	return append([]float32(nil), w.output.GetData()...), nil
+}
+
+// destroy releases the session and tensors
+func (w *worker) destroy() {
+	w.session.Destroy()
+	w.input.Destroy()
+	w.output.Destroy()
+}
+
+// readClassMap reads the class names in model output order from a
+// "index,mid,display_name" CSV (YAMNet's yamnet_class_map.csv)
+func readClassMap(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.New("SOUND_CLASS_MAP_PATH is required")
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var classes []string
+	for i, record := range records {
+		if len(record) < 3 {
+			return nil, fmt.Errorf("line %d: expected index,mid,display_name", i+1)
+		}
+		if _, err := strconv.Atoi(record[0]); err != nil {
+			continue // header
+		}
+		classes = append(classes, record[2])
+	}
+	if len(classes) == 0 {
+		return nil, errors.New("no classes")
+	}
+	return classes, nil
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	"github.com/pion/webrtc/v4"
+)
+
+// trackActivity records when a published track was created and how much
+// has been written to it, for the status RPC
+type trackActivity struct {
+	createdAt     time.Time
+	framesWritten atomic.Int64
+	lastWrite     atomic.Int64 // Unix nanos, 0 = never written
+}
+
+// newTrackActivity starts tracking a track created now
+func newTrackActivity() *trackActivity {
+	return &trackActivity{createdAt: time.Now()}
+}
+
+// recordWrite counts one frame written to the track
+func (a *trackActivity) recordWrite() {
+	a.recordFrames(1)
+}
+
+// recordFrames counts a write to the track that played frames of queued
+// audio (more or fewer than one while the audio is time-stretched)
+func (a *trackActivity) recordFrames(frames int64) {
+	a.framesWritten.Add(frames)
+	a.lastWrite.Store(time.Now().UnixNano())
+}
+
+// publishedTracks describes every track the session has published
+func (s *RoomSession) publishedTracks() []*pb.PublishedTrack {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tracks := make([]*pb.PublishedTrack, 0, len(s.publications))
+	for trackName, publication := range s.publications {
+		info := &pb.PublishedTrack{
+			Name:  trackName,
+			Sid:   publication.SID(),
+			Codec: publication.MimeType(),
+			Muted: publication.IsMuted(),
+		}
+		if info.Codec == "" {
+			if track, ok := publication.TrackLocal().(interface {
+				Codec() webrtc.RTPCodecCapability
+			}); ok {
+				info.Codec = track.Codec().MimeType
+			}
+		}
+
+		var activity *trackActivity
+		if state, exists := s.trackStates[trackName]; exists {
+			info.Encoding = "pcm"
+			activity = state.player.activity
+		} else if opus, exists := s.opusTracks[trackName]; exists {
+			info.Encoding = "opus"
+			activity = opus.activity
+		}
+		if activity != nil {
+			info.CreatedAt = activity.createdAt.UnixMilli()
+			info.FramesWritten = activity.framesWritten.Load()
+			if last := activity.lastWrite.Load(); last != 0 {
+				info.LastWriteAt = time.Unix(0, last).UnixMilli()
+			}
+		}
+		tracks = append(tracks, info)
+	}
+
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].Name < tracks[j].Name })
+	return tracks
+}
+
+// listTracks describes every track the session has, published or still
+// being set up, with what each is playing
+func (s *RoomSession) listTracks() []*pb.TrackInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make(map[string]bool)
+	for trackName := range s.publications {
+		names[trackName] = true
+	}
+	for trackName := range s.trackStates {
+		names[trackName] = true
+	}
+	for trackName := range s.opusTracks {
+		names[trackName] = true
+	}
+
+	running := make(map[string]string)
+	for playback := range s.running {
+		running[playback.trackName] = playback.requestID
+	}
+
+	tracks := make([]*pb.TrackInfo, 0, len(names))
+	for trackName := range names {
+		info := &pb.TrackInfo{Name: trackName, OwnerApp: trackOwner(trackName)}
+		if publication, exists := s.publications[trackName]; exists {
+			info.Sid = publication.SID()
+		}
+
+		requestID, playing := running[trackName]
+		info.RequestId = requestID
+		if state, exists := s.trackStates[trackName]; exists {
+			info.Encoding = "pcm"
+			info.SampleRate = int32(s.sampleRate)
+			info.Channels = int32(state.channels)
+			queued := state.player.queuedFrames()
+			info.BufferedMs = (time.Duration(queued) * playbackFrameDuration).Milliseconds()
+			info.Muted = state.player.isMuted()
+			playing = playing || queued > 0 || state.player.isActive()
+			if state.player.isPaused() {
+				info.State = pb.TrackInfo_PAUSED
+			}
+		} else if opus, exists := s.opusTracks[trackName]; exists {
+			info.Encoding = "opus"
+			info.SampleRate = 48000
+			info.Channels = 2
+			info.BufferedMs = (time.Duration(len(opus.frames)) * defaultOpusFrameDuration).Milliseconds()
+			playing = playing || len(opus.frames) > 0
+		}
+		if playing && info.State != pb.TrackInfo_PAUSED {
+			info.State = pb.TrackInfo_PLAYING
+		}
+		if queue, exists := s.audioQueues[trackName]; exists {
+			info.QueuedClips = int32(queue.waitingCount())
+		}
+		tracks = append(tracks, info)
+	}
+
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].Name < tracks[j].Name })
+	return tracks
+}
+
+// participantChanged refreshes the participant count after a remote
+// participant joins or leaves, and emits the matching event
+func (s *RoomSession) participantChanged(participant *lksdk.RemoteParticipant, eventType string) {
+	s.connMu.Lock()
+	if s.room != nil {
+		s.participantCount = len(s.room.GetRemoteParticipants()) + 1
+	}
+	count := s.participantCount
+	s.connMu.Unlock()
+
+	s.log().Info("Remote participant changed", "event", eventType, "participant", participant.Identity(), "participant_count", count)
+	s.emitEvent(eventType, "", map[string]string{
+		"participant_identity": participant.Identity(),
+		"participant_sid":      participant.SID(),
+		"participant_name":     participant.Name(),
+		"participant_count":    strconv.Itoa(count),
+	})
+}
+
+// remoteTrackChanged emits an event for a track another participant
+// published, unpublished, muted or unmuted
+func (s *RoomSession) remoteTrackChanged(publication lksdk.TrackPublication, participant lksdk.Participant, eventType string) {
+	s.log().Debug("Remote track changed", "event", eventType, "participant", participant.Identity(), "track", publication.Name())
+	s.emitEvent(eventType, publication.Name(), map[string]string{
+		"participant_identity": participant.Identity(),
+		"participant_sid":      participant.SID(),
+		"track_sid":            publication.SID(),
+		"kind":                 string(publication.Kind()),
+		"source":               publication.Source().String(),
+		"muted":                strconv.FormatBool(publication.IsMuted()),
+	})
+}
+
+// activeSpeakersChanged forwards LiveKit's active speaker list, loudest
+// first, with each speaker's audio level (0-1) in the same order
+func (s *RoomSession) activeSpeakersChanged(speakers []lksdk.Participant) {
+	identities := make([]string, 0, len(speakers))
+	levels := make([]string, 0, len(speakers))
+	for _, speaker := range speakers {
+		identities = append(identities, speaker.Identity())
+		levels = append(levels, strconv.FormatFloat(float64(speaker.AudioLevel()), 'f', 3, 32))
+	}
+
+	s.emitEvent(EventActiveSpeakers, "", map[string]string{
+		"speakers":     strings.Join(identities, ","),
+		"audio_levels": strings.Join(levels, ","),
+	})
+}
+
+// remoteParticipants lists the other participants in the room and the
+// tracks each has published
+func remoteParticipants(room *lksdk.Room) []*pb.RemoteParticipant {
+	if room == nil {
+		return nil
+	}
+
+	var participants []*pb.RemoteParticipant
+	for _, participant := range room.GetRemoteParticipants() {
+		info := &pb.RemoteParticipant{
+			Identity: participant.Identity(),
+			Sid:      participant.SID(),
+			Name:     participant.Name(),
+		}
+		for _, publication := range participant.TrackPublications() {
+			info.Tracks = append(info.Tracks, &pb.RemoteTrack{
+				Name:       publication.Name(),
+				Sid:        publication.SID(),
+				Kind:       string(publication.Kind()),
+				Codec:      publication.MimeType(),
+				Muted:      publication.IsMuted(),
+				Subscribed: publication.IsSubscribed(),
+			})
+		}
+		sort.Slice(info.Tracks, func(i, j int) bool { return info.Tracks[i].Name < info.Tracks[j].Name })
+		participants = append(participants, info)
+	}
+
+	sort.Slice(participants, func(i, j int) bool { return participants[i].Identity < participants[j].Identity })
+	return participants
+}
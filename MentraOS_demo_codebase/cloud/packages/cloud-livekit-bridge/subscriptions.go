@@ -0,0 +1,219 @@
+package main
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	msdk "github.com/livekit/media-sdk"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+	"github.com/pion/webrtc/v4"
+)
+
+// audioSubscriptions decides which remote audio the session takes in. With
+// no rules the bridge keeps its original behaviour: data packet audio from
+// every participant and no media tracks. Once rules exist, only audio
+// matching one of them is accepted, and remote audio tracks they match are
+// subscribed and decoded alongside data packet audio.
+type audioSubscriptions struct {
+	mu    sync.RWMutex
+	rules []AudioFilter
+}
+
+// add adds a rule; returns false if it was already present
+func (a *audioSubscriptions) add(rule AudioFilter) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, existing := range a.rules {
+		if existing == rule {
+			return false
+		}
+	}
+	a.rules = append(a.rules, rule)
+	return true
+}
+
+// remove drops a rule; returns false if it wasn't present
+func (a *audioSubscriptions) remove(rule AudioFilter) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, existing := range a.rules {
+		if existing == rule {
+			a.rules = append(a.rules[:i], a.rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// list returns the current rules
+func (a *audioSubscriptions) list() []AudioFilter {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return append([]AudioFilter(nil), a.rules...)
+}
+
+// accepts reports whether audio from identity on trackName should be taken
+// in; everything is accepted while there are no rules
+func (a *audioSubscriptions) accepts(identity, trackName string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if len(a.rules) == 0 {
+		return true
+	}
+	return a.matchesLocked(identity, trackName)
+}
+
+// wantsTrack reports whether a remote media track should be subscribed;
+// unlike accepts, this needs an explicit rule
+func (a *audioSubscriptions) wantsTrack(identity, trackName string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.matchesLocked(identity, trackName)
+}
+
+// matchesLocked reports whether any rule matches; a.mu must be held
+func (a *audioSubscriptions) matchesLocked(identity, trackName string) bool {
+	frame := AudioFrame{ParticipantIdentity: identity, TrackName: trackName}
+	for _, rule := range a.rules {
+		if rule.matches(frame) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyTrackSubscription subscribes to or unsubscribes from a remote audio
+// track according to the session's rules
+func (s *RoomSession) applyTrackSubscription(publication *lksdk.RemoteTrackPublication, participant *lksdk.RemoteParticipant) {
+	if publication.Kind() != lksdk.TrackKindAudio {
+		return
+	}
+
+	want := s.subscriptions.wantsTrack(participant.Identity(), publication.Name())
+	if shared := s.shared.Load(); shared != nil {
+		// The connection is subscribed for every member that routes the track
+		want = shared.wantsTrack(participant.Identity(), publication.Name())
+	}
+	if want == publication.IsSubscribed() {
+		return
+	}
+
+	if err := publication.SetSubscribed(want); err != nil {
+		s.log().Warn("Failed to update remote track subscription", "participant", participant.Identity(),
+			"track", publication.Name(), "subscribe", want, "error", err)
+		return
+	}
+	s.log().Info("Updated remote track subscription", "participant", participant.Identity(),
+		"track", publication.Name(), "subscribe", want)
+}
+
+// applySubscriptions re-evaluates every remote audio track in the room
+// after the rules change
+func (s *RoomSession) applySubscriptions() {
+	room := s.currentRoom()
+	if room == nil {
+		return
+	}
+
+	for _, participant := range room.GetRemoteParticipants() {
+		for _, publication := range participant.TrackPublications() {
+			if remotePub, ok := publication.(*lksdk.RemoteTrackPublication); ok {
+				s.applyTrackSubscription(remotePub, participant)
+			}
+		}
+	}
+}
+
+// subscriptionRules lists the session's rules for the RPC response
+func (s *RoomSession) subscriptionRules() []*pb.AudioSubscription {
+	rules := s.subscriptions.list()
+	out := make([]*pb.AudioSubscription, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, &pb.AudioSubscription{
+			ParticipantIdentity: rule.ParticipantIdentity,
+			TrackName:           rule.TrackName,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ParticipantIdentity != out[j].ParticipantIdentity {
+			return out[i].ParticipantIdentity < out[j].ParticipantIdentity
+		}
+		return out[i].TrackName < out[j].TrackName
+	})
+	return out
+}
+
+// remoteAudioWriter receives decoded PCM from a subscribed remote track
+type remoteAudioWriter struct {
+	deliver func(pcmData []byte)
+}
+
+// WriteSample hands one decoded frame to the incoming audio pipeline
+func (w *remoteAudioWriter) WriteSample(sample msdk.PCM16Sample) error {
+	if len(sample) > 0 {
+		w.deliver(int16ToBytes(sample))
+	}
+	return nil
+}
+
+// Close is a no-op; the pipeline outlives any one track
+func (w *remoteAudioWriter) Close() error {
+	return nil
+}
+
+// consumeRemoteTrack decodes a subscribed remote audio track to PCM at the
+// session's rate and feeds it to deliver, like data packet audio
+func (s *RoomSession) consumeRemoteTrack(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication,
+	participant *lksdk.RemoteParticipant, deliver func(pcmData []byte, identity, trackName string, stamp captureStamp)) {
+	if track.Kind() != webrtc.RTPCodecTypeAudio {
+		return
+	}
+
+	identity, name := participant.Identity(), publication.Name()
+	clock := s.remoteClock(uint32(track.SSRC()), identity, name, publication.SID())
+	writer := &remoteAudioWriter{deliver: func(pcmData []byte) {
+		deliver(pcmData, identity, name, clock.stamp(len(pcmData)/2, s.sampleRate))
+	}}
+	remote, err := lkmedia.NewPCMRemoteTrack(track, writer, lkmedia.WithTargetSampleRate(s.sampleRate))
+	if err != nil {
+		s.log().Warn("Failed to decode remote audio track", "participant", identity, "track", name, "error", err)
+		return
+	}
+
+	s.mu.Lock()
+	if previous, exists := s.remoteTracks[publication.SID()]; exists {
+		previous.Close()
+	}
+	s.remoteTracks[publication.SID()] = remote
+	s.mu.Unlock()
+
+	s.log().Info("Receiving remote audio track", "participant", identity, "track", name)
+}
+
+// releaseRemoteTrack stops decoding a remote track once it is unsubscribed
+func (s *RoomSession) releaseRemoteTrack(publication *lksdk.RemoteTrackPublication) {
+	s.mu.Lock()
+	remote, exists := s.remoteTracks[publication.SID()]
+	delete(s.remoteTracks, publication.SID())
+	s.mu.Unlock()
+
+	if exists {
+		remote.Close()
+	}
+}
+
+// closeRemoteTracksLocked stops decoding every remote track, for when the
+// room goes away; s.mu must be held
+func (s *RoomSession) closeRemoteTracksLocked() {
+	for sid, remote := range s.remoteTracks {
+		remote.Close()
+		delete(s.remoteTracks, sid)
+	}
+}
@@ -0,0 +1,419 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// toneSampleRate is the rate tones are rendered at; tracks resample it
+const toneSampleRate = 48000
+
+// maxToneDuration caps how long a rendered tone may be
+const maxToneDuration = time.Minute
+
+// toneRamp fades each segment in and out, so tones start and stop without
+// clicks
+const toneRamp = 5 * time.Millisecond
+
+// Defaults for DTMF digits and the tone level
+const (
+	defaultDTMFTone  = 100 * time.Millisecond
+	defaultDTMFGap   = 60 * time.Millisecond
+	defaultToneLevel = -12.0 // dBFS
+)
+
+// dtmfFrequencies are each digit's row and column tones (ITU-T Q.23)
+var dtmfFrequencies = map[rune][2]float64{
+	'1': {697, 1209}, '2': {697, 1336}, '3': {697, 1477}, 'A': {697, 1633},
+	'4': {770, 1209}, '5': {770, 1336}, '6': {770, 1477}, 'B': {770, 1633},
+	'7': {852, 1209}, '8': {852, 1336}, '9': {852, 1477}, 'C': {852, 1633},
+	'*': {941, 1209}, '0': {941, 1336}, '#': {941, 1477}, 'D': {941, 1633},
+}
+
+// Defaults for beep patterns and chimes
+const (
+	defaultBeepHz     = 1000.0
+	defaultBeepLength = 100 * time.Millisecond
+	defaultChimeNote  = 150 * time.Millisecond
+	defaultChimeRing  = 600 * time.Millisecond
+	maxBeeps          = 100 // symbols in a beep pattern
+)
+
+// defaultChimeNotes is a rising major third (C6, E6)
+var defaultChimeNotes = []float64{1047, 1319}
+
+// chimePartials are a chime note's overtones: frequency ratio and level
+var chimePartials = [][2]float64{{1, 1}, {2, 0.4}, {3, 0.2}, {4.2, 0.1}}
+
+// toneSegment is a stretch of a rendered tone: the sum of sines gliding
+// from their start to their end frequencies (silence when there are none),
+// shaped by envelope (just a short fade in and out when nil)
+type toneSegment struct {
+	startHz  []float64
+	endHz    []float64
+	duration time.Duration
+	envelope *toneEnvelope
+}
+
+// toneEnvelope is an ADSR envelope: the level rises over attack, falls
+// over decay to sustain (0-1), and fades out over release at the end
+type toneEnvelope struct {
+	attack, decay, release time.Duration
+	sustain                float64
+}
+
+// parseEnvelope converts a requested envelope (nil when none was given)
+func parseEnvelope(envelope *pb.ToneEnvelope) (*toneEnvelope, error) {
+	if envelope == nil {
+		return nil, nil
+	}
+	if envelope.AttackMs < 0 || envelope.DecayMs < 0 || envelope.ReleaseMs < 0 {
+		return nil, fmt.Errorf("envelope times must not be negative")
+	}
+	if envelope.Sustain < 0 || envelope.Sustain > 1 {
+		return nil, fmt.Errorf("envelope sustain must be between 0 and 1")
+	}
+	sustain := float64(envelope.Sustain)
+	if envelope.DecayMs == 0 {
+		sustain = 1 // nothing to decay from
+	}
+	return &toneEnvelope{
+		attack:  time.Duration(envelope.AttackMs) * time.Millisecond,
+		decay:   time.Duration(envelope.DecayMs) * time.Millisecond,
+		release: time.Duration(envelope.ReleaseMs) * time.Millisecond,
+		sustain: sustain,
+	}, nil
+}
+
+// level returns the envelope at sample i of n; attack and release are at
+// least toneRamp, so nothing clicks
+func (e *toneEnvelope) level(i, n int) float64 {
+	ramp := toneRamp.Seconds() * toneSampleRate
+	attack := max(ramp, e.attack.Seconds()*toneSampleRate)
+	decay := e.decay.Seconds() * toneSampleRate
+	release := max(ramp, e.release.Seconds()*toneSampleRate)
+
+	t := float64(i)
+	level := e.sustain
+	switch {
+	case t < attack:
+		level = t / attack
+	case t < attack+decay:
+		level = 1 - (1-e.sustain)*(t-attack)/decay
+	}
+	return level * min(1, float64(n-1-i)/release)
+}
+
+// steady returns a segment of sines held at fixed frequencies
+func steady(duration time.Duration, hz ...float64) toneSegment {
+	return toneSegment{startHz: hz, endHz: hz, duration: duration}
+}
+
+// sweep returns a segment of one sine gliding from one frequency to another
+func sweep(from, to float64, duration time.Duration) toneSegment {
+	return toneSegment{startHz: []float64{from}, endHz: []float64{to}, duration: duration}
+}
+
+// earcons are the built-in cues, by name
+var earcons = map[string][]toneSegment{
+	"success": {steady(80*time.Millisecond, 880), steady(120*time.Millisecond, 1320)},
+	"error":   {steady(150*time.Millisecond, 330), steady(50 * time.Millisecond), steady(200*time.Millisecond, 262)},
+	"notify":  {steady(100*time.Millisecond, 1047)},
+	"alert": {
+		steady(120*time.Millisecond, 1000), steady(80 * time.Millisecond),
+		steady(120*time.Millisecond, 1000), steady(80 * time.Millisecond),
+		steady(120*time.Millisecond, 1000),
+	},
+	"start": {sweep(600, 1200, 150*time.Millisecond)},
+	"stop":  {sweep(1200, 600, 150*time.Millisecond)},
+}
+
+// toneSegments turns a tone request into the segments to render: DTMF
+// digits, explicit segments (sweeps where the end frequency differs), a
+// beep pattern or a built-in earcon
+func toneSegments(tone *pb.Tone, envelope *toneEnvelope) ([]toneSegment, error) {
+	switch {
+	case tone.Dtmf != "":
+		length, gap := defaultDTMFTone, defaultDTMFGap
+		if tone.ToneMs > 0 {
+			length = time.Duration(tone.ToneMs) * time.Millisecond
+		}
+		if tone.GapMs > 0 {
+			gap = time.Duration(tone.GapMs) * time.Millisecond
+		}
+		var segments []toneSegment
+		for i, digit := range strings.ToUpper(tone.Dtmf) {
+			if i > 0 {
+				segments = append(segments, steady(gap))
+			}
+			if digit == ',' {
+				// A comma pauses, like a dialler's
+				segments = append(segments, steady(length+gap))
+				continue
+			}
+			hz, ok := dtmfFrequencies[digit]
+			if !ok {
+				return nil, fmt.Errorf("invalid DTMF digit %q", digit)
+			}
+			segments = append(segments, steady(length, hz[0], hz[1]))
+		}
+		return segments, nil
+
+	case len(tone.Segments) > 0:
+		segments := make([]toneSegment, 0, len(tone.Segments))
+		for _, segment := range tone.Segments {
+			if segment.DurationMs <= 0 {
+				return nil, fmt.Errorf("tone segments need a positive duration_ms")
+			}
+			start, end := float64(segment.StartHz), float64(segment.EndHz)
+			if end == 0 {
+				end = start
+			}
+			duration := time.Duration(segment.DurationMs) * time.Millisecond
+			switch {
+			case start == 0 && segment.EndHz == 0:
+				segments = append(segments, steady(duration))
+			case start < 20 || end < 20 || start > 20000 || end > 20000:
+				return nil, fmt.Errorf("tone frequencies must be 20-20000 Hz")
+			default:
+				segment := sweep(start, end, duration)
+				segment.envelope = envelope
+				segments = append(segments, segment)
+			}
+		}
+		return segments, nil
+
+	case tone.Beeps != nil:
+		return beepSegments(tone.Beeps, envelope)
+
+	default:
+		segments, ok := earcons[strings.ToLower(tone.Earcon)]
+		if !ok {
+			return nil, fmt.Errorf("unknown earcon %q", tone.Earcon)
+		}
+		return segments, nil
+	}
+}
+
+// beepSegments turns a beep pattern into segments: count beeps, or a
+// pattern of "." (a beep), "-" (a beep three times as long) and " " (a
+// pause as long as a beep), with gap_ms between them (default beep_ms)
+func beepSegments(beeps *pb.BeepPattern, envelope *toneEnvelope) ([]toneSegment, error) {
+	hz := defaultBeepHz
+	if beeps.Hz != 0 {
+		hz = float64(beeps.Hz)
+	}
+	if hz < 20 || hz > 20000 {
+		return nil, fmt.Errorf("beep frequency must be 20-20000 Hz")
+	}
+	length := defaultBeepLength
+	if beeps.BeepMs > 0 {
+		length = time.Duration(beeps.BeepMs) * time.Millisecond
+	}
+	gap := length
+	if beeps.GapMs > 0 {
+		gap = time.Duration(beeps.GapMs) * time.Millisecond
+	}
+
+	if beeps.Count > maxBeeps || len(beeps.Pattern) > maxBeeps {
+		return nil, fmt.Errorf("at most %d beeps are allowed", maxBeeps)
+	}
+	pattern := beeps.Pattern
+	if pattern == "" {
+		pattern = strings.Repeat(".", int(max(1, beeps.Count)))
+	}
+	var segments []toneSegment
+	for i, symbol := range pattern {
+		if i > 0 {
+			segments = append(segments, steady(gap))
+		}
+		var segment toneSegment
+		switch symbol {
+		case '.':
+			segment = steady(length, hz)
+		case '-':
+			segment = steady(3*length, hz)
+		case ' ':
+			segment = steady(length)
+		default:
+			return nil, fmt.Errorf("invalid beep pattern symbol %q: use '.', '-' or ' '", symbol)
+		}
+		if len(segment.startHz) > 0 {
+			segment.envelope = envelope
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// renderChime renders a chime: notes struck spacing apart, each a bell-like
+// sum of overtones ringing out exponentially over ring (or shaped by
+// envelope instead), overlapping the notes after it
+func renderChime(chime *pb.Chime, envelope *toneEnvelope, level float64) ([]int16, error) {
+	notes := defaultChimeNotes
+	if len(chime.NotesHz) > 0 {
+		notes = make([]float64, len(chime.NotesHz))
+		for i, hz := range chime.NotesHz {
+			if hz < 20 || hz > 20000 {
+				return nil, fmt.Errorf("chime notes must be 20-20000 Hz")
+			}
+			notes[i] = float64(hz)
+		}
+	}
+	spacing, ring := defaultChimeNote, defaultChimeRing
+	if chime.NoteMs > 0 {
+		spacing = time.Duration(chime.NoteMs) * time.Millisecond
+	}
+	if chime.RingMs > 0 {
+		ring = time.Duration(chime.RingMs) * time.Millisecond
+	}
+	if total := time.Duration(len(notes)-1)*spacing + ring; total > maxToneDuration {
+		return nil, fmt.Errorf("chime is %v long, at most %v is allowed", total, maxToneDuration)
+	}
+
+	step := int(spacing.Seconds() * toneSampleRate)
+	length := int(ring.Seconds() * toneSampleRate)
+	mix := make([]float64, (len(notes)-1)*step+length)
+	ramp := toneRamp.Seconds() * toneSampleRate
+	// Decays to -60dB over the ring
+	decay := math.Log(1000) / float64(length)
+
+	totalLevel := 0.0
+	for _, partial := range chimePartials {
+		totalLevel += partial[1]
+	}
+	for n, hz := range notes {
+		start := n * step
+		for i := 0; i < length; i++ {
+			shape := math.Exp(-decay*float64(i)) * min(1, float64(i)/ramp, float64(length-1-i)/ramp)
+			if envelope != nil {
+				shape = envelope.level(i, length)
+			}
+			t := float64(i) / toneSampleRate
+			value := 0.0
+			for _, partial := range chimePartials {
+				if f := hz * partial[0]; f < toneSampleRate/2 {
+					value += partial[1] * math.Sin(2*math.Pi*f*t)
+				}
+			}
+			mix[start+i] += value / totalLevel * shape
+		}
+	}
+
+	samples := make([]int16, len(mix))
+	for i, value := range mix {
+		samples[i] = softClip(value * level * 32767)
+	}
+	return samples, nil
+}
+
+// renderTone renders segments as 16-bit mono samples at toneSampleRate and
+// level (linear, full scale = 1). Sweeps glide logarithmically, which
+// sounds even across octaves, and each sine's phase carries on between
+// segments.
+func renderTone(segments []toneSegment, level float64) []int16 {
+	var total time.Duration
+	for _, segment := range segments {
+		total += segment.duration
+	}
+	samples := make([]int16, 0, int(total.Seconds()*toneSampleRate)+len(segments))
+	ramp := toneRamp.Seconds() * toneSampleRate
+
+	var phases []float64
+	for _, segment := range segments {
+		n := int(segment.duration.Seconds() * toneSampleRate)
+		if len(phases) < len(segment.startHz) {
+			phases = append(phases, make([]float64, len(segment.startHz)-len(phases))...)
+		}
+		amplitude := 0.0
+		if len(segment.startHz) > 0 {
+			amplitude = level * 32767 / float64(len(segment.startHz))
+		}
+		for i := 0; i < n; i++ {
+			envelope := min(1, float64(i)/ramp, float64(n-1-i)/ramp)
+			if segment.envelope != nil {
+				envelope = segment.envelope.level(i, n)
+			}
+			value := 0.0
+			for c, start := range segment.startHz {
+				hz := start * math.Pow(segment.endHz[c]/start, float64(i)/float64(n))
+				value += math.Sin(phases[c])
+				phases[c] = math.Mod(phases[c]+2*math.Pi*hz/toneSampleRate, 2*math.Pi)
+			}
+			samples = append(samples, int16(value*amplitude*envelope))
+		}
+	}
+	return samples
+}
+
+// toneSamples renders a tone request, which must give exactly one of dtmf,
+// segments, beeps, chime or earcon
+func toneSamples(tone *pb.Tone) ([]int16, error) {
+	given := 0
+	for _, set := range []bool{tone.Dtmf != "", len(tone.Segments) > 0, tone.Beeps != nil, tone.Chime != nil, tone.Earcon != ""} {
+		if set {
+			given++
+		}
+	}
+	if given != 1 {
+		return nil, fmt.Errorf("tone needs exactly one of dtmf, segments, beeps, chime or earcon")
+	}
+	envelope, err := parseEnvelope(tone.Envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	levelDb := defaultToneLevel
+	if tone.LevelDb < 0 {
+		levelDb = float64(tone.LevelDb)
+	}
+	level := math.Pow(10, levelDb/20)
+	if tone.Chime != nil {
+		return renderChime(tone.Chime, envelope, level)
+	}
+
+	segments, err := toneSegments(tone, envelope)
+	if err != nil {
+		return nil, err
+	}
+	var total time.Duration
+	for _, segment := range segments {
+		total += segment.duration
+	}
+	if total > maxToneDuration {
+		return nil, fmt.Errorf("tone is %v long, at most %v is allowed", total, maxToneDuration)
+	}
+	return renderTone(segments, level), nil
+}
+
+// applyTone renders a request's tone as inline WAV audio, so it plays,
+// queues, loops and reports events like any other clip
+func applyTone(req *pb.PlayAudioRequest) error {
+	if req.Tone == nil {
+		return nil
+	}
+	if req.AudioUrl != "" || len(req.AudioData) > 0 {
+		return fmt.Errorf("tone can't be combined with audio_url or audio_data")
+	}
+	if req.Passthrough {
+		return fmt.Errorf("tone can't be played with passthrough")
+	}
+
+	samples, err := toneSamples(req.Tone)
+	if err != nil {
+		return err
+	}
+
+	data := append(wavHeader(toneSampleRate, 1, len(samples)*2), make([]byte, len(samples)*2)...)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(data[wavHeaderSize+2*i:], uint16(sample))
+	}
+	req.AudioData = data
+	req.ContentType = "audio/wav"
+	return nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
+)
+
+// trackBus mixes a session's PCM tracks into one published track, for
+// devices that can only render one remote audio track reliably. Each
+// logical track (speech, earcons, music) keeps its own player, so volume,
+// ducking, priorities, queueing and stopping work per track as usual, but
+// its frames are summed into the bus instead of going out on a track of
+// their own. The bus is mono; stereo tracks are downmixed into it.
+type trackBus struct {
+	name string
+	mix  *mixdown
+
+	mu          sync.Mutex
+	track       *lkmedia.PCMLocalTrack // nil until first published; replaced after a reconnect
+	publication *lksdk.LocalTrackPublication
+	ready       chan struct{} // closed once the current track is negotiated
+}
+
+// newTrackBus creates a bus published as name, mixing at sampleRate
+func newTrackBus(name string, sampleRate int) *trackBus {
+	if name == "" {
+		name = "speaker"
+	}
+	return &trackBus{name: name, mix: newMixdown(sampleRate, "track_bus")}
+}
+
+// write adds a frame a logical track played to the mix
+func (b *trackBus) write(trackName string, frame []int16, channels int) {
+	b.mix.add(trackName, trackName, convertChannels(frame, channels, 1))
+}
+
+// current returns the published track and whether it is negotiated yet
+func (b *trackBus) current() (*lkmedia.PCMLocalTrack, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.track == nil {
+		return nil, false
+	}
+	select {
+	case <-b.ready:
+		return b.track, true
+	default:
+		return b.track, false
+	}
+}
+
+// readyChan returns the channel that closes once the current track is
+// negotiated
+func (b *trackBus) readyChan() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.ready
+}
+
+// createBusTrackLocked creates a logical track that plays into the bus,
+// publishing the bus first if it isn't yet; caller must hold s.mu
+func (s *RoomSession) createBusTrackLocked(trackName string, channels int) (*lkmedia.PCMLocalTrack, error) {
+	if s.room != nil && s.bus.publication == nil {
+		if err := s.publishBusLocked(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Never published: the player writes its frames to the bus
+	track, err := lkmedia.NewPCMLocalTrack(s.sampleRate, channels, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PCM track: %w", err)
+	}
+	ready := make(chan struct{})
+	close(ready)
+	s.tracks[trackName] = track
+	s.trackStates[trackName] = &trackState{
+		channels: channels,
+		player:   s.newPlayerLocked(trackName, track, channels, ready),
+		agc:      s.newAGCLocked(trackName, channels),
+	}
+	if s.room == nil {
+		s.trackStates[trackName].player.suspendOutput(s.reconnect.Buffer)
+	}
+
+	s.log().Info("Created mixed track", "track_name", trackName, "bus", s.bus.name, "channels", channels)
+	return track, nil
+}
+
+// publishBusLocked publishes (or after a reconnect, republishes) the bus
+// track, starting the mixer the first time; caller must hold s.mu
+func (s *RoomSession) publishBusLocked() error {
+	b := s.bus
+	track, err := lkmedia.NewPCMLocalTrack(s.sampleRate, 1, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create PCM track: %w", err)
+	}
+
+	onBind, ready := s.negotiationGate(b.name)
+	publication, err := s.room.LocalParticipant.PublishTrack(&negotiatedTrack{PCMLocalTrack: track, onBind: onBind}, &lksdk.TrackPublicationOptions{
+		Name: s.publishName(b.name),
+	})
+	if err != nil {
+		track.Close()
+		return fmt.Errorf("failed to publish mixed track: %w", err)
+	}
+
+	b.mu.Lock()
+	previous := b.track
+	b.track, b.publication, b.ready = track, publication, ready
+	b.mu.Unlock()
+
+	if previous != nil {
+		previous.Close()
+	} else {
+		s.spawn("track_bus", func() { s.runTrackBus(b) })
+	}
+	s.log().Info("Published mixed track", "track_name", b.name, "track_sid", publication.SID())
+	s.emitEvent(EventTrackPublished, b.name, map[string]string{"track_sid": publication.SID(), "encoding": "pcm", "mixed": "true"})
+	return nil
+}
+
+// closeBusLocked unpublishes and closes the bus track; caller must hold s.mu
+func (s *RoomSession) closeBusLocked() {
+	b := s.bus
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	track, publication := b.track, b.publication
+	b.track, b.publication = nil, nil
+	b.mu.Unlock()
+
+	if publication != nil && s.room != nil && s.room.LocalParticipant != nil {
+		s.room.LocalParticipant.UnpublishTrack(publication.SID())
+		s.log().Info("Unpublished mixed track", "track_name", b.name, "track_sid", publication.SID())
+	}
+	if track != nil {
+		track.Close()
+	}
+}
+
+// runTrackBus writes the mix of the logical tracks to the bus track every
+// 10ms that any of them plays, until the session closes
+func (s *RoomSession) runTrackBus(b *trackBus) {
+	ticker := time.NewTicker(playbackFrameDuration)
+	defer ticker.Stop()
+
+	failing := false
+	for {
+		select {
+		case now := <-ticker.C:
+			pcm, ok := b.mix.mix(now)
+			if !ok {
+				continue
+			}
+			track, ready := b.current()
+			if !ready {
+				continue // dropped while the bus is (re)negotiating
+			}
+			err := track.WriteSample(bytesToInt16(pcm))
+			switch {
+			case err != nil && !failing && s.ctx.Err() == nil:
+				s.log().Warn("Failed to write mixed track", "track_name", b.name, "error", err)
+				failing = true
+			case err == nil:
+				failing = false
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
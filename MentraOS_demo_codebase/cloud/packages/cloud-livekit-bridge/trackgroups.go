@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// trackMatcher compiles a StopAudio track_pattern, a glob over track names
+// such as "appX:*". An empty pattern matches every track and returns nil.
+func trackMatcher(pattern string) (func(string) bool, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid track pattern %q: %w", pattern, err)
+	}
+	return func(trackName string) bool {
+		matched, _ := path.Match(pattern, trackName)
+		return matched
+	}, nil
+}
+
+// trackOwner returns the app a track is named under: the part of its name
+// before ':' ("appX" for "appX:music"), or "" for shared tracks
+func trackOwner(trackName string) string {
+	owner, _, found := strings.Cut(trackName, ":")
+	if !found {
+		return ""
+	}
+	return owner
+}
+
+// errNotTrackOwner refuses an app access to another app's track
+var errNotTrackOwner = errors.New("track belongs to another app")
+
+// appTrackName resolves the track a request addresses. A request made for
+// an app is confined to that app's tracks: the name gets the "appID:"
+// prefix, and a name under another app's prefix is refused. Requests with
+// no app (the cloud itself) may address any track.
+func appTrackName(appID, trackName string, trackID int32) (string, error) {
+	trackName = requestTrackName(trackName, trackID)
+	if appID == "" {
+		return trackName, nil
+	}
+	if strings.Contains(appID, ":") {
+		return "", fmt.Errorf("invalid app ID %q: must not contain ':'", appID)
+	}
+
+	if owner := trackOwner(trackName); owner != "" {
+		if owner != appID {
+			return "", fmt.Errorf("%w: %q is owned by %q", errNotTrackOwner, trackName, owner)
+		}
+		return trackName, nil
+	}
+	return appID + ":" + trackName, nil
+}
+
+// appTrackMatcher is trackMatcher confined to one app's tracks when appID
+// is set; the pattern then matches the part of the name after "appID:"
+func appTrackMatcher(appID, pattern string) (func(string) bool, error) {
+	if appID == "" {
+		return trackMatcher(pattern)
+	}
+	if strings.Contains(appID, ":") {
+		return nil, fmt.Errorf("invalid app ID %q: must not contain ':'", appID)
+	}
+
+	prefix := appID + ":"
+	match, err := trackMatcher(strings.TrimPrefix(pattern, prefix))
+	if err != nil {
+		return nil, err
+	}
+	return func(trackName string) bool {
+		rest, owned := strings.CutPrefix(trackName, prefix)
+		return owned && matches(match, rest)
+	}, nil
+}
+
+// matches reports whether match selects trackName; a nil match selects all
+func matches(match func(string) bool, trackName string) bool {
+	return match == nil || match(trackName)
+}
+
+// runningPlayback is one PlayAudio or EnqueueAudio call writing to a track,
+// so a stop aimed at some tracks can cancel just the playback on them
+type runningPlayback struct {
+	trackName string
+	requestID string
+	cancel    context.CancelFunc
+	done      <-chan struct{} // closed once the playback has returned
+}
+
+// startPlayback registers playback on a track until endPlayback
+func (s *RoomSession) startPlayback(trackName, requestID string, cancel context.CancelFunc, done <-chan struct{}) *runningPlayback {
+	playback := &runningPlayback{trackName: trackName, requestID: requestID, cancel: cancel, done: done}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running[playback] = struct{}{}
+	return playback
+}
+
+// endPlayback unregisters playback once it has returned
+func (s *RoomSession) endPlayback(playback *runningPlayback) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.running, playback)
+}
+
+// cancelPlaybackLocked cancels the running playback on matching tracks and
+// returns channels that close once each has returned; caller must hold s.mu
+func (s *RoomSession) cancelPlaybackLocked(match func(string) bool) []<-chan struct{} {
+	var done []<-chan struct{}
+	for playback := range s.running {
+		if matches(match, playback.trackName) {
+			playback.cancel()
+			done = append(done, playback.done)
+		}
+	}
+	return done
+}
+
+// finishGeneration returns how many finish-current stops have covered a
+// track; a loop stops repeating once it changes
+func (s *RoomSession) finishGeneration(trackName string) int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.finishGenerations[trackName]
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"strconv"
+	"time"
+)
+
+// idleTracks returns the tracks that have had no audio for longer than
+// timeout, with how long each has been idle. A track that is paused,
+// playing, holding queued audio or waiting on running playback (e.g. a
+// scheduled start or a slow download) is never idle.
+func (s *RoomSession) idleTracks(timeout time.Duration) map[string]time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	busy := make(map[string]bool)
+	for playback := range s.running {
+		busy[playback.trackName] = true
+	}
+
+	idle := make(map[string]time.Duration)
+	check := func(trackName string, activity *trackActivity) {
+		last := activity.createdAt
+		if written := activity.lastWrite.Load(); written != 0 {
+			last = time.Unix(0, written)
+		}
+		if idleFor := time.Since(last); idleFor > timeout && !busy[trackName] {
+			idle[trackName] = idleFor
+		}
+	}
+
+	for trackName, state := range s.trackStates {
+		player := state.player
+		if player == nil || player.isPaused() || player.isActive() || player.queuedFrames() > 0 {
+			continue
+		}
+		check(trackName, player.activity)
+	}
+	for trackName, opus := range s.opusTracks {
+		if len(opus.frames) > 0 {
+			continue
+		}
+		check(trackName, opus.activity)
+	}
+	return idle
+}
+
+// unpublishIdleTracks unpublishes and closes every track that has had no
+// audio for longer than timeout, so forgotten tracks don't linger for
+// subscribers; writing to the name again publishes a fresh track
+func (s *RoomSession) unpublishIdleTracks(timeout time.Duration) {
+	for trackName, idleFor := range s.idleTracks(timeout) {
+		s.log().Info("Unpublishing idle track", "track_name", trackName, "idle", idleFor.Round(time.Second))
+		s.emitEvent(EventTrackIdle, trackName, map[string]string{
+			"idle_ms": strconv.FormatInt(idleFor.Milliseconds(), 10),
+		})
+		s.closeTrack(trackName)
+	}
+}
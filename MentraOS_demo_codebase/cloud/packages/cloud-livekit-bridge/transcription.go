@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	"github.com/livekit/protocol/livekit"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+)
+
+// transcriptionPacket sends a transcription with LiveKit's native protocol,
+// which standard LiveKit clients render as captions
+type transcriptionPacket struct {
+	transcription *livekit.Transcription
+}
+
+// ToProto implements lksdk.DataPacket
+func (p *transcriptionPacket) ToProto() *livekit.DataPacket {
+	return &livekit.DataPacket{Value: &livekit.DataPacket_Transcription{Transcription: p.transcription}}
+}
+
+// resolveTrackSID finds the SID of a participant's track by name, looking at
+// the bridge's own publications when identity is the bridge itself
+func (s *RoomSession) resolveTrackSID(room *lksdk.Room, identity, trackName string) (string, error) {
+	if identity == room.LocalParticipant.Identity() {
+		s.mu.RLock()
+		publication, exists := s.publications[trackName]
+		s.mu.RUnlock()
+		if !exists {
+			return "", fmt.Errorf("bridge has no published track %q", trackName)
+		}
+		return publication.SID(), nil
+	}
+
+	participant := room.GetParticipantByIdentity(identity)
+	if participant == nil {
+		return "", fmt.Errorf("participant %q is not in the room", identity)
+	}
+	for _, publication := range participant.TrackPublications() {
+		if publication.Name() == trackName {
+			return publication.SID(), nil
+		}
+	}
+	return "", fmt.Errorf("participant %q has no track %q", identity, trackName)
+}
+
+// publishTranscription publishes transcript segments attributed to a
+// participant's track. identity defaults to the bridge itself (captions for
+// its own audio); the track is given by SID or, failing that, by name.
+func (s *RoomSession) publishTranscription(identity, trackSID, trackName string, segments []*pb.TranscriptSegment) error {
+	room := s.currentRoom()
+	if room == nil {
+		return errNoRoom
+	}
+
+	if identity == "" {
+		identity = room.LocalParticipant.Identity()
+	}
+	if trackSID == "" {
+		if trackName == "" {
+			return fmt.Errorf("track_sid or track_name is required")
+		}
+		sid, err := s.resolveTrackSID(room, identity, trackName)
+		if err != nil {
+			return err
+		}
+		trackSID = sid
+	}
+
+	transcription := &livekit.Transcription{
+		TranscribedParticipantIdentity: identity,
+		TrackId:                        trackSID,
+	}
+	for _, segment := range segments {
+		transcription.Segments = append(transcription.Segments, &livekit.TranscriptionSegment{
+			Id:        segment.Id,
+			Text:      segment.Text,
+			Language:  segment.Language,
+			StartTime: uint64(segment.StartMs),
+			EndTime:   uint64(segment.EndMs),
+			Final:     segment.Final,
+		})
+	}
+
+	return room.LocalParticipant.PublishDataPacket(&transcriptionPacket{transcription: transcription},
+		lksdk.WithDataPublishReliable(true))
+}
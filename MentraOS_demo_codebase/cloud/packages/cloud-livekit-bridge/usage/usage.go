@@ -0,0 +1,348 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Period is the span usage is bucketed into in MongoDB
+const Period = time.Hour
+
+// Totals is audio metered for one user and app
+type Totals struct {
+	UserID    string
+	AppID     string // "" for audio on shared tracks (and mic audio)
+	Published time.Duration
+	Received  time.Duration
+}
+
+// Query selects metered usage; zero fields match everything. Since and
+// Until are rounded down to the Period.
+type Query struct {
+	UserID string
+	AppID  string
+	Since  time.Time
+	Until  time.Time
+}
+
+// Config for Meter
+type Config struct {
+	URI           string // MongoDB connection string
+	Database      string
+	Collection    string
+	FlushInterval time.Duration
+	Enabled       bool
+}
+
+// bucket identifies one user's usage of one app in one period
+type bucket struct {
+	userID string
+	appID  string
+	period time.Time
+}
+
+// pending is usage added to a bucket since the last flush
+type pending struct {
+	published time.Duration
+	received  time.Duration
+}
+
+// Meter adds up seconds of audio published and received per user and app
+// in memory and flushes them to MongoDB, one document per user, app and
+// hour. A disabled (or nil) meter drops usage and answers queries with an
+// error.
+type Meter struct {
+	cfg        Config
+	client     *mongo.Client
+	collection *mongo.Collection
+	pending    map[bucket]*pending
+	mu         sync.Mutex
+	stopCh     chan struct{}
+	wg         sync.WaitGroup
+}
+
+// New connects to MongoDB and starts the flush loop. Connection errors are
+// logged and leave the meter disabled.
+func New(cfg Config) *Meter {
+	if cfg.FlushInterval == 0 {
+		cfg.FlushInterval = time.Minute
+	}
+	if cfg.Database == "" {
+		cfg.Database = "mentraos"
+	}
+	if cfg.Collection == "" {
+		cfg.Collection = "livekit_bridge_usage"
+	}
+
+	m := &Meter{cfg: cfg, pending: make(map[bucket]*pending), stopCh: make(chan struct{})}
+	if !cfg.Enabled {
+		return m
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		slog.Error("Usage metering disabled: failed to connect to MongoDB", "error", err)
+		m.cfg.Enabled = false
+		return m
+	}
+	m.client = client
+	m.collection = client.Database(cfg.Database).Collection(cfg.Collection)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "app_id", Value: 1}, {Key: "period", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := m.collection.Indexes().CreateOne(ctx, index); err != nil {
+		slog.Warn("Failed to create usage index", "error", err)
+	}
+
+	m.wg.Add(1)
+	go m.flushLoop()
+	slog.Info("Usage metering enabled", "database", cfg.Database, "collection", cfg.Collection)
+	return m
+}
+
+// NewFromEnv creates a meter from MONGODB_URI and friends; metering is off
+// unless MONGODB_URI is set
+func NewFromEnv() *Meter {
+	uri := os.Getenv("MONGODB_URI")
+	flushMs, _ := strconv.Atoi(os.Getenv("USAGE_FLUSH_INTERVAL_MS"))
+	return New(Config{
+		URI:           uri,
+		Database:      os.Getenv("MONGODB_DATABASE"),
+		Collection:    os.Getenv("MONGODB_USAGE_COLLECTION"),
+		FlushInterval: time.Duration(flushMs) * time.Millisecond,
+		Enabled:       uri != "",
+	})
+}
+
+// Enabled reports whether usage is being metered
+func (m *Meter) Enabled() bool {
+	return m != nil && m.cfg.Enabled
+}
+
+// AddPublished meters audio the bridge published for a user's app
+func (m *Meter) AddPublished(userID, appID string, d time.Duration) {
+	m.add(userID, appID, d, 0)
+}
+
+// AddReceived meters audio the bridge received for a user
+func (m *Meter) AddReceived(userID, appID string, d time.Duration) {
+	m.add(userID, appID, 0, d)
+}
+
+// add adds to the current period's bucket
+func (m *Meter) add(userID, appID string, published, received time.Duration) {
+	if !m.Enabled() || published+received <= 0 {
+		return
+	}
+	key := bucket{userID: userID, appID: appID, period: time.Now().UTC().Truncate(Period)}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.pending[key]
+	if !ok {
+		p = &pending{}
+		m.pending[key] = p
+	}
+	p.published += published
+	p.received += received
+}
+
+// flushLoop writes pending usage every flush interval until Close
+func (m *Meter) flushLoop() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.flush()
+		case <-m.stopCh:
+			m.flush()
+			return
+		}
+	}
+}
+
+// flush adds pending usage to its documents; usage that fails to write is
+// kept for the next flush
+func (m *Meter) flush() {
+	m.mu.Lock()
+	if len(m.pending) == 0 {
+		m.mu.Unlock()
+		return
+	}
+	batch := m.pending
+	m.pending = make(map[bucket]*pending)
+	m.mu.Unlock()
+
+	now := time.Now()
+	writes := make([]mongo.WriteModel, 0, len(batch))
+	for key, p := range batch {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "user_id", Value: key.userID},
+				{Key: "app_id", Value: key.appID},
+				{Key: "period", Value: key.period},
+			}).
+			SetUpdate(bson.D{
+				{Key: "$inc", Value: bson.D{
+					{Key: "published_seconds", Value: p.published.Seconds()},
+					{Key: "received_seconds", Value: p.received.Seconds()},
+				}},
+				{Key: "$set", Value: bson.D{{Key: "updated_at", Value: now}}},
+			}).
+			SetUpsert(true))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := m.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		// An unordered bulk write may have applied some updates; retrying
+		// them all could count those twice, which billing can't tolerate
+		// either way, so only a write that failed outright is retried
+		if !mongo.IsNetworkError(err) && !mongo.IsTimeout(err) {
+			slog.Error("Failed to store usage, dropping it", "buckets", len(batch), "error", err)
+			return
+		}
+		slog.Warn("Failed to store usage, retrying next flush", "buckets", len(batch), "error", err)
+
+		m.mu.Lock()
+		for key, p := range batch {
+			if current, ok := m.pending[key]; ok {
+				current.published += p.published
+				current.received += p.received
+			} else {
+				m.pending[key] = p
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+// Query returns usage per user and app matching q: what has been stored
+// plus what is waiting for the next flush
+func (m *Meter) Query(ctx context.Context, q Query) ([]Totals, error) {
+	if !m.Enabled() {
+		return nil, fmt.Errorf("usage metering is not configured")
+	}
+	since := q.Since.UTC().Truncate(Period)
+	until := q.Until.UTC().Truncate(Period)
+
+	filter := bson.D{}
+	if q.UserID != "" {
+		filter = append(filter, bson.E{Key: "user_id", Value: q.UserID})
+	}
+	if q.AppID != "" {
+		filter = append(filter, bson.E{Key: "app_id", Value: q.AppID})
+	}
+	periodRange := bson.D{}
+	if !q.Since.IsZero() {
+		periodRange = append(periodRange, bson.E{Key: "$gte", Value: since})
+	}
+	if !q.Until.IsZero() {
+		periodRange = append(periodRange, bson.E{Key: "$lt", Value: until})
+	}
+	if len(periodRange) > 0 {
+		filter = append(filter, bson.E{Key: "period", Value: periodRange})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "user_id", Value: "$user_id"}, {Key: "app_id", Value: "$app_id"}}},
+			{Key: "published_seconds", Value: bson.D{{Key: "$sum", Value: "$published_seconds"}}},
+			{Key: "received_seconds", Value: bson.D{{Key: "$sum", Value: "$received_seconds"}}},
+		}}},
+	}
+	cursor, err := m.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usage: %w", err)
+	}
+	var rows []struct {
+		ID struct {
+			UserID string `bson:"user_id"`
+			AppID  string `bson:"app_id"`
+		} `bson:"_id"`
+		PublishedSeconds float64 `bson:"published_seconds"`
+		ReceivedSeconds  float64 `bson:"received_seconds"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to read usage: %w", err)
+	}
+
+	totals := make(map[bucket]*Totals, len(rows))
+	for _, row := range rows {
+		totals[bucket{userID: row.ID.UserID, appID: row.ID.AppID}] = &Totals{
+			UserID:    row.ID.UserID,
+			AppID:     row.ID.AppID,
+			Published: time.Duration(row.PublishedSeconds * float64(time.Second)),
+			Received:  time.Duration(row.ReceivedSeconds * float64(time.Second)),
+		}
+	}
+
+	m.mu.Lock()
+	for key, p := range m.pending {
+		if (q.UserID != "" && key.userID != q.UserID) || (q.AppID != "" && key.appID != q.AppID) ||
+			(!q.Since.IsZero() && key.period.Before(since)) || (!q.Until.IsZero() && !key.period.Before(until)) {
+			continue
+		}
+		total, ok := totals[bucket{userID: key.userID, appID: key.appID}]
+		if !ok {
+			total = &Totals{UserID: key.userID, AppID: key.appID}
+			totals[bucket{userID: key.userID, appID: key.appID}] = total
+		}
+		total.Published += p.published
+		total.Received += p.received
+	}
+	m.mu.Unlock()
+
+	out := make([]Totals, 0, len(totals))
+	for _, total := range totals {
+		out = append(out, *total)
+	}
+	return out, nil
+}
+
+// Today returns a user's audio so far today (UTC), published plus received,
+// per app
+func (m *Meter) Today(ctx context.Context, userID string) (map[string]time.Duration, error) {
+	totals, err := m.Query(ctx, Query{UserID: userID, Since: time.Now().UTC().Truncate(24 * time.Hour)})
+	if err != nil {
+		return nil, err
+	}
+
+	today := make(map[string]time.Duration, len(totals))
+	for _, total := range totals {
+		today[total.AppID] += total.Published + total.Received
+	}
+	return today, nil
+}
+
+// Close flushes pending usage and disconnects
+func (m *Meter) Close() {
+	if !m.Enabled() {
+		return
+	}
+	close(m.stopCh)
+	m.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	m.client.Disconnect(ctx)
+}
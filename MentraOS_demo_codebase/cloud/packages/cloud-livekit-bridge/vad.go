@@ -0,0 +1,114 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// incomingSampleRate is the rate of the mic audio the bridge analyzes (VAD,
+// ASR, wake words, ...): the session's ASR feed
+const incomingSampleRate = 16000
+
+// vadFrameSamples is the analysis window of the VAD (10ms mono)
+const vadFrameSamples = incomingSampleRate / 100
+
+// vadMinLevelDb is the level below which audio is never treated as speech,
+// however quiet the noise floor gets
+const vadMinLevelDb = -50.0
+
+// vad is a lightweight energy-based voice activity detector for incoming mic
+// audio. It tracks an adaptive noise floor and reports speech once frames stay
+// above it by thresholdDb for minSpeech, ending after hangover of quiet.
+type vad struct {
+	thresholdDb float64
+	minFrames   int
+	hangFrames  int
+
+	noiseFloorDb  float64
+	speaking      bool
+	speechFrames  int
+	silenceFrames int
+	pending       []int16
+}
+
+// newVAD creates a detector with the given sensitivity and timings
+func newVAD(thresholdDb float64, minSpeech, hangover time.Duration) *vad {
+	return &vad{
+		thresholdDb:  thresholdDb,
+		minFrames:    max(1, int(minSpeech/(10*time.Millisecond))),
+		hangFrames:   max(1, int(hangover/(10*time.Millisecond))),
+		noiseFloorDb: vadMinLevelDb,
+	}
+}
+
+// process feeds mono 16kHz samples and reports speech start/end transitions
+// that happened within them
+func (v *vad) process(samples []int16) (started, ended bool) {
+	v.pending = append(v.pending, samples...)
+
+	for len(v.pending) >= vadFrameSamples {
+		level := frameLevelDb(v.pending[:vadFrameSamples])
+		v.pending = v.pending[vadFrameSamples:]
+
+		loud := level > vadMinLevelDb && level > v.noiseFloorDb+v.thresholdDb
+		if !v.speaking {
+			v.adaptNoiseFloor(level, loud)
+		}
+
+		if loud {
+			v.speechFrames++
+			v.silenceFrames = 0
+			if !v.speaking && v.speechFrames >= v.minFrames {
+				v.speaking = true
+				started = true
+			}
+		} else {
+			v.silenceFrames++
+			if v.silenceFrames >= v.hangFrames {
+				v.speechFrames = 0
+				if v.speaking {
+					v.speaking = false
+					ended = true
+				}
+			}
+		}
+	}
+
+	// Don't let the tail grow; it is always shorter than a frame here
+	if cap(v.pending) > 4*vadFrameSamples {
+		v.pending = append([]int16(nil), v.pending...)
+	}
+	return started, ended
+}
+
+// adaptNoiseFloor follows the background level: down quickly, up slowly, and
+// barely at all while the frame looks like speech
+func (v *vad) adaptNoiseFloor(level float64, loud bool) {
+	switch {
+	case level < v.noiseFloorDb:
+		v.noiseFloorDb += (level - v.noiseFloorDb) * 0.2
+	case loud:
+		v.noiseFloorDb += (level - v.noiseFloorDb) * 0.001
+	default:
+		v.noiseFloorDb += (level - v.noiseFloorDb) * 0.02
+	}
+	v.noiseFloorDb = math.Max(v.noiseFloorDb, -90)
+}
+
+// frameLevelDb returns the RMS level of a frame in dBFS
+func frameLevelDb(frame []int16) float64 {
+	if len(frame) == 0 {
+		return -120
+	}
+
+	var sum float64
+	for _, s := range frame {
+		f := float64(s) / 32768
+		sum += f * f
+	}
+	rms := math.Sqrt(sum / float64(len(frame)))
+	if rms <= 0 {
+		return -120
+	}
+	return 20 * math.Log10(rms)
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+)
+
+// voiceOversample is how many analysis frames of the pitch shifter overlap
+const voiceOversample = 4
+
+// maxVoiceSemitones caps a pitch shift either way (an octave)
+const maxVoiceSemitones = 12
+
+// voiceDriftStep is how far an anonymizing shift wanders per 10ms, as a
+// fraction of its drift
+const voiceDriftStep = 0.05
+
+// voiceEffect transforms a voice: a pitch shift, optionally wandering
+// slowly so it is harder to undo, and/or a flat robotic monotone
+type voiceEffect struct {
+	semitones float64
+	drift     float64 // how many semitones the shift wanders either way
+	robot     bool
+}
+
+// voicePresets are the named voice effects
+var voicePresets = map[string]voiceEffect{
+	"anonymize": {semitones: -3, drift: 1},
+	"deep":      {semitones: -6},
+	"chipmunk":  {semitones: 7},
+	"robot":     {robot: true},
+}
+
+// parseVoiceEffect builds an effect from a preset (optional) and a shift,
+// which overrides the preset's when set
+func parseVoiceEffect(preset string, semitones float32) (voiceEffect, error) {
+	var effect voiceEffect
+	if preset != "" {
+		var ok bool
+		if effect, ok = voicePresets[strings.ToLower(preset)]; !ok {
+			return voiceEffect{}, fmt.Errorf("unknown voice preset %q", preset)
+		}
+	}
+	if math.IsNaN(float64(semitones)) || math.Abs(float64(semitones)) > maxVoiceSemitones {
+		return voiceEffect{}, fmt.Errorf("semitones must be between -%d and %d", maxVoiceSemitones, maxVoiceSemitones)
+	}
+	if semitones != 0 {
+		effect.semitones = float64(semitones)
+	}
+	if effect == (voiceEffect{}) {
+		return voiceEffect{}, fmt.Errorf("voice effect needs a preset or semitones")
+	}
+	return effect, nil
+}
+
+// String describes the effect for logs
+func (e voiceEffect) String() string {
+	s := strconv.FormatFloat(e.semitones, 'f', -1, 64) + " semitones"
+	if e.drift > 0 {
+		s += fmt.Sprintf(" ±%v", e.drift)
+	}
+	if e.robot {
+		s += ", robot"
+	}
+	return s
+}
+
+// voiceShifter applies a voice effect to interleaved audio as it streams,
+// with a phase vocoder per channel: each ~32ms Hann-windowed frame's bins
+// are moved to their shifted frequencies, keeping phases coherent between
+// frames so the pitch changes without the length changing. Formants move
+// with the pitch, which disguises the speaker. The robot effect zeroes the
+// phases instead, leaving a monotone buzz at the frame rate. Output lags
+// input by most of a frame.
+type voiceShifter struct {
+	sampleRate int
+	size       int // FFT size
+	step       int // hop between frames
+	window     []float64
+	channels   []*vocoderChannel
+	spectrum   []complex128
+	magnitude  []float64
+	frequency  []float64
+	wander     float64 // -1..1: where an anonymizing shift has drifted to
+}
+
+// vocoderChannel is one channel's streaming phase vocoder state
+type vocoderChannel struct {
+	input     []float64 // the current frame, filling from rover
+	output    []float64 // synthesized samples ready to go out
+	accum     []float64 // overlap-add of synthesized frames
+	lastPhase []float64
+	sumPhase  []float64
+	rover     int
+}
+
+// newVoiceShifter creates a shifter for audio at sampleRate with channels
+func newVoiceShifter(sampleRate, channels int) *voiceShifter {
+	size := 256
+	for size < sampleRate/32 {
+		size *= 2
+	}
+	step := size / voiceOversample
+
+	window := make([]float64, size)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size))
+	}
+	v := &voiceShifter{
+		sampleRate: sampleRate,
+		size:       size,
+		step:       step,
+		window:     window,
+		spectrum:   make([]complex128, size),
+		magnitude:  make([]float64, size/2+1),
+		frequency:  make([]float64, size/2+1),
+	}
+	for c := 0; c < channels; c++ {
+		v.channels = append(v.channels, &vocoderChannel{
+			input:     make([]float64, size),
+			output:    make([]float64, size),
+			accum:     make([]float64, 2*size),
+			lastPhase: make([]float64, size/2+1),
+			sumPhase:  make([]float64, size/2+1),
+			rover:     size - step,
+		})
+	}
+	return v
+}
+
+// process applies effect to interleaved samples in place
+func (v *voiceShifter) process(samples []int16, effect voiceEffect) {
+	if effect.drift > 0 {
+		v.wander = max(-1, min(1, v.wander+rand.NormFloat64()*voiceDriftStep))
+	}
+	shift := math.Pow(2, (effect.semitones+v.wander*effect.drift)/12)
+
+	latency := v.size - v.step
+	channels := len(v.channels)
+	for c, ch := range v.channels {
+		for i := c; i < len(samples); i += channels {
+			ch.input[ch.rover] = float64(samples[i])
+			samples[i] = clampInt16(ch.output[ch.rover-latency])
+			ch.rover++
+			if ch.rover < v.size {
+				continue
+			}
+			ch.rover = latency
+			v.processFrame(ch, shift, effect.robot)
+		}
+	}
+}
+
+// processFrame shifts one channel's current frame and overlap-adds it,
+// making the next step of output ready
+func (v *voiceShifter) processFrame(ch *vocoderChannel, shift float64, robot bool) {
+	n := v.size
+	bins := n/2 + 1
+	expected := 2 * math.Pi * float64(v.step) / float64(n)
+	binHz := float64(v.sampleRate) / float64(n)
+
+	for i := range v.spectrum {
+		v.spectrum[i] = complex(ch.input[i]*v.window[i], 0)
+	}
+	fft(v.spectrum, false)
+
+	// Analysis: each bin's magnitude and true frequency, from how far its
+	// phase moved beyond what the bin's center frequency explains
+	magnitude, frequency := v.magnitude, v.frequency
+	for k := range magnitude {
+		magnitude[k], frequency[k] = 0, 0
+	}
+	for k := 0; k < bins; k++ {
+		magn, phase := cmplx.Abs(v.spectrum[k]), cmplx.Phase(v.spectrum[k])
+		delta := phase - ch.lastPhase[k] - float64(k)*expected
+		ch.lastPhase[k] = phase
+		delta -= 2 * math.Pi * math.Round(delta/(2*math.Pi))
+		hz := (float64(k) + delta*voiceOversample/(2*math.Pi)) * binHz
+
+		// Move the bin to its shifted frequency
+		if target := int(float64(k) * shift); target < bins {
+			magnitude[target] += magn
+			frequency[target] = hz * shift
+		}
+	}
+
+	// Synthesis: advance each bin's phase by its new frequency
+	for k := 0; k < bins; k++ {
+		phase := 0.0
+		if !robot {
+			delta := (frequency[k]/binHz - float64(k)) * 2 * math.Pi / voiceOversample
+			ch.sumPhase[k] = math.Mod(ch.sumPhase[k]+delta+float64(k)*expected, 2*math.Pi)
+			phase = ch.sumPhase[k]
+		}
+		v.spectrum[k] = cmplx.Rect(magnitude[k], phase)
+		if k > 0 && k < n/2 {
+			v.spectrum[n-k] = cmplx.Conj(v.spectrum[k])
+		}
+	}
+	fft(v.spectrum, true)
+
+	// Overlapping squared Hann windows sum to 1.5 at this oversampling
+	for i := 0; i < n; i++ {
+		ch.accum[i] += real(v.spectrum[i]) * v.window[i] / 1.5
+	}
+	copy(ch.output, ch.accum[:v.step])
+	copy(ch.accum, ch.accum[v.step:])
+	clear(ch.accum[n:])
+
+	// Keep the frame's newest samples for the next one
+	copy(ch.input, ch.input[v.step:])
+}
+
+// SetVoiceEffect transforms the voice on a named track, or stops when
+// effect is nil. The setting survives the track being recreated by later
+// playback.
+func (s *RoomSession) SetVoiceEffect(trackName string, effect *voiceEffect) {
+	if trackName == "" {
+		trackName = "speaker"
+	}
+
+	s.mu.Lock()
+	if effect == nil {
+		delete(s.trackVoices, trackName)
+	} else {
+		s.trackVoices[trackName] = *effect
+	}
+	state, exists := s.trackStates[trackName]
+	s.mu.Unlock()
+
+	if exists && state.player != nil {
+		state.player.setVoice(effect)
+	}
+
+	if effect == nil {
+		s.log().Info("Cleared track voice effect", "track_name", trackName)
+	} else {
+		s.log().Info("Set track voice effect", "track_name", trackName, "effect", effect.String())
+	}
+}
+
+// SetIncomingVoice transforms the voice in received mic audio, or stops
+// when effect is nil
+func (s *RoomSession) SetIncomingVoice(effect *voiceEffect) {
+	s.voiceMu.Lock()
+	defer s.voiceMu.Unlock()
+
+	s.incomingVoice = effect
+	if effect == nil {
+		clear(s.voiceShifters)
+		s.log().Info("Cleared incoming voice effect")
+	} else {
+		s.log().Info("Set incoming voice effect", "effect", effect.String())
+	}
+}
+
+// transformIncoming applies the incoming voice effect to a sender's mono
+// PCM at sampleRate, returning it unchanged when there is none
+func (s *RoomSession) transformIncoming(identity string, pcmData []byte, sampleRate int) []byte {
+	s.voiceMu.Lock()
+	defer s.voiceMu.Unlock()
+
+	if s.incomingVoice == nil || len(pcmData) == 0 {
+		return pcmData
+	}
+	key := identity + "/" + strconv.Itoa(sampleRate)
+	shifter, exists := s.voiceShifters[key]
+	if !exists {
+		shifter = newVoiceShifter(sampleRate, 1)
+		s.voiceShifters[key] = shifter
+	}
+
+	samples := bytesToInt16(pcmData)
+	shifter.process(samples, *s.incomingVoice)
+	return int16ToBytes(samples)
+}
+
+// SetVoiceEffect shifts the pitch of, anonymizes or otherwise transforms
+// the voice on a track or in received mic audio
+func (s *LiveKitBridgeService) SetVoiceEffect(
+	ctx context.Context,
+	req *pb.SetVoiceEffectRequest,
+) (*pb.SetVoiceEffectResponse, error) {
+	slog.Info("SetVoiceEffect request", "user_id", req.UserId, "track_name", req.TrackName, "app_id", req.AppId,
+		"incoming", req.Incoming, "preset", req.Preset, "semitones", req.Semitones, "clear", req.Clear)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetVoiceEffectResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	var effect *voiceEffect
+	if !req.Clear {
+		parsed, err := parseVoiceEffect(req.Preset, req.Semitones)
+		if err != nil {
+			return &pb.SetVoiceEffectResponse{
+				Success: false,
+				Error:   err.Error(),
+			}, nil
+		}
+		effect = &parsed
+	}
+
+	if req.Incoming {
+		if req.TrackName != "" || req.AppId != "" {
+			return &pb.SetVoiceEffectResponse{
+				Success: false,
+				Error:   "incoming can't be combined with track_name or app_id",
+			}, nil
+		}
+		session.SetIncomingVoice(effect)
+		return &pb.SetVoiceEffectResponse{Success: true}, nil
+	}
+
+	trackName, err := appTrackName(req.AppId, req.TrackName, 0)
+	if err != nil {
+		return &pb.SetVoiceEffectResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.SetVoiceEffect(trackName, effect)
+	return &pb.SetVoiceEffectResponse{Success: true}, nil
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	porcupine "github.com/Picovoice/porcupine/binding/go/v3"
+)
+
+// wakeWordHit is a keyword detected in the audio
+type wakeWordHit struct {
+	keyword string
+	offset  time.Duration // end of the keyword, from the detector's first sample
+}
+
+// wakeWordDetector runs Porcupine over a session's incoming mic audio. It
+// takes 16kHz mono PCM16 in any chunk size and feeds the engine whole
+// frames.
+type wakeWordDetector struct {
+	engine      *porcupine.Porcupine
+	keywords    []string // in the order the engine reports them
+	sensitivity float64
+	pending     []int16 // samples short of a frame
+	processed   int64   // samples fed to the engine
+}
+
+// newWakeWordDetector starts an engine for keywords: a "<name>.ppn" file in
+// dir if there is one, otherwise one of Porcupine's built-in keywords
+// ("computer", "hey google", ...)
+func newWakeWordDetector(config *Config, keywords []string, sensitivity float64) (*wakeWordDetector, error) {
+	if config.WakeWordAccessKey == "" {
+		return nil, errors.New("wake word detection needs WAKE_WORD_ACCESS_KEY")
+	}
+	if sensitivity <= 0 || sensitivity > 1 {
+		sensitivity = config.WakeWordSensitivity
+	}
+
+	engine := &porcupine.Porcupine{
+		AccessKey: config.WakeWordAccessKey,
+		ModelPath: config.WakeWordModelPath,
+	}
+	var custom, builtIn []string
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			continue
+		}
+		if config.WakeWordDir != "" {
+			path := filepath.Join(config.WakeWordDir, keyword+".ppn")
+			if _, err := os.Stat(path); err == nil {
+				engine.KeywordPaths = append(engine.KeywordPaths, path)
+				custom = append(custom, keyword)
+				continue
+			}
+		}
+		if !porcupine.BuiltInKeyword(keyword).IsValid() {
+			return nil, fmt.Errorf("unknown wake word %q", keyword)
+		}
+		engine.BuiltInKeywords = append(engine.BuiltInKeywords, porcupine.BuiltInKeyword(keyword))
+		builtIn = append(builtIn, keyword)
+	}
+	if len(custom)+len(builtIn) == 0 {
+		return nil, errors.New("no wake words given")
+	}
+
+	// Porcupine appends built-in keywords after KeywordPaths
+	d := &wakeWordDetector{
+		engine:      engine,
+		keywords:    append(custom, builtIn...),
+		sensitivity: sensitivity,
+	}
+	for range d.keywords {
+		engine.Sensitivities = append(engine.Sensitivities, float32(sensitivity))
+	}
+	if err := engine.Init(); err != nil {
+		return nil, fmt.Errorf("failed to start wake word engine: %w", err)
+	}
+	return d, nil
+}
+
+// process feeds samples to the engine and returns the keywords detected
+func (d *wakeWordDetector) process(samples []int16) ([]wakeWordHit, error) {
+	var hits []wakeWordHit
+	d.pending = append(d.pending, samples...)
+	frameLength := porcupine.FrameLength
+	offset := 0
+	defer func() {
+		// Keep the leftover at the front, reusing the buffer
+		d.pending = d.pending[:copy(d.pending, d.pending[offset:])]
+	}()
+	for ; len(d.pending)-offset >= frameLength; offset += frameLength {
+		index, err := d.engine.Process(d.pending[offset : offset+frameLength])
+		d.processed += int64(frameLength)
+		if err != nil {
+			offset += frameLength
+			return hits, err
+		}
+		if index >= 0 && index < len(d.keywords) {
+			hits = append(hits, wakeWordHit{
+				keyword: d.keywords[index],
+				offset:  time.Duration(d.processed) * time.Second / incomingSampleRate,
+			})
+		}
+	}
+	return hits, nil
+}
+
+// close releases the engine
+func (d *wakeWordDetector) close() {
+	if err := d.engine.Delete(); err != nil {
+		slog.Warn("Failed to release wake word engine", "error", err)
+	}
+}
+
+// setWakeWords replaces the session's wake words; none turns detection off
+func (s *RoomSession) setWakeWords(config *Config, keywords []string, sensitivity float64) ([]string, error) {
+	var detector *wakeWordDetector
+	if len(keywords) > 0 {
+		var err error
+		if detector, err = newWakeWordDetector(config, keywords, sensitivity); err != nil {
+			return nil, err
+		}
+	}
+
+	s.wakeMu.Lock()
+	old := s.wakeWords
+	s.wakeWords = detector
+	s.wakeMu.Unlock()
+	if old != nil {
+		old.close()
+	}
+
+	if detector == nil {
+		s.log().Info("Wake word detection off")
+		return nil, nil
+	}
+	s.log().Info("Set wake words", "keywords", detector.keywords, "sensitivity", detector.sensitivity)
+	return detector.keywords, nil
+}
+
+// wakeWordConfig returns the session's wake words, for snapshots
+func (s *RoomSession) wakeWordConfig() *pb.WakeWordConfig {
+	s.wakeMu.Lock()
+	defer s.wakeMu.Unlock()
+
+	config := &pb.WakeWordConfig{}
+	if s.wakeWords != nil {
+		config.Keywords = s.wakeWords.keywords
+		config.Sensitivity = float32(s.wakeWords.sensitivity)
+	}
+	return config
+}
+
+// detectWakeWords runs a received mic frame through the session's wake word
+// detector, if any, and emits a wake_word event per keyword heard
+func (s *RoomSession) detectWakeWords(frame AudioFrame) {
+	s.wakeMu.Lock()
+	detector := s.wakeWords
+	if detector == nil {
+		s.wakeMu.Unlock()
+		return
+	}
+	samples := pooledSamples(frame.PCM)
+	hits, err := detector.process(*samples)
+	putSamples(samples)
+	s.wakeMu.Unlock()
+	if err != nil {
+		s.log().Warn("Wake word detection failed", "error", err)
+	}
+
+	for _, hit := range hits {
+		latency := time.Since(frame.CapturedAt)
+		wakeWordDetections.WithLabelValues(hit.keyword).Inc()
+		s.log().Info("Wake word detected", "keyword", hit.keyword, "participant", frame.ParticipantIdentity,
+			"latency_ms", latency.Milliseconds())
+		s.emitEvent(EventWakeWord, frame.TrackName, map[string]string{
+			"keyword":              hit.keyword,
+			"participant_identity": frame.ParticipantIdentity,
+			"audio_ms":             strconv.FormatInt(hit.offset.Milliseconds(), 10),
+			"captured_at_ms":       strconv.FormatInt(frame.CapturedAt.UnixMilli(), 10),
+			"latency_ms":           strconv.FormatInt(latency.Milliseconds(), 10),
+		})
+		s.heardSpeech(hit.keyword, "wake_word")
+	}
+}
+
+// closeWakeWords releases the session's wake word engine
+func (s *RoomSession) closeWakeWords() {
+	s.wakeMu.Lock()
+	detector := s.wakeWords
+	s.wakeWords = nil
+	s.wakeMu.Unlock()
+	if detector != nil {
+		detector.close()
+	}
+}
+
+// SetWakeWords changes which keywords a session listens for on its incoming
+// mic audio; an empty list turns detection off
+func (s *LiveKitBridgeService) SetWakeWords(
+	ctx context.Context,
+	req *pb.SetWakeWordsRequest,
+) (*pb.SetWakeWordsResponse, error) {
+	slog.Info("SetWakeWords request", "user_id", req.UserId, "keywords", req.Keywords, "sensitivity", req.Sensitivity)
+
+	session, err := s.getSession(req.UserId)
+	if err != nil {
+		return &pb.SetWakeWordsResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	session.touch()
+
+	keywords, err := session.setWakeWords(s.config, req.Keywords, float64(req.Sensitivity))
+	if err != nil {
+		return &pb.SetWakeWordsResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+	return &pb.SetWakeWordsResponse{
+		Success:  true,
+		Keywords: keywords,
+	}, nil
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Mentra-Community/MentraOS/cloud/packages/cloud-livekit-bridge/proto"
+	lkinterceptor "github.com/livekit/mediatransportutil/pkg/interceptor"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	sdkinterceptor "github.com/livekit/server-sdk-go/v2/pkg/interceptor"
+	"github.com/pion/interceptor"
+	"github.com/pion/interceptor/pkg/nack"
+	"github.com/pion/interceptor/pkg/report"
+	"github.com/pion/interceptor/pkg/stats"
+	"github.com/pion/interceptor/pkg/twcc"
+)
+
+// bitrateMinInterval is the shortest span a bitrate is measured over; reads
+// closer together than this reuse the previous figure
+const bitrateMinInterval = time.Second
+
+// rtcStats collects RTP stream statistics (loss, jitter, RTT, bitrate) for a
+// session's tracks using pion's stats interceptor on the room's peer
+// connections, and follows received streams' RTP clocks
+type rtcStats struct {
+	mu      sync.Mutex
+	getters []stats.Getter           // one per peer connection of the current room
+	rates   map[uint32]bitrateSample // by SSRC
+	clocks  *rtpClocks
+}
+
+// bitrateSample is the byte count a bitrate was last measured from
+type bitrateSample struct {
+	bytes uint64
+	at    time.Time
+	bps   float64
+}
+
+// newRTCStats creates a collector; returns nil when WebRTC stats are disabled
+func newRTCStats(config *Config) *rtcStats {
+	if !config.WebRTCStats {
+		return nil
+	}
+	return &rtcStats{rates: make(map[uint32]bitrateSample), clocks: newRTPClocks()}
+}
+
+// interceptors returns the interceptor chain for a new room connection: the
+// SDK's defaults plus the stats and RTP clock recorders. Passing any interceptors to the SDK
+// replaces its defaults, so they are rebuilt here (without the SDK-internal
+// RTT feedback into NACK timing). Call once per dial; stats from the previous
+// connection are dropped.
+func (r *rtcStats) interceptors() ([]interceptor.Factory, error) {
+	statsFactory, err := stats.NewInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.getters = nil
+	r.rates = make(map[uint32]bitrateSample)
+	r.mu.Unlock()
+	r.clocks.reset()
+
+	statsFactory.OnNewPeerConnection(func(_ string, getter stats.Getter) {
+		r.mu.Lock()
+		r.getters = append(r.getters, getter)
+		r.mu.Unlock()
+	})
+
+	nackResponder, err := nack.NewResponderInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	reportReceiver, err := report.NewReceiverInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	reportSender, err := report.NewSenderInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	twccSender, err := twcc.NewSenderInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
+	return []interceptor.Factory{
+		&sdkinterceptor.NackGeneratorInterceptorFactory{},
+		nackResponder,
+		reportReceiver,
+		reportSender,
+		twccSender,
+		sdkinterceptor.NewLimitSizeInterceptorFactory(),
+		lkinterceptor.NewRTTFromXRFactory(func(uint32) {}),
+		statsFactory,
+		&clockInterceptorFactory{clocks: r.clocks},
+	}, nil
+}
+
+// lookup returns the recorded stats of a stream, or nil if it isn't known
+func (r *rtcStats) lookup(ssrc uint32) *stats.Stats {
+	r.mu.Lock()
+	getters := append([]stats.Getter(nil), r.getters...)
+	r.mu.Unlock()
+
+	for _, getter := range getters {
+		if st := getter.Get(ssrc); st != nil {
+			return st
+		}
+	}
+	return nil
+}
+
+// bitrate turns a stream's running byte count into bits per second since
+// the previous measurement
+func (r *rtcStats) bitrate(ssrc uint32, bytes uint64) float64 {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.rates[ssrc]
+	if ok && now.Sub(prev.at) < bitrateMinInterval {
+		return prev.bps
+	}
+
+	var bps float64
+	if ok && bytes >= prev.bytes {
+		bps = float64(bytes-prev.bytes) * 8 / now.Sub(prev.at).Seconds()
+	}
+	r.rates[ssrc] = bitrateSample{bytes: bytes, at: now, bps: bps}
+	return bps
+}
+
+// trackRTCStats reports RTP statistics for every published track and every
+// subscribed remote track, or nil when stats are off or there is no room
+func (s *RoomSession) trackRTCStats() []*pb.TrackRTCStats {
+	if s.rtc == nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	room := s.room
+	names := make(map[string]string, len(s.publications)) // track ID -> name
+	sids := make(map[string]string, len(s.publications))
+	for name, publication := range s.publications {
+		if track := publication.TrackLocal(); track != nil {
+			names[track.ID()] = name
+			sids[track.ID()] = publication.SID()
+		}
+	}
+	s.mu.RUnlock()
+
+	if room == nil {
+		return nil
+	}
+
+	var out []*pb.TrackRTCStats
+
+	if pc := room.LocalParticipant.GetPublisherPeerConnection(); pc != nil {
+		for _, sender := range pc.GetSenders() {
+			track := sender.Track()
+			if track == nil {
+				continue
+			}
+			name, ok := names[track.ID()]
+			if !ok {
+				continue
+			}
+			for _, encoding := range sender.GetParameters().Encodings {
+				ssrc := uint32(encoding.SSRC)
+				st := s.rtc.lookup(ssrc)
+				if st == nil {
+					continue
+				}
+				remote := st.RemoteInboundRTPStreamStats
+				out = append(out, &pb.TrackRTCStats{
+					TrackName:    name,
+					TrackSid:     sids[track.ID()],
+					Direction:    "outgoing",
+					Packets:      st.OutboundRTPStreamStats.PacketsSent,
+					PacketsLost:  remote.PacketsLost,
+					FractionLost: remote.FractionLost,
+					JitterMs:     remote.Jitter * 1000,
+					RttMs:        float64(remote.RoundTripTime) / float64(time.Millisecond),
+					BitrateBps:   s.rtc.bitrate(ssrc, st.OutboundRTPStreamStats.BytesSent),
+				})
+			}
+		}
+	}
+
+	for _, participant := range room.GetRemoteParticipants() {
+		for _, publication := range participant.TrackPublications() {
+			remotePub, ok := publication.(*lksdk.RemoteTrackPublication)
+			if !ok || remotePub.TrackRemote() == nil {
+				continue
+			}
+			ssrc := uint32(remotePub.TrackRemote().SSRC())
+			st := s.rtc.lookup(ssrc)
+			if st == nil {
+				continue
+			}
+			in := st.InboundRTPStreamStats
+			var fractionLost float64
+			if expected := float64(in.PacketsReceived) + float64(in.PacketsLost); expected > 0 && in.PacketsLost > 0 {
+				fractionLost = float64(in.PacketsLost) / expected
+			}
+			out = append(out, &pb.TrackRTCStats{
+				TrackName:           remotePub.Name(),
+				TrackSid:            remotePub.SID(),
+				Direction:           "incoming",
+				ParticipantIdentity: participant.Identity(),
+				Packets:             in.PacketsReceived,
+				PacketsLost:         in.PacketsLost,
+				FractionLost:        fractionLost,
+				JitterMs:            in.Jitter * 1000,
+				RttMs:               float64(st.RemoteOutboundRTPStreamStats.RoundTripTime) / float64(time.Millisecond),
+				BitrateBps:          s.rtc.bitrate(ssrc, in.BytesReceived),
+			})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Direction != out[j].Direction {
+			return out[i].Direction > out[j].Direction // outgoing first
+		}
+		return out[i].TrackSid < out[j].TrackSid
+	})
+	return out
+}